@@ -19,7 +19,10 @@ func init() {
 }
 
 func main() {
-	app := fiber.New()
+	// Limite acima do tamanho maximo dos documentos enviados no cadastro do aluno
+	app := fiber.New(fiber.Config{
+		BodyLimit: 12 * 1024 * 1024,
+	})
 	app.Use(cors.New(cors.Config{
 		AllowOrigins:     "*",
 		AllowHeaders:     "Origin, Content-Type, Accept",
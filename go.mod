@@ -3,7 +3,9 @@ module github.com/henriquerocha2004/sistema-escolar
 go 1.20
 
 require (
+	github.com/jung-kurt/gofpdf v1.16.2
 	github.com/lib/pq v1.10.9
+	github.com/skip2/go-qrcode v0.0.0-20200617195104-da1b6568686e
 	github.com/stretchr/testify v1.8.4
 )
 
@@ -5,10 +5,38 @@ import (
 	"github.com/henriquerocha2004/sistema-escolar/internal/infra/database/postgres"
 	"github.com/henriquerocha2004/sistema-escolar/internal/infra/database/postgres/repositories"
 	"github.com/henriquerocha2004/sistema-escolar/internal/infra/http/controllers"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/psp"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/storage"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/cnab"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/cnab/cnabService"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/delinquency"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/delinquency/delinquencyService"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice/invoiceService"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/payment"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/payment/paymentService"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/pix"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/pix/pixService"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/service"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/service/serviceActions"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/attendance"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/attendance/attendanceService"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom/classRoomService"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/contract"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/contract/contractService"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/curriculum"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/curriculum/curriculumService"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/document"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/document/documentService"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/idcard"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/idcard/idcardService"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/parent"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/parent/parentService"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/pickup"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/pickup/pickupService"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/reenrollment"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/reenrollment/reenrollmentService"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration/registrationService"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/room"
@@ -18,6 +46,15 @@ import (
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear/schoolYearService"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student/studentService"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/teacher"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/teacher/teacherService"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/timetable"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/timetable/timetableService"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/waitinglist"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/waitinglist/waitingListService"
+	"os"
+	"strconv"
 )
 
 type ContainerDependency struct {
@@ -30,6 +67,21 @@ type ContainerDependency struct {
 	serviceRepository      service.Repository
 	registrationRepository registration.Repository
 	studentRepository      student.Repository
+	waitingListRepository  waitinglist.Repository
+	invoiceRepository      invoice.Repository
+	paymentRepository      payment.Repository
+	cnabRepository         cnab.Repository
+	delinquencyRepository  delinquency.Repository
+	parentRepository       parent.Repository
+	pickupRepository       pickup.Repository
+	documentRepository     document.Repository
+	contractRepository     contract.Repository
+	reenrollmentRepository reenrollment.Repository
+	idCardRepository       idcard.Repository
+	teacherRepository      teacher.Repository
+	curriculumRepository   curriculum.Repository
+	timetableRepository    timetable.Repository
+	attendanceRepository   attendance.Repository
 
 	roomActions         roomService.ServiceRoomInterface
 	schoolYearActions   schoolYearService.SchoolYearActionsInterface
@@ -37,6 +89,23 @@ type ContainerDependency struct {
 	classRoomActions    classRoomService.ServiceClassRoomInterface
 	serviceActions      serviceActions.ActionsServiceInterface
 	registrationActions registrationService.RegistrationActionsInterface
+	waitingListActions  waitingListService.WaitingListActionsInterface
+	invoiceActions      invoiceService.InvoiceActionsInterface
+	paymentActions      paymentService.PaymentActionsInterface
+	cnabActions         cnabService.CnabActionsInterface
+	pixActions          pixService.PixActionsInterface
+	delinquencyActions  delinquencyService.DelinquencyActionsInterface
+	studentActions      studentService.StudentActionsInterface
+	parentActions       parentService.ParentActionsInterface
+	pickupActions       pickupService.PickupActionsInterface
+	documentActions     documentService.DocumentActionsInterface
+	contractActions     contractService.ContractActionsInterface
+	reenrollmentActions reenrollmentService.ReenrollmentActionsInterface
+	idCardActions       idcardService.IdCardActionsInterface
+	teacherActions      teacherService.TeacherActionsInterface
+	curriculumActions   curriculumService.CurriculumActionsInterface
+	timetableActions    timetableService.TimetableActionsInterface
+	attendanceActions   attendanceService.AttendanceActionsInterface
 
 	roomController          *controllers.RoomController
 	schoolYearController    *controllers.SchoolYearController
@@ -44,8 +113,23 @@ type ContainerDependency struct {
 	classRoomController     *controllers.ClassRoomController
 	serviceController       *controllers.ServiceController
 	registrationController  *controllers.RegisterController
-
-	registerUow registration.RegisterUow
+	waitingListController   *controllers.WaitingListController
+	invoiceController       *controllers.InvoiceController
+	paymentController       *controllers.PaymentController
+	cnabController          *controllers.CnabController
+	pixController           *controllers.PixController
+	delinquencyController   *controllers.DelinquencyController
+	studentController       *controllers.StudentController
+	parentController        *controllers.ParentController
+	pickupController        *controllers.PickupController
+	documentController      *controllers.DocumentController
+	contractController      *controllers.ContractController
+	reenrollmentController  *controllers.ReenrollmentController
+	idCardController        *controllers.IdCardController
+	teacherController       *controllers.TeacherController
+	curriculumController    *controllers.CurriculumController
+	timetableController     *controllers.TimetableController
+	attendanceController    *controllers.AttendanceController
 }
 
 func (c *ContainerDependency) GetDB() *sql.DB {
@@ -128,6 +212,156 @@ func (c *ContainerDependency) GetStudentRepository() *student.Repository {
 	return &c.studentRepository
 }
 
+func (c *ContainerDependency) GetWaitingListRepository() *waitinglist.Repository {
+	if c.waitingListRepository == nil {
+		c.waitingListRepository = repositories.NewWaitingListRepository(
+			c.GetDB(),
+		)
+	}
+
+	return &c.waitingListRepository
+}
+
+func (c *ContainerDependency) GetInvoiceRepository() *invoice.Repository {
+	if c.invoiceRepository == nil {
+		c.invoiceRepository = repositories.NewInvoiceRepository(
+			c.GetDB(),
+		)
+	}
+
+	return &c.invoiceRepository
+}
+
+func (c *ContainerDependency) GetPaymentRepository() *payment.Repository {
+	if c.paymentRepository == nil {
+		c.paymentRepository = repositories.NewPaymentRepository(
+			c.GetDB(),
+		)
+	}
+
+	return &c.paymentRepository
+}
+
+func (c *ContainerDependency) GetCnabRepository() *cnab.Repository {
+	if c.cnabRepository == nil {
+		c.cnabRepository = repositories.NewCnabRepository(
+			c.GetDB(),
+		)
+	}
+
+	return &c.cnabRepository
+}
+
+func (c *ContainerDependency) GetDelinquencyRepository() *delinquency.Repository {
+	if c.delinquencyRepository == nil {
+		c.delinquencyRepository = repositories.NewDelinquencyRepository(
+			c.GetDB(),
+		)
+	}
+
+	return &c.delinquencyRepository
+}
+
+func (c *ContainerDependency) GetParentRepository() *parent.Repository {
+	if c.parentRepository == nil {
+		c.parentRepository = repositories.NewParentRepository(
+			c.GetDB(),
+		)
+	}
+
+	return &c.parentRepository
+}
+
+func (c *ContainerDependency) GetPickupRepository() *pickup.Repository {
+	if c.pickupRepository == nil {
+		c.pickupRepository = repositories.NewPickupRepository(
+			c.GetDB(),
+		)
+	}
+
+	return &c.pickupRepository
+}
+
+func (c *ContainerDependency) GetDocumentRepository() *document.Repository {
+	if c.documentRepository == nil {
+		c.documentRepository = repositories.NewDocumentRepository(
+			c.GetDB(),
+		)
+	}
+
+	return &c.documentRepository
+}
+
+func (c *ContainerDependency) GetContractRepository() *contract.Repository {
+	if c.contractRepository == nil {
+		c.contractRepository = repositories.NewContractRepository(
+			c.GetDB(),
+		)
+	}
+
+	return &c.contractRepository
+}
+
+func (c *ContainerDependency) GetReenrollmentRepository() *reenrollment.Repository {
+	if c.reenrollmentRepository == nil {
+		c.reenrollmentRepository = repositories.NewReenrollmentRepository(
+			c.GetDB(),
+		)
+	}
+
+	return &c.reenrollmentRepository
+}
+
+func (c *ContainerDependency) GetIdCardRepository() *idcard.Repository {
+	if c.idCardRepository == nil {
+		c.idCardRepository = repositories.NewIdCardRepository(
+			c.GetDB(),
+		)
+	}
+
+	return &c.idCardRepository
+}
+
+func (c *ContainerDependency) GetTeacherRepository() *teacher.Repository {
+	if c.teacherRepository == nil {
+		c.teacherRepository = repositories.NewTeacherRepository(
+			c.GetDB(),
+		)
+	}
+
+	return &c.teacherRepository
+}
+
+func (c *ContainerDependency) GetCurriculumRepository() *curriculum.Repository {
+	if c.curriculumRepository == nil {
+		c.curriculumRepository = repositories.NewCurriculumRepository(
+			c.GetDB(),
+		)
+	}
+
+	return &c.curriculumRepository
+}
+
+func (c *ContainerDependency) GetTimetableRepository() *timetable.Repository {
+	if c.timetableRepository == nil {
+		c.timetableRepository = repositories.NewTimetableRepository(
+			c.GetDB(),
+		)
+	}
+
+	return &c.timetableRepository
+}
+
+func (c *ContainerDependency) GetAttendanceRepository() *attendance.Repository {
+	if c.attendanceRepository == nil {
+		c.attendanceRepository = repositories.NewAttendanceRepository(
+			c.GetDB(),
+		)
+	}
+
+	return &c.attendanceRepository
+}
+
 // Actions
 
 func (c *ContainerDependency) GetRoomActions() roomService.ServiceRoomInterface {
@@ -155,6 +389,8 @@ func (c *ContainerDependency) GetScheduleRoomActions() scheduleService.ServiceSc
 		c.scheduleRoomActions = scheduleService.New(
 			*c.GetScheduleRepository(),
 			*c.GetSchoolYearRepository(),
+			*c.GetClassRoomRepository(),
+			*c.GetTimetableRepository(),
 		)
 	}
 
@@ -165,6 +401,8 @@ func (c *ContainerDependency) GetClassRoomActions() classRoomService.ServiceClas
 	if c.classRoomActions == nil {
 		c.classRoomActions = classRoomService.New(
 			*c.GetClassRoomRepository(),
+			c.GetWaitingListActions(),
+			*c.GetCurriculumRepository(),
 		)
 	}
 
@@ -185,26 +423,356 @@ func (c *ContainerDependency) GetRegistrationActions() registrationService.Regis
 	if c.registrationActions == nil {
 		c.registrationActions = registrationService.NewRegistrationActions(
 			*c.GetServiceRepository(),
-			*c.GetClassRoomRepository(),
-			c.GetRegistrationUow(),
+			*c.GetRegisterRepository(),
+			*c.GetSchoolYearRepository(),
+			c.GetRegistrationUowFactory(),
+			c.GetWaitingListActions(),
+			c.GetDelinquencyPolicy(),
 		)
 	}
 
 	return c.registrationActions
 }
 
+func (c *ContainerDependency) GetWaitingListActions() waitingListService.WaitingListActionsInterface {
+	if c.waitingListActions == nil {
+		c.waitingListActions = waitingListService.New(
+			*c.GetServiceRepository(),
+			*c.GetClassRoomRepository(),
+			*c.GetSchoolYearRepository(),
+			*c.GetWaitingListRepository(),
+			c.GetRegistrationUowFactory(),
+		)
+	}
+
+	return c.waitingListActions
+}
+
+func (c *ContainerDependency) GetInvoiceActions() invoiceService.InvoiceActionsInterface {
+	if c.invoiceActions == nil {
+		c.invoiceActions = invoiceService.New(
+			*c.GetInvoiceRepository(),
+		)
+	}
+
+	return c.invoiceActions
+}
+
+func (c *ContainerDependency) GetPaymentActions() paymentService.PaymentActionsInterface {
+	if c.paymentActions == nil {
+		c.paymentActions = paymentService.New(
+			*c.GetPaymentRepository(),
+			c.GetPaymentUowFactory(),
+		)
+	}
+
+	return c.paymentActions
+}
+
+func (c *ContainerDependency) GetCnabActions() cnabService.CnabActionsInterface {
+	if c.cnabActions == nil {
+		c.cnabActions = cnabService.New(
+			*c.GetCnabRepository(),
+			c.GetCnabUowFactory(),
+			c.GetPaymentActions(),
+			c.GetBeneficiary(),
+		)
+	}
+
+	return c.cnabActions
+}
+
+func (c *ContainerDependency) GetPixActions() pixService.PixActionsInterface {
+	if c.pixActions == nil {
+		c.pixActions = pixService.New(
+			*c.GetInvoiceRepository(),
+			c.GetPixMerchant(),
+			c.GetPixPsp(),
+		)
+	}
+
+	return c.pixActions
+}
+
+func (c *ContainerDependency) GetDelinquencyActions() delinquencyService.DelinquencyActionsInterface {
+	if c.delinquencyActions == nil {
+		c.delinquencyActions = delinquencyService.New(
+			*c.GetDelinquencyRepository(),
+		)
+	}
+
+	return c.delinquencyActions
+}
+
+func (c *ContainerDependency) GetStudentActions() studentService.StudentActionsInterface {
+	if c.studentActions == nil {
+		c.studentActions = studentService.New(
+			*c.GetStudentRepository(),
+		)
+	}
+
+	return c.studentActions
+}
+
+func (c *ContainerDependency) GetParentActions() parentService.ParentActionsInterface {
+	if c.parentActions == nil {
+		c.parentActions = parentService.New(
+			*c.GetParentRepository(),
+		)
+	}
+
+	return c.parentActions
+}
+
+func (c *ContainerDependency) GetPickupActions() pickupService.PickupActionsInterface {
+	if c.pickupActions == nil {
+		c.pickupActions = pickupService.New(
+			*c.GetPickupRepository(),
+			*c.GetStudentRepository(),
+		)
+	}
+
+	return c.pickupActions
+}
+
+func (c *ContainerDependency) GetDocumentActions() documentService.DocumentActionsInterface {
+	if c.documentActions == nil {
+		c.documentActions = documentService.New(
+			*c.GetDocumentRepository(),
+			c.GetDocumentStorage(),
+			*c.GetStudentRepository(),
+			*c.GetRegisterRepository(),
+		)
+	}
+
+	return c.documentActions
+}
+
+func (c *ContainerDependency) GetContractActions() contractService.ContractActionsInterface {
+	if c.contractActions == nil {
+		c.contractActions = contractService.New(
+			*c.GetContractRepository(),
+			*c.GetRegisterRepository(),
+			*c.GetSchoolYearRepository(),
+			c.GetDocumentStorage(),
+		)
+	}
+
+	return c.contractActions
+}
+
+func (c *ContainerDependency) GetReenrollmentActions() reenrollmentService.ReenrollmentActionsInterface {
+	if c.reenrollmentActions == nil {
+		c.reenrollmentActions = reenrollmentService.New(
+			*c.GetReenrollmentRepository(),
+			*c.GetRegisterRepository(),
+			*c.GetSchoolYearRepository(),
+			c.GetReenrollmentUowFactory(),
+			c.GetReenrollmentProgression(),
+			c.GetDelinquencyPolicy(),
+		)
+	}
+
+	return c.reenrollmentActions
+}
+
+func (c *ContainerDependency) GetIdCardActions() idcardService.IdCardActionsInterface {
+	if c.idCardActions == nil {
+		c.idCardActions = idcardService.New(
+			*c.GetIdCardRepository(),
+			c.GetDocumentStorage(),
+			*c.GetStudentRepository(),
+		)
+	}
+
+	return c.idCardActions
+}
+
+func (c *ContainerDependency) GetTeacherActions() teacherService.TeacherActionsInterface {
+	if c.teacherActions == nil {
+		c.teacherActions = teacherService.New(
+			*c.GetTeacherRepository(),
+			*c.GetClassRoomRepository(),
+			*c.GetCurriculumRepository(),
+		)
+	}
+
+	return c.teacherActions
+}
+
+func (c *ContainerDependency) GetCurriculumActions() curriculumService.CurriculumActionsInterface {
+	if c.curriculumActions == nil {
+		c.curriculumActions = curriculumService.New(
+			*c.GetCurriculumRepository(),
+			*c.GetSchoolYearRepository(),
+		)
+	}
+
+	return c.curriculumActions
+}
+
+func (c *ContainerDependency) GetTimetableActions() timetableService.TimetableActionsInterface {
+	if c.timetableActions == nil {
+		c.timetableActions = timetableService.New(
+			*c.GetTimetableRepository(),
+			*c.GetSchoolYearRepository(),
+			*c.GetClassRoomRepository(),
+			*c.GetScheduleRepository(),
+			*c.GetCurriculumRepository(),
+			*c.GetTeacherRepository(),
+		)
+	}
+
+	return c.timetableActions
+}
+
+func (c *ContainerDependency) GetAttendanceActions() attendanceService.AttendanceActionsInterface {
+	if c.attendanceActions == nil {
+		c.attendanceActions = attendanceService.New(
+			*c.GetAttendanceRepository(),
+			*c.GetClassRoomRepository(),
+			*c.GetSchoolYearRepository(),
+			*c.GetScheduleRepository(),
+			*c.GetTeacherRepository(),
+			*c.GetTimetableRepository(),
+		)
+	}
+
+	return c.attendanceActions
+}
+
 // Uow
 
-func (c *ContainerDependency) GetRegistrationUow() registration.RegisterUow {
-	if c.registerUow == nil {
-		c.registerUow = repositories.NewRegistrationUow(
+func (c *ContainerDependency) GetRegistrationUowFactory() registration.RegisterUowFactory {
+	return func() registration.RegisterUow {
+		return repositories.NewRegistrationUow(
 			c.GetDB(),
 			*repositories.NewStudentRepository(c.GetDB()),
+			*repositories.NewParentRepository(c.GetDB()),
+			*repositories.NewRegistrationRepository(c.GetDB()),
+			*repositories.NewClassRoomRepository(c.GetDB()),
+			*repositories.NewWaitingListRepository(c.GetDB()),
+			*repositories.NewInvoiceRepository(c.GetDB()),
+		)
+	}
+}
+
+func (c *ContainerDependency) GetPaymentUowFactory() payment.PaymentUowFactory {
+	return func() payment.PaymentUow {
+		return repositories.NewPaymentUow(
+			c.GetDB(),
+			*repositories.NewPaymentRepository(c.GetDB()),
+			*repositories.NewInvoiceRepository(c.GetDB()),
+			*repositories.NewRegistrationRepository(c.GetDB()),
+		)
+	}
+}
+
+func (c *ContainerDependency) GetCnabUowFactory() cnab.CnabUowFactory {
+	return func() cnab.CnabUow {
+		return repositories.NewCnabUow(
+			c.GetDB(),
+			*repositories.NewCnabRepository(c.GetDB()),
+		)
+	}
+}
+
+func (c *ContainerDependency) GetReenrollmentUowFactory() reenrollment.ReenrollmentUowFactory {
+	return func() reenrollment.ReenrollmentUow {
+		return repositories.NewReenrollmentUow(
+			c.GetDB(),
+			*repositories.NewReenrollmentRepository(c.GetDB()),
 			*repositories.NewRegistrationRepository(c.GetDB()),
+			*repositories.NewClassRoomRepository(c.GetDB()),
+			*repositories.NewInvoiceRepository(c.GetDB()),
 		)
 	}
+}
+
+// Config
+
+// GetBeneficiary Dados do convenio de cobranca da escola com o banco
+func (c *ContainerDependency) GetBeneficiary() cnab.Beneficiary {
+	return cnab.Beneficiary{
+		BankCode:        os.Getenv("CNAB_BANK_CODE"),
+		BankName:        os.Getenv("CNAB_BANK_NAME"),
+		CompanyName:     os.Getenv("CNAB_COMPANY_NAME"),
+		CompanyDocument: os.Getenv("CNAB_COMPANY_DOCUMENT"),
+		CompanyCode:     os.Getenv("CNAB_COMPANY_CODE"),
+		Agency:          os.Getenv("CNAB_AGENCY"),
+		AgencyDigit:     os.Getenv("CNAB_AGENCY_DIGIT"),
+		Account:         os.Getenv("CNAB_ACCOUNT"),
+		AccountDigit:    os.Getenv("CNAB_ACCOUNT_DIGIT"),
+		Wallet:          os.Getenv("CNAB_WALLET"),
+	}
+}
 
-	return c.registerUow
+// GetPixMerchant Dados do recebedor das cobrancas PIX da escola
+func (c *ContainerDependency) GetPixMerchant() pix.Merchant {
+	return pix.Merchant{
+		Key:  os.Getenv("PIX_KEY"),
+		Name: os.Getenv("PIX_MERCHANT_NAME"),
+		City: os.Getenv("PIX_MERCHANT_CITY"),
+	}
+}
+
+// GetPixPsp API PIX do PSP usada nas cobrancas dinamicas. Sem PIX_PSP_URL configurada apenas
+// cobrancas estaticas sao geradas
+func (c *ContainerDependency) GetPixPsp() pix.Psp {
+	baseUrl := os.Getenv("PIX_PSP_URL")
+	if baseUrl == "" {
+		return nil
+	}
+
+	client, err := psp.NewPixClient(psp.PixConfig{
+		BaseUrl:      baseUrl,
+		TokenUrl:     os.Getenv("PIX_PSP_TOKEN_URL"),
+		ClientId:     os.Getenv("PIX_PSP_CLIENT_ID"),
+		ClientSecret: os.Getenv("PIX_PSP_CLIENT_SECRET"),
+		CertFile:     os.Getenv("PIX_PSP_CERT_FILE"),
+		KeyFile:      os.Getenv("PIX_PSP_KEY_FILE"),
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return client
+}
+
+// GetDelinquencyPolicy Regra de bloqueio de matricula para alunos inadimplentes
+func (c *ContainerDependency) GetDelinquencyPolicy() delinquency.Policy {
+	enabled, _ := strconv.ParseBool(os.Getenv("DELINQUENCY_BLOCK_ENABLED"))
+	toleranceDays, _ := strconv.Atoi(os.Getenv("DELINQUENCY_TOLERANCE_DAYS"))
+	minimumDebt, _ := strconv.ParseFloat(os.Getenv("DELINQUENCY_MINIMUM_DEBT"), 64)
+
+	return delinquency.Policy{
+		Enabled:       enabled,
+		ToleranceDays: toleranceDays,
+		MinimumDebt:   minimumDebt,
+		Approvers:     delinquency.ParseApprovers(os.Getenv("DELINQUENCY_OVERRIDE_APPROVERS")),
+	}
+}
+
+// GetDocumentStorage Armazenamento dos documentos dos alunos. Por padrao os arquivos sao gravados
+// no disco local, no diretorio informado em STORAGE_LOCAL_PATH
+func (c *ContainerDependency) GetDocumentStorage() document.Storage {
+	switch driver := os.Getenv("STORAGE_DRIVER"); driver {
+	case "", "local":
+		basePath := os.Getenv("STORAGE_LOCAL_PATH")
+		if basePath == "" {
+			basePath = "storage"
+		}
+
+		return storage.NewLocalStorage(basePath)
+	default:
+		panic("invalid storage driver: " + driver)
+	}
+}
+
+// GetReenrollmentProgression Sequencia de niveis usada na rematricula, informada em
+// REENROLLMENT_LEVEL_PROGRESSION no formato "nivel:proximo nivel,..."
+func (c *ContainerDependency) GetReenrollmentProgression() reenrollment.Progression {
+	return reenrollment.ParseProgression(os.Getenv("REENROLLMENT_LEVEL_PROGRESSION"))
 }
 
 // Controllers
@@ -268,3 +836,173 @@ func (c *ContainerDependency) GetRegisterController() *controllers.RegisterContr
 
 	return c.registrationController
 }
+
+func (c *ContainerDependency) GetWaitingListController() *controllers.WaitingListController {
+	if c.waitingListController == nil {
+		c.waitingListController = controllers.NewWaitingListController(
+			c.GetWaitingListActions(),
+		)
+	}
+
+	return c.waitingListController
+}
+
+func (c *ContainerDependency) GetInvoiceController() *controllers.InvoiceController {
+	if c.invoiceController == nil {
+		c.invoiceController = controllers.NewInvoiceController(
+			c.GetInvoiceActions(),
+		)
+	}
+
+	return c.invoiceController
+}
+
+func (c *ContainerDependency) GetPaymentController() *controllers.PaymentController {
+	if c.paymentController == nil {
+		c.paymentController = controllers.NewPaymentController(
+			c.GetPaymentActions(),
+		)
+	}
+
+	return c.paymentController
+}
+
+func (c *ContainerDependency) GetCnabController() *controllers.CnabController {
+	if c.cnabController == nil {
+		c.cnabController = controllers.NewCnabController(
+			c.GetCnabActions(),
+		)
+	}
+
+	return c.cnabController
+}
+
+func (c *ContainerDependency) GetPixController() *controllers.PixController {
+	if c.pixController == nil {
+		c.pixController = controllers.NewPixController(
+			c.GetPixActions(),
+		)
+	}
+
+	return c.pixController
+}
+
+func (c *ContainerDependency) GetDelinquencyController() *controllers.DelinquencyController {
+	if c.delinquencyController == nil {
+		c.delinquencyController = controllers.NewDelinquencyController(
+			c.GetDelinquencyActions(),
+		)
+	}
+
+	return c.delinquencyController
+}
+
+func (c *ContainerDependency) GetStudentController() *controllers.StudentController {
+	if c.studentController == nil {
+		c.studentController = controllers.NewStudentController(
+			c.GetStudentActions(),
+		)
+	}
+
+	return c.studentController
+}
+
+func (c *ContainerDependency) GetParentController() *controllers.ParentController {
+	if c.parentController == nil {
+		c.parentController = controllers.NewParentController(
+			c.GetParentActions(),
+		)
+	}
+
+	return c.parentController
+}
+
+func (c *ContainerDependency) GetPickupController() *controllers.PickupController {
+	if c.pickupController == nil {
+		c.pickupController = controllers.NewPickupController(
+			c.GetPickupActions(),
+		)
+	}
+
+	return c.pickupController
+}
+
+func (c *ContainerDependency) GetDocumentController() *controllers.DocumentController {
+	if c.documentController == nil {
+		c.documentController = controllers.NewDocumentController(
+			c.GetDocumentActions(),
+		)
+	}
+
+	return c.documentController
+}
+
+func (c *ContainerDependency) GetContractController() *controllers.ContractController {
+	if c.contractController == nil {
+		c.contractController = controllers.NewContractController(
+			c.GetContractActions(),
+		)
+	}
+
+	return c.contractController
+}
+
+func (c *ContainerDependency) GetReenrollmentController() *controllers.ReenrollmentController {
+	if c.reenrollmentController == nil {
+		c.reenrollmentController = controllers.NewReenrollmentController(
+			c.GetReenrollmentActions(),
+		)
+	}
+
+	return c.reenrollmentController
+}
+
+func (c *ContainerDependency) GetIdCardController() *controllers.IdCardController {
+	if c.idCardController == nil {
+		c.idCardController = controllers.NewIdCardController(
+			c.GetIdCardActions(),
+		)
+	}
+
+	return c.idCardController
+}
+
+func (c *ContainerDependency) GetTeacherController() *controllers.TeacherController {
+	if c.teacherController == nil {
+		c.teacherController = controllers.NewTeacherController(
+			c.GetTeacherActions(),
+		)
+	}
+
+	return c.teacherController
+}
+
+func (c *ContainerDependency) GetCurriculumController() *controllers.CurriculumController {
+	if c.curriculumController == nil {
+		c.curriculumController = controllers.NewCurriculumController(
+			c.GetCurriculumActions(),
+		)
+	}
+
+	return c.curriculumController
+}
+
+func (c *ContainerDependency) GetTimetableController() *controllers.TimetableController {
+	if c.timetableController == nil {
+		c.timetableController = controllers.NewTimetableController(
+			c.GetTimetableActions(),
+		)
+	}
+
+	return c.timetableController
+}
+
+func (c *ContainerDependency) GetAttendanceController() *controllers.AttendanceController {
+	if c.attendanceController == nil {
+		c.attendanceController = controllers.NewAttendanceController(
+			c.GetAttendanceActions(),
+		)
+	}
+
+	return c.attendanceController
+}
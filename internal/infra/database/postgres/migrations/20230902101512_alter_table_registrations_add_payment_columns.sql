@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE registrations ADD COLUMN payment_day VARCHAR(2);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE registrations ADD COLUMN paid BOOLEAN NOT NULL DEFAULT false;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE registrations DROP COLUMN paid;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE registrations DROP COLUMN payment_day;
+-- +goose StatementEnd
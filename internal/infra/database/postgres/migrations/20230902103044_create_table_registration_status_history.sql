@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE registration_status_history (
+    id UUID PRIMARY KEY,
+    registration_id UUID NOT NULL,
+    previous_status VARCHAR(255),
+    status VARCHAR(255) NOT NULL,
+    reason TEXT NOT NULL,
+    class_room_id UUID NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE registration_status_history ADD CONSTRAINT fk_status_history_registration FOREIGN KEY (registration_id) REFERENCES registrations (id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE registration_status_history;
+-- +goose StatementEnd
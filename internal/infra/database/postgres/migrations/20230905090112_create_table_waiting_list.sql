@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE waiting_list (
+    id UUID PRIMARY KEY,
+    class_room_id UUID NOT NULL,
+    student_id UUID NOT NULL,
+    service_id UUID NOT NULL,
+    shift VARCHAR(255) NOT NULL,
+    monthly_fee NUMERIC(10,2) NOT NULL,
+    installments_quantity INTEGER NOT NULL,
+    enrollment_fee NUMERIC(10,2) NOT NULL DEFAULT 0,
+    month_duration INTEGER NOT NULL,
+    payment_day VARCHAR(2) NOT NULL,
+    priority INTEGER NOT NULL DEFAULT 0,
+    request_date TIMESTAMP NOT NULL,
+    status VARCHAR(255) NOT NULL,
+    registration_id UUID,
+    created_at TIMESTAMP,
+    updated_at TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE waiting_list ADD CONSTRAINT fk_waiting_list_class_room FOREIGN KEY (class_room_id) REFERENCES class_room (id);
+ALTER TABLE waiting_list ADD CONSTRAINT fk_waiting_list_student FOREIGN KEY (student_id) REFERENCES students (id);
+ALTER TABLE waiting_list ADD CONSTRAINT fk_waiting_list_registration FOREIGN KEY (registration_id) REFERENCES registrations (id);
+CREATE UNIQUE INDEX uq_waiting_list_student_class_room ON waiting_list (class_room_id, student_id) WHERE status = 'WAITING';
+CREATE INDEX idx_waiting_list_queue ON waiting_list (class_room_id, status, priority DESC, request_date);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE waiting_list;
+-- +goose StatementEnd
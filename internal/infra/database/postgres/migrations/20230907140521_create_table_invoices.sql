@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE invoices (
+    id UUID PRIMARY KEY,
+    registration_id UUID NOT NULL,
+    student_id UUID NOT NULL,
+    description VARCHAR(255) NOT NULL,
+    kind VARCHAR(50) NOT NULL,
+    installment INTEGER NOT NULL DEFAULT 0,
+    value NUMERIC(10,2) NOT NULL,
+    due_date TIMESTAMP NOT NULL,
+    status VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP,
+    updated_at TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE invoices ADD CONSTRAINT fk_invoices_registration FOREIGN KEY (registration_id) REFERENCES registrations (id);
+ALTER TABLE invoices ADD CONSTRAINT fk_invoices_student FOREIGN KEY (student_id) REFERENCES students (id);
+CREATE INDEX idx_invoices_registration ON invoices (registration_id);
+CREATE INDEX idx_invoices_student ON invoices (student_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE invoices;
+-- +goose StatementEnd
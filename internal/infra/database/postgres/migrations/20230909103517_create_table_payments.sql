@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE payments (
+    id UUID PRIMARY KEY,
+    invoice_id UUID NOT NULL,
+    registration_id UUID NOT NULL,
+    student_id UUID NOT NULL,
+    method VARCHAR(50) NOT NULL,
+    value NUMERIC(10,2) NOT NULL,
+    applied_value NUMERIC(10,2) NOT NULL,
+    paid_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE payments ADD CONSTRAINT fk_payments_invoice FOREIGN KEY (invoice_id) REFERENCES invoices (id);
+ALTER TABLE payments ADD CONSTRAINT fk_payments_registration FOREIGN KEY (registration_id) REFERENCES registrations (id);
+ALTER TABLE payments ADD CONSTRAINT fk_payments_student FOREIGN KEY (student_id) REFERENCES students (id);
+CREATE INDEX idx_payments_invoice ON payments (invoice_id);
+CREATE INDEX idx_payments_registration ON payments (registration_id);
+CREATE INDEX idx_payments_student ON payments (student_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE payments;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE student_credits (
+    id UUID PRIMARY KEY,
+    student_id UUID NOT NULL,
+    payment_id UUID NOT NULL,
+    value NUMERIC(10,2) NOT NULL,
+    balance NUMERIC(10,2) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE student_credits ADD CONSTRAINT fk_student_credits_student FOREIGN KEY (student_id) REFERENCES students (id);
+ALTER TABLE student_credits ADD CONSTRAINT fk_student_credits_payment FOREIGN KEY (payment_id) REFERENCES payments (id);
+CREATE INDEX idx_student_credits_student ON student_credits (student_id) WHERE balance > 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE student_credits;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE services ADD COLUMN fine_percentage NUMERIC(5,2) NOT NULL DEFAULT 0;
+ALTER TABLE services ADD COLUMN daily_interest_percentage NUMERIC(7,4) NOT NULL DEFAULT 0;
+ALTER TABLE services ADD COLUMN discount_percentage NUMERIC(5,2) NOT NULL DEFAULT 0;
+ALTER TABLE services ADD COLUMN discount_days_before INTEGER NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE services DROP COLUMN fine_percentage;
+ALTER TABLE services DROP COLUMN daily_interest_percentage;
+ALTER TABLE services DROP COLUMN discount_percentage;
+ALTER TABLE services DROP COLUMN discount_days_before;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE invoices ADD COLUMN fine_percentage NUMERIC(5,2) NOT NULL DEFAULT 0;
+ALTER TABLE invoices ADD COLUMN daily_interest_percentage NUMERIC(7,4) NOT NULL DEFAULT 0;
+ALTER TABLE invoices ADD COLUMN discount_percentage NUMERIC(5,2) NOT NULL DEFAULT 0;
+ALTER TABLE invoices ADD COLUMN discount_days_before INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE invoices ADD COLUMN charges_paid NUMERIC(10,2) NOT NULL DEFAULT 0;
+ALTER TABLE invoices ADD COLUMN discount_value NUMERIC(10,2) NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE invoices DROP COLUMN fine_percentage;
+ALTER TABLE invoices DROP COLUMN daily_interest_percentage;
+ALTER TABLE invoices DROP COLUMN discount_percentage;
+ALTER TABLE invoices DROP COLUMN discount_days_before;
+ALTER TABLE invoices DROP COLUMN charges_paid;
+ALTER TABLE invoices DROP COLUMN discount_value;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE registration_discounts (
+    id UUID PRIMARY KEY,
+    registration_id UUID NOT NULL,
+    kind VARCHAR(50) NOT NULL,
+    type VARCHAR(50) NOT NULL,
+    value NUMERIC(10,2) NOT NULL,
+    reason TEXT NOT NULL,
+    valid_from TIMESTAMP NOT NULL,
+    valid_until TIMESTAMP,
+    approved_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE registration_discounts ADD CONSTRAINT fk_discounts_registration FOREIGN KEY (registration_id) REFERENCES registrations (id);
+CREATE INDEX idx_registration_discounts_registration ON registration_discounts (registration_id);
+CREATE INDEX idx_parents_cpf_document ON parents (cpf_document);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_parents_cpf_document;
+DROP TABLE registration_discounts;
+-- +goose StatementEnd
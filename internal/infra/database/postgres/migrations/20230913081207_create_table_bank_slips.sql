@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE SEQUENCE bank_slips_our_number_seq;
+CREATE SEQUENCE bank_remittance_seq;
+
+CREATE TABLE bank_slips (
+    id UUID PRIMARY KEY,
+    invoice_id UUID NOT NULL,
+    our_number BIGINT NOT NULL,
+    layout VARCHAR(20) NOT NULL,
+    remittance INTEGER NOT NULL,
+    value NUMERIC(10,2) NOT NULL,
+    due_date TIMESTAMP NOT NULL,
+    status VARCHAR(50) NOT NULL,
+    message VARCHAR(255),
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE bank_slips ADD CONSTRAINT fk_bank_slips_invoice FOREIGN KEY (invoice_id) REFERENCES invoices (id);
+CREATE UNIQUE INDEX idx_bank_slips_our_number ON bank_slips (our_number);
+CREATE INDEX idx_bank_slips_invoice ON bank_slips (invoice_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE bank_slips;
+DROP SEQUENCE bank_remittance_seq;
+DROP SEQUENCE bank_slips_our_number_seq;
+-- +goose StatementEnd
@@ -0,0 +1,64 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE student_parents (
+    student_id UUID NOT NULL,
+    parent_id UUID NOT NULL,
+    relationship VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP,
+    updated_at TIMESTAMP,
+    deleted_at TIMESTAMP,
+    PRIMARY KEY (student_id, parent_id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Cada CPF passa a ter um unico responsavel. Os vinculos dos registros duplicados apontam para o
+-- registro mais antigo e os duplicados sao removidos. O tipo de vinculo nao era registrado
+CREATE TEMPORARY TABLE kept_parents AS
+    SELECT DISTINCT ON (cpf_document) cpf_document, id
+    FROM parents
+    ORDER BY cpf_document, deleted_at IS NOT NULL, created_at, id;
+
+INSERT INTO student_parents (student_id, parent_id, relationship, created_at, updated_at, deleted_at)
+    SELECT DISTINCT ON (p.student_id, k.id) p.student_id, k.id, 'LEGAL_GUARDIAN', p.created_at, p.updated_at, p.deleted_at
+    FROM parents p
+        JOIN kept_parents k ON k.cpf_document = p.cpf_document
+    ORDER BY p.student_id, k.id, p.deleted_at IS NOT NULL, p.created_at;
+
+UPDATE addresses SET deleted_at = NOW()
+    WHERE deleted_at IS NULL
+        AND owner_id IN (SELECT id FROM parents WHERE id NOT IN (SELECT id FROM kept_parents));
+
+UPDATE phones SET deleted_at = NOW()
+    WHERE deleted_at IS NULL
+        AND owner_id IN (SELECT id FROM parents WHERE id NOT IN (SELECT id FROM kept_parents));
+
+UPDATE parents SET deleted_at = NOW()
+    WHERE deleted_at IS NULL
+        AND id NOT IN (SELECT id FROM kept_parents);
+
+DROP TABLE kept_parents;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE student_parents ADD CONSTRAINT fk_student_parents_student FOREIGN KEY (student_id) REFERENCES students (id);
+ALTER TABLE student_parents ADD CONSTRAINT fk_student_parents_parent FOREIGN KEY (parent_id) REFERENCES parents (id);
+CREATE INDEX idx_student_parents_parent ON student_parents (parent_id);
+ALTER TABLE parents DROP COLUMN student_id;
+DROP INDEX idx_parents_cpf_document;
+CREATE UNIQUE INDEX idx_parents_cpf_document ON parents (cpf_document) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_parents_cpf_document;
+CREATE INDEX idx_parents_cpf_document ON parents (cpf_document);
+ALTER TABLE parents ADD COLUMN student_id UUID;
+UPDATE parents p SET student_id = (
+    SELECT sp.student_id FROM student_parents sp
+        WHERE sp.parent_id = p.id
+        ORDER BY sp.deleted_at IS NOT NULL, sp.created_at
+        LIMIT 1
+);
+DROP TABLE student_parents;
+-- +goose StatementEnd
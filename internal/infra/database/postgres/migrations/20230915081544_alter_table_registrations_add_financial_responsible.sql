@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE registrations
+    ADD COLUMN financial_responsible_id UUID NULL,
+    ADD COLUMN financial_responsible_type VARCHAR(20) NULL,
+    ADD COLUMN financial_responsible_name VARCHAR(255) NULL,
+    ADD COLUMN financial_responsible_cpf VARCHAR(14) NULL,
+    ADD COLUMN financial_responsible_email VARCHAR(255) NULL;
+
+UPDATE registrations r
+    SET financial_responsible_id = s.id,
+        financial_responsible_type = 'STUDENT',
+        financial_responsible_name = s.first_name || ' ' || s.last_name,
+        financial_responsible_cpf = s.cpf_document,
+        financial_responsible_email = s.email
+    FROM students s
+    WHERE s.id = r.student_id
+        AND s.him_self_responsible;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE registrations
+    DROP COLUMN financial_responsible_id,
+    DROP COLUMN financial_responsible_type,
+    DROP COLUMN financial_responsible_name,
+    DROP COLUMN financial_responsible_cpf,
+    DROP COLUMN financial_responsible_email;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE students ADD COLUMN academic_responsible_id UUID NULL;
+ALTER TABLE students ADD CONSTRAINT fk_students_academic_responsible FOREIGN KEY (academic_responsible_id) REFERENCES parents (id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE students DROP CONSTRAINT fk_students_academic_responsible;
+ALTER TABLE students DROP COLUMN academic_responsible_id;
+-- +goose StatementEnd
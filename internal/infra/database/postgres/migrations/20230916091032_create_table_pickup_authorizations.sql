@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE pickup_authorizations (
+    id UUID PRIMARY KEY,
+    student_id UUID NOT NULL,
+    parent_id UUID NULL,
+    name VARCHAR(255) NOT NULL,
+    cpf_document VARCHAR(14) NOT NULL,
+    photo VARCHAR(255) NULL,
+    valid_from DATE NOT NULL,
+    valid_until DATE NULL,
+    created_at TIMESTAMP,
+    updated_at TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE pickup_authorizations ADD CONSTRAINT fk_pickup_authorizations_student FOREIGN KEY (student_id) REFERENCES students (id);
+ALTER TABLE pickup_authorizations ADD CONSTRAINT fk_pickup_authorizations_parent FOREIGN KEY (parent_id) REFERENCES parents (id);
+CREATE INDEX idx_pickup_authorizations_student_cpf ON pickup_authorizations (student_id, cpf_document) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE pickup_authorizations;
+-- +goose StatementEnd
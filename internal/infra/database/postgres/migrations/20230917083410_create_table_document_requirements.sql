@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE document_requirements (
+    id UUID PRIMARY KEY,
+    level VARCHAR(50) NOT NULL DEFAULT '',
+    kind VARCHAR(50) NOT NULL,
+    description VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP,
+    updated_at TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE UNIQUE INDEX idx_document_requirements_level_kind ON document_requirements (level, kind) WHERE deleted_at IS NULL;
+INSERT INTO document_requirements (id, level, kind, description, created_at, updated_at) VALUES
+    (gen_random_uuid(), '', 'BIRTH_CERTIFICATE', 'Certidao de nascimento', NOW(), NOW()),
+    (gen_random_uuid(), '', 'VACCINATION_CARD', 'Cartao de vacinacao', NOW(), NOW()),
+    (gen_random_uuid(), '', 'PROOF_OF_RESIDENCE', 'Comprovante de residencia', NOW(), NOW()),
+    (gen_random_uuid(), '', 'TRANSFER_DOCUMENT', 'Declaracao de transferencia da escola anterior', NOW(), NOW()),
+    (gen_random_uuid(), '', 'PHOTO', 'Foto 3x4', NOW(), NOW());
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE document_requirements;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE student_documents (
+    id UUID PRIMARY KEY,
+    student_id UUID NOT NULL,
+    registration_id UUID NULL,
+    kind VARCHAR(50) NOT NULL,
+    file_name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(100) NOT NULL,
+    size BIGINT NOT NULL,
+    storage_key VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    reason TEXT NULL,
+    uploaded_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP,
+    updated_at TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE student_documents ADD CONSTRAINT fk_student_documents_student FOREIGN KEY (student_id) REFERENCES students (id);
+ALTER TABLE student_documents ADD CONSTRAINT fk_student_documents_registration FOREIGN KEY (registration_id) REFERENCES registrations (id);
+CREATE INDEX idx_student_documents_student ON student_documents (student_id, kind);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE student_documents;
+-- +goose StatementEnd
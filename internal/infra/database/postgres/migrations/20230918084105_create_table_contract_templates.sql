@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE contract_templates (
+    id UUID PRIMARY KEY,
+    content TEXT NOT NULL,
+    hash VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE contract_templates;
+-- +goose StatementEnd
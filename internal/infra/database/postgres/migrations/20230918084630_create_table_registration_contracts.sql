@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE registration_contracts (
+    id UUID PRIMARY KEY,
+    registration_id UUID NOT NULL,
+    template_id UUID NULL,
+    template_hash VARCHAR(64) NOT NULL,
+    hash VARCHAR(64) NOT NULL,
+    storage_key VARCHAR(255) NOT NULL,
+    issued_at TIMESTAMP NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE registration_contracts ADD CONSTRAINT fk_registration_contracts_registration FOREIGN KEY (registration_id) REFERENCES registrations (id);
+ALTER TABLE registration_contracts ADD CONSTRAINT fk_registration_contracts_template FOREIGN KEY (template_id) REFERENCES contract_templates (id);
+CREATE INDEX idx_registration_contracts_registration ON registration_contracts (registration_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE registration_contracts;
+-- +goose StatementEnd
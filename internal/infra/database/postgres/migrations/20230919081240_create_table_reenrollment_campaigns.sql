@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE reenrollment_campaigns (
+    id UUID PRIMARY KEY,
+    from_school_year_id UUID NOT NULL,
+    to_school_year_id UUID NOT NULL,
+    deadline DATE NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE reenrollment_campaigns ADD CONSTRAINT fk_reenrollment_campaigns_from_school_year FOREIGN KEY (from_school_year_id) REFERENCES school_year (id);
+ALTER TABLE reenrollment_campaigns ADD CONSTRAINT fk_reenrollment_campaigns_to_school_year FOREIGN KEY (to_school_year_id) REFERENCES school_year (id);
+CREATE UNIQUE INDEX idx_reenrollment_campaigns_from_school_year ON reenrollment_campaigns (from_school_year_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE reenrollment_campaigns;
+-- +goose StatementEnd
\ No newline at end of file
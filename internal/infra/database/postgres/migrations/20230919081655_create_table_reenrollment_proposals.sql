@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE reenrollment_proposals (
+    id UUID PRIMARY KEY,
+    campaign_id UUID NOT NULL,
+    registration_id UUID NOT NULL,
+    student_id UUID NOT NULL,
+    student_name VARCHAR(255) NOT NULL,
+    class_room_id UUID NOT NULL,
+    class_room VARCHAR(255) NOT NULL,
+    target_level VARCHAR(100) NOT NULL,
+    target_class_room_id UUID NULL,
+    status VARCHAR(20) NOT NULL,
+    reason TEXT NULL,
+    new_registration_id UUID NULL,
+    responded_at TIMESTAMP NULL
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE reenrollment_proposals ADD CONSTRAINT fk_reenrollment_proposals_campaign FOREIGN KEY (campaign_id) REFERENCES reenrollment_campaigns (id);
+ALTER TABLE reenrollment_proposals ADD CONSTRAINT fk_reenrollment_proposals_registration FOREIGN KEY (registration_id) REFERENCES registrations (id);
+ALTER TABLE reenrollment_proposals ADD CONSTRAINT fk_reenrollment_proposals_target_class_room FOREIGN KEY (target_class_room_id) REFERENCES class_room (id);
+ALTER TABLE reenrollment_proposals ADD CONSTRAINT fk_reenrollment_proposals_new_registration FOREIGN KEY (new_registration_id) REFERENCES registrations (id);
+CREATE UNIQUE INDEX idx_reenrollment_proposals_campaign_registration ON reenrollment_proposals (campaign_id, registration_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE reenrollment_proposals;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE students
+    ADD COLUMN document_type VARCHAR(30) NOT NULL DEFAULT 'CPF',
+    ADD COLUMN document_number VARCHAR(255) NOT NULL DEFAULT '';
+
+UPDATE students SET document_number = cpf_document;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX idx_students_document ON students (document_type, document_number) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_students_document;
+
+ALTER TABLE students
+    DROP COLUMN document_type,
+    DROP COLUMN document_number;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE students
+    ADD COLUMN photo_key VARCHAR(255) NULL,
+    ADD COLUMN photo_content_type VARCHAR(50) NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE students
+    DROP COLUMN photo_key,
+    DROP COLUMN photo_content_type;
+-- +goose StatementEnd
\ No newline at end of file
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE teacher_qualifications (
+    id UUID PRIMARY KEY,
+    teacher_id UUID NOT NULL,
+    degree VARCHAR(20) NOT NULL,
+    course VARCHAR(255) NOT NULL,
+    institution VARCHAR(255) NOT NULL,
+    conclusion_year INT NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE teacher_qualifications ADD CONSTRAINT fk_teacher_qualifications_teacher FOREIGN KEY (teacher_id) REFERENCES teachers (id);
+CREATE INDEX idx_teacher_qualifications_teacher ON teacher_qualifications (teacher_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE teacher_qualifications;
+-- +goose StatementEnd
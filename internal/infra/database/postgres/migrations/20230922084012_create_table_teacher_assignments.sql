@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE teacher_assignments (
+    id UUID PRIMARY KEY,
+    teacher_id UUID NOT NULL,
+    class_room_id UUID NOT NULL,
+    school_year_id UUID NOT NULL,
+    subject VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE teacher_assignments ADD CONSTRAINT fk_teacher_assignments_teacher FOREIGN KEY (teacher_id) REFERENCES teachers (id);
+ALTER TABLE teacher_assignments ADD CONSTRAINT fk_teacher_assignments_class_room FOREIGN KEY (class_room_id) REFERENCES class_room (id);
+ALTER TABLE teacher_assignments ADD CONSTRAINT fk_teacher_assignments_school_year FOREIGN KEY (school_year_id) REFERENCES school_year (id);
+CREATE UNIQUE INDEX idx_teacher_assignments_class_room_subject ON teacher_assignments (class_room_id, LOWER(subject)) WHERE deleted_at IS NULL;
+CREATE INDEX idx_teacher_assignments_teacher ON teacher_assignments (teacher_id, school_year_id) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE teacher_assignments;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE curriculum_matrices (
+    id UUID PRIMARY KEY,
+    level VARCHAR(100) NOT NULL,
+    school_year_id UUID NOT NULL,
+    created_at TIMESTAMP,
+    updated_at TIMESTAMP,
+    deleted_at TIMESTAMP
+);
+
+CREATE TABLE curriculum_matrix_items (
+    matrix_id UUID NOT NULL,
+    subject_id UUID NOT NULL,
+    weekly_classes INT NOT NULL,
+    PRIMARY KEY (matrix_id, subject_id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE curriculum_matrices ADD CONSTRAINT fk_curriculum_matrices_school_year FOREIGN KEY (school_year_id) REFERENCES school_year (id);
+ALTER TABLE curriculum_matrix_items ADD CONSTRAINT fk_curriculum_matrix_items_matrix FOREIGN KEY (matrix_id) REFERENCES curriculum_matrices (id);
+ALTER TABLE curriculum_matrix_items ADD CONSTRAINT fk_curriculum_matrix_items_subject FOREIGN KEY (subject_id) REFERENCES subjects (id);
+CREATE UNIQUE INDEX idx_curriculum_matrices_level_school_year ON curriculum_matrices (LOWER(level), school_year_id) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE curriculum_matrix_items;
+DROP TABLE curriculum_matrices;
+-- +goose StatementEnd
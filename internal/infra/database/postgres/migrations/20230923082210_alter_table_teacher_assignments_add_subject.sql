@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE teacher_assignments ADD COLUMN subject_id UUID NULL;
+ALTER TABLE teacher_assignments ADD CONSTRAINT fk_teacher_assignments_subject FOREIGN KEY (subject_id) REFERENCES subjects (id);
+DROP INDEX idx_teacher_assignments_class_room_subject;
+CREATE UNIQUE INDEX idx_teacher_assignments_class_room_subject ON teacher_assignments (class_room_id, subject_id) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_teacher_assignments_class_room_subject;
+CREATE UNIQUE INDEX idx_teacher_assignments_class_room_subject ON teacher_assignments (class_room_id, LOWER(subject)) WHERE deleted_at IS NULL;
+ALTER TABLE teacher_assignments DROP CONSTRAINT fk_teacher_assignments_subject;
+ALTER TABLE teacher_assignments DROP COLUMN subject_id;
+-- +goose StatementEnd
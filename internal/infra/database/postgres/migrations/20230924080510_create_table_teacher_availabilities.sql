@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE teacher_availabilities (
+    id UUID PRIMARY KEY,
+    teacher_id UUID NOT NULL,
+    weekday SMALLINT NOT NULL,
+    start_at TIME NOT NULL,
+    end_at TIME NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE teacher_availabilities ADD CONSTRAINT fk_teacher_availabilities_teacher FOREIGN KEY (teacher_id) REFERENCES teachers (id);
+CREATE INDEX idx_teacher_availabilities_teacher ON teacher_availabilities (teacher_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE teacher_availabilities;
+-- +goose StatementEnd
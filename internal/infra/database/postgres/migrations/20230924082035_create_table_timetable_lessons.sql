@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE timetable_lessons (
+    id UUID PRIMARY KEY,
+    school_year_id UUID NOT NULL,
+    class_room_id UUID NOT NULL,
+    subject_id UUID NOT NULL,
+    teacher_id UUID NOT NULL,
+    room_id UUID NOT NULL,
+    schedule_id UUID NOT NULL,
+    weekday SMALLINT NOT NULL,
+    created_at TIMESTAMP,
+    updated_at TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE timetable_lessons ADD CONSTRAINT fk_timetable_lessons_school_year FOREIGN KEY (school_year_id) REFERENCES school_year (id);
+ALTER TABLE timetable_lessons ADD CONSTRAINT fk_timetable_lessons_class_room FOREIGN KEY (class_room_id) REFERENCES class_room (id);
+ALTER TABLE timetable_lessons ADD CONSTRAINT fk_timetable_lessons_subject FOREIGN KEY (subject_id) REFERENCES subjects (id);
+ALTER TABLE timetable_lessons ADD CONSTRAINT fk_timetable_lessons_teacher FOREIGN KEY (teacher_id) REFERENCES teachers (id);
+ALTER TABLE timetable_lessons ADD CONSTRAINT fk_timetable_lessons_room FOREIGN KEY (room_id) REFERENCES rooms (id);
+ALTER TABLE timetable_lessons ADD CONSTRAINT fk_timetable_lessons_schedule FOREIGN KEY (schedule_id) REFERENCES class_schedule (id);
+CREATE INDEX idx_timetable_lessons_school_year ON timetable_lessons (school_year_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE timetable_lessons;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE class_schedule ADD COLUMN weekdays SMALLINT[] NOT NULL DEFAULT '{1,2,3,4,5}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE class_schedule DROP COLUMN weekdays;
+-- +goose StatementEnd
@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE attendances (
+    id UUID PRIMARY KEY,
+    class_room_id UUID NOT NULL,
+    subject_id UUID NOT NULL,
+    schedule_id UUID NOT NULL,
+    lesson_date DATE NOT NULL,
+    teacher_id UUID NOT NULL,
+    registration_id UUID NOT NULL,
+    student_id UUID NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    note VARCHAR(255) NULL,
+    created_at TIMESTAMP,
+    updated_at TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE attendances ADD CONSTRAINT fk_attendances_class_room FOREIGN KEY (class_room_id) REFERENCES class_room (id);
+ALTER TABLE attendances ADD CONSTRAINT fk_attendances_subject FOREIGN KEY (subject_id) REFERENCES subjects (id);
+ALTER TABLE attendances ADD CONSTRAINT fk_attendances_schedule FOREIGN KEY (schedule_id) REFERENCES class_schedule (id);
+ALTER TABLE attendances ADD CONSTRAINT fk_attendances_teacher FOREIGN KEY (teacher_id) REFERENCES teachers (id);
+ALTER TABLE attendances ADD CONSTRAINT fk_attendances_registration FOREIGN KEY (registration_id) REFERENCES registrations (id);
+ALTER TABLE attendances ADD CONSTRAINT fk_attendances_student FOREIGN KEY (student_id) REFERENCES students (id);
+CREATE UNIQUE INDEX idx_attendances_lesson_student ON attendances (class_room_id, subject_id, schedule_id, lesson_date, student_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE attendances;
+-- +goose StatementEnd
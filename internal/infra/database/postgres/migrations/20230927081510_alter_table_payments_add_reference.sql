@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE payments ADD COLUMN IF NOT EXISTS reference VARCHAR(100) NULL;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE payments SET reference = NULL
+WHERE id IN (
+    SELECT id FROM (
+        SELECT id, ROW_NUMBER() OVER (PARTITION BY reference ORDER BY created_at, paid_at, id) AS position
+        FROM payments
+        WHERE reference IS NOT NULL
+    ) duplicated
+    WHERE duplicated.position > 1
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE UNIQUE INDEX idx_payments_reference ON payments (reference) WHERE reference IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_payments_reference;
+ALTER TABLE payments DROP COLUMN reference;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+DROP INDEX idx_registration_contracts_registration;
+CREATE UNIQUE INDEX idx_registration_contracts_registration ON registration_contracts (registration_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_registration_contracts_registration;
+CREATE INDEX idx_registration_contracts_registration ON registration_contracts (registration_id);
+-- +goose StatementEnd
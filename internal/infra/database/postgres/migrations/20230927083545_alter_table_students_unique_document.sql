@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+DROP INDEX idx_students_document;
+CREATE UNIQUE INDEX idx_students_document ON students (document_type, document_number) WHERE deleted_at IS NULL AND document_number <> '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX idx_students_document;
+CREATE INDEX idx_students_document ON students (document_type, document_number) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE registration_status_history ADD COLUMN approved_by VARCHAR(255) NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE registration_status_history DROP COLUMN approved_by;
+-- +goose StatementEnd
@@ -60,3 +60,62 @@ func (q *Queries) DeleteAddressByOwner(ctx context.Context, arg DeleteAddressByO
 	_, err := q.db.ExecContext(ctx, deleteAddressByOwner, arg.DeletedAt, arg.OwnerID)
 	return err
 }
+
+const findAddressesByOwner = `-- name: FindAddressesByOwner :many
+SELECT id, street, city, district, state, zip_code, owner_id FROM addresses WHERE owner_id = $1 AND deleted_at IS NULL ORDER BY created_at
+`
+
+type FindAddressesByOwnerRow struct {
+	ID       uuid.UUID `json:"id"`
+	Street   string    `json:"street"`
+	City     string    `json:"city"`
+	District string    `json:"district"`
+	State    string    `json:"state"`
+	ZipCode  string    `json:"zip_code"`
+	OwnerID  uuid.UUID `json:"owner_id"`
+}
+
+func (q *Queries) FindAddressesByOwner(ctx context.Context, ownerID uuid.UUID) ([]FindAddressesByOwnerRow, error) {
+	rows, err := q.db.QueryContext(ctx, findAddressesByOwner, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindAddressesByOwnerRow
+	for rows.Next() {
+		var i FindAddressesByOwnerRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Street,
+			&i.City,
+			&i.District,
+			&i.State,
+			&i.ZipCode,
+			&i.OwnerID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const moveAddressesToOwner = `-- name: MoveAddressesToOwner :exec
+UPDATE addresses SET owner_id = $1 WHERE owner_id = $2 AND deleted_at IS NULL
+`
+
+type MoveAddressesToOwnerParams struct {
+	OwnerID   uuid.UUID `json:"owner_id"`
+	OwnerID_2 uuid.UUID `json:"owner_id_2"`
+}
+
+func (q *Queries) MoveAddressesToOwner(ctx context.Context, arg MoveAddressesToOwnerParams) error {
+	_, err := q.db.ExecContext(ctx, moveAddressesToOwner, arg.OwnerID, arg.OwnerID_2)
+	return err
+}
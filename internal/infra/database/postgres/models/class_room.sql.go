@@ -42,7 +42,6 @@ func (q *Queries) CreateClass(ctx context.Context, arg CreateClassParams) error
 		arg.Status,
 		arg.Identification,
 		arg.Vacancies,
-		arg.VacanciesOccupied,
 		arg.Shift,
 		arg.Level,
 		arg.Localization,
@@ -191,25 +190,31 @@ func (q *Queries) FindClassByIdLock(ctx context.Context, id uuid.UUID) (FindClas
 	return i, err
 }
 
-const updateClass = `-- name: UpdateClass :exec
-UPDATE class_room SET
-        status = $1,
-        identification = $2,
-        vacancies = $3,
-        vacancies_occupied = $4,
-        shift = $5,
-        level = $6,
-        localization = $7,
-        open_date = $8,
-        school_year_id = $9,
-        room_id = $10,
-        schedule_id = $11,
-        updated_at = $12
-WHERE id = $13
+const findClassesBySchoolYear = `-- name: FindClassesBySchoolYear :many
+SELECT id,
+       status,
+       active,
+       identification,
+       vacancies,
+       vacancies_occupied,
+       shift,
+       level,
+       localization,
+       open_date,
+       school_year_id,
+       room_id,
+      schedule_id,
+      type
+FROM class_room
+    WHERE school_year_id = $1
+        AND deleted_at IS NULL
+    ORDER BY identification
 `
 
-type UpdateClassParams struct {
+type FindClassesBySchoolYearRow struct {
+	ID                uuid.UUID      `json:"id"`
 	Status            string         `json:"status"`
+	Active            bool           `json:"active"`
 	Identification    string         `json:"identification"`
 	Vacancies         int32          `json:"vacancies"`
 	VacanciesOccupied int32          `json:"vacancies_occupied"`
@@ -220,8 +225,100 @@ type UpdateClassParams struct {
 	SchoolYearID      uuid.UUID      `json:"school_year_id"`
 	RoomID            uuid.NullUUID  `json:"room_id"`
 	ScheduleID        uuid.UUID      `json:"schedule_id"`
-	UpdatedAt         sql.NullTime   `json:"updated_at"`
-	ID                uuid.UUID      `json:"id"`
+	Type              string         `json:"type"`
+}
+
+func (q *Queries) FindClassesBySchoolYear(ctx context.Context, schoolYearID uuid.UUID) ([]FindClassesBySchoolYearRow, error) {
+	rows, err := q.db.QueryContext(ctx, findClassesBySchoolYear, schoolYearID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindClassesBySchoolYearRow
+	for rows.Next() {
+		var i FindClassesBySchoolYearRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Status,
+			&i.Active,
+			&i.Identification,
+			&i.Vacancies,
+			&i.VacanciesOccupied,
+			&i.Shift,
+			&i.Level,
+			&i.Localization,
+			&i.OpenDate,
+			&i.SchoolYearID,
+			&i.RoomID,
+			&i.ScheduleID,
+			&i.Type,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const releaseVacancyOccupied = `-- name: ReleaseVacancyOccupied :execrows
+UPDATE class_room
+    SET vacancies_occupied = $1,
+        updated_at = $2
+WHERE
+    id = $3
+    AND vacancies_occupied > 0
+    AND deleted_at IS NULL
+`
+
+type ReleaseVacancyOccupiedParams struct {
+	VacanciesOccupied int32        `json:"vacancies_occupied"`
+	UpdatedAt         sql.NullTime `json:"updated_at"`
+	ID                uuid.UUID    `json:"id"`
+}
+
+func (q *Queries) ReleaseVacancyOccupied(ctx context.Context, arg ReleaseVacancyOccupiedParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, releaseVacancyOccupied, arg.VacanciesOccupied, arg.UpdatedAt, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const updateClass = `-- name: UpdateClass :exec
+UPDATE class_room SET
+        status = $1,
+        identification = $2,
+        vacancies = $3,
+        shift = $4,
+        level = $5,
+        localization = $6,
+        open_date = $7,
+        school_year_id = $8,
+        room_id = $9,
+        schedule_id = $10,
+        updated_at = $11
+WHERE id = $12
+`
+
+type UpdateClassParams struct {
+	Status         string         `json:"status"`
+	Identification string         `json:"identification"`
+	Vacancies      int32          `json:"vacancies"`
+	Shift          string         `json:"shift"`
+	Level          string         `json:"level"`
+	Localization   sql.NullString `json:"localization"`
+	OpenDate       time.Time      `json:"open_date"`
+	SchoolYearID   uuid.UUID      `json:"school_year_id"`
+	RoomID         uuid.NullUUID  `json:"room_id"`
+	ScheduleID     uuid.UUID      `json:"schedule_id"`
+	UpdatedAt      sql.NullTime   `json:"updated_at"`
+	ID             uuid.UUID      `json:"id"`
 }
 
 func (q *Queries) UpdateClass(ctx context.Context, arg UpdateClassParams) error {
@@ -229,7 +326,6 @@ func (q *Queries) UpdateClass(ctx context.Context, arg UpdateClassParams) error
 		arg.Status,
 		arg.Identification,
 		arg.Vacancies,
-		arg.VacanciesOccupied,
 		arg.Shift,
 		arg.Level,
 		arg.Localization,
@@ -243,15 +339,14 @@ func (q *Queries) UpdateClass(ctx context.Context, arg UpdateClassParams) error
 	return err
 }
 
-const updateVacancyOccupied = `-- name: UpdateVacancyOccupied :exec
+const updateVacancyOccupied = `-- name: UpdateVacancyOccupied :execrows
 UPDATE class_room 
     SET vacancies_occupied = $1, 
         updated_at = $2 
 WHERE 
     id = $3 
     AND vacancies_occupied < vacancies 
-    AND deleted_at IS NULL 
-    RETURNING vacancies_occupied
+    AND deleted_at IS NULL
 `
 
 type UpdateVacancyOccupiedParams struct {
@@ -260,7 +355,10 @@ type UpdateVacancyOccupiedParams struct {
 	ID                uuid.UUID    `json:"id"`
 }
 
-func (q *Queries) UpdateVacancyOccupied(ctx context.Context, arg UpdateVacancyOccupiedParams) error {
-	_, err := q.db.ExecContext(ctx, updateVacancyOccupied, arg.VacanciesOccupied, arg.UpdatedAt, arg.ID)
-	return err
+func (q *Queries) UpdateVacancyOccupied(ctx context.Context, arg UpdateVacancyOccupiedParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, updateVacancyOccupied, arg.VacanciesOccupied, arg.UpdatedAt, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
@@ -24,6 +24,35 @@ type Address struct {
 	DeletedAt sql.NullTime `json:"deleted_at"`
 }
 
+type Attendance struct {
+	ID             uuid.UUID      `json:"id"`
+	ClassRoomID    uuid.UUID      `json:"class_room_id"`
+	SubjectID      uuid.UUID      `json:"subject_id"`
+	ScheduleID     uuid.UUID      `json:"schedule_id"`
+	LessonDate     time.Time      `json:"lesson_date"`
+	TeacherID      uuid.UUID      `json:"teacher_id"`
+	RegistrationID uuid.UUID      `json:"registration_id"`
+	StudentID      uuid.UUID      `json:"student_id"`
+	Status         string         `json:"status"`
+	Note           sql.NullString `json:"note"`
+	CreatedAt      sql.NullTime   `json:"created_at"`
+	UpdatedAt      sql.NullTime   `json:"updated_at"`
+}
+
+type BankSlip struct {
+	ID         uuid.UUID      `json:"id"`
+	InvoiceID  uuid.UUID      `json:"invoice_id"`
+	OurNumber  int64          `json:"our_number"`
+	Layout     string         `json:"layout"`
+	Remittance int32          `json:"remittance"`
+	Value      string         `json:"value"`
+	DueDate    time.Time      `json:"due_date"`
+	Status     string         `json:"status"`
+	Message    sql.NullString `json:"message"`
+	CreatedAt  time.Time      `json:"created_at"`
+	UpdatedAt  sql.NullTime   `json:"updated_at"`
+}
+
 type ClassRoom struct {
 	ID                uuid.UUID      `json:"id"`
 	Active            bool           `json:"active"`
@@ -53,6 +82,60 @@ type ClassSchedule struct {
 	CreatedAt    sql.NullTime `json:"created_at"`
 	UpdatedAt    sql.NullTime `json:"updated_at"`
 	DeletedAt    sql.NullTime `json:"deleted_at"`
+	Weekdays     []int16      `json:"weekdays"`
+}
+
+type ContractTemplate struct {
+	ID        uuid.UUID `json:"id"`
+	Content   string    `json:"content"`
+	Hash      string    `json:"hash"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type CurriculumMatrix struct {
+	ID           uuid.UUID    `json:"id"`
+	Level        string       `json:"level"`
+	SchoolYearID uuid.UUID    `json:"school_year_id"`
+	CreatedAt    sql.NullTime `json:"created_at"`
+	UpdatedAt    sql.NullTime `json:"updated_at"`
+	DeletedAt    sql.NullTime `json:"deleted_at"`
+}
+
+type CurriculumMatrixItem struct {
+	MatrixID      uuid.UUID `json:"matrix_id"`
+	SubjectID     uuid.UUID `json:"subject_id"`
+	WeeklyClasses int32     `json:"weekly_classes"`
+}
+
+type DocumentRequirement struct {
+	ID          uuid.UUID    `json:"id"`
+	Level       string       `json:"level"`
+	Kind        string       `json:"kind"`
+	Description string       `json:"description"`
+	CreatedAt   sql.NullTime `json:"created_at"`
+	UpdatedAt   sql.NullTime `json:"updated_at"`
+	DeletedAt   sql.NullTime `json:"deleted_at"`
+}
+
+type Invoice struct {
+	ID                      uuid.UUID    `json:"id"`
+	RegistrationID          uuid.UUID    `json:"registration_id"`
+	StudentID               uuid.UUID    `json:"student_id"`
+	Description             string       `json:"description"`
+	Kind                    string       `json:"kind"`
+	Installment             int32        `json:"installment"`
+	Value                   string       `json:"value"`
+	DueDate                 time.Time    `json:"due_date"`
+	Status                  string       `json:"status"`
+	CreatedAt               sql.NullTime `json:"created_at"`
+	UpdatedAt               sql.NullTime `json:"updated_at"`
+	PaidValue               string       `json:"paid_value"`
+	FinePercentage          string       `json:"fine_percentage"`
+	DailyInterestPercentage string       `json:"daily_interest_percentage"`
+	DiscountPercentage      string       `json:"discount_percentage"`
+	DiscountDaysBefore      int32        `json:"discount_days_before"`
+	ChargesPaid             string       `json:"charges_paid"`
+	DiscountValue           string       `json:"discount_value"`
 }
 
 type Parent struct {
@@ -62,13 +145,25 @@ type Parent struct {
 	Birthday    time.Time      `json:"birthday"`
 	RgDocument  sql.NullString `json:"rg_document"`
 	CpfDocument string         `json:"cpf_document"`
-	StudentID   uuid.UUID      `json:"student_id"`
 	Email       string         `json:"email"`
 	CreatedAt   sql.NullTime   `json:"created_at"`
 	UpdatedAt   sql.NullTime   `json:"updated_at"`
 	DeletedAt   sql.NullTime   `json:"deleted_at"`
 }
 
+type Payment struct {
+	ID             uuid.UUID      `json:"id"`
+	InvoiceID      uuid.UUID      `json:"invoice_id"`
+	RegistrationID uuid.UUID      `json:"registration_id"`
+	StudentID      uuid.UUID      `json:"student_id"`
+	Method         string         `json:"method"`
+	Value          string         `json:"value"`
+	AppliedValue   string         `json:"applied_value"`
+	PaidAt         time.Time      `json:"paid_at"`
+	CreatedAt      sql.NullTime   `json:"created_at"`
+	Reference      sql.NullString `json:"reference"`
+}
+
 type Phone struct {
 	ID          uuid.UUID    `json:"id"`
 	Description string       `json:"description"`
@@ -79,24 +174,103 @@ type Phone struct {
 	DeletedAt   sql.NullTime `json:"deleted_at"`
 }
 
+type PickupAuthorization struct {
+	ID          uuid.UUID      `json:"id"`
+	StudentID   uuid.UUID      `json:"student_id"`
+	ParentID    uuid.NullUUID  `json:"parent_id"`
+	Name        string         `json:"name"`
+	CpfDocument string         `json:"cpf_document"`
+	Photo       sql.NullString `json:"photo"`
+	ValidFrom   time.Time      `json:"valid_from"`
+	ValidUntil  sql.NullTime   `json:"valid_until"`
+	CreatedAt   sql.NullTime   `json:"created_at"`
+	UpdatedAt   sql.NullTime   `json:"updated_at"`
+	DeletedAt   sql.NullTime   `json:"deleted_at"`
+}
+
+type ReenrollmentCampaign struct {
+	ID               uuid.UUID `json:"id"`
+	FromSchoolYearID uuid.UUID `json:"from_school_year_id"`
+	ToSchoolYearID   uuid.UUID `json:"to_school_year_id"`
+	Deadline         time.Time `json:"deadline"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+type ReenrollmentProposal struct {
+	ID                uuid.UUID      `json:"id"`
+	CampaignID        uuid.UUID      `json:"campaign_id"`
+	RegistrationID    uuid.UUID      `json:"registration_id"`
+	StudentID         uuid.UUID      `json:"student_id"`
+	StudentName       string         `json:"student_name"`
+	ClassRoomID       uuid.UUID      `json:"class_room_id"`
+	ClassRoom         string         `json:"class_room"`
+	TargetLevel       string         `json:"target_level"`
+	TargetClassRoomID uuid.NullUUID  `json:"target_class_room_id"`
+	Status            string         `json:"status"`
+	Reason            sql.NullString `json:"reason"`
+	NewRegistrationID uuid.NullUUID  `json:"new_registration_id"`
+	RespondedAt       sql.NullTime   `json:"responded_at"`
+}
+
 type Registration struct {
-	ID                   uuid.UUID      `json:"id"`
-	Code                 string         `json:"code"`
-	ClassRoomID          uuid.NullUUID  `json:"class_room_id"`
-	Shift                sql.NullString `json:"shift"`
-	StudentID            uuid.UUID      `json:"student_id"`
-	ServiceID            uuid.UUID      `json:"service_id"`
-	MonthlyFee           string         `json:"monthly_fee"`
-	InstallmentsQuantity int32          `json:"installments_quantity"`
-	EnrollmentFee        sql.NullString `json:"enrollment_fee"`
-	DueDate              sql.NullTime   `json:"due_date"`
-	MonthDuration        sql.NullInt32  `json:"month_duration"`
-	Status               string         `json:"status"`
-	EnrollmentDate       sql.NullTime   `json:"enrollment_date"`
-	SchoolYearID         uuid.NullUUID  `json:"school_year_id"`
-	CreatedAt            sql.NullTime   `json:"created_at"`
-	UpdatedAt            sql.NullTime   `json:"updated_at"`
-	DeletedAt            sql.NullTime   `json:"deleted_at"`
+	ID                        uuid.UUID      `json:"id"`
+	Code                      string         `json:"code"`
+	ClassRoomID               uuid.NullUUID  `json:"class_room_id"`
+	Shift                     sql.NullString `json:"shift"`
+	StudentID                 uuid.UUID      `json:"student_id"`
+	ServiceID                 uuid.UUID      `json:"service_id"`
+	MonthlyFee                string         `json:"monthly_fee"`
+	InstallmentsQuantity      int32          `json:"installments_quantity"`
+	EnrollmentFee             sql.NullString `json:"enrollment_fee"`
+	DueDate                   sql.NullTime   `json:"due_date"`
+	MonthDuration             sql.NullInt32  `json:"month_duration"`
+	Status                    string         `json:"status"`
+	EnrollmentDate            sql.NullTime   `json:"enrollment_date"`
+	SchoolYearID              uuid.NullUUID  `json:"school_year_id"`
+	CreatedAt                 sql.NullTime   `json:"created_at"`
+	UpdatedAt                 sql.NullTime   `json:"updated_at"`
+	DeletedAt                 sql.NullTime   `json:"deleted_at"`
+	PaymentDay                sql.NullString `json:"payment_day"`
+	Paid                      bool           `json:"paid"`
+	FinancialResponsibleID    uuid.NullUUID  `json:"financial_responsible_id"`
+	FinancialResponsibleType  sql.NullString `json:"financial_responsible_type"`
+	FinancialResponsibleName  sql.NullString `json:"financial_responsible_name"`
+	FinancialResponsibleCpf   sql.NullString `json:"financial_responsible_cpf"`
+	FinancialResponsibleEmail sql.NullString `json:"financial_responsible_email"`
+}
+
+type RegistrationContract struct {
+	ID             uuid.UUID     `json:"id"`
+	RegistrationID uuid.UUID     `json:"registration_id"`
+	TemplateID     uuid.NullUUID `json:"template_id"`
+	TemplateHash   string        `json:"template_hash"`
+	Hash           string        `json:"hash"`
+	StorageKey     string        `json:"storage_key"`
+	IssuedAt       time.Time     `json:"issued_at"`
+}
+
+type RegistrationDiscount struct {
+	ID             uuid.UUID    `json:"id"`
+	RegistrationID uuid.UUID    `json:"registration_id"`
+	Kind           string       `json:"kind"`
+	Type           string       `json:"type"`
+	Value          string       `json:"value"`
+	Reason         string       `json:"reason"`
+	ValidFrom      time.Time    `json:"valid_from"`
+	ValidUntil     sql.NullTime `json:"valid_until"`
+	ApprovedBy     string       `json:"approved_by"`
+	CreatedAt      sql.NullTime `json:"created_at"`
+}
+
+type RegistrationStatusHistory struct {
+	ID             uuid.UUID      `json:"id"`
+	RegistrationID uuid.UUID      `json:"registration_id"`
+	PreviousStatus sql.NullString `json:"previous_status"`
+	Status         string         `json:"status"`
+	Reason         string         `json:"reason"`
+	ClassRoomID    uuid.UUID      `json:"class_room_id"`
+	CreatedAt      time.Time      `json:"created_at"`
+	ApprovedBy     sql.NullString `json:"approved_by"`
 }
 
 type Room struct {
@@ -127,24 +301,154 @@ type SchoolYear struct {
 }
 
 type Service struct {
-	ID          uuid.UUID    `json:"id"`
-	Description string       `json:"description"`
-	Price       string       `json:"price"`
-	CreatedAt   sql.NullTime `json:"created_at"`
-	UpdatedAt   sql.NullTime `json:"updated_at"`
-	DeletedAt   sql.NullTime `json:"deleted_at"`
+	ID                      uuid.UUID    `json:"id"`
+	Description             string       `json:"description"`
+	Price                   string       `json:"price"`
+	CreatedAt               sql.NullTime `json:"created_at"`
+	UpdatedAt               sql.NullTime `json:"updated_at"`
+	DeletedAt               sql.NullTime `json:"deleted_at"`
+	FinePercentage          string       `json:"fine_percentage"`
+	DailyInterestPercentage string       `json:"daily_interest_percentage"`
+	DiscountPercentage      string       `json:"discount_percentage"`
+	DiscountDaysBefore      int32        `json:"discount_days_before"`
 }
 
 type Student struct {
-	ID                 uuid.UUID      `json:"id"`
-	FirstName          string         `json:"first_name"`
-	LastName           string         `json:"last_name"`
-	Birthday           time.Time      `json:"birthday"`
-	RgDocument         sql.NullString `json:"rg_document"`
-	CpfDocument        string         `json:"cpf_document"`
-	Email              sql.NullString `json:"email"`
-	HimSelfResponsible bool           `json:"him_self_responsible"`
-	CreatedAt          sql.NullTime   `json:"created_at"`
-	UpdatedAt          sql.NullTime   `json:"updated_at"`
-	DeletedAt          sql.NullTime   `json:"deleted_at"`
+	ID                    uuid.UUID      `json:"id"`
+	FirstName             string         `json:"first_name"`
+	LastName              string         `json:"last_name"`
+	Birthday              time.Time      `json:"birthday"`
+	RgDocument            sql.NullString `json:"rg_document"`
+	CpfDocument           string         `json:"cpf_document"`
+	Email                 sql.NullString `json:"email"`
+	HimSelfResponsible    bool           `json:"him_self_responsible"`
+	CreatedAt             sql.NullTime   `json:"created_at"`
+	UpdatedAt             sql.NullTime   `json:"updated_at"`
+	DeletedAt             sql.NullTime   `json:"deleted_at"`
+	AcademicResponsibleID uuid.NullUUID  `json:"academic_responsible_id"`
+	DocumentType          string         `json:"document_type"`
+	DocumentNumber        string         `json:"document_number"`
+	PhotoKey              sql.NullString `json:"photo_key"`
+	PhotoContentType      sql.NullString `json:"photo_content_type"`
+}
+
+type StudentCredit struct {
+	ID        uuid.UUID    `json:"id"`
+	StudentID uuid.UUID    `json:"student_id"`
+	PaymentID uuid.UUID    `json:"payment_id"`
+	Value     string       `json:"value"`
+	Balance   string       `json:"balance"`
+	CreatedAt time.Time    `json:"created_at"`
+	UpdatedAt sql.NullTime `json:"updated_at"`
+}
+
+type StudentDocument struct {
+	ID             uuid.UUID      `json:"id"`
+	StudentID      uuid.UUID      `json:"student_id"`
+	RegistrationID uuid.NullUUID  `json:"registration_id"`
+	Kind           string         `json:"kind"`
+	FileName       string         `json:"file_name"`
+	ContentType    string         `json:"content_type"`
+	Size           int64          `json:"size"`
+	StorageKey     string         `json:"storage_key"`
+	Status         string         `json:"status"`
+	Reason         sql.NullString `json:"reason"`
+	UploadedAt     time.Time      `json:"uploaded_at"`
+	CreatedAt      sql.NullTime   `json:"created_at"`
+	UpdatedAt      sql.NullTime   `json:"updated_at"`
+}
+
+type StudentParent struct {
+	StudentID    uuid.UUID    `json:"student_id"`
+	ParentID     uuid.UUID    `json:"parent_id"`
+	Relationship string       `json:"relationship"`
+	CreatedAt    sql.NullTime `json:"created_at"`
+	UpdatedAt    sql.NullTime `json:"updated_at"`
+	DeletedAt    sql.NullTime `json:"deleted_at"`
+}
+
+type Subject struct {
+	ID        uuid.UUID    `json:"id"`
+	Code      string       `json:"code"`
+	Name      string       `json:"name"`
+	Workload  int32        `json:"workload"`
+	CreatedAt sql.NullTime `json:"created_at"`
+	UpdatedAt sql.NullTime `json:"updated_at"`
+	DeletedAt sql.NullTime `json:"deleted_at"`
+}
+
+type Teacher struct {
+	ID          uuid.UUID      `json:"id"`
+	FirstName   string         `json:"first_name"`
+	LastName    string         `json:"last_name"`
+	Birthday    time.Time      `json:"birthday"`
+	RgDocument  sql.NullString `json:"rg_document"`
+	CpfDocument string         `json:"cpf_document"`
+	Email       string         `json:"email"`
+	Status      string         `json:"status"`
+	HiredAt     time.Time      `json:"hired_at"`
+	DismissedAt sql.NullTime   `json:"dismissed_at"`
+	CreatedAt   sql.NullTime   `json:"created_at"`
+	UpdatedAt   sql.NullTime   `json:"updated_at"`
+	DeletedAt   sql.NullTime   `json:"deleted_at"`
+}
+
+type TeacherAssignment struct {
+	ID           uuid.UUID     `json:"id"`
+	TeacherID    uuid.UUID     `json:"teacher_id"`
+	ClassRoomID  uuid.UUID     `json:"class_room_id"`
+	SchoolYearID uuid.UUID     `json:"school_year_id"`
+	Subject      string        `json:"subject"`
+	CreatedAt    sql.NullTime  `json:"created_at"`
+	DeletedAt    sql.NullTime  `json:"deleted_at"`
+	SubjectID    uuid.NullUUID `json:"subject_id"`
+}
+
+type TeacherAvailability struct {
+	ID        uuid.UUID `json:"id"`
+	TeacherID uuid.UUID `json:"teacher_id"`
+	Weekday   int16     `json:"weekday"`
+	StartAt   time.Time `json:"start_at"`
+	EndAt     time.Time `json:"end_at"`
+}
+
+type TeacherQualification struct {
+	ID             uuid.UUID `json:"id"`
+	TeacherID      uuid.UUID `json:"teacher_id"`
+	Degree         string    `json:"degree"`
+	Course         string    `json:"course"`
+	Institution    string    `json:"institution"`
+	ConclusionYear int32     `json:"conclusion_year"`
+}
+
+type TimetableLesson struct {
+	ID           uuid.UUID    `json:"id"`
+	SchoolYearID uuid.UUID    `json:"school_year_id"`
+	ClassRoomID  uuid.UUID    `json:"class_room_id"`
+	SubjectID    uuid.UUID    `json:"subject_id"`
+	TeacherID    uuid.UUID    `json:"teacher_id"`
+	RoomID       uuid.UUID    `json:"room_id"`
+	ScheduleID   uuid.UUID    `json:"schedule_id"`
+	Weekday      int16        `json:"weekday"`
+	CreatedAt    sql.NullTime `json:"created_at"`
+	UpdatedAt    sql.NullTime `json:"updated_at"`
+}
+
+type WaitingList struct {
+	ID                   uuid.UUID     `json:"id"`
+	ClassRoomID          uuid.UUID     `json:"class_room_id"`
+	StudentID            uuid.UUID     `json:"student_id"`
+	ServiceID            uuid.UUID     `json:"service_id"`
+	Shift                string        `json:"shift"`
+	MonthlyFee           string        `json:"monthly_fee"`
+	InstallmentsQuantity int32         `json:"installments_quantity"`
+	EnrollmentFee        string        `json:"enrollment_fee"`
+	MonthDuration        int32         `json:"month_duration"`
+	PaymentDay           string        `json:"payment_day"`
+	Priority             int32         `json:"priority"`
+	RequestDate          time.Time     `json:"request_date"`
+	Status               string        `json:"status"`
+	RegistrationID       uuid.NullUUID `json:"registration_id"`
+	CreatedAt            sql.NullTime  `json:"created_at"`
+	UpdatedAt            sql.NullTime  `json:"updated_at"`
 }
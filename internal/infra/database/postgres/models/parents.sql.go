@@ -15,9 +15,9 @@ import (
 
 const createParent = `-- name: CreateParent :exec
 INSERT INTO parents
-(id, first_name, last_name, birthday, rg_document, cpf_document, student_id, email, created_at, updated_at)
+(id, first_name, last_name, birthday, rg_document, cpf_document, email, created_at, updated_at)
 VALUES
-($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)
+($1,$2,$3,$4,$5,$6,$7,$8,$9)
 `
 
 type CreateParentParams struct {
@@ -27,7 +27,6 @@ type CreateParentParams struct {
 	Birthday    time.Time      `json:"birthday"`
 	RgDocument  sql.NullString `json:"rg_document"`
 	CpfDocument string         `json:"cpf_document"`
-	StudentID   uuid.UUID      `json:"student_id"`
 	Email       string         `json:"email"`
 	CreatedAt   sql.NullTime   `json:"created_at"`
 	UpdatedAt   sql.NullTime   `json:"updated_at"`
@@ -42,7 +41,6 @@ func (q *Queries) CreateParent(ctx context.Context, arg CreateParentParams) erro
 		arg.Birthday,
 		arg.RgDocument,
 		arg.CpfDocument,
-		arg.StudentID,
 		arg.Email,
 		arg.CreatedAt,
 		arg.UpdatedAt,
@@ -50,16 +48,202 @@ func (q *Queries) CreateParent(ctx context.Context, arg CreateParentParams) erro
 	return err
 }
 
-const deleteParentsByStudent = `-- name: DeleteParentsByStudent :exec
-UPDATE parents SET deleted_at = $1 WHERE student_id = $2
+const findParentByCpf = `-- name: FindParentByCpf :one
+SELECT id, first_name, last_name, birthday, rg_document, cpf_document, email FROM parents WHERE cpf_document = $1 AND deleted_at IS NULL LIMIT 1
+`
+
+type FindParentByCpfRow struct {
+	ID          uuid.UUID      `json:"id"`
+	FirstName   string         `json:"first_name"`
+	LastName    string         `json:"last_name"`
+	Birthday    time.Time      `json:"birthday"`
+	RgDocument  sql.NullString `json:"rg_document"`
+	CpfDocument string         `json:"cpf_document"`
+	Email       string         `json:"email"`
+}
+
+func (q *Queries) FindParentByCpf(ctx context.Context, cpfDocument string) (FindParentByCpfRow, error) {
+	row := q.db.QueryRowContext(ctx, findParentByCpf, cpfDocument)
+	var i FindParentByCpfRow
+	err := row.Scan(
+		&i.ID,
+		&i.FirstName,
+		&i.LastName,
+		&i.Birthday,
+		&i.RgDocument,
+		&i.CpfDocument,
+		&i.Email,
+	)
+	return i, err
+}
+
+const findParentById = `-- name: FindParentById :one
+SELECT id, first_name, last_name, birthday, rg_document, cpf_document, email FROM parents WHERE id = $1 AND deleted_at IS NULL LIMIT 1
+`
+
+type FindParentByIdRow struct {
+	ID          uuid.UUID      `json:"id"`
+	FirstName   string         `json:"first_name"`
+	LastName    string         `json:"last_name"`
+	Birthday    time.Time      `json:"birthday"`
+	RgDocument  sql.NullString `json:"rg_document"`
+	CpfDocument string         `json:"cpf_document"`
+	Email       string         `json:"email"`
+}
+
+func (q *Queries) FindParentById(ctx context.Context, id uuid.UUID) (FindParentByIdRow, error) {
+	row := q.db.QueryRowContext(ctx, findParentById, id)
+	var i FindParentByIdRow
+	err := row.Scan(
+		&i.ID,
+		&i.FirstName,
+		&i.LastName,
+		&i.Birthday,
+		&i.RgDocument,
+		&i.CpfDocument,
+		&i.Email,
+	)
+	return i, err
+}
+
+const findParentChildren = `-- name: FindParentChildren :many
+SELECT s.id, s.first_name, s.last_name, s.cpf_document, sp.relationship
+FROM student_parents sp
+    JOIN students s ON s.id = sp.student_id
+WHERE sp.parent_id = $1
+    AND sp.deleted_at IS NULL
+    AND s.deleted_at IS NULL
+ORDER BY s.first_name, s.last_name
+`
+
+type FindParentChildrenRow struct {
+	ID           uuid.UUID `json:"id"`
+	FirstName    string    `json:"first_name"`
+	LastName     string    `json:"last_name"`
+	CpfDocument  string    `json:"cpf_document"`
+	Relationship string    `json:"relationship"`
+}
+
+func (q *Queries) FindParentChildren(ctx context.Context, parentID uuid.UUID) ([]FindParentChildrenRow, error) {
+	rows, err := q.db.QueryContext(ctx, findParentChildren, parentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindParentChildrenRow
+	for rows.Next() {
+		var i FindParentChildrenRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.CpfDocument,
+			&i.Relationship,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findParentsByStudent = `-- name: FindParentsByStudent :many
+SELECT p.id, p.first_name, p.last_name, p.birthday, p.rg_document, p.cpf_document, p.email, sp.relationship
+FROM parents p
+    JOIN student_parents sp ON sp.parent_id = p.id
+WHERE sp.student_id = $1
+    AND sp.deleted_at IS NULL
+    AND p.deleted_at IS NULL
+ORDER BY sp.created_at
+`
+
+type FindParentsByStudentRow struct {
+	ID           uuid.UUID      `json:"id"`
+	FirstName    string         `json:"first_name"`
+	LastName     string         `json:"last_name"`
+	Birthday     time.Time      `json:"birthday"`
+	RgDocument   sql.NullString `json:"rg_document"`
+	CpfDocument  string         `json:"cpf_document"`
+	Email        string         `json:"email"`
+	Relationship string         `json:"relationship"`
+}
+
+func (q *Queries) FindParentsByStudent(ctx context.Context, studentID uuid.UUID) ([]FindParentsByStudentRow, error) {
+	rows, err := q.db.QueryContext(ctx, findParentsByStudent, studentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindParentsByStudentRow
+	for rows.Next() {
+		var i FindParentsByStudentRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Birthday,
+			&i.RgDocument,
+			&i.CpfDocument,
+			&i.Email,
+			&i.Relationship,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const linkStudentParent = `-- name: LinkStudentParent :exec
+INSERT INTO student_parents
+(student_id, parent_id, relationship, created_at, updated_at)
+VALUES
+($1,$2,$3,$4,$5)
+ON CONFLICT (student_id, parent_id)
+DO UPDATE SET relationship = EXCLUDED.relationship, updated_at = EXCLUDED.updated_at, deleted_at = NULL
+`
+
+type LinkStudentParentParams struct {
+	StudentID    uuid.UUID    `json:"student_id"`
+	ParentID     uuid.UUID    `json:"parent_id"`
+	Relationship string       `json:"relationship"`
+	CreatedAt    sql.NullTime `json:"created_at"`
+	UpdatedAt    sql.NullTime `json:"updated_at"`
+}
+
+func (q *Queries) LinkStudentParent(ctx context.Context, arg LinkStudentParentParams) error {
+	_, err := q.db.ExecContext(ctx, linkStudentParent,
+		arg.StudentID,
+		arg.ParentID,
+		arg.Relationship,
+		arg.CreatedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
+
+const unlinkStudentParents = `-- name: UnlinkStudentParents :exec
+UPDATE student_parents SET deleted_at = $1 WHERE student_id = $2 AND deleted_at IS NULL
 `
 
-type DeleteParentsByStudentParams struct {
+type UnlinkStudentParentsParams struct {
 	DeletedAt sql.NullTime `json:"deleted_at"`
 	StudentID uuid.UUID    `json:"student_id"`
 }
 
-func (q *Queries) DeleteParentsByStudent(ctx context.Context, arg DeleteParentsByStudentParams) error {
-	_, err := q.db.ExecContext(ctx, deleteParentsByStudent, arg.DeletedAt, arg.StudentID)
+func (q *Queries) UnlinkStudentParents(ctx context.Context, arg UnlinkStudentParentsParams) error {
+	_, err := q.db.ExecContext(ctx, unlinkStudentParents, arg.DeletedAt, arg.StudentID)
 	return err
 }
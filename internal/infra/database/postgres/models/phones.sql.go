@@ -50,3 +50,56 @@ func (q *Queries) DeletePhonesByOwner(ctx context.Context, arg DeletePhonesByOwn
 	_, err := q.db.ExecContext(ctx, deletePhonesByOwner, arg.DeletedAt, arg.OwnerID)
 	return err
 }
+
+const findPhonesByOwner = `-- name: FindPhonesByOwner :many
+SELECT id, description, phone, owner_id FROM phones WHERE owner_id = $1 AND deleted_at IS NULL
+`
+
+type FindPhonesByOwnerRow struct {
+	ID          uuid.UUID `json:"id"`
+	Description string    `json:"description"`
+	Phone       string    `json:"phone"`
+	OwnerID     uuid.UUID `json:"owner_id"`
+}
+
+func (q *Queries) FindPhonesByOwner(ctx context.Context, ownerID uuid.UUID) ([]FindPhonesByOwnerRow, error) {
+	rows, err := q.db.QueryContext(ctx, findPhonesByOwner, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindPhonesByOwnerRow
+	for rows.Next() {
+		var i FindPhonesByOwnerRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Description,
+			&i.Phone,
+			&i.OwnerID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const movePhonesToOwner = `-- name: MovePhonesToOwner :exec
+UPDATE phones SET owner_id = $1 WHERE owner_id = $2 AND deleted_at IS NULL
+`
+
+type MovePhonesToOwnerParams struct {
+	OwnerID   uuid.UUID `json:"owner_id"`
+	OwnerID_2 uuid.UUID `json:"owner_id_2"`
+}
+
+func (q *Queries) MovePhonesToOwner(ctx context.Context, arg MovePhonesToOwnerParams) error {
+	_, err := q.db.ExecContext(ctx, movePhonesToOwner, arg.OwnerID, arg.OwnerID_2)
+	return err
+}
@@ -8,37 +8,71 @@ package models
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
+const countActiveSiblingRegistrations = `-- name: CountActiveSiblingRegistrations :one
+SELECT COUNT(DISTINCT r.id)
+FROM registrations r
+    JOIN student_parents sp ON sp.student_id = r.student_id AND sp.deleted_at IS NULL
+    JOIN parents p ON p.id = sp.parent_id AND p.deleted_at IS NULL
+WHERE p.cpf_document = ANY($1::varchar[])
+    AND r.student_id <> $2
+    AND r.status IN ('WAIT_ENROLLMENT_FEE', 'APPROVED', 'LOCKED')
+    AND r.deleted_at IS NULL
+`
+
+type CountActiveSiblingRegistrationsParams struct {
+	ParentCpfs []string  `json:"parent_cpfs"`
+	StudentID  uuid.UUID `json:"student_id"`
+}
+
+func (q *Queries) CountActiveSiblingRegistrations(ctx context.Context, arg CountActiveSiblingRegistrationsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countActiveSiblingRegistrations, pq.Array(arg.ParentCpfs), arg.StudentID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createRegistration = `-- name: CreateRegistration :exec
 
 INSERT INTO registrations 
     (id, code, class_room_id, shift, student_id, 
      service_id, monthly_fee, installments_quantity,
      enrollment_fee, due_date, month_duration, status,
-     enrollment_date, school_year_id, created_at, updated_at)
-    VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16)
+     enrollment_date, school_year_id, created_at, updated_at,
+     payment_day, paid, financial_responsible_id, financial_responsible_type,
+     financial_responsible_name, financial_responsible_cpf, financial_responsible_email)
+    VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23)
 `
 
 type CreateRegistrationParams struct {
-	ID                   uuid.UUID      `json:"id"`
-	Code                 string         `json:"code"`
-	ClassRoomID          uuid.NullUUID  `json:"class_room_id"`
-	Shift                sql.NullString `json:"shift"`
-	StudentID            uuid.UUID      `json:"student_id"`
-	ServiceID            uuid.UUID      `json:"service_id"`
-	MonthlyFee           string         `json:"monthly_fee"`
-	InstallmentsQuantity int32          `json:"installments_quantity"`
-	EnrollmentFee        sql.NullString `json:"enrollment_fee"`
-	DueDate              sql.NullTime   `json:"due_date"`
-	MonthDuration        sql.NullInt32  `json:"month_duration"`
-	Status               string         `json:"status"`
-	EnrollmentDate       sql.NullTime   `json:"enrollment_date"`
-	SchoolYearID         uuid.NullUUID  `json:"school_year_id"`
-	CreatedAt            sql.NullTime   `json:"created_at"`
-	UpdatedAt            sql.NullTime   `json:"updated_at"`
+	ID                        uuid.UUID      `json:"id"`
+	Code                      string         `json:"code"`
+	ClassRoomID               uuid.NullUUID  `json:"class_room_id"`
+	Shift                     sql.NullString `json:"shift"`
+	StudentID                 uuid.UUID      `json:"student_id"`
+	ServiceID                 uuid.UUID      `json:"service_id"`
+	MonthlyFee                string         `json:"monthly_fee"`
+	InstallmentsQuantity      int32          `json:"installments_quantity"`
+	EnrollmentFee             sql.NullString `json:"enrollment_fee"`
+	DueDate                   sql.NullTime   `json:"due_date"`
+	MonthDuration             sql.NullInt32  `json:"month_duration"`
+	Status                    string         `json:"status"`
+	EnrollmentDate            sql.NullTime   `json:"enrollment_date"`
+	SchoolYearID              uuid.NullUUID  `json:"school_year_id"`
+	CreatedAt                 sql.NullTime   `json:"created_at"`
+	UpdatedAt                 sql.NullTime   `json:"updated_at"`
+	PaymentDay                sql.NullString `json:"payment_day"`
+	Paid                      bool           `json:"paid"`
+	FinancialResponsibleID    uuid.NullUUID  `json:"financial_responsible_id"`
+	FinancialResponsibleType  sql.NullString `json:"financial_responsible_type"`
+	FinancialResponsibleName  sql.NullString `json:"financial_responsible_name"`
+	FinancialResponsibleCpf   sql.NullString `json:"financial_responsible_cpf"`
+	FinancialResponsibleEmail sql.NullString `json:"financial_responsible_email"`
 }
 
 // Active: 1691937846246@@127.0.0.1@9500@postgres
@@ -60,12 +94,360 @@ func (q *Queries) CreateRegistration(ctx context.Context, arg CreateRegistration
 		arg.SchoolYearID,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.PaymentDay,
+		arg.Paid,
+		arg.FinancialResponsibleID,
+		arg.FinancialResponsibleType,
+		arg.FinancialResponsibleName,
+		arg.FinancialResponsibleCpf,
+		arg.FinancialResponsibleEmail,
+	)
+	return err
+}
+
+const createRegistrationDiscount = `-- name: CreateRegistrationDiscount :exec
+INSERT INTO registration_discounts
+    (id, registration_id, kind, type, value, reason, valid_from, valid_until, approved_by, created_at)
+    VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)
+`
+
+type CreateRegistrationDiscountParams struct {
+	ID             uuid.UUID    `json:"id"`
+	RegistrationID uuid.UUID    `json:"registration_id"`
+	Kind           string       `json:"kind"`
+	Type           string       `json:"type"`
+	Value          string       `json:"value"`
+	Reason         string       `json:"reason"`
+	ValidFrom      time.Time    `json:"valid_from"`
+	ValidUntil     sql.NullTime `json:"valid_until"`
+	ApprovedBy     string       `json:"approved_by"`
+	CreatedAt      sql.NullTime `json:"created_at"`
+}
+
+func (q *Queries) CreateRegistrationDiscount(ctx context.Context, arg CreateRegistrationDiscountParams) error {
+	_, err := q.db.ExecContext(ctx, createRegistrationDiscount,
+		arg.ID,
+		arg.RegistrationID,
+		arg.Kind,
+		arg.Type,
+		arg.Value,
+		arg.Reason,
+		arg.ValidFrom,
+		arg.ValidUntil,
+		arg.ApprovedBy,
+		arg.CreatedAt,
 	)
 	return err
 }
 
+const createRegistrationStatusHistory = `-- name: CreateRegistrationStatusHistory :exec
+INSERT INTO registration_status_history
+    (id, registration_id, previous_status, status, reason, class_room_id, created_at, approved_by)
+    VALUES ($1,$2,$3,$4,$5,$6,$7,$8)
+`
+
+type CreateRegistrationStatusHistoryParams struct {
+	ID             uuid.UUID      `json:"id"`
+	RegistrationID uuid.UUID      `json:"registration_id"`
+	PreviousStatus sql.NullString `json:"previous_status"`
+	Status         string         `json:"status"`
+	Reason         string         `json:"reason"`
+	ClassRoomID    uuid.UUID      `json:"class_room_id"`
+	CreatedAt      time.Time      `json:"created_at"`
+	ApprovedBy     sql.NullString `json:"approved_by"`
+}
+
+func (q *Queries) CreateRegistrationStatusHistory(ctx context.Context, arg CreateRegistrationStatusHistoryParams) error {
+	_, err := q.db.ExecContext(ctx, createRegistrationStatusHistory,
+		arg.ID,
+		arg.RegistrationID,
+		arg.PreviousStatus,
+		arg.Status,
+		arg.Reason,
+		arg.ClassRoomID,
+		arg.CreatedAt,
+		arg.ApprovedBy,
+	)
+	return err
+}
+
+const findRegistrationById = `-- name: FindRegistrationById :one
+SELECT id, code, class_room_id, shift, student_id, service_id, monthly_fee,
+       installments_quantity, enrollment_fee, due_date, month_duration, status,
+       enrollment_date, payment_day, paid, financial_responsible_id, financial_responsible_type,
+       financial_responsible_name, financial_responsible_cpf, financial_responsible_email
+FROM registrations
+    WHERE id = $1
+        AND deleted_at IS NULL
+`
+
+type FindRegistrationByIdRow struct {
+	ID                        uuid.UUID      `json:"id"`
+	Code                      string         `json:"code"`
+	ClassRoomID               uuid.NullUUID  `json:"class_room_id"`
+	Shift                     sql.NullString `json:"shift"`
+	StudentID                 uuid.UUID      `json:"student_id"`
+	ServiceID                 uuid.UUID      `json:"service_id"`
+	MonthlyFee                string         `json:"monthly_fee"`
+	InstallmentsQuantity      int32          `json:"installments_quantity"`
+	EnrollmentFee             sql.NullString `json:"enrollment_fee"`
+	DueDate                   sql.NullTime   `json:"due_date"`
+	MonthDuration             sql.NullInt32  `json:"month_duration"`
+	Status                    string         `json:"status"`
+	EnrollmentDate            sql.NullTime   `json:"enrollment_date"`
+	PaymentDay                sql.NullString `json:"payment_day"`
+	Paid                      bool           `json:"paid"`
+	FinancialResponsibleID    uuid.NullUUID  `json:"financial_responsible_id"`
+	FinancialResponsibleType  sql.NullString `json:"financial_responsible_type"`
+	FinancialResponsibleName  sql.NullString `json:"financial_responsible_name"`
+	FinancialResponsibleCpf   sql.NullString `json:"financial_responsible_cpf"`
+	FinancialResponsibleEmail sql.NullString `json:"financial_responsible_email"`
+}
+
+func (q *Queries) FindRegistrationById(ctx context.Context, id uuid.UUID) (FindRegistrationByIdRow, error) {
+	row := q.db.QueryRowContext(ctx, findRegistrationById, id)
+	var i FindRegistrationByIdRow
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.ClassRoomID,
+		&i.Shift,
+		&i.StudentID,
+		&i.ServiceID,
+		&i.MonthlyFee,
+		&i.InstallmentsQuantity,
+		&i.EnrollmentFee,
+		&i.DueDate,
+		&i.MonthDuration,
+		&i.Status,
+		&i.EnrollmentDate,
+		&i.PaymentDay,
+		&i.Paid,
+		&i.FinancialResponsibleID,
+		&i.FinancialResponsibleType,
+		&i.FinancialResponsibleName,
+		&i.FinancialResponsibleCpf,
+		&i.FinancialResponsibleEmail,
+	)
+	return i, err
+}
+
+const findRegistrationByIdLock = `-- name: FindRegistrationByIdLock :one
+SELECT id, code, class_room_id, shift, student_id, service_id, monthly_fee,
+       installments_quantity, enrollment_fee, due_date, month_duration, status,
+       enrollment_date, payment_day, paid, financial_responsible_id, financial_responsible_type,
+       financial_responsible_name, financial_responsible_cpf, financial_responsible_email
+FROM registrations
+    WHERE id = $1
+        AND deleted_at IS NULL
+        FOR UPDATE
+`
+
+type FindRegistrationByIdLockRow struct {
+	ID                        uuid.UUID      `json:"id"`
+	Code                      string         `json:"code"`
+	ClassRoomID               uuid.NullUUID  `json:"class_room_id"`
+	Shift                     sql.NullString `json:"shift"`
+	StudentID                 uuid.UUID      `json:"student_id"`
+	ServiceID                 uuid.UUID      `json:"service_id"`
+	MonthlyFee                string         `json:"monthly_fee"`
+	InstallmentsQuantity      int32          `json:"installments_quantity"`
+	EnrollmentFee             sql.NullString `json:"enrollment_fee"`
+	DueDate                   sql.NullTime   `json:"due_date"`
+	MonthDuration             sql.NullInt32  `json:"month_duration"`
+	Status                    string         `json:"status"`
+	EnrollmentDate            sql.NullTime   `json:"enrollment_date"`
+	PaymentDay                sql.NullString `json:"payment_day"`
+	Paid                      bool           `json:"paid"`
+	FinancialResponsibleID    uuid.NullUUID  `json:"financial_responsible_id"`
+	FinancialResponsibleType  sql.NullString `json:"financial_responsible_type"`
+	FinancialResponsibleName  sql.NullString `json:"financial_responsible_name"`
+	FinancialResponsibleCpf   sql.NullString `json:"financial_responsible_cpf"`
+	FinancialResponsibleEmail sql.NullString `json:"financial_responsible_email"`
+}
+
+func (q *Queries) FindRegistrationByIdLock(ctx context.Context, id uuid.UUID) (FindRegistrationByIdLockRow, error) {
+	row := q.db.QueryRowContext(ctx, findRegistrationByIdLock, id)
+	var i FindRegistrationByIdLockRow
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.ClassRoomID,
+		&i.Shift,
+		&i.StudentID,
+		&i.ServiceID,
+		&i.MonthlyFee,
+		&i.InstallmentsQuantity,
+		&i.EnrollmentFee,
+		&i.DueDate,
+		&i.MonthDuration,
+		&i.Status,
+		&i.EnrollmentDate,
+		&i.PaymentDay,
+		&i.Paid,
+		&i.FinancialResponsibleID,
+		&i.FinancialResponsibleType,
+		&i.FinancialResponsibleName,
+		&i.FinancialResponsibleCpf,
+		&i.FinancialResponsibleEmail,
+	)
+	return i, err
+}
+
+const findRegistrationDiscounts = `-- name: FindRegistrationDiscounts :many
+SELECT id, registration_id, kind, type, value, reason, valid_from, valid_until, approved_by
+FROM registration_discounts
+    WHERE registration_id = $1
+    ORDER BY created_at
+`
+
+type FindRegistrationDiscountsRow struct {
+	ID             uuid.UUID    `json:"id"`
+	RegistrationID uuid.UUID    `json:"registration_id"`
+	Kind           string       `json:"kind"`
+	Type           string       `json:"type"`
+	Value          string       `json:"value"`
+	Reason         string       `json:"reason"`
+	ValidFrom      time.Time    `json:"valid_from"`
+	ValidUntil     sql.NullTime `json:"valid_until"`
+	ApprovedBy     string       `json:"approved_by"`
+}
+
+func (q *Queries) FindRegistrationDiscounts(ctx context.Context, registrationID uuid.UUID) ([]FindRegistrationDiscountsRow, error) {
+	rows, err := q.db.QueryContext(ctx, findRegistrationDiscounts, registrationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindRegistrationDiscountsRow
+	for rows.Next() {
+		var i FindRegistrationDiscountsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.RegistrationID,
+			&i.Kind,
+			&i.Type,
+			&i.Value,
+			&i.Reason,
+			&i.ValidFrom,
+			&i.ValidUntil,
+			&i.ApprovedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findRegistrationStatusHistory = `-- name: FindRegistrationStatusHistory :many
+SELECT id, registration_id, previous_status, status, reason, class_room_id, created_at, approved_by
+FROM registration_status_history
+    WHERE registration_id = $1
+    ORDER BY created_at
+`
+
+func (q *Queries) FindRegistrationStatusHistory(ctx context.Context, registrationID uuid.UUID) ([]RegistrationStatusHistory, error) {
+	rows, err := q.db.QueryContext(ctx, findRegistrationStatusHistory, registrationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RegistrationStatusHistory
+	for rows.Next() {
+		var i RegistrationStatusHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.RegistrationID,
+			&i.PreviousStatus,
+			&i.Status,
+			&i.Reason,
+			&i.ClassRoomID,
+			&i.CreatedAt,
+			&i.ApprovedBy,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findRegistrationsByStudent = `-- name: FindRegistrationsByStudent :many
+SELECT r.id, r.code, r.status, r.shift, r.enrollment_date,
+       r.class_room_id, c.identification, r.school_year_id, y.year
+FROM registrations r
+    LEFT JOIN class_room c ON c.id = r.class_room_id
+    LEFT JOIN school_year y ON y.id = r.school_year_id
+WHERE r.student_id = $1
+    AND r.deleted_at IS NULL
+ORDER BY r.enrollment_date DESC
+`
+
+type FindRegistrationsByStudentRow struct {
+	ID             uuid.UUID      `json:"id"`
+	Code           string         `json:"code"`
+	Status         string         `json:"status"`
+	Shift          sql.NullString `json:"shift"`
+	EnrollmentDate sql.NullTime   `json:"enrollment_date"`
+	ClassRoomID    uuid.NullUUID  `json:"class_room_id"`
+	Identification sql.NullString `json:"identification"`
+	SchoolYearID   uuid.NullUUID  `json:"school_year_id"`
+	Year           sql.NullString `json:"year"`
+}
+
+func (q *Queries) FindRegistrationsByStudent(ctx context.Context, studentID uuid.UUID) ([]FindRegistrationsByStudentRow, error) {
+	rows, err := q.db.QueryContext(ctx, findRegistrationsByStudent, studentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindRegistrationsByStudentRow
+	for rows.Next() {
+		var i FindRegistrationsByStudentRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.Status,
+			&i.Shift,
+			&i.EnrollmentDate,
+			&i.ClassRoomID,
+			&i.Identification,
+			&i.SchoolYearID,
+			&i.Year,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const searchStudentAlreadyRegistered = `-- name: SearchStudentAlreadyRegistered :one
-    SELECT code FROM registrations WHERE class_room_id = $1 AND student_id = $2 LIMIT 1
+    SELECT code FROM registrations
+        WHERE class_room_id = $1
+            AND student_id = $2
+            AND status IN ('WAIT_ENROLLMENT_FEE', 'APPROVED', 'LOCKED')
+            AND deleted_at IS NULL
+        LIMIT 1
 `
 
 type SearchStudentAlreadyRegisteredParams struct {
@@ -79,3 +461,24 @@ func (q *Queries) SearchStudentAlreadyRegistered(ctx context.Context, arg Search
 	err := row.Scan(&code)
 	return code, err
 }
+
+const updateRegistrationStatus = `-- name: UpdateRegistrationStatus :exec
+UPDATE registrations SET status = $1, paid = $2, updated_at = $3 WHERE id = $4
+`
+
+type UpdateRegistrationStatusParams struct {
+	Status    string       `json:"status"`
+	Paid      bool         `json:"paid"`
+	UpdatedAt sql.NullTime `json:"updated_at"`
+	ID        uuid.UUID    `json:"id"`
+}
+
+func (q *Queries) UpdateRegistrationStatus(ctx context.Context, arg UpdateRegistrationStatusParams) error {
+	_, err := q.db.ExecContext(ctx, updateRegistrationStatus,
+		arg.Status,
+		arg.Paid,
+		arg.UpdatedAt,
+		arg.ID,
+	)
+	return err
+}
@@ -11,10 +11,11 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 const createSchedule = `-- name: CreateSchedule :exec
-INSERT INTO class_schedule (id, description, start_at, end_at, school_year_id, created_at, updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7)
+INSERT INTO class_schedule (id, description, start_at, end_at, weekdays, school_year_id, created_at, updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8)
 `
 
 type CreateScheduleParams struct {
@@ -22,6 +23,7 @@ type CreateScheduleParams struct {
 	Description  string       `json:"description"`
 	StartAt      time.Time    `json:"start_at"`
 	EndAt        time.Time    `json:"end_at"`
+	Weekdays     []int16      `json:"weekdays"`
 	SchoolYearID uuid.UUID    `json:"school_year_id"`
 	CreatedAt    sql.NullTime `json:"created_at"`
 	UpdatedAt    sql.NullTime `json:"updated_at"`
@@ -33,6 +35,7 @@ func (q *Queries) CreateSchedule(ctx context.Context, arg CreateScheduleParams)
 		arg.Description,
 		arg.StartAt,
 		arg.EndAt,
+		pq.Array(arg.Weekdays),
 		arg.SchoolYearID,
 		arg.CreatedAt,
 		arg.UpdatedAt,
@@ -55,7 +58,7 @@ func (q *Queries) DeleteSchedule(ctx context.Context, arg DeleteScheduleParams)
 }
 
 const findBySchoolYearId = `-- name: FindBySchoolYearId :many
-SELECT class_schedule.id as schedule_id, description, class_schedule.start_at, class_schedule.end_at, school_year.year FROM class_schedule
+SELECT class_schedule.id as schedule_id, description, class_schedule.start_at, class_schedule.end_at, class_schedule.weekdays, school_year.year FROM class_schedule
      JOIN school_year ON school_year.id = class_schedule.school_year_id
      WHERE class_schedule.school_year_id = $1 AND class_schedule.deleted_at IS NULL
 `
@@ -65,6 +68,7 @@ type FindBySchoolYearIdRow struct {
 	Description string    `json:"description"`
 	StartAt     time.Time `json:"start_at"`
 	EndAt       time.Time `json:"end_at"`
+	Weekdays    []int16   `json:"weekdays"`
 	Year        string    `json:"year"`
 }
 
@@ -82,6 +86,7 @@ func (q *Queries) FindBySchoolYearId(ctx context.Context, schoolYearID uuid.UUID
 			&i.Description,
 			&i.StartAt,
 			&i.EndAt,
+			pq.Array(&i.Weekdays),
 			&i.Year,
 		); err != nil {
 			return nil, err
@@ -98,7 +103,7 @@ func (q *Queries) FindBySchoolYearId(ctx context.Context, schoolYearID uuid.UUID
 }
 
 const findOneSchedule = `-- name: FindOneSchedule :one
-SELECT class_schedule.id as schedule_id, description, class_schedule.start_at, class_schedule.end_at, school_year.id FROM class_schedule
+SELECT class_schedule.id as schedule_id, description, class_schedule.start_at, class_schedule.end_at, class_schedule.weekdays, school_year.id FROM class_schedule
      JOIN school_year ON school_year.id = class_schedule.school_year_id
      WHERE class_schedule.id = $1 AND class_schedule.deleted_at IS NULL
 `
@@ -108,6 +113,7 @@ type FindOneScheduleRow struct {
 	Description string    `json:"description"`
 	StartAt     time.Time `json:"start_at"`
 	EndAt       time.Time `json:"end_at"`
+	Weekdays    []int16   `json:"weekdays"`
 	ID          uuid.UUID `json:"id"`
 }
 
@@ -119,19 +125,102 @@ func (q *Queries) FindOneSchedule(ctx context.Context, id uuid.UUID) (FindOneSch
 		&i.Description,
 		&i.StartAt,
 		&i.EndAt,
+		pq.Array(&i.Weekdays),
 		&i.ID,
 	)
 	return i, err
 }
 
+const findScheduleRooms = `-- name: FindScheduleRooms :many
+SELECT room_id FROM room_schedule WHERE schedule_id = $1 AND school_year_id = $2
+`
+
+type FindScheduleRoomsParams struct {
+	ScheduleID   uuid.UUID `json:"schedule_id"`
+	SchoolYearID uuid.UUID `json:"school_year_id"`
+}
+
+func (q *Queries) FindScheduleRooms(ctx context.Context, arg FindScheduleRoomsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, findScheduleRooms, arg.ScheduleID, arg.SchoolYearID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var room_id uuid.UUID
+		if err := rows.Scan(&room_id); err != nil {
+			return nil, err
+		}
+		items = append(items, room_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findSchedulesByRoom = `-- name: FindSchedulesByRoom :many
+SELECT class_schedule.id as schedule_id, description, class_schedule.start_at, class_schedule.end_at, class_schedule.weekdays FROM class_schedule
+     JOIN room_schedule ON room_schedule.schedule_id = class_schedule.id
+     WHERE room_schedule.room_id = $1 AND room_schedule.school_year_id = $2 AND class_schedule.deleted_at IS NULL
+     ORDER BY class_schedule.start_at
+`
+
+type FindSchedulesByRoomParams struct {
+	RoomID       uuid.UUID `json:"room_id"`
+	SchoolYearID uuid.UUID `json:"school_year_id"`
+}
+
+type FindSchedulesByRoomRow struct {
+	ScheduleID  uuid.UUID `json:"schedule_id"`
+	Description string    `json:"description"`
+	StartAt     time.Time `json:"start_at"`
+	EndAt       time.Time `json:"end_at"`
+	Weekdays    []int16   `json:"weekdays"`
+}
+
+func (q *Queries) FindSchedulesByRoom(ctx context.Context, arg FindSchedulesByRoomParams) ([]FindSchedulesByRoomRow, error) {
+	rows, err := q.db.QueryContext(ctx, findSchedulesByRoom, arg.RoomID, arg.SchoolYearID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindSchedulesByRoomRow
+	for rows.Next() {
+		var i FindSchedulesByRoomRow
+		if err := rows.Scan(
+			&i.ScheduleID,
+			&i.Description,
+			&i.StartAt,
+			&i.EndAt,
+			pq.Array(&i.Weekdays),
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateSchedule = `-- name: UpdateSchedule :exec
-UPDATE class_schedule SET description = $1, start_at = $2, end_at = $3, school_year_id = $4, updated_at = $5 WHERE id = $6
+UPDATE class_schedule SET description = $1, start_at = $2, end_at = $3, weekdays = $4, school_year_id = $5, updated_at = $6 WHERE id = $7
 `
 
 type UpdateScheduleParams struct {
 	Description  string       `json:"description"`
 	StartAt      time.Time    `json:"start_at"`
 	EndAt        time.Time    `json:"end_at"`
+	Weekdays     []int16      `json:"weekdays"`
 	SchoolYearID uuid.UUID    `json:"school_year_id"`
 	UpdatedAt    sql.NullTime `json:"updated_at"`
 	ID           uuid.UUID    `json:"id"`
@@ -142,6 +231,7 @@ func (q *Queries) UpdateSchedule(ctx context.Context, arg UpdateScheduleParams)
 		arg.Description,
 		arg.StartAt,
 		arg.EndAt,
+		pq.Array(arg.Weekdays),
 		arg.SchoolYearID,
 		arg.UpdatedAt,
 		arg.ID,
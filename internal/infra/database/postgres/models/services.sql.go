@@ -13,15 +13,19 @@ import (
 )
 
 const createService = `-- name: CreateService :exec
-INSERT into services (id, description, price, created_at, updated_at) VALUES ($1,$2,$3,$4,$5)
+INSERT into services (id, description, price, fine_percentage, daily_interest_percentage, discount_percentage, discount_days_before, created_at, updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)
 `
 
 type CreateServiceParams struct {
-	ID          uuid.UUID    `json:"id"`
-	Description string       `json:"description"`
-	Price       string       `json:"price"`
-	CreatedAt   sql.NullTime `json:"created_at"`
-	UpdatedAt   sql.NullTime `json:"updated_at"`
+	ID                      uuid.UUID    `json:"id"`
+	Description             string       `json:"description"`
+	Price                   string       `json:"price"`
+	FinePercentage          string       `json:"fine_percentage"`
+	DailyInterestPercentage string       `json:"daily_interest_percentage"`
+	DiscountPercentage      string       `json:"discount_percentage"`
+	DiscountDaysBefore      int32        `json:"discount_days_before"`
+	CreatedAt               sql.NullTime `json:"created_at"`
+	UpdatedAt               sql.NullTime `json:"updated_at"`
 }
 
 func (q *Queries) CreateService(ctx context.Context, arg CreateServiceParams) error {
@@ -29,6 +33,10 @@ func (q *Queries) CreateService(ctx context.Context, arg CreateServiceParams) er
 		arg.ID,
 		arg.Description,
 		arg.Price,
+		arg.FinePercentage,
+		arg.DailyInterestPercentage,
+		arg.DiscountPercentage,
+		arg.DiscountDaysBefore,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 	)
@@ -50,37 +58,57 @@ func (q *Queries) DeleteService(ctx context.Context, arg DeleteServiceParams) er
 }
 
 const findServiceById = `-- name: FindServiceById :one
-SELECT id, description, price FROM services WHERE id = $1 AND deleted_at IS NULL
+SELECT id, description, price, fine_percentage, daily_interest_percentage, discount_percentage, discount_days_before FROM services WHERE id = $1 AND deleted_at IS NULL
 `
 
 type FindServiceByIdRow struct {
-	ID          uuid.UUID `json:"id"`
-	Description string    `json:"description"`
-	Price       string    `json:"price"`
+	ID                      uuid.UUID `json:"id"`
+	Description             string    `json:"description"`
+	Price                   string    `json:"price"`
+	FinePercentage          string    `json:"fine_percentage"`
+	DailyInterestPercentage string    `json:"daily_interest_percentage"`
+	DiscountPercentage      string    `json:"discount_percentage"`
+	DiscountDaysBefore      int32     `json:"discount_days_before"`
 }
 
 func (q *Queries) FindServiceById(ctx context.Context, id uuid.UUID) (FindServiceByIdRow, error) {
 	row := q.db.QueryRowContext(ctx, findServiceById, id)
 	var i FindServiceByIdRow
-	err := row.Scan(&i.ID, &i.Description, &i.Price)
+	err := row.Scan(
+		&i.ID,
+		&i.Description,
+		&i.Price,
+		&i.FinePercentage,
+		&i.DailyInterestPercentage,
+		&i.DiscountPercentage,
+		&i.DiscountDaysBefore,
+	)
 	return i, err
 }
 
 const updateService = `-- name: UpdateService :exec
-UPDATE services SET description = $1, price = $2, updated_at = $3 WHERE id = $4
+UPDATE services SET description = $1, price = $2, fine_percentage = $3, daily_interest_percentage = $4, discount_percentage = $5, discount_days_before = $6, updated_at = $7 WHERE id = $8
 `
 
 type UpdateServiceParams struct {
-	Description string       `json:"description"`
-	Price       string       `json:"price"`
-	UpdatedAt   sql.NullTime `json:"updated_at"`
-	ID          uuid.UUID    `json:"id"`
+	Description             string       `json:"description"`
+	Price                   string       `json:"price"`
+	FinePercentage          string       `json:"fine_percentage"`
+	DailyInterestPercentage string       `json:"daily_interest_percentage"`
+	DiscountPercentage      string       `json:"discount_percentage"`
+	DiscountDaysBefore      int32        `json:"discount_days_before"`
+	UpdatedAt               sql.NullTime `json:"updated_at"`
+	ID                      uuid.UUID    `json:"id"`
 }
 
 func (q *Queries) UpdateService(ctx context.Context, arg UpdateServiceParams) error {
 	_, err := q.db.ExecContext(ctx, updateService,
 		arg.Description,
 		arg.Price,
+		arg.FinePercentage,
+		arg.DailyInterestPercentage,
+		arg.DiscountPercentage,
+		arg.DiscountDaysBefore,
 		arg.UpdatedAt,
 		arg.ID,
 	)
@@ -16,9 +16,9 @@ import (
 const createStudent = `-- name: CreateStudent :exec
 
 INSERT INTO students 
-(id, first_name, last_name, birthday, rg_document, cpf_document, email, him_self_responsible, created_at, updated_at)
+(id, first_name, last_name, birthday, rg_document, cpf_document, email, him_self_responsible, created_at, updated_at, document_type, document_number)
 VALUES
-($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)
+($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12)
 `
 
 type CreateStudentParams struct {
@@ -32,6 +32,8 @@ type CreateStudentParams struct {
 	HimSelfResponsible bool           `json:"him_self_responsible"`
 	CreatedAt          sql.NullTime   `json:"created_at"`
 	UpdatedAt          sql.NullTime   `json:"updated_at"`
+	DocumentType       string         `json:"document_type"`
+	DocumentNumber     string         `json:"document_number"`
 }
 
 // Active: 1691937846246@@127.0.0.1@9500@sistema-escolar
@@ -47,12 +49,49 @@ func (q *Queries) CreateStudent(ctx context.Context, arg CreateStudentParams) er
 		arg.HimSelfResponsible,
 		arg.CreatedAt,
 		arg.UpdatedAt,
+		arg.DocumentType,
+		arg.DocumentNumber,
 	)
 	return err
 }
 
+const deleteConflictingAttendances = `-- name: DeleteConflictingAttendances :exec
+DELETE FROM attendances
+WHERE student_id = $2
+    AND EXISTS (
+        SELECT 1 FROM attendances a
+        WHERE a.student_id = $1 AND a.class_room_id = attendances.class_room_id
+            AND a.subject_id = attendances.subject_id AND a.schedule_id = attendances.schedule_id
+            AND a.lesson_date = attendances.lesson_date
+    )
+`
+
+type DeleteConflictingAttendancesParams struct {
+	StudentID   uuid.UUID `json:"student_id"`
+	StudentID_2 uuid.UUID `json:"student_id_2"`
+}
+
+func (q *Queries) DeleteConflictingAttendances(ctx context.Context, arg DeleteConflictingAttendancesParams) error {
+	_, err := q.db.ExecContext(ctx, deleteConflictingAttendances, arg.StudentID, arg.StudentID_2)
+	return err
+}
+
+const deleteStudent = `-- name: DeleteStudent :exec
+UPDATE students SET deleted_at = $1 WHERE id = $2
+`
+
+type DeleteStudentParams struct {
+	DeletedAt sql.NullTime `json:"deleted_at"`
+	ID        uuid.UUID    `json:"id"`
+}
+
+func (q *Queries) DeleteStudent(ctx context.Context, arg DeleteStudentParams) error {
+	_, err := q.db.ExecContext(ctx, deleteStudent, arg.DeletedAt, arg.ID)
+	return err
+}
+
 const findByCPFDocument = `-- name: FindByCPFDocument :one
-SELECT id, first_name, last_name, birthday, rg_document, cpf_document, email, him_self_responsible FROM students WHERE cpf_document = $1 LIMIT 1
+SELECT id, first_name, last_name, birthday, rg_document, cpf_document, email, him_self_responsible FROM students WHERE cpf_document = $1 AND deleted_at IS NULL LIMIT 1
 `
 
 type FindByCPFDocumentRow struct {
@@ -81,3 +120,292 @@ func (q *Queries) FindByCPFDocument(ctx context.Context, cpfDocument string) (Fi
 	)
 	return i, err
 }
+
+const findDuplicateCandidates = `-- name: FindDuplicateCandidates :many
+SELECT s.id, s.first_name, s.last_name, s.birthday, s.cpf_document, s.email,
+    COALESCE(string_agg(p.cpf_document, ',' ORDER BY p.cpf_document), '')::text AS parent_cpfs
+FROM students s
+    LEFT JOIN student_parents sp ON sp.student_id = s.id AND sp.deleted_at IS NULL
+    LEFT JOIN parents p ON p.id = sp.parent_id AND p.deleted_at IS NULL
+WHERE s.deleted_at IS NULL
+GROUP BY s.id
+ORDER BY s.first_name, s.last_name
+`
+
+type FindDuplicateCandidatesRow struct {
+	ID          uuid.UUID      `json:"id"`
+	FirstName   string         `json:"first_name"`
+	LastName    string         `json:"last_name"`
+	Birthday    time.Time      `json:"birthday"`
+	CpfDocument string         `json:"cpf_document"`
+	Email       sql.NullString `json:"email"`
+	ParentCpfs  string         `json:"parent_cpfs"`
+}
+
+func (q *Queries) FindDuplicateCandidates(ctx context.Context) ([]FindDuplicateCandidatesRow, error) {
+	rows, err := q.db.QueryContext(ctx, findDuplicateCandidates)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindDuplicateCandidatesRow
+	for rows.Next() {
+		var i FindDuplicateCandidatesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.FirstName,
+			&i.LastName,
+			&i.Birthday,
+			&i.CpfDocument,
+			&i.Email,
+			&i.ParentCpfs,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findStudentByDocument = `-- name: FindStudentByDocument :one
+SELECT id FROM students WHERE document_type = $1 AND document_number = $2 AND deleted_at IS NULL LIMIT 1
+`
+
+type FindStudentByDocumentParams struct {
+	DocumentType   string `json:"document_type"`
+	DocumentNumber string `json:"document_number"`
+}
+
+func (q *Queries) FindStudentByDocument(ctx context.Context, arg FindStudentByDocumentParams) (uuid.UUID, error) {
+	row := q.db.QueryRowContext(ctx, findStudentByDocument, arg.DocumentType, arg.DocumentNumber)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const findStudentById = `-- name: FindStudentById :one
+
+SELECT id, first_name, last_name, birthday, rg_document, cpf_document, email, him_self_responsible, academic_responsible_id, document_type, document_number FROM students WHERE id = $1 AND deleted_at IS NULL LIMIT 1
+`
+
+type FindStudentByIdRow struct {
+	ID                    uuid.UUID      `json:"id"`
+	FirstName             string         `json:"first_name"`
+	LastName              string         `json:"last_name"`
+	Birthday              time.Time      `json:"birthday"`
+	RgDocument            sql.NullString `json:"rg_document"`
+	CpfDocument           string         `json:"cpf_document"`
+	Email                 sql.NullString `json:"email"`
+	HimSelfResponsible    bool           `json:"him_self_responsible"`
+	AcademicResponsibleID uuid.NullUUID  `json:"academic_responsible_id"`
+	DocumentType          string         `json:"document_type"`
+	DocumentNumber        string         `json:"document_number"`
+}
+
+func (q *Queries) FindStudentById(ctx context.Context, id uuid.UUID) (FindStudentByIdRow, error) {
+	row := q.db.QueryRowContext(ctx, findStudentById, id)
+	var i FindStudentByIdRow
+	err := row.Scan(
+		&i.ID,
+		&i.FirstName,
+		&i.LastName,
+		&i.Birthday,
+		&i.RgDocument,
+		&i.CpfDocument,
+		&i.Email,
+		&i.HimSelfResponsible,
+		&i.AcademicResponsibleID,
+		&i.DocumentType,
+		&i.DocumentNumber,
+	)
+	return i, err
+}
+
+const moveAttendancesToStudent = `-- name: MoveAttendancesToStudent :exec
+UPDATE attendances SET student_id = $1 WHERE student_id = $2
+`
+
+type MoveAttendancesToStudentParams struct {
+	StudentID   uuid.UUID `json:"student_id"`
+	StudentID_2 uuid.UUID `json:"student_id_2"`
+}
+
+func (q *Queries) MoveAttendancesToStudent(ctx context.Context, arg MoveAttendancesToStudentParams) error {
+	_, err := q.db.ExecContext(ctx, moveAttendancesToStudent, arg.StudentID, arg.StudentID_2)
+	return err
+}
+
+const moveInvoicesToStudent = `-- name: MoveInvoicesToStudent :exec
+UPDATE invoices SET student_id = $1 WHERE student_id = $2
+`
+
+type MoveInvoicesToStudentParams struct {
+	StudentID   uuid.UUID `json:"student_id"`
+	StudentID_2 uuid.UUID `json:"student_id_2"`
+}
+
+func (q *Queries) MoveInvoicesToStudent(ctx context.Context, arg MoveInvoicesToStudentParams) error {
+	_, err := q.db.ExecContext(ctx, moveInvoicesToStudent, arg.StudentID, arg.StudentID_2)
+	return err
+}
+
+const movePaymentsToStudent = `-- name: MovePaymentsToStudent :exec
+UPDATE payments SET student_id = $1 WHERE student_id = $2
+`
+
+type MovePaymentsToStudentParams struct {
+	StudentID   uuid.UUID `json:"student_id"`
+	StudentID_2 uuid.UUID `json:"student_id_2"`
+}
+
+func (q *Queries) MovePaymentsToStudent(ctx context.Context, arg MovePaymentsToStudentParams) error {
+	_, err := q.db.ExecContext(ctx, movePaymentsToStudent, arg.StudentID, arg.StudentID_2)
+	return err
+}
+
+const movePickupAuthorizationsToStudent = `-- name: MovePickupAuthorizationsToStudent :exec
+UPDATE pickup_authorizations SET student_id = $1 WHERE student_id = $2
+`
+
+type MovePickupAuthorizationsToStudentParams struct {
+	StudentID   uuid.UUID `json:"student_id"`
+	StudentID_2 uuid.UUID `json:"student_id_2"`
+}
+
+func (q *Queries) MovePickupAuthorizationsToStudent(ctx context.Context, arg MovePickupAuthorizationsToStudentParams) error {
+	_, err := q.db.ExecContext(ctx, movePickupAuthorizationsToStudent, arg.StudentID, arg.StudentID_2)
+	return err
+}
+
+const moveReenrollmentProposalsToStudent = `-- name: MoveReenrollmentProposalsToStudent :exec
+UPDATE reenrollment_proposals SET student_id = $1 WHERE student_id = $2
+`
+
+type MoveReenrollmentProposalsToStudentParams struct {
+	StudentID   uuid.UUID `json:"student_id"`
+	StudentID_2 uuid.UUID `json:"student_id_2"`
+}
+
+func (q *Queries) MoveReenrollmentProposalsToStudent(ctx context.Context, arg MoveReenrollmentProposalsToStudentParams) error {
+	_, err := q.db.ExecContext(ctx, moveReenrollmentProposalsToStudent, arg.StudentID, arg.StudentID_2)
+	return err
+}
+
+const moveRegistrationsToStudent = `-- name: MoveRegistrationsToStudent :exec
+UPDATE registrations SET student_id = $1 WHERE student_id = $2
+`
+
+type MoveRegistrationsToStudentParams struct {
+	StudentID   uuid.UUID `json:"student_id"`
+	StudentID_2 uuid.UUID `json:"student_id_2"`
+}
+
+func (q *Queries) MoveRegistrationsToStudent(ctx context.Context, arg MoveRegistrationsToStudentParams) error {
+	_, err := q.db.ExecContext(ctx, moveRegistrationsToStudent, arg.StudentID, arg.StudentID_2)
+	return err
+}
+
+const moveStudentCreditsToStudent = `-- name: MoveStudentCreditsToStudent :exec
+UPDATE student_credits SET student_id = $1 WHERE student_id = $2
+`
+
+type MoveStudentCreditsToStudentParams struct {
+	StudentID   uuid.UUID `json:"student_id"`
+	StudentID_2 uuid.UUID `json:"student_id_2"`
+}
+
+func (q *Queries) MoveStudentCreditsToStudent(ctx context.Context, arg MoveStudentCreditsToStudentParams) error {
+	_, err := q.db.ExecContext(ctx, moveStudentCreditsToStudent, arg.StudentID, arg.StudentID_2)
+	return err
+}
+
+const moveStudentDocumentsToStudent = `-- name: MoveStudentDocumentsToStudent :exec
+UPDATE student_documents SET student_id = $1 WHERE student_id = $2
+`
+
+type MoveStudentDocumentsToStudentParams struct {
+	StudentID   uuid.UUID `json:"student_id"`
+	StudentID_2 uuid.UUID `json:"student_id_2"`
+}
+
+func (q *Queries) MoveStudentDocumentsToStudent(ctx context.Context, arg MoveStudentDocumentsToStudentParams) error {
+	_, err := q.db.ExecContext(ctx, moveStudentDocumentsToStudent, arg.StudentID, arg.StudentID_2)
+	return err
+}
+
+const moveWaitingListToStudent = `-- name: MoveWaitingListToStudent :exec
+UPDATE waiting_list SET student_id = $1
+WHERE student_id = $2
+    AND NOT (status = 'WAITING' AND class_room_id IN (
+        SELECT w.class_room_id FROM waiting_list w WHERE w.student_id = $1 AND w.status = 'WAITING'
+    ))
+`
+
+type MoveWaitingListToStudentParams struct {
+	StudentID   uuid.UUID `json:"student_id"`
+	StudentID_2 uuid.UUID `json:"student_id_2"`
+}
+
+func (q *Queries) MoveWaitingListToStudent(ctx context.Context, arg MoveWaitingListToStudentParams) error {
+	_, err := q.db.ExecContext(ctx, moveWaitingListToStudent, arg.StudentID, arg.StudentID_2)
+	return err
+}
+
+const updateStudent = `-- name: UpdateStudent :exec
+UPDATE students SET first_name = $1, last_name = $2, birthday = $3, rg_document = $4,
+    cpf_document = $5, email = $6, him_self_responsible = $7, updated_at = $8,
+    document_type = $9, document_number = $10
+WHERE id = $11 AND deleted_at IS NULL
+`
+
+type UpdateStudentParams struct {
+	FirstName          string         `json:"first_name"`
+	LastName           string         `json:"last_name"`
+	Birthday           time.Time      `json:"birthday"`
+	RgDocument         sql.NullString `json:"rg_document"`
+	CpfDocument        string         `json:"cpf_document"`
+	Email              sql.NullString `json:"email"`
+	HimSelfResponsible bool           `json:"him_self_responsible"`
+	UpdatedAt          sql.NullTime   `json:"updated_at"`
+	DocumentType       string         `json:"document_type"`
+	DocumentNumber     string         `json:"document_number"`
+	ID                 uuid.UUID      `json:"id"`
+}
+
+func (q *Queries) UpdateStudent(ctx context.Context, arg UpdateStudentParams) error {
+	_, err := q.db.ExecContext(ctx, updateStudent,
+		arg.FirstName,
+		arg.LastName,
+		arg.Birthday,
+		arg.RgDocument,
+		arg.CpfDocument,
+		arg.Email,
+		arg.HimSelfResponsible,
+		arg.UpdatedAt,
+		arg.DocumentType,
+		arg.DocumentNumber,
+		arg.ID,
+	)
+	return err
+}
+
+const updateStudentAcademicResponsible = `-- name: UpdateStudentAcademicResponsible :exec
+UPDATE students SET academic_responsible_id = $1, updated_at = $2 WHERE id = $3 AND deleted_at IS NULL
+`
+
+type UpdateStudentAcademicResponsibleParams struct {
+	AcademicResponsibleID uuid.NullUUID `json:"academic_responsible_id"`
+	UpdatedAt             sql.NullTime  `json:"updated_at"`
+	ID                    uuid.UUID     `json:"id"`
+}
+
+func (q *Queries) UpdateStudentAcademicResponsible(ctx context.Context, arg UpdateStudentAcademicResponsibleParams) error {
+	_, err := q.db.ExecContext(ctx, updateStudentAcademicResponsible, arg.AcademicResponsibleID, arg.UpdatedAt, arg.ID)
+	return err
+}
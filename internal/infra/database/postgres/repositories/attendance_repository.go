@@ -0,0 +1,210 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/database/postgres/models"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/attendance"
+)
+
+type AttendanceRepository struct {
+	db     *sql.DB
+	queues *models.Queries
+}
+
+func NewAttendanceRepository(db *sql.DB) *AttendanceRepository {
+	return &AttendanceRepository{
+		db:     db,
+		queues: models.New(db),
+	}
+}
+
+// SaveRollCall Grava a chamada em uma unica transacao. Alunos que ja possuem registro na aula tem a
+// situacao atualizada e os registros dos alunos fora da chamada sao mantidos
+func (a *AttendanceRepository) SaveRollCall(records []attendance.Record) error {
+	tx, err := a.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	queues := a.queues.WithTx(tx)
+
+	for _, record := range records {
+		err = queues.UpsertAttendance(context.Background(), models.UpsertAttendanceParams{
+			ID:             record.Id(),
+			ClassRoomID:    record.Lesson().ClassRoomId,
+			SubjectID:      record.Lesson().SubjectId,
+			ScheduleID:     record.Lesson().ScheduleId,
+			LessonDate:     record.Lesson().Date,
+			TeacherID:      record.TeacherId(),
+			RegistrationID: record.RegistrationId(),
+			StudentID:      record.StudentId(),
+			Status:         record.Status(),
+			Note: sql.NullString{
+				String: record.Note(),
+				Valid:  record.Note() != "",
+			},
+			CreatedAt: sql.NullTime{
+				Time:  time.Now(),
+				Valid: true,
+			},
+			UpdatedAt: sql.NullTime{
+				Time:  time.Now(),
+				Valid: true,
+			},
+		})
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (a *AttendanceRepository) Update(record attendance.Record) error {
+	return a.queues.UpdateAttendance(context.Background(), models.UpdateAttendanceParams{
+		Status: record.Status(),
+		Note: sql.NullString{
+			String: record.Note(),
+			Valid:  record.Note() != "",
+		},
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		ID: record.Id(),
+	})
+}
+
+func (a *AttendanceRepository) FindById(id string) (*attendance.Record, error) {
+	recordId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	recordModel, err := a.queues.FindAttendanceById(context.Background(), recordId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return loadAttendance(recordModel)
+}
+
+func (a *AttendanceRepository) FindByLesson(lesson attendance.Lesson) ([]attendance.Record, error) {
+	recordsModel, err := a.queues.FindAttendancesByLesson(context.Background(), models.FindAttendancesByLessonParams{
+		ClassRoomID: lesson.ClassRoomId,
+		SubjectID:   lesson.SubjectId,
+		ScheduleID:  lesson.ScheduleId,
+		LessonDate:  lesson.Date,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var records []attendance.Record
+
+	for _, recordModel := range recordsModel {
+		record, err := loadAttendance(models.FindAttendanceByIdRow(recordModel))
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, *record)
+	}
+
+	return records, nil
+}
+
+func (a *AttendanceRepository) FindByClassRoom(classRoomId uuid.UUID) ([]attendance.Record, error) {
+	recordsModel, err := a.queues.FindAttendancesByClassRoom(context.Background(), classRoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []attendance.Record
+
+	for _, recordModel := range recordsModel {
+		record, err := loadAttendance(models.FindAttendanceByIdRow(recordModel))
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, *record)
+	}
+
+	return records, nil
+}
+
+func (a *AttendanceRepository) FindBySchoolYear(schoolYearId uuid.UUID) ([]attendance.Record, error) {
+	recordsModel, err := a.queues.FindAttendancesBySchoolYear(context.Background(), schoolYearId)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []attendance.Record
+
+	for _, recordModel := range recordsModel {
+		record, err := loadAttendance(models.FindAttendanceByIdRow(recordModel))
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, *record)
+	}
+
+	return records, nil
+}
+
+// FindStudents Alunos com matricula aprovada na turma na data da aula
+func (a *AttendanceRepository) FindStudents(classRoomId uuid.UUID, date time.Time) ([]attendance.Student, error) {
+	studentsModel, err := a.queues.FindAttendanceStudents(context.Background(), models.FindAttendanceStudentsParams{
+		ClassRoomID: classRoomId,
+		LessonDate:  date,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var students []attendance.Student
+
+	for _, studentModel := range studentsModel {
+		students = append(students, attendance.Student{
+			RegistrationId: studentModel.ID,
+			StudentId:      studentModel.StudentID,
+			Name:           studentModel.FirstName + " " + studentModel.LastName,
+		})
+	}
+
+	return students, nil
+}
+
+func loadAttendance(recordModel models.FindAttendanceByIdRow) (*attendance.Record, error) {
+	return attendance.LoadRecord(
+		recordModel.ID.String(),
+		attendance.Lesson{
+			ClassRoomId: recordModel.ClassRoomID,
+			SubjectId:   recordModel.SubjectID,
+			ScheduleId:  recordModel.ScheduleID,
+			Date:        recordModel.LessonDate,
+		},
+		recordModel.Identification,
+		recordModel.Name,
+		recordModel.TeacherID,
+		attendance.Student{
+			RegistrationId: recordModel.RegistrationID,
+			StudentId:      recordModel.StudentID,
+			Name:           recordModel.FirstName + " " + recordModel.LastName,
+		},
+		recordModel.Status,
+		recordModel.Note.String,
+	)
+}
@@ -3,6 +3,7 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
 	"time"
@@ -43,6 +44,10 @@ func NewClassRoomRepository(db *sql.DB) *ClassRoomRepository {
 	}
 }
 
+func (c *ClassRoomRepository) SetTransaction(tx *sql.Tx) {
+	c.queues = c.queues.WithTx(tx)
+}
+
 func (c *ClassRoomRepository) Create(classRoom classroom.ClassRoom) error {
 
 	classRoomModel := models.CreateClassParams{
@@ -177,7 +182,7 @@ func (c *ClassRoomRepository) FindByIdLock(id string) (*classroom.ClassRoom, err
 		classRoomModel.Status,
 		int(classRoomModel.VacanciesOccupied),
 		int(classRoomModel.Vacancies),
-		classRoomModel.OpenDate.String(),
+		classRoomModel.OpenDate.Format("2006-01-02"),
 		classRoomModel.Shift,
 		classRoomModel.Level,
 		classRoomModel.Identification,
@@ -188,9 +193,95 @@ func (c *ClassRoomRepository) FindByIdLock(id string) (*classroom.ClassRoom, err
 		classRoomModel.Type,
 	)
 
+	if err != nil {
+		return nil, err
+	}
+
 	return classRoom, nil
 }
 
+// OccupyVacancies Persiste a quantidade de vagas ocupadas na turma. O banco recusa a atualizacao
+// caso a turma ja esteja com todas as vagas ocupadas
+func (c *ClassRoomRepository) OccupyVacancies(classRoom classroom.ClassRoom) error {
+	occupyParams := models.UpdateVacancyOccupiedParams{
+		VacanciesOccupied: int32(classRoom.OccupiedVacancies()),
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		ID: classRoom.Id(),
+	}
+
+	rowsAffected, err := c.queues.UpdateVacancyOccupied(context.Background(), occupyParams)
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected < 1 {
+		return classroom.ErrClassRoomFull
+	}
+
+	return nil
+}
+
+// ReleaseVacancies Persiste a quantidade de vagas ocupadas apos a liberacao de vagas na turma
+func (c *ClassRoomRepository) ReleaseVacancies(classRoom classroom.ClassRoom) error {
+	releaseParams := models.ReleaseVacancyOccupiedParams{
+		VacanciesOccupied: int32(classRoom.OccupiedVacancies()),
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		ID: classRoom.Id(),
+	}
+
+	rowsAffected, err := c.queues.ReleaseVacancyOccupied(context.Background(), releaseParams)
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected < 1 {
+		return errors.New("no occupied vacancies to release")
+	}
+
+	return nil
+}
+
+func (c *ClassRoomRepository) FindBySchoolYear(schoolYearId uuid.UUID) ([]classroom.ClassRoom, error) {
+	classRoomsModel, err := c.queues.FindClassesBySchoolYear(context.Background(), schoolYearId)
+	if err != nil {
+		return nil, err
+	}
+
+	var classRooms []classroom.ClassRoom
+
+	for _, classRoomModel := range classRoomsModel {
+		classRoom, err := classroom.Load(
+			classRoomModel.ID.String(),
+			classRoomModel.Active,
+			classRoomModel.Status,
+			int(classRoomModel.VacanciesOccupied),
+			int(classRoomModel.Vacancies),
+			classRoomModel.OpenDate.Format("2006-01-02"),
+			classRoomModel.Shift,
+			classRoomModel.Level,
+			classRoomModel.Identification,
+			classRoomModel.SchoolYearID.String(),
+			classRoomModel.RoomID.UUID.String(),
+			classRoomModel.ScheduleID.String(),
+			classRoomModel.Localization.String,
+			classRoomModel.Type,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		classRooms = append(classRooms, *classRoom)
+	}
+
+	return classRooms, nil
+}
+
 func (c *ClassRoomRepository) FindAll(pagination paginator.Pagination) (*paginator.PaginationResult, error) {
 
 	ctx, cancelQuery := context.WithTimeout(context.Background(), 30*time.Second)
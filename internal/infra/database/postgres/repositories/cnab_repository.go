@@ -0,0 +1,197 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/database/postgres/models"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/cnab"
+)
+
+type CnabRepository struct {
+	db     *sql.DB
+	queues *models.Queries
+}
+
+func NewCnabRepository(db *sql.DB) *CnabRepository {
+	return &CnabRepository{
+		db:     db,
+		queues: models.New(db),
+	}
+}
+
+func (c *CnabRepository) SetTransaction(tx *sql.Tx) {
+	c.queues = c.queues.WithTx(tx)
+}
+
+func (c *CnabRepository) CreateTitles(titles []cnab.Title) error {
+	for _, title := range titles {
+		createParams := models.CreateBankSlipParams{
+			ID:         title.Id(),
+			InvoiceID:  title.InvoiceId(),
+			OurNumber:  title.OurNumber(),
+			Layout:     title.Layout(),
+			Remittance: int32(title.Remittance()),
+			Value:      strconv.FormatFloat(title.Value(), 'f', -1, 64),
+			DueDate:    title.DueDate(),
+			Status:     title.Status(),
+			Message: sql.NullString{
+				String: title.Message(),
+				Valid:  title.Message() != "",
+			},
+			CreatedAt: time.Now(),
+			UpdatedAt: sql.NullTime{
+				Time:  time.Now(),
+				Valid: true,
+			},
+		}
+
+		err := c.queues.CreateBankSlip(context.Background(), createParams)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *CnabRepository) UpdateStatus(title cnab.Title) error {
+	updateParams := models.UpdateBankSlipStatusParams{
+		Status: title.Status(),
+		Message: sql.NullString{
+			String: title.Message(),
+			Valid:  title.Message() != "",
+		},
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		ID: title.Id(),
+	}
+
+	return c.queues.UpdateBankSlipStatus(context.Background(), updateParams)
+}
+
+// FindByOurNumberLock Busca o boleto pelo nosso numero, bloqueando o registro ate o fim da transacao
+// para que a mesma ocorrencia nao seja processada em paralelo
+func (c *CnabRepository) FindByOurNumberLock(ourNumber int64) (*cnab.Title, error) {
+	titleModel, err := c.queues.FindBankSlipByOurNumberLock(context.Background(), ourNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return loadTitle(models.FindBankSlipsByInvoiceRow(titleModel))
+}
+
+func (c *CnabRepository) FindByInvoice(invoiceId string) ([]cnab.Title, error) {
+	invId, err := uuid.Parse(invoiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	titleModels, err := c.queues.FindBankSlipsByInvoice(context.Background(), invId)
+	if err != nil {
+		return nil, err
+	}
+
+	var titles []cnab.Title
+
+	for _, titleModel := range titleModels {
+		title, err := loadTitle(titleModel)
+		if err != nil {
+			return nil, err
+		}
+
+		titles = append(titles, *title)
+	}
+
+	return titles, nil
+}
+
+func (c *CnabRepository) NextOurNumber() (int64, error) {
+	return c.queues.NextBankSlipOurNumber(context.Background())
+}
+
+func (c *CnabRepository) NextRemittanceSequence() (int, error) {
+	sequence, err := c.queues.NextBankRemittanceSequence(context.Background())
+	if err != nil {
+		return 0, err
+	}
+
+	return int(sequence), nil
+}
+
+// FindInvoicesToRemit Cobrancas em aberto com vencimento ate a data informada e sem boleto ativo no
+// banco. Sem data limite todas as cobrancas em aberto sao enviadas. O pagador e o responsavel
+// financeiro da matricula, ou o proprio aluno nas matriculas sem responsavel definido
+func (c *CnabRepository) FindInvoicesToRemit(dueUntil time.Time) ([]cnab.RemittanceItem, error) {
+	if dueUntil.IsZero() {
+		dueUntil = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+	}
+
+	invoiceModels, err := c.queues.FindInvoicesToRemit(context.Background(), dueUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []cnab.RemittanceItem
+
+	for _, invoiceModel := range invoiceModels {
+		inv, err := loadInvoice(models.FindInvoiceByIdRow{
+			ID:                      invoiceModel.ID,
+			RegistrationID:          invoiceModel.RegistrationID,
+			StudentID:               invoiceModel.StudentID,
+			Description:             invoiceModel.Description,
+			Kind:                    invoiceModel.Kind,
+			Installment:             invoiceModel.Installment,
+			Value:                   invoiceModel.Value,
+			PaidValue:               invoiceModel.PaidValue,
+			DueDate:                 invoiceModel.DueDate,
+			Status:                  invoiceModel.Status,
+			FinePercentage:          invoiceModel.FinePercentage,
+			DailyInterestPercentage: invoiceModel.DailyInterestPercentage,
+			DiscountPercentage:      invoiceModel.DiscountPercentage,
+			DiscountDaysBefore:      invoiceModel.DiscountDaysBefore,
+			ChargesPaid:             invoiceModel.ChargesPaid,
+			DiscountValue:           invoiceModel.DiscountValue,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, cnab.RemittanceItem{
+			Invoice: *inv,
+			Payer: cnab.Payer{
+				Name:     strings.TrimSpace(invoiceModel.PayerName),
+				Document: invoiceModel.PayerDocument,
+				Street:   invoiceModel.Street.String,
+				District: invoiceModel.District.String,
+				ZipCode:  invoiceModel.ZipCode.String,
+				City:     invoiceModel.City.String,
+				State:    invoiceModel.State.String,
+			},
+		})
+	}
+
+	return items, nil
+}
+
+func loadTitle(titleModel models.FindBankSlipsByInvoiceRow) (*cnab.Title, error) {
+	value, _ := strconv.ParseFloat(titleModel.Value, 64)
+
+	return cnab.LoadTitle(
+		titleModel.ID.String(),
+		titleModel.InvoiceID,
+		titleModel.OurNumber,
+		titleModel.Layout,
+		int(titleModel.Remittance),
+		value,
+		titleModel.DueDate,
+		titleModel.Status,
+		titleModel.Message.String,
+	)
+}
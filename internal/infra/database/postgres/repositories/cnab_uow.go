@@ -0,0 +1,110 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/cnab"
+)
+
+type CnabUow struct {
+	db       *sql.DB
+	tx       *sql.Tx
+	cnabRepo CnabRepository
+}
+
+func NewCnabUow(db *sql.DB, cnabRepo CnabRepository) *CnabUow {
+	return &CnabUow{
+		db:       db,
+		cnabRepo: cnabRepo,
+	}
+}
+
+func (c *CnabUow) BeginTransaction() error {
+	tx, err := c.db.Begin()
+
+	if err != nil {
+		return err
+	}
+
+	c.tx = tx
+
+	return nil
+}
+
+func (c *CnabUow) Rollback() error {
+	if c.tx == nil {
+		return errors.New("failed to rollback transaction. Transaction not started")
+	}
+
+	return c.tx.Rollback()
+}
+
+func (c *CnabUow) Commit() error {
+	if c.tx == nil {
+		return errors.New("failed in commit transaction. Transaction not started")
+	}
+
+	return c.tx.Commit()
+}
+
+func (c *CnabUow) NextRemittanceSequence() (int, error) {
+	if c.tx == nil {
+		return 0, errors.New("failed in get remittance sequence. Transaction not started")
+	}
+
+	c.cnabRepo.SetTransaction(c.tx)
+
+	return c.cnabRepo.NextRemittanceSequence()
+}
+
+func (c *CnabUow) NextOurNumber() (int64, error) {
+	if c.tx == nil {
+		return 0, errors.New("failed in get our number. Transaction not started")
+	}
+
+	c.cnabRepo.SetTransaction(c.tx)
+
+	return c.cnabRepo.NextOurNumber()
+}
+
+func (c *CnabUow) FindInvoicesToRemit(dueUntil time.Time) ([]cnab.RemittanceItem, error) {
+	if c.tx == nil {
+		return nil, errors.New("failed in find invoices to remit. Transaction not started")
+	}
+
+	c.cnabRepo.SetTransaction(c.tx)
+
+	return c.cnabRepo.FindInvoicesToRemit(dueUntil)
+}
+
+func (c *CnabUow) CreateTitles(titles []cnab.Title) error {
+	if c.tx == nil {
+		return errors.New("failed in create titles. Transaction not started")
+	}
+
+	c.cnabRepo.SetTransaction(c.tx)
+
+	return c.cnabRepo.CreateTitles(titles)
+}
+
+func (c *CnabUow) FindTitleByOurNumberLock(ourNumber int64) (*cnab.Title, error) {
+	if c.tx == nil {
+		return nil, errors.New("failed in find title. Transaction not started")
+	}
+
+	c.cnabRepo.SetTransaction(c.tx)
+
+	return c.cnabRepo.FindByOurNumberLock(ourNumber)
+}
+
+func (c *CnabUow) UpdateTitleStatus(title cnab.Title) error {
+	if c.tx == nil {
+		return errors.New("failed in update title status. Transaction not started")
+	}
+
+	c.cnabRepo.SetTransaction(c.tx)
+
+	return c.cnabRepo.UpdateStatus(title)
+}
@@ -0,0 +1,94 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/database/postgres/models"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/contract"
+)
+
+type ContractRepository struct {
+	db     *sql.DB
+	queues *models.Queries
+}
+
+func NewContractRepository(db *sql.DB) *ContractRepository {
+	return &ContractRepository{
+		db:     db,
+		queues: models.New(db),
+	}
+}
+
+func (c *ContractRepository) SetTransaction(tx *sql.Tx) {
+	c.queues = c.queues.WithTx(tx)
+}
+
+func (c *ContractRepository) Create(issued contract.Contract) error {
+	return c.queues.CreateRegistrationContract(context.Background(), models.CreateRegistrationContractParams{
+		ID:             issued.Id(),
+		RegistrationID: issued.RegistrationId(),
+		TemplateID:     issued.TemplateId(),
+		TemplateHash:   issued.TemplateHash(),
+		Hash:           issued.Hash(),
+		StorageKey:     issued.StorageKey(),
+		IssuedAt:       issued.IssuedAt(),
+	})
+}
+
+// FindByRegistration Busca o contrato emitido para a matricula. Retorna nil se ainda nao foi emitido
+func (c *ContractRepository) FindByRegistration(registrationId string) (*contract.Contract, error) {
+	id, err := uuid.Parse(registrationId)
+	if err != nil {
+		return nil, err
+	}
+
+	contractModel, err := c.queues.FindRegistrationContract(context.Background(), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return contract.Load(
+		contractModel.ID.String(),
+		contractModel.RegistrationID,
+		contractModel.TemplateID,
+		contractModel.TemplateHash,
+		contractModel.Hash,
+		contractModel.StorageKey,
+		contractModel.IssuedAt,
+	)
+}
+
+func (c *ContractRepository) CreateTemplate(template contract.Template) error {
+	return c.queues.CreateContractTemplate(context.Background(), models.CreateContractTemplateParams{
+		ID:        template.Id,
+		Content:   template.Content,
+		Hash:      template.Hash,
+		CreatedAt: template.CreatedAt,
+	})
+}
+
+// FindCurrentTemplate Busca a ultima versao do modelo de contrato. Retorna nil se nenhum modelo foi cadastrado
+func (c *ContractRepository) FindCurrentTemplate() (*contract.Template, error) {
+	templateModel, err := c.queues.FindCurrentContractTemplate(context.Background())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &contract.Template{
+		Id:        templateModel.ID,
+		Content:   templateModel.Content,
+		Hash:      templateModel.Hash,
+		CreatedAt: templateModel.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,352 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/database/postgres/models"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/curriculum"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+)
+
+type CurriculumRepository struct {
+	db     *sql.DB
+	queues *models.Queries
+}
+
+func NewCurriculumRepository(db *sql.DB) *CurriculumRepository {
+	return &CurriculumRepository{
+		db:     db,
+		queues: models.New(db),
+	}
+}
+
+func (c *CurriculumRepository) CreateSubject(subject curriculum.Subject) error {
+	return c.queues.CreateSubject(context.Background(), models.CreateSubjectParams{
+		ID:       subject.Id(),
+		Code:     subject.Code(),
+		Name:     subject.Name(),
+		Workload: int32(subject.Workload()),
+		CreatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	})
+}
+
+func (c *CurriculumRepository) UpdateSubject(subject curriculum.Subject) error {
+	return c.queues.UpdateSubject(context.Background(), models.UpdateSubjectParams{
+		Code:     subject.Code(),
+		Name:     subject.Name(),
+		Workload: int32(subject.Workload()),
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		ID: subject.Id(),
+	})
+}
+
+func (c *CurriculumRepository) DeleteSubject(id string) error {
+	subjectId, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	return c.queues.DeleteSubject(context.Background(), models.DeleteSubjectParams{
+		DeletedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		ID: subjectId,
+	})
+}
+
+func (c *CurriculumRepository) FindSubjectById(id string) (*curriculum.Subject, error) {
+	subjectId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	subjectModel, err := c.queues.FindSubjectById(context.Background(), subjectId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return loadSubject(subjectModel)
+}
+
+func (c *CurriculumRepository) FindSubjectByCode(code string) (*curriculum.Subject, error) {
+	subjectModel, err := c.queues.FindSubjectByCode(context.Background(), code)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return loadSubject(models.FindSubjectByIdRow(subjectModel))
+}
+
+func (c *CurriculumRepository) FindSubjects(pagination paginator.Pagination) (*paginator.PaginationResult, error) {
+	ctx, cancelQuery := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancelQuery()
+
+	query := `
+		SELECT id, code, name, workload, COUNT(*) OVER() as total
+		FROM subjects
+		WHERE deleted_at IS NULL
+		    AND (code ILIKE $1 OR name ILIKE $1)
+	`
+	query += pagination.FiltersInSql()
+
+	stmt, err := c.db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+	rows, err := stmt.QueryContext(ctx, "%"+pagination.Search+"%")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var subjects []curriculum.Subject
+	total := 0
+
+	for rows.Next() {
+		var subjectModel models.FindSubjectByIdRow
+		err = rows.Scan(&subjectModel.ID, &subjectModel.Code, &subjectModel.Name, &subjectModel.Workload, &total)
+		if err != nil {
+			return nil, err
+		}
+
+		subject, err := loadSubject(subjectModel)
+		if err != nil {
+			return nil, err
+		}
+
+		subjects = append(subjects, *subject)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &paginator.PaginationResult{
+		Total: total,
+		Data:  subjects,
+	}, nil
+}
+
+func (c *CurriculumRepository) SubjectInUse(subjectId uuid.UUID) (bool, error) {
+	return c.queues.SubjectInUse(context.Background(), subjectId)
+}
+
+// CreateMatrices Grava as matrizes com suas disciplinas em uma unica transacao
+func (c *CurriculumRepository) CreateMatrices(matrices []curriculum.Matrix) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	queues := c.queues.WithTx(tx)
+
+	for _, matrix := range matrices {
+		err = queues.CreateMatrix(context.Background(), models.CreateMatrixParams{
+			ID:           matrix.Id(),
+			Level:        matrix.Level(),
+			SchoolYearID: matrix.SchoolYearId(),
+			CreatedAt: sql.NullTime{
+				Time:  time.Now(),
+				Valid: true,
+			},
+			UpdatedAt: sql.NullTime{
+				Time:  time.Now(),
+				Valid: true,
+			},
+		})
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+
+		err = createMatrixItems(queues, matrix)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// UpdateMatrix Refaz as disciplinas da matriz
+func (c *CurriculumRepository) UpdateMatrix(matrix curriculum.Matrix) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	queues := c.queues.WithTx(tx)
+
+	err = queues.UpdateMatrix(context.Background(), models.UpdateMatrixParams{
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		ID: matrix.Id(),
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = queues.DeleteMatrixItems(context.Background(), matrix.Id())
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = createMatrixItems(queues, matrix)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (c *CurriculumRepository) DeleteMatrix(id string) error {
+	matrixId, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	return c.queues.DeleteMatrix(context.Background(), models.DeleteMatrixParams{
+		DeletedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		ID: matrixId,
+	})
+}
+
+func (c *CurriculumRepository) FindMatrixById(id string) (*curriculum.Matrix, error) {
+	matrixId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	matrixModel, err := c.queues.FindMatrixById(context.Background(), matrixId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.loadMatrix(matrixModel)
+}
+
+// FindMatrix Matriz do nivel no ano letivo. O nivel e comparado sem diferenciar maiusculas
+func (c *CurriculumRepository) FindMatrix(level string, schoolYearId uuid.UUID) (*curriculum.Matrix, error) {
+	matrixModel, err := c.queues.FindMatrixByLevel(context.Background(), models.FindMatrixByLevelParams{
+		Level:        level,
+		SchoolYearID: schoolYearId,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return c.loadMatrix(models.FindMatrixByIdRow(matrixModel))
+}
+
+func (c *CurriculumRepository) FindMatrices(schoolYearId uuid.UUID) ([]curriculum.Matrix, error) {
+	matricesModel, err := c.queues.FindMatricesBySchoolYear(context.Background(), schoolYearId)
+	if err != nil {
+		return nil, err
+	}
+
+	var matrices []curriculum.Matrix
+
+	for _, matrixModel := range matricesModel {
+		matrix, err := c.loadMatrix(models.FindMatrixByIdRow(matrixModel))
+		if err != nil {
+			return nil, err
+		}
+
+		matrices = append(matrices, *matrix)
+	}
+
+	return matrices, nil
+}
+
+func (c *CurriculumRepository) MatrixInUse(matrix curriculum.Matrix) (bool, error) {
+	return c.queues.MatrixInUse(context.Background(), models.MatrixInUseParams{
+		Level:        matrix.Level(),
+		SchoolYearID: matrix.SchoolYearId(),
+	})
+}
+
+// loadMatrix Carrega a matriz com suas disciplinas
+func (c *CurriculumRepository) loadMatrix(matrixModel models.FindMatrixByIdRow) (*curriculum.Matrix, error) {
+	itemsModel, err := c.queues.FindMatrixItems(context.Background(), matrixModel.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var items []curriculum.MatrixItem
+
+	for _, itemModel := range itemsModel {
+		items = append(items, curriculum.MatrixItem{
+			SubjectId:     itemModel.SubjectID,
+			Subject:       itemModel.Name,
+			WeeklyClasses: int(itemModel.WeeklyClasses),
+		})
+	}
+
+	return curriculum.LoadMatrix(matrixModel.ID, matrixModel.Level, matrixModel.SchoolYearID, items), nil
+}
+
+func createMatrixItems(queues *models.Queries, matrix curriculum.Matrix) error {
+	for _, item := range matrix.Items() {
+		err := queues.CreateMatrixItem(context.Background(), models.CreateMatrixItemParams{
+			MatrixID:      matrix.Id(),
+			SubjectID:     item.SubjectId,
+			WeeklyClasses: int32(item.WeeklyClasses),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func loadSubject(subjectModel models.FindSubjectByIdRow) (*curriculum.Subject, error) {
+	return curriculum.LoadSubject(
+		subjectModel.ID.String(),
+		subjectModel.Code,
+		subjectModel.Name,
+		int(subjectModel.Workload),
+	)
+}
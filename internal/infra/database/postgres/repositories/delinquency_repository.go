@@ -0,0 +1,159 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/delinquency"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+)
+
+type DelinquencyRepository struct {
+	db *sql.DB
+}
+
+func NewDelinquencyRepository(db *sql.DB) *DelinquencyRepository {
+	return &DelinquencyRepository{
+		db: db,
+	}
+}
+
+// debtorsQuery Cobrancas vencidas agrupadas por aluno e responsavel, sem as cobrancas de matriculas
+// canceladas. O responsavel e o responsavel financeiro da matricula mais recente; sem ele, o
+// primeiro responsavel cadastrado ou o proprio aluno quando ele e responsavel por si
+const debtorsQuery = `
+	SELECT student_id, student_name, student_cpf, responsible_name, responsible_cpf, responsible_email,
+	       overdue_invoices, oldest_due_date, up_to_30, up_to_60, up_to_90, over_90, total,
+	       COUNT(*) OVER() as total_rows
+	FROM (
+		SELECT s.id AS student_id,
+		       s.first_name || ' ' || s.last_name AS student_name,
+		       s.cpf_document AS student_cpf,
+		       COALESCE(f.name, r.name, s.first_name || ' ' || s.last_name) AS responsible_name,
+		       COALESCE(f.cpf_document, r.cpf_document, s.cpf_document) AS responsible_cpf,
+		       COALESCE(f.email, r.email, s.email, '') AS responsible_email,
+		       COUNT(i.id) AS overdue_invoices,
+		       MIN(i.due_date) AS oldest_due_date,
+		       SUM(CASE WHEN $1::date - i.due_date::date <= 30 THEN i.value - i.paid_value ELSE 0 END) AS up_to_30,
+		       SUM(CASE WHEN $1::date - i.due_date::date BETWEEN 31 AND 60 THEN i.value - i.paid_value ELSE 0 END) AS up_to_60,
+		       SUM(CASE WHEN $1::date - i.due_date::date BETWEEN 61 AND 90 THEN i.value - i.paid_value ELSE 0 END) AS up_to_90,
+		       SUM(CASE WHEN $1::date - i.due_date::date > 90 THEN i.value - i.paid_value ELSE 0 END) AS over_90,
+		       SUM(i.value - i.paid_value) AS total
+		FROM invoices i
+		    JOIN registrations ir ON ir.id = i.registration_id
+		    JOIN students s ON s.id = i.student_id
+		    LEFT JOIN LATERAL (
+		        SELECT p.first_name || ' ' || p.last_name AS name, p.cpf_document, p.email
+		        FROM student_parents sp
+		            JOIN parents p ON p.id = sp.parent_id
+		        WHERE sp.student_id = s.id
+		            AND sp.deleted_at IS NULL
+		            AND p.deleted_at IS NULL
+		            AND NOT s.him_self_responsible
+		        ORDER BY sp.created_at, p.id
+		        LIMIT 1
+		    ) r ON true
+		    LEFT JOIN LATERAL (
+		        SELECT reg.financial_responsible_name AS name,
+		               reg.financial_responsible_cpf AS cpf_document,
+		               reg.financial_responsible_email AS email
+		        FROM registrations reg
+		        WHERE reg.student_id = s.id
+		            AND reg.financial_responsible_id IS NOT NULL
+		            AND reg.deleted_at IS NULL
+		        ORDER BY reg.enrollment_date DESC
+		        LIMIT 1
+		    ) f ON true
+		WHERE i.status IN ('OPEN', 'PARTIALLY_PAID')
+		    AND i.due_date < $1::date
+		    AND ir.status <> 'CANCELLED'
+		GROUP BY s.id, s.first_name, s.last_name, s.cpf_document, s.email, r.name, r.cpf_document, r.email,
+		         f.name, f.cpf_document, f.email
+	) debtors
+	WHERE (student_name ILIKE $2 OR responsible_name ILIKE $2)
+`
+
+func (d *DelinquencyRepository) FindDebtors(referenceDate time.Time, pagination paginator.Pagination) (*paginator.PaginationResult, error) {
+	debtors, total, err := d.findDebtors(referenceDate, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &paginator.PaginationResult{
+		Total: total,
+		Data:  debtors,
+	}, nil
+}
+
+func (d *DelinquencyRepository) FindAllDebtors(referenceDate time.Time) ([]delinquency.Debtor, error) {
+	debtors, _, err := d.findDebtors(referenceDate, paginator.Pagination{})
+	return debtors, err
+}
+
+func (d *DelinquencyRepository) findDebtors(referenceDate time.Time, pagination paginator.Pagination) ([]delinquency.Debtor, int, error) {
+	ctx, cancelQuery := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancelQuery()
+
+	if pagination.SortField == "" {
+		pagination.SortField = "total"
+		pagination.Sort = "DESC"
+	}
+
+	query := debtorsQuery + pagination.FiltersInSql()
+
+	stmt, err := d.db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	defer stmt.Close()
+	rows, err := stmt.QueryContext(ctx, referenceDate, "%"+pagination.Search+"%")
+	if err != nil {
+		return nil, 0, err
+	}
+	defer rows.Close()
+
+	var debtors []delinquency.Debtor
+	total := 0
+
+	for rows.Next() {
+		var debtor delinquency.Debtor
+		var upTo30, upTo60, upTo90, over90, debt string
+
+		err = rows.Scan(
+			&debtor.StudentId,
+			&debtor.StudentName,
+			&debtor.StudentCpf,
+			&debtor.ResponsibleName,
+			&debtor.ResponsibleCpf,
+			&debtor.ResponsibleEmail,
+			&debtor.OverdueInvoices,
+			&debtor.OldestDueDate,
+			&upTo30,
+			&upTo60,
+			&upTo90,
+			&over90,
+			&debt,
+			&total,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		debtor.Aging.UpTo30, _ = strconv.ParseFloat(upTo30, 64)
+		debtor.Aging.UpTo60, _ = strconv.ParseFloat(upTo60, 64)
+		debtor.Aging.UpTo90, _ = strconv.ParseFloat(upTo90, 64)
+		debtor.Aging.Over90, _ = strconv.ParseFloat(over90, 64)
+		debtor.Total, _ = strconv.ParseFloat(debt, 64)
+
+		debtors = append(debtors, debtor)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	return debtors, total, nil
+}
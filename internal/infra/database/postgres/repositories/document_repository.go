@@ -0,0 +1,176 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/database/postgres/models"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/document"
+)
+
+type DocumentRepository struct {
+	db     *sql.DB
+	queues *models.Queries
+}
+
+func NewDocumentRepository(db *sql.DB) *DocumentRepository {
+	return &DocumentRepository{
+		db:     db,
+		queues: models.New(db),
+	}
+}
+
+func (d *DocumentRepository) SetTransaction(tx *sql.Tx) {
+	d.queues = d.queues.WithTx(tx)
+}
+
+func (d *DocumentRepository) Create(doc document.Document) error {
+	return d.queues.CreateStudentDocument(context.Background(), models.CreateStudentDocumentParams{
+		ID:             doc.Id(),
+		StudentID:      doc.StudentId(),
+		RegistrationID: doc.RegistrationId(),
+		Kind:           doc.Kind(),
+		FileName:       doc.FileName(),
+		ContentType:    doc.ContentType(),
+		Size:           doc.Size(),
+		StorageKey:     doc.StorageKey(),
+		Status:         doc.Status(),
+		Reason: sql.NullString{
+			String: doc.Reason(),
+			Valid:  doc.Reason() != "",
+		},
+		UploadedAt: doc.UploadedAt(),
+		CreatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	})
+}
+
+func (d *DocumentRepository) UpdateStatus(doc document.Document) error {
+	return d.queues.UpdateStudentDocumentStatus(context.Background(), models.UpdateStudentDocumentStatusParams{
+		Status: doc.Status(),
+		Reason: sql.NullString{
+			String: doc.Reason(),
+			Valid:  doc.Reason() != "",
+		},
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		ID: doc.Id(),
+	})
+}
+
+func (d *DocumentRepository) FindById(id string) (*document.Document, error) {
+	documentId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	documentModel, err := d.queues.FindStudentDocumentById(context.Background(), documentId)
+	if err != nil {
+		return nil, err
+	}
+
+	return loadDocument(documentModel)
+}
+
+func (d *DocumentRepository) FindByStudent(studentId string) ([]document.Document, error) {
+	id, err := uuid.Parse(studentId)
+	if err != nil {
+		return nil, err
+	}
+
+	documentModels, err := d.queues.FindStudentDocumentsByStudent(context.Background(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	var documents []document.Document
+
+	for _, documentModel := range documentModels {
+		doc, err := loadDocument(models.FindStudentDocumentByIdRow(documentModel))
+		if err != nil {
+			return nil, err
+		}
+
+		documents = append(documents, *doc)
+	}
+
+	return documents, nil
+}
+
+func (d *DocumentRepository) CreateRequirement(requirement document.Requirement) error {
+	return d.queues.CreateDocumentRequirement(context.Background(), models.CreateDocumentRequirementParams{
+		ID:          requirement.Id,
+		Level:       requirement.Level,
+		Kind:        requirement.Kind,
+		Description: requirement.Description,
+		CreatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	})
+}
+
+func (d *DocumentRepository) DeleteRequirement(id string) error {
+	requirementId, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	return d.queues.DeleteDocumentRequirement(context.Background(), models.DeleteDocumentRequirementParams{
+		ID: requirementId,
+		DeletedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	})
+}
+
+func (d *DocumentRepository) FindRequirements() ([]document.Requirement, error) {
+	requirementModels, err := d.queues.FindDocumentRequirements(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	var requirements []document.Requirement
+
+	for _, requirementModel := range requirementModels {
+		requirements = append(requirements, document.Requirement{
+			Id:          requirementModel.ID,
+			Level:       requirementModel.Level,
+			Kind:        requirementModel.Kind,
+			Description: requirementModel.Description,
+		})
+	}
+
+	return requirements, nil
+}
+
+func loadDocument(documentModel models.FindStudentDocumentByIdRow) (*document.Document, error) {
+	return document.Load(
+		documentModel.ID.String(),
+		documentModel.StudentID,
+		documentModel.RegistrationID,
+		documentModel.Kind,
+		documentModel.FileName,
+		documentModel.ContentType,
+		documentModel.Size,
+		documentModel.StorageKey,
+		documentModel.Status,
+		documentModel.Reason.String,
+		documentModel.UploadedAt,
+	)
+}
@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/database/postgres/models"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/idcard"
+)
+
+type IdCardRepository struct {
+	db     *sql.DB
+	queues *models.Queries
+}
+
+func NewIdCardRepository(db *sql.DB) *IdCardRepository {
+	return &IdCardRepository{
+		db:     db,
+		queues: models.New(db),
+	}
+}
+
+func (i *IdCardRepository) SetTransaction(tx *sql.Tx) {
+	i.queues = i.queues.WithTx(tx)
+}
+
+func (i *IdCardRepository) UpdatePhoto(photo idcard.Photo) error {
+	return i.queues.UpdateStudentPhoto(context.Background(), models.UpdateStudentPhotoParams{
+		ID: photo.StudentId(),
+		PhotoKey: sql.NullString{
+			String: photo.StorageKey(),
+			Valid:  true,
+		},
+		PhotoContentType: sql.NullString{
+			String: photo.ContentType(),
+			Valid:  true,
+		},
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	})
+}
+
+// FindPhoto Retorna nil quando o aluno nao existe ou ainda nao tem foto
+func (i *IdCardRepository) FindPhoto(studentId string) (*idcard.Photo, error) {
+	id, err := uuid.Parse(studentId)
+	if err != nil {
+		return nil, err
+	}
+
+	photoModel, err := i.queues.FindStudentPhoto(context.Background(), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return loadPhoto(photoModel.ID, photoModel.PhotoKey, photoModel.PhotoContentType), nil
+}
+
+func (i *IdCardRepository) FindByClassRoom(classRoomId string) ([]idcard.Card, error) {
+	id, err := uuid.Parse(classRoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := i.queues.FindIdCardsByClassRoom(context.Background(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	var cards []idcard.Card
+
+	for _, row := range rows {
+		cards = append(cards, idcard.Card{
+			StudentId:  row.StudentID,
+			Name:       row.FirstName + " " + row.LastName,
+			Code:       row.Code,
+			ClassRoom:  row.Identification,
+			Shift:      row.Shift.String,
+			SchoolYear: row.Year,
+			Photo:      loadPhoto(row.StudentID, row.PhotoKey, row.PhotoContentType),
+		})
+	}
+
+	return cards, nil
+}
+
+// FindByStudents Cartoes da matricula aprovada mais recente de cada aluno
+func (i *IdCardRepository) FindByStudents(studentIds []string) ([]idcard.Card, error) {
+	var ids []uuid.UUID
+
+	for _, studentId := range studentIds {
+		id, err := uuid.Parse(studentId)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	rows, err := i.queues.FindIdCardsByStudents(context.Background(), ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var cards []idcard.Card
+
+	for _, row := range rows {
+		cards = append(cards, idcard.Card{
+			StudentId:  row.StudentID,
+			Name:       row.FirstName + " " + row.LastName,
+			Code:       row.Code,
+			ClassRoom:  row.Identification,
+			Shift:      row.Shift.String,
+			SchoolYear: row.Year,
+			Photo:      loadPhoto(row.StudentID, row.PhotoKey, row.PhotoContentType),
+		})
+	}
+
+	return cards, nil
+}
+
+func loadPhoto(studentId uuid.UUID, storageKey sql.NullString, contentType sql.NullString) *idcard.Photo {
+	if !storageKey.Valid || storageKey.String == "" {
+		return nil
+	}
+
+	return idcard.LoadPhoto(studentId, storageKey.String, contentType.String)
+}
@@ -0,0 +1,330 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/database/postgres/models"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+)
+
+type InvoiceRepository struct {
+	db     *sql.DB
+	queues *models.Queries
+}
+
+type invoiceSearchModel struct {
+	Invoice models.FindInvoiceByIdRow
+	Total   int
+}
+
+func NewInvoiceRepository(db *sql.DB) *InvoiceRepository {
+	return &InvoiceRepository{
+		db:     db,
+		queues: models.New(db),
+	}
+}
+
+func (i *InvoiceRepository) SetTransaction(tx *sql.Tx) {
+	i.queues = i.queues.WithTx(tx)
+}
+
+func (i *InvoiceRepository) Create(invoices []invoice.Invoice) error {
+	for _, inv := range invoices {
+		createParams := models.CreateInvoiceParams{
+			ID:                      inv.Id(),
+			RegistrationID:          inv.RegistrationId(),
+			StudentID:               inv.StudentId(),
+			Description:             inv.Description(),
+			Kind:                    inv.Kind(),
+			Installment:             int32(inv.Installment()),
+			Value:                   strconv.FormatFloat(inv.Value(), 'f', -1, 64),
+			DueDate:                 inv.DueDate(),
+			Status:                  inv.Status(),
+			FinePercentage:          strconv.FormatFloat(inv.ChargeRules().FinePercentage, 'f', -1, 64),
+			DailyInterestPercentage: strconv.FormatFloat(inv.ChargeRules().DailyInterestPercentage, 'f', -1, 64),
+			DiscountPercentage:      strconv.FormatFloat(inv.ChargeRules().DiscountPercentage, 'f', -1, 64),
+			DiscountDaysBefore:      int32(inv.ChargeRules().DiscountDaysBefore),
+			CreatedAt: sql.NullTime{
+				Time:  time.Now(),
+				Valid: true,
+			},
+			UpdatedAt: sql.NullTime{
+				Time:  time.Now(),
+				Valid: true,
+			},
+		}
+
+		err := i.queues.CreateInvoice(context.Background(), createParams)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (i *InvoiceRepository) FindById(id string) (*invoice.Invoice, error) {
+	invoiceId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceModel, err := i.queues.FindInvoiceById(context.Background(), invoiceId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return loadInvoice(invoiceModel)
+}
+
+// FindByIdLock Busca a cobranca fazendo o lock do registro. Deve ser usada dentro de uma transacao
+func (i *InvoiceRepository) FindByIdLock(id string) (*invoice.Invoice, error) {
+	invoiceId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceModel, err := i.queues.FindInvoiceByIdLock(context.Background(), invoiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return loadInvoice(models.FindInvoiceByIdRow(invoiceModel))
+}
+
+// FindOpenByStudentLock Cobrancas em aberto do aluno ordenadas pelo vencimento, com lock dos registros
+func (i *InvoiceRepository) FindOpenByStudentLock(studentId uuid.UUID) ([]invoice.Invoice, error) {
+	invoicesModel, err := i.queues.FindOpenInvoicesByStudentLock(context.Background(), studentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var invoices []invoice.Invoice
+
+	for _, invoiceModel := range invoicesModel {
+		inv, err := loadInvoice(models.FindInvoiceByIdRow(invoiceModel))
+		if err != nil {
+			return nil, err
+		}
+
+		invoices = append(invoices, *inv)
+	}
+
+	return invoices, nil
+}
+
+// FindOpenByRegistrationLock Cobrancas da matricula ainda sem pagamento, com lock dos registros
+func (i *InvoiceRepository) FindOpenByRegistrationLock(registrationId uuid.UUID) ([]invoice.Invoice, error) {
+	invoicesModel, err := i.queues.FindOpenInvoicesByRegistrationLock(context.Background(), registrationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var invoices []invoice.Invoice
+
+	for _, invoiceModel := range invoicesModel {
+		inv, err := loadInvoice(models.FindInvoiceByIdRow(invoiceModel))
+		if err != nil {
+			return nil, err
+		}
+
+		invoices = append(invoices, *inv)
+	}
+
+	return invoices, nil
+}
+
+// OverdueBalance Saldo das cobrancas do aluno vencidas antes da data informada
+func (i *InvoiceRepository) OverdueBalance(studentId uuid.UUID, dueBefore time.Time) (float64, error) {
+	balance, err := i.queues.SumStudentOverdueBalance(context.Background(), models.SumStudentOverdueBalanceParams{
+		StudentID: studentId,
+		DueDate:   dueBefore,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseFloat(balance, 64)
+}
+
+func (i *InvoiceRepository) UpdatePayment(inv invoice.Invoice) error {
+	updateParams := models.UpdateInvoicePaymentParams{
+		PaidValue:     strconv.FormatFloat(inv.PaidValue(), 'f', -1, 64),
+		ChargesPaid:   strconv.FormatFloat(inv.ChargesPaid(), 'f', -1, 64),
+		DiscountValue: strconv.FormatFloat(inv.DiscountValue(), 'f', -1, 64),
+		Status:        inv.Status(),
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		ID: inv.Id(),
+	}
+
+	return i.queues.UpdateInvoicePayment(context.Background(), updateParams)
+}
+
+func (i *InvoiceRepository) UpdateStatus(inv invoice.Invoice) error {
+	updateParams := models.UpdateInvoiceStatusParams{
+		Status: inv.Status(),
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		ID: inv.Id(),
+	}
+
+	return i.queues.UpdateInvoiceStatus(context.Background(), updateParams)
+}
+
+func (i *InvoiceRepository) UpdateRegistration(inv invoice.Invoice) error {
+	updateParams := models.UpdateInvoiceRegistrationParams{
+		RegistrationID: inv.RegistrationId(),
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		ID: inv.Id(),
+	}
+
+	return i.queues.UpdateInvoiceRegistration(context.Background(), updateParams)
+}
+
+func (i *InvoiceRepository) FindByStudent(studentId string, pagination paginator.Pagination) (*paginator.PaginationResult, error) {
+	return i.findBy("student_id", studentId, pagination)
+}
+
+func (i *InvoiceRepository) FindByRegistration(registrationId string, pagination paginator.Pagination) (*paginator.PaginationResult, error) {
+	return i.findBy("registration_id", registrationId, pagination)
+}
+
+// findBy Listagem paginada das cobrancas filtrando pela coluna informada (student_id ou registration_id)
+func (i *InvoiceRepository) findBy(column string, value string, pagination paginator.Pagination) (*paginator.PaginationResult, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancelQuery := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancelQuery()
+
+	if pagination.SortField == "" {
+		pagination.SortField = "due_date"
+		pagination.Sort = "ASC"
+	}
+
+	query := `
+		SELECT id, registration_id, student_id, description, kind, installment,
+		       value, paid_value, due_date, status,
+		       fine_percentage, daily_interest_percentage, discount_percentage,
+		       discount_days_before, charges_paid, discount_value,
+		       COUNT(*) OVER() as total
+			FROM invoices
+		WHERE ` + column + ` = $1 AND description like $2
+	`
+	filters := pagination.FiltersInSql()
+
+	if filters != "" {
+		query += filters
+	}
+
+	stmt, err := i.db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+	rows, err := stmt.QueryContext(ctx, id, "%"+pagination.Search+"%")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var invoicesModel []invoiceSearchModel
+
+	for rows.Next() {
+		var invoiceModel invoiceSearchModel
+		err = rows.Scan(
+			&invoiceModel.Invoice.ID,
+			&invoiceModel.Invoice.RegistrationID,
+			&invoiceModel.Invoice.StudentID,
+			&invoiceModel.Invoice.Description,
+			&invoiceModel.Invoice.Kind,
+			&invoiceModel.Invoice.Installment,
+			&invoiceModel.Invoice.Value,
+			&invoiceModel.Invoice.PaidValue,
+			&invoiceModel.Invoice.DueDate,
+			&invoiceModel.Invoice.Status,
+			&invoiceModel.Invoice.FinePercentage,
+			&invoiceModel.Invoice.DailyInterestPercentage,
+			&invoiceModel.Invoice.DiscountPercentage,
+			&invoiceModel.Invoice.DiscountDaysBefore,
+			&invoiceModel.Invoice.ChargesPaid,
+			&invoiceModel.Invoice.DiscountValue,
+			&invoiceModel.Total,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		invoicesModel = append(invoicesModel, invoiceModel)
+	}
+
+	var invoices []invoice.Invoice
+
+	for _, invoiceModel := range invoicesModel {
+		inv, err := loadInvoice(invoiceModel.Invoice)
+		if err != nil {
+			return nil, err
+		}
+
+		invoices = append(invoices, *inv)
+	}
+
+	paginationResult := paginator.PaginationResult{
+		Data: invoices,
+	}
+
+	if len(invoicesModel) > 0 {
+		paginationResult.Total = invoicesModel[0].Total
+	}
+
+	return &paginationResult, nil
+}
+
+func loadInvoice(invoiceModel models.FindInvoiceByIdRow) (*invoice.Invoice, error) {
+	value, _ := strconv.ParseFloat(invoiceModel.Value, 64)
+	paidValue, _ := strconv.ParseFloat(invoiceModel.PaidValue, 64)
+	chargesPaid, _ := strconv.ParseFloat(invoiceModel.ChargesPaid, 64)
+	discountValue, _ := strconv.ParseFloat(invoiceModel.DiscountValue, 64)
+
+	return invoice.Load(
+		invoiceModel.ID.String(),
+		invoiceModel.RegistrationID,
+		invoiceModel.StudentID,
+		invoiceModel.Description,
+		invoiceModel.Kind,
+		int(invoiceModel.Installment),
+		value,
+		paidValue,
+		invoiceModel.DueDate,
+		invoiceModel.Status,
+		loadChargeRules(
+			invoiceModel.FinePercentage,
+			invoiceModel.DailyInterestPercentage,
+			invoiceModel.DiscountPercentage,
+			invoiceModel.DiscountDaysBefore,
+		),
+		chargesPaid,
+		discountValue,
+	)
+}
@@ -0,0 +1,222 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/database/postgres/models"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/parent"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
+)
+
+type ParentRepository struct {
+	db     *sql.DB
+	queues *models.Queries
+}
+
+func NewParentRepository(db *sql.DB) *ParentRepository {
+	return &ParentRepository{
+		db:     db,
+		queues: models.New(db),
+	}
+}
+
+func (p *ParentRepository) SetTransaction(tx *sql.Tx) {
+	p.queues = p.queues.WithTx(tx)
+}
+
+func (p *ParentRepository) FindById(id string) (*parent.Parent, error) {
+	parentId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	parentModel, err := p.queues.FindParentById(context.Background(), parentId)
+	if err != nil {
+		return nil, err
+	}
+
+	return loadParent(p.queues, parentModel)
+}
+
+func (p *ParentRepository) FindByCpf(cpf value_objects.CPF) (*parent.Parent, error) {
+	parentModel, err := p.queues.FindParentByCpf(context.Background(), string(cpf))
+	if err != nil {
+		return nil, err
+	}
+
+	return loadParent(p.queues, models.FindParentByIdRow(parentModel))
+}
+
+func (p *ParentRepository) FindChildren(parentId string) ([]parent.Child, error) {
+	id, err := uuid.Parse(parentId)
+	if err != nil {
+		return nil, err
+	}
+
+	childrenModel, err := p.queues.FindParentChildren(context.Background(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	var children []parent.Child
+
+	for _, childModel := range childrenModel {
+		children = append(children, parent.Child{
+			StudentId:    childModel.ID,
+			FirstName:    childModel.FirstName,
+			LastName:     childModel.LastName,
+			CpfDocument:  childModel.CpfDocument,
+			Relationship: childModel.Relationship,
+		})
+	}
+
+	return children, nil
+}
+
+func (p *ParentRepository) FindAll(pagination paginator.Pagination) (*paginator.PaginationResult, error) {
+	ctx, cancelQuery := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancelQuery()
+
+	query := `
+		SELECT id, first_name, last_name, birthday, rg_document, cpf_document, email,
+		       COUNT(*) OVER() as total
+		FROM parents
+		WHERE deleted_at IS NULL
+		    AND (first_name || ' ' || last_name ILIKE $1 OR cpf_document LIKE $1 OR email ILIKE $1)
+	`
+	query += pagination.FiltersInSql()
+
+	stmt, err := p.db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+	rows, err := stmt.QueryContext(ctx, "%"+pagination.Search+"%")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var parents []parent.Parent
+	total := 0
+
+	for rows.Next() {
+		var parentModel models.FindParentByIdRow
+		err = rows.Scan(
+			&parentModel.ID,
+			&parentModel.FirstName,
+			&parentModel.LastName,
+			&parentModel.Birthday,
+			&parentModel.RgDocument,
+			&parentModel.CpfDocument,
+			&parentModel.Email,
+			&total,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		prt, err := parent.Load(
+			parentModel.ID.String(),
+			parentModel.FirstName,
+			parentModel.LastName,
+			parentModel.Birthday.Format("2006-01-02"),
+			parentModel.RgDocument.String,
+			parentModel.CpfDocument,
+			parentModel.Email,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		parents = append(parents, *prt)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &paginator.PaginationResult{
+		Total: total,
+		Data:  parents,
+	}, nil
+}
+
+// loadParent Carrega o responsavel com seus enderecos e telefones
+func loadParent(queues *models.Queries, parentModel models.FindParentByIdRow) (*parent.Parent, error) {
+	prt, err := parent.Load(
+		parentModel.ID.String(),
+		parentModel.FirstName,
+		parentModel.LastName,
+		parentModel.Birthday.Format("2006-01-02"),
+		parentModel.RgDocument.String,
+		parentModel.CpfDocument,
+		parentModel.Email,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses, err := findAddresses(queues, prt.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	phones, err := findPhones(queues, prt.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	prt.ChangeAddresses(addresses)
+	prt.ChangePhones(phones)
+
+	return prt, nil
+}
+
+func findAddresses(queues *models.Queries, ownerId uuid.UUID) ([]value_objects.Address, error) {
+	addressesModel, err := queues.FindAddressesByOwner(context.Background(), ownerId)
+	if err != nil {
+		return nil, err
+	}
+
+	var addresses []value_objects.Address
+
+	for _, addressModel := range addressesModel {
+		addresses = append(addresses, value_objects.Address{
+			Id:       addressModel.ID,
+			Street:   addressModel.Street,
+			City:     addressModel.City,
+			District: addressModel.District,
+			State:    addressModel.State,
+			ZipCode:  addressModel.ZipCode,
+			OwnerId:  addressModel.OwnerID,
+		})
+	}
+
+	return addresses, nil
+}
+
+func findPhones(queues *models.Queries, ownerId uuid.UUID) ([]value_objects.Phone, error) {
+	phonesModel, err := queues.FindPhonesByOwner(context.Background(), ownerId)
+	if err != nil {
+		return nil, err
+	}
+
+	var phones []value_objects.Phone
+
+	for _, phoneModel := range phonesModel {
+		phones = append(phones, value_objects.Phone{
+			Id:          phoneModel.ID,
+			Description: phoneModel.Description,
+			Phone:       phoneModel.Phone,
+			OwnerId:     phoneModel.OwnerID,
+		})
+	}
+
+	return phones, nil
+}
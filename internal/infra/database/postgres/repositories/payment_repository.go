@@ -0,0 +1,295 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/database/postgres/models"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/payment"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+)
+
+type PaymentRepository struct {
+	db     *sql.DB
+	queues *models.Queries
+}
+
+type paymentSearchModel struct {
+	Payment models.FindPaymentsByInvoiceRow
+	Total   int
+}
+
+func NewPaymentRepository(db *sql.DB) *PaymentRepository {
+	return &PaymentRepository{
+		db:     db,
+		queues: models.New(db),
+	}
+}
+
+func (p *PaymentRepository) SetTransaction(tx *sql.Tx) {
+	p.queues = p.queues.WithTx(tx)
+}
+
+func (p *PaymentRepository) Create(pay payment.Payment) error {
+	createParams := models.CreatePaymentParams{
+		ID:             pay.Id(),
+		InvoiceID:      pay.InvoiceId(),
+		RegistrationID: pay.RegistrationId(),
+		StudentID:      pay.StudentId(),
+		Method:         pay.Method(),
+		Value:          strconv.FormatFloat(pay.Value(), 'f', -1, 64),
+		AppliedValue:   strconv.FormatFloat(pay.AppliedValue(), 'f', -1, 64),
+		PaidAt:         pay.PaidAt(),
+		CreatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		Reference: sql.NullString{
+			String: pay.Reference(),
+			Valid:  pay.Reference() != "",
+		},
+	}
+
+	return p.queues.CreatePayment(context.Background(), createParams)
+}
+
+// ReferenceExists Indica se ja existe pagamento registrado com a referencia externa informada
+func (p *PaymentRepository) ReferenceExists(reference string) (bool, error) {
+	total, err := p.queues.CountPaymentsByReference(context.Background(), sql.NullString{
+		String: reference,
+		Valid:  true,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return total > 0, nil
+}
+
+func (p *PaymentRepository) CreateCredit(credit payment.Credit) error {
+	createParams := models.CreateStudentCreditParams{
+		ID:        credit.Id(),
+		StudentID: credit.StudentId(),
+		PaymentID: credit.PaymentId(),
+		Value:     strconv.FormatFloat(credit.Value(), 'f', -1, 64),
+		Balance:   strconv.FormatFloat(credit.Balance(), 'f', -1, 64),
+		CreatedAt: credit.CreatedAt(),
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	}
+
+	return p.queues.CreateStudentCredit(context.Background(), createParams)
+}
+
+// FindAvailableCreditsLock Creditos com saldo do aluno, do mais antigo para o mais novo, com lock dos registros
+func (p *PaymentRepository) FindAvailableCreditsLock(studentId uuid.UUID) ([]payment.Credit, error) {
+	creditsModel, err := p.queues.FindAvailableStudentCreditsLock(context.Background(), studentId)
+	if err != nil {
+		return nil, err
+	}
+
+	var credits []payment.Credit
+
+	for _, creditModel := range creditsModel {
+		value, _ := strconv.ParseFloat(creditModel.Value, 64)
+		balance, _ := strconv.ParseFloat(creditModel.Balance, 64)
+
+		credit := payment.LoadCredit(
+			creditModel.ID,
+			creditModel.StudentID,
+			creditModel.PaymentID,
+			value,
+			balance,
+			creditModel.CreatedAt,
+		)
+
+		credits = append(credits, *credit)
+	}
+
+	return credits, nil
+}
+
+func (p *PaymentRepository) UpdateCredit(credit payment.Credit) error {
+	updateParams := models.UpdateStudentCreditBalanceParams{
+		Balance: strconv.FormatFloat(credit.Balance(), 'f', -1, 64),
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		ID: credit.Id(),
+	}
+
+	return p.queues.UpdateStudentCreditBalance(context.Background(), updateParams)
+}
+
+func (p *PaymentRepository) FindByInvoice(invoiceId string) ([]payment.Payment, error) {
+	id, err := uuid.Parse(invoiceId)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentsModel, err := p.queues.FindPaymentsByInvoice(context.Background(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	var payments []payment.Payment
+
+	for _, paymentModel := range paymentsModel {
+		pay, err := p.loadPayment(paymentModel)
+		if err != nil {
+			return nil, err
+		}
+
+		payments = append(payments, *pay)
+	}
+
+	return payments, nil
+}
+
+func (p *PaymentRepository) FindByStudent(studentId string, pagination paginator.Pagination) (*paginator.PaginationResult, error) {
+	return p.findBy("student_id", studentId, pagination)
+}
+
+func (p *PaymentRepository) FindByRegistration(registrationId string, pagination paginator.Pagination) (*paginator.PaginationResult, error) {
+	return p.findBy("registration_id", registrationId, pagination)
+}
+
+// RegistrationBalance Soma as cobrancas nao canceladas da matricula e o credito disponivel do aluno
+func (p *PaymentRepository) RegistrationBalance(registrationId string) (*payment.Balance, error) {
+	id, err := uuid.Parse(registrationId)
+	if err != nil {
+		return nil, err
+	}
+
+	balance := payment.Balance{
+		RegistrationId: id.String(),
+	}
+
+	totals, err := p.queues.SumRegistrationInvoices(context.Background(), id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &balance, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	credit, err := p.queues.SumStudentCreditBalance(context.Background(), totals.StudentID)
+	if err != nil {
+		return nil, err
+	}
+
+	balance.Charged, _ = strconv.ParseFloat(totals.Charged, 64)
+	balance.Paid, _ = strconv.ParseFloat(totals.Paid, 64)
+	balance.Credit, _ = strconv.ParseFloat(credit, 64)
+	balance.Outstanding, _ = strconv.ParseFloat(strconv.FormatFloat(balance.Charged-balance.Paid, 'f', 2, 64), 64)
+
+	return &balance, nil
+}
+
+// findBy Historico paginado de pagamentos filtrando pela coluna informada (student_id ou registration_id)
+func (p *PaymentRepository) findBy(column string, value string, pagination paginator.Pagination) (*paginator.PaginationResult, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancelQuery := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancelQuery()
+
+	if pagination.SortField == "" {
+		pagination.SortField = "paid_at"
+		pagination.Sort = "DESC"
+	}
+
+	query := `
+		SELECT id, invoice_id, registration_id, student_id, method,
+		       value, applied_value, paid_at,
+		       COUNT(*) OVER() as total
+			FROM payments
+		WHERE ` + column + ` = $1 AND method like $2
+	`
+	filters := pagination.FiltersInSql()
+
+	if filters != "" {
+		query += filters
+	}
+
+	stmt, err := p.db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+	rows, err := stmt.QueryContext(ctx, id, "%"+pagination.Search+"%")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var paymentsModel []paymentSearchModel
+
+	for rows.Next() {
+		var paymentModel paymentSearchModel
+		err = rows.Scan(
+			&paymentModel.Payment.ID,
+			&paymentModel.Payment.InvoiceID,
+			&paymentModel.Payment.RegistrationID,
+			&paymentModel.Payment.StudentID,
+			&paymentModel.Payment.Method,
+			&paymentModel.Payment.Value,
+			&paymentModel.Payment.AppliedValue,
+			&paymentModel.Payment.PaidAt,
+			&paymentModel.Total,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		paymentsModel = append(paymentsModel, paymentModel)
+	}
+
+	var payments []payment.Payment
+
+	for _, paymentModel := range paymentsModel {
+		pay, err := p.loadPayment(paymentModel.Payment)
+		if err != nil {
+			return nil, err
+		}
+
+		payments = append(payments, *pay)
+	}
+
+	paginationResult := paginator.PaginationResult{
+		Data: payments,
+	}
+
+	if len(paymentsModel) > 0 {
+		paginationResult.Total = paymentsModel[0].Total
+	}
+
+	return &paginationResult, nil
+}
+
+func (p *PaymentRepository) loadPayment(paymentModel models.FindPaymentsByInvoiceRow) (*payment.Payment, error) {
+	value, _ := strconv.ParseFloat(paymentModel.Value, 64)
+	appliedValue, _ := strconv.ParseFloat(paymentModel.AppliedValue, 64)
+
+	return payment.Load(
+		paymentModel.ID.String(),
+		paymentModel.InvoiceID,
+		paymentModel.RegistrationID,
+		paymentModel.StudentID,
+		paymentModel.Method,
+		value,
+		appliedValue,
+		paymentModel.PaidAt,
+	)
+}
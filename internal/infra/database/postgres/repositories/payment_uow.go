@@ -0,0 +1,161 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/payment"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+)
+
+type PaymentUow struct {
+	db               *sql.DB
+	tx               *sql.Tx
+	paymentRepo      PaymentRepository
+	invoiceRepo      InvoiceRepository
+	registrationRepo RegistrationRepository
+}
+
+func NewPaymentUow(
+	db *sql.DB,
+	paymentRepo PaymentRepository,
+	invoiceRepo InvoiceRepository,
+	registrationRepo RegistrationRepository,
+) *PaymentUow {
+	return &PaymentUow{
+		db:               db,
+		paymentRepo:      paymentRepo,
+		invoiceRepo:      invoiceRepo,
+		registrationRepo: registrationRepo,
+	}
+}
+
+func (p *PaymentUow) BeginTransaction() error {
+	tx, err := p.db.Begin()
+
+	if err != nil {
+		return err
+	}
+
+	p.tx = tx
+
+	return nil
+}
+
+func (p *PaymentUow) Rollback() error {
+	if p.tx == nil {
+		return errors.New("failed to rollback transaction. Transaction not started")
+	}
+
+	return p.tx.Rollback()
+}
+
+func (p *PaymentUow) Commit() error {
+	if p.tx == nil {
+		return errors.New("failed in commit transaction. Transaction not started")
+	}
+
+	return p.tx.Commit()
+}
+
+func (p *PaymentUow) FindInvoiceLock(id string) (*invoice.Invoice, error) {
+	if p.tx == nil {
+		return nil, errors.New("failed in find invoice. Transaction not started")
+	}
+
+	p.invoiceRepo.SetTransaction(p.tx)
+
+	return p.invoiceRepo.FindByIdLock(id)
+}
+
+func (p *PaymentUow) FindOpenInvoicesLock(studentId uuid.UUID) ([]invoice.Invoice, error) {
+	if p.tx == nil {
+		return nil, errors.New("failed in find open invoices. Transaction not started")
+	}
+
+	p.invoiceRepo.SetTransaction(p.tx)
+
+	return p.invoiceRepo.FindOpenByStudentLock(studentId)
+}
+
+func (p *PaymentUow) UpdateInvoicePayment(inv invoice.Invoice) error {
+	if p.tx == nil {
+		return errors.New("failed in update invoice payment. Transaction not started")
+	}
+
+	p.invoiceRepo.SetTransaction(p.tx)
+
+	return p.invoiceRepo.UpdatePayment(inv)
+}
+
+func (p *PaymentUow) CreatePayment(pay payment.Payment) error {
+	if p.tx == nil {
+		return errors.New("failed in create payment. Transaction not started")
+	}
+
+	p.paymentRepo.SetTransaction(p.tx)
+
+	return p.paymentRepo.Create(pay)
+}
+
+func (p *PaymentUow) PaymentReferenceExists(reference string) (bool, error) {
+	if p.tx == nil {
+		return false, errors.New("failed in check payment reference. Transaction not started")
+	}
+
+	p.paymentRepo.SetTransaction(p.tx)
+
+	return p.paymentRepo.ReferenceExists(reference)
+}
+
+func (p *PaymentUow) CreateCredit(credit payment.Credit) error {
+	if p.tx == nil {
+		return errors.New("failed in create student credit. Transaction not started")
+	}
+
+	p.paymentRepo.SetTransaction(p.tx)
+
+	return p.paymentRepo.CreateCredit(credit)
+}
+
+func (p *PaymentUow) FindAvailableCreditsLock(studentId uuid.UUID) ([]payment.Credit, error) {
+	if p.tx == nil {
+		return nil, errors.New("failed in find student credits. Transaction not started")
+	}
+
+	p.paymentRepo.SetTransaction(p.tx)
+
+	return p.paymentRepo.FindAvailableCreditsLock(studentId)
+}
+
+func (p *PaymentUow) UpdateCredit(credit payment.Credit) error {
+	if p.tx == nil {
+		return errors.New("failed in update student credit. Transaction not started")
+	}
+
+	p.paymentRepo.SetTransaction(p.tx)
+
+	return p.paymentRepo.UpdateCredit(credit)
+}
+
+func (p *PaymentUow) FindRegisterLock(id string) (*registration.Registration, error) {
+	if p.tx == nil {
+		return nil, errors.New("failed in find registration. Transaction not started")
+	}
+
+	p.registrationRepo.SetTransaction(p.tx)
+
+	return p.registrationRepo.FindByIdLock(id)
+}
+
+func (p *PaymentUow) UpdateRegisterStatus(register registration.Registration) error {
+	if p.tx == nil {
+		return errors.New("failed in update registration status. Transaction not started")
+	}
+
+	p.registrationRepo.SetTransaction(p.tx)
+
+	return p.registrationRepo.UpdateStatus(register)
+}
@@ -0,0 +1,150 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/database/postgres/models"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/pickup"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
+)
+
+type PickupRepository struct {
+	db     *sql.DB
+	queues *models.Queries
+}
+
+func NewPickupRepository(db *sql.DB) *PickupRepository {
+	return &PickupRepository{
+		db:     db,
+		queues: models.New(db),
+	}
+}
+
+func (p *PickupRepository) SetTransaction(tx *sql.Tx) {
+	p.queues = p.queues.WithTx(tx)
+}
+
+func (p *PickupRepository) Create(authorization pickup.Authorization) error {
+	return p.queues.CreatePickupAuthorization(context.Background(), models.CreatePickupAuthorizationParams{
+		ID:          authorization.Id(),
+		StudentID:   authorization.StudentId(),
+		ParentID:    authorization.ParentId(),
+		Name:        authorization.Name(),
+		CpfDocument: string(authorization.Cpf()),
+		Photo: sql.NullString{
+			String: authorization.Photo(),
+			Valid:  authorization.Photo() != "",
+		},
+		ValidFrom: authorization.ValidFrom(),
+		ValidUntil: sql.NullTime{
+			Time:  authorization.ValidUntil(),
+			Valid: !authorization.ValidUntil().IsZero(),
+		},
+		CreatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	})
+}
+
+func (p *PickupRepository) Delete(id string) error {
+	authorizationId, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	return p.queues.DeletePickupAuthorization(context.Background(), models.DeletePickupAuthorizationParams{
+		ID: authorizationId,
+		DeletedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	})
+}
+
+func (p *PickupRepository) FindById(id string) (*pickup.Authorization, error) {
+	authorizationId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	authorizationModel, err := p.queues.FindPickupAuthorizationById(context.Background(), authorizationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return loadPickupAuthorization(authorizationModel)
+}
+
+func (p *PickupRepository) FindByStudent(studentId string) ([]pickup.Authorization, error) {
+	id, err := uuid.Parse(studentId)
+	if err != nil {
+		return nil, err
+	}
+
+	authorizationModels, err := p.queues.FindPickupAuthorizationsByStudent(context.Background(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	var authorizations []pickup.Authorization
+
+	for _, authorizationModel := range authorizationModels {
+		authorization, err := loadPickupAuthorization(models.FindPickupAuthorizationByIdRow(authorizationModel))
+		if err != nil {
+			return nil, err
+		}
+
+		authorizations = append(authorizations, *authorization)
+	}
+
+	return authorizations, nil
+}
+
+func (p *PickupRepository) FindByCpf(studentId string, cpf value_objects.CPF) ([]pickup.Authorization, error) {
+	id, err := uuid.Parse(studentId)
+	if err != nil {
+		return nil, err
+	}
+
+	authorizationModels, err := p.queues.FindPickupAuthorizationsByCpf(context.Background(), models.FindPickupAuthorizationsByCpfParams{
+		StudentID:   id,
+		CpfDocument: string(cpf),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var authorizations []pickup.Authorization
+
+	for _, authorizationModel := range authorizationModels {
+		authorization, err := loadPickupAuthorization(models.FindPickupAuthorizationByIdRow(authorizationModel))
+		if err != nil {
+			return nil, err
+		}
+
+		authorizations = append(authorizations, *authorization)
+	}
+
+	return authorizations, nil
+}
+
+func loadPickupAuthorization(authorizationModel models.FindPickupAuthorizationByIdRow) (*pickup.Authorization, error) {
+	return pickup.Load(
+		authorizationModel.ID.String(),
+		authorizationModel.StudentID,
+		authorizationModel.ParentID,
+		authorizationModel.Name,
+		authorizationModel.CpfDocument,
+		authorizationModel.Photo.String,
+		authorizationModel.ValidFrom,
+		authorizationModel.ValidUntil.Time,
+	)
+}
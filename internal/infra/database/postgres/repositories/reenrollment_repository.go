@@ -0,0 +1,246 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/database/postgres/models"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/reenrollment"
+)
+
+type ReenrollmentRepository struct {
+	db     *sql.DB
+	queues *models.Queries
+}
+
+func NewReenrollmentRepository(db *sql.DB) *ReenrollmentRepository {
+	return &ReenrollmentRepository{
+		db:     db,
+		queues: models.New(db),
+	}
+}
+
+func (r *ReenrollmentRepository) SetTransaction(tx *sql.Tx) {
+	r.queues = r.queues.WithTx(tx)
+}
+
+func (r *ReenrollmentRepository) CreateCampaign(campaign reenrollment.Campaign) error {
+	return r.queues.CreateReenrollmentCampaign(context.Background(), models.CreateReenrollmentCampaignParams{
+		ID:               campaign.Id(),
+		FromSchoolYearID: campaign.FromSchoolYearId(),
+		ToSchoolYearID:   campaign.ToSchoolYearId(),
+		Deadline:         campaign.Deadline(),
+		CreatedAt:        campaign.CreatedAt(),
+	})
+}
+
+func (r *ReenrollmentRepository) FindCampaignById(id string) (*reenrollment.Campaign, error) {
+	campaignId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	campaignModel, err := r.queues.FindReenrollmentCampaign(context.Background(), campaignId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.loadCampaign(campaignModel)
+}
+
+// FindCampaignBySchoolYear Busca a campanha de rematricula do ano letivo. Retorna nil se ainda nao foi criada
+func (r *ReenrollmentRepository) FindCampaignBySchoolYear(fromSchoolYearId uuid.UUID) (*reenrollment.Campaign, error) {
+	campaignModel, err := r.queues.FindReenrollmentCampaignBySchoolYear(context.Background(), fromSchoolYearId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.loadCampaign(campaignModel)
+}
+
+func (r *ReenrollmentRepository) loadCampaign(campaignModel models.ReenrollmentCampaign) (*reenrollment.Campaign, error) {
+	return reenrollment.LoadCampaign(
+		campaignModel.ID.String(),
+		campaignModel.FromSchoolYearID,
+		campaignModel.ToSchoolYearID,
+		campaignModel.Deadline,
+		campaignModel.CreatedAt,
+	)
+}
+
+func (r *ReenrollmentRepository) CreateProposals(proposals []reenrollment.Proposal) error {
+	for _, proposal := range proposals {
+		err := r.queues.CreateReenrollmentProposal(context.Background(), models.CreateReenrollmentProposalParams{
+			ID:                proposal.Id(),
+			CampaignID:        proposal.CampaignId(),
+			RegistrationID:    proposal.RegistrationId(),
+			StudentID:         proposal.StudentId(),
+			StudentName:       proposal.StudentName(),
+			ClassRoomID:       proposal.ClassRoomId(),
+			ClassRoom:         proposal.ClassRoom(),
+			TargetLevel:       proposal.TargetLevel(),
+			TargetClassRoomID: proposal.TargetClassRoomId(),
+			Status:            proposal.Status(),
+			Reason: sql.NullString{
+				String: proposal.Reason(),
+				Valid:  proposal.Reason() != "",
+			},
+		})
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *ReenrollmentRepository) UpdateProposal(proposal reenrollment.Proposal) error {
+	return r.queues.UpdateReenrollmentProposal(context.Background(), models.UpdateReenrollmentProposalParams{
+		ID:                proposal.Id(),
+		TargetClassRoomID: proposal.TargetClassRoomId(),
+		Status:            proposal.Status(),
+		Reason: sql.NullString{
+			String: proposal.Reason(),
+			Valid:  proposal.Reason() != "",
+		},
+		NewRegistrationID: proposal.NewRegistrationId(),
+		RespondedAt: sql.NullTime{
+			Time:  proposal.RespondedAt(),
+			Valid: !proposal.RespondedAt().IsZero(),
+		},
+	})
+}
+
+func (r *ReenrollmentRepository) FindProposals(campaignId string) ([]reenrollment.Proposal, error) {
+	id, err := uuid.Parse(campaignId)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalModels, err := r.queues.FindReenrollmentProposals(context.Background(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	var proposals []reenrollment.Proposal
+
+	for _, proposalModel := range proposalModels {
+		proposal, err := r.loadProposal(proposalModel)
+		if err != nil {
+			return nil, err
+		}
+
+		proposals = append(proposals, *proposal)
+	}
+
+	return proposals, nil
+}
+
+func (r *ReenrollmentRepository) FindProposalById(id string) (*reenrollment.Proposal, error) {
+	proposalId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalModel, err := r.queues.FindReenrollmentProposal(context.Background(), proposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.loadProposal(proposalModel)
+}
+
+// FindProposalByIdLock Busca a proposta bloqueando o registro ate o fim da transacao, evitando
+// respostas simultaneas para a mesma proposta
+func (r *ReenrollmentRepository) FindProposalByIdLock(id string) (*reenrollment.Proposal, error) {
+	proposalId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalModel, err := r.queues.FindReenrollmentProposalLock(context.Background(), proposalId)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.loadProposal(proposalModel)
+}
+
+func (r *ReenrollmentRepository) loadProposal(proposalModel models.ReenrollmentProposal) (*reenrollment.Proposal, error) {
+	return reenrollment.LoadProposal(
+		proposalModel.ID.String(),
+		proposalModel.CampaignID,
+		proposalModel.RegistrationID,
+		proposalModel.StudentID,
+		proposalModel.StudentName,
+		proposalModel.ClassRoomID,
+		proposalModel.ClassRoom,
+		proposalModel.TargetLevel,
+		proposalModel.TargetClassRoomID,
+		proposalModel.Status,
+		proposalModel.Reason.String,
+		proposalModel.NewRegistrationID,
+		proposalModel.RespondedAt.Time,
+	)
+}
+
+// FindApprovedRegistrations Matriculas aprovadas do ano letivo que podem ser renovadas
+func (r *ReenrollmentRepository) FindApprovedRegistrations(schoolYearId uuid.UUID) ([]uuid.UUID, error) {
+	return r.queues.FindApprovedRegistrationsBySchoolYear(context.Background(), uuid.NullUUID{
+		UUID:  schoolYearId,
+		Valid: true,
+	})
+}
+
+// FindClassRooms Turmas abertas do nivel no ano letivo informado
+func (r *ReenrollmentRepository) FindClassRooms(schoolYearId uuid.UUID, level string) ([]classroom.ClassRoom, error) {
+	classRoomModels, err := r.queues.FindClassRoomsBySchoolYearAndLevel(context.Background(), models.FindClassRoomsBySchoolYearAndLevelParams{
+		SchoolYearID: schoolYearId,
+		Level:        level,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var classRooms []classroom.ClassRoom
+
+	for _, classRoomModel := range classRoomModels {
+		classRoom, err := classroom.Load(
+			classRoomModel.ID.String(),
+			classRoomModel.Active,
+			classRoomModel.Status,
+			int(classRoomModel.VacanciesOccupied),
+			int(classRoomModel.Vacancies),
+			classRoomModel.OpenDate.Format("2006-01-02"),
+			classRoomModel.Shift,
+			classRoomModel.Level,
+			classRoomModel.Identification,
+			classRoomModel.SchoolYearID.String(),
+			classRoomModel.RoomID.UUID.String(),
+			classRoomModel.ScheduleID.String(),
+			classRoomModel.Localization.String,
+			classRoomModel.Type,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		classRooms = append(classRooms, *classRoom)
+	}
+
+	return classRooms, nil
+}
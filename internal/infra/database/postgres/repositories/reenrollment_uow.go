@@ -0,0 +1,181 @@
+package repositories
+
+import (
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/reenrollment"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+)
+
+type ReenrollmentUow struct {
+	db               *sql.DB
+	tx               *sql.Tx
+	reenrollmentRepo ReenrollmentRepository
+	registrationRepo RegistrationRepository
+	classRoomRepo    ClassRoomRepository
+	invoiceRepo      InvoiceRepository
+}
+
+func NewReenrollmentUow(
+	db *sql.DB,
+	reenrollmentRepo ReenrollmentRepository,
+	registrationRepo RegistrationRepository,
+	classRoomRepo ClassRoomRepository,
+	invoiceRepo InvoiceRepository,
+) *ReenrollmentUow {
+	return &ReenrollmentUow{
+		db:               db,
+		reenrollmentRepo: reenrollmentRepo,
+		registrationRepo: registrationRepo,
+		classRoomRepo:    classRoomRepo,
+		invoiceRepo:      invoiceRepo,
+	}
+}
+
+func (r *ReenrollmentUow) BeginTransaction() error {
+	tx, err := r.db.Begin()
+
+	if err != nil {
+		return err
+	}
+
+	r.tx = tx
+
+	return nil
+}
+
+func (r *ReenrollmentUow) Rollback() error {
+	if r.tx == nil {
+		return errors.New("failed to rollback transaction. Transaction not started")
+	}
+
+	return r.tx.Rollback()
+}
+
+func (r *ReenrollmentUow) Commit() error {
+	if r.tx == nil {
+		return errors.New("failed in commit transaction. Transaction not started")
+	}
+
+	return r.tx.Commit()
+}
+
+func (r *ReenrollmentUow) CreateCampaign(campaign reenrollment.Campaign) error {
+	if r.tx == nil {
+		return errors.New("failed in create re-enrollment campaign. Transaction not started")
+	}
+
+	r.reenrollmentRepo.SetTransaction(r.tx)
+
+	return r.reenrollmentRepo.CreateCampaign(campaign)
+}
+
+func (r *ReenrollmentUow) CreateProposals(proposals []reenrollment.Proposal) error {
+	if r.tx == nil {
+		return errors.New("failed in create re-enrollment proposals. Transaction not started")
+	}
+
+	r.reenrollmentRepo.SetTransaction(r.tx)
+
+	return r.reenrollmentRepo.CreateProposals(proposals)
+}
+
+func (r *ReenrollmentUow) FindProposalLock(id string) (*reenrollment.Proposal, error) {
+	if r.tx == nil {
+		return nil, errors.New("failed in find re-enrollment proposal. Transaction not started")
+	}
+
+	r.reenrollmentRepo.SetTransaction(r.tx)
+
+	return r.reenrollmentRepo.FindProposalByIdLock(id)
+}
+
+func (r *ReenrollmentUow) UpdateProposal(proposal reenrollment.Proposal) error {
+	if r.tx == nil {
+		return errors.New("failed in update re-enrollment proposal. Transaction not started")
+	}
+
+	r.reenrollmentRepo.SetTransaction(r.tx)
+
+	return r.reenrollmentRepo.UpdateProposal(proposal)
+}
+
+func (r *ReenrollmentUow) FindRegisterLock(id string) (*registration.Registration, error) {
+	if r.tx == nil {
+		return nil, errors.New("failed in find registration. Transaction not started")
+	}
+
+	r.registrationRepo.SetTransaction(r.tx)
+
+	return r.registrationRepo.FindByIdLock(id)
+}
+
+func (r *ReenrollmentUow) FindClassRoomLock(id string) (*classroom.ClassRoom, error) {
+	if r.tx == nil {
+		return nil, errors.New("failed in find class room. Transaction not started")
+	}
+
+	r.classRoomRepo.SetTransaction(r.tx)
+
+	return r.classRoomRepo.FindByIdLock(id)
+}
+
+func (r *ReenrollmentUow) OccupyVacancies(classRoom classroom.ClassRoom) error {
+	if r.tx == nil {
+		return errors.New("failed in occupy vacancies. Transaction not started")
+	}
+
+	r.classRoomRepo.SetTransaction(r.tx)
+
+	return r.classRoomRepo.OccupyVacancies(classRoom)
+}
+
+func (r *ReenrollmentUow) StudentAlreadyRegisterInClass(studentId uuid.UUID, classRoomId uuid.UUID) (bool, error) {
+	if r.tx == nil {
+		return false, errors.New("failed in find student registration. Transaction not started")
+	}
+
+	r.registrationRepo.SetTransaction(r.tx)
+
+	registrationCode, err := r.registrationRepo.SearchStudentAlreadyRegistered(studentId, classRoomId)
+	if err != nil {
+		return false, err
+	}
+
+	return registrationCode != "", nil
+}
+
+func (r *ReenrollmentUow) StudentOverdueDebt(studentId uuid.UUID, dueBefore time.Time) (float64, error) {
+	if r.tx == nil {
+		return 0, errors.New("failed in find student overdue debt. Transaction not started")
+	}
+
+	r.invoiceRepo.SetTransaction(r.tx)
+
+	return r.invoiceRepo.OverdueBalance(studentId, dueBefore)
+}
+
+func (r *ReenrollmentUow) CreateRegister(register registration.Registration) error {
+	if r.tx == nil {
+		return errors.New("failed in register student. Transaction not started")
+	}
+
+	r.registrationRepo.SetTransaction(r.tx)
+
+	return r.registrationRepo.Create(register)
+}
+
+func (r *ReenrollmentUow) CreateInvoices(invoices []invoice.Invoice) error {
+	if r.tx == nil {
+		return errors.New("failed in create invoices. Transaction not started")
+	}
+
+	r.invoiceRepo.SetTransaction(r.tx)
+
+	return r.invoiceRepo.Create(invoices)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/service"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
 	"log"
 	"strconv"
@@ -30,15 +32,6 @@ func (r *RegistrationRepository) SetTransaction(tx *sql.Tx) {
 }
 
 func (r *RegistrationRepository) Create(registration registration.Registration) error {
-	classRoomModel, err := r.queues.FindClassByIdLock(context.Background(), registration.Class().Id())
-	if err != nil {
-		return err
-	}
-
-	if classRoomModel.VacanciesOccupied >= classRoomModel.Vacancies {
-		return errors.New("no vacancies available for this class")
-	}
-
 	monthlyFee := strconv.FormatFloat(registration.MonthlyFee(), 'f', -1, 64)
 	enrollmentFee := strconv.FormatFloat(registration.EnrollmentFee(), 'f', -1, 64)
 
@@ -46,7 +39,7 @@ func (r *RegistrationRepository) Create(registration registration.Registration)
 		ID:   registration.Id(),
 		Code: registration.Code(),
 		ClassRoomID: uuid.NullUUID{
-			UUID:  classRoomModel.ID,
+			UUID:  registration.Class().Id(),
 			Valid: true,
 		},
 		Shift: sql.NullString{
@@ -75,7 +68,7 @@ func (r *RegistrationRepository) Create(registration registration.Registration)
 			Valid: true,
 		},
 		SchoolYearID: uuid.NullUUID{
-			UUID:  registration.Class().Id(),
+			UUID:  registration.Class().SchoolYearId(),
 			Valid: true,
 		},
 		CreatedAt: sql.NullTime{
@@ -86,52 +79,40 @@ func (r *RegistrationRepository) Create(registration registration.Registration)
 			Time:  time.Now(),
 			Valid: true,
 		},
+		PaymentDay: sql.NullString{
+			String: registration.PaymentDay(),
+			Valid:  true,
+		},
+		Paid: registration.Paid(),
+	}
+
+	if responsible := registration.FinancialResponsible(); responsible != nil {
+		registrationModel.FinancialResponsibleID = uuid.NullUUID{UUID: responsible.Id, Valid: true}
+		registrationModel.FinancialResponsibleType = sql.NullString{String: responsible.Type, Valid: true}
+		registrationModel.FinancialResponsibleName = sql.NullString{String: responsible.Name, Valid: true}
+		registrationModel.FinancialResponsibleCpf = sql.NullString{String: responsible.Cpf, Valid: true}
+		registrationModel.FinancialResponsibleEmail = sql.NullString{String: responsible.Email, Valid: responsible.Email != ""}
 	}
 
-	err = r.queues.CreateRegistration(context.Background(), registrationModel)
+	err := r.queues.CreateRegistration(context.Background(), registrationModel)
 	if err != nil {
 		log.Println(err)
 		return errors.New("failed to create registration")
 	}
 
-	rowAffected, err := r.UpdateOccupiedVacancies(classRoomModel.ID.String(), classRoomModel.VacanciesOccupied)
+	err = r.createStatusChanges(registration)
 	if err != nil {
 		log.Println(err)
-		return errors.New("failed to update occupied vacancies")
-	}
-
-	if rowAffected < 1 {
-		return errors.New("free vacancies not available")
+		return errors.New("failed to save registration status history")
 	}
 
-	return nil
-}
-
-func (r *RegistrationRepository) UpdateOccupiedVacancies(classroomId string, currentVacancyOccupied int32) (int, error) {
-
-	query := `UPDATE class_room 
-    			SET vacancies_occupied = $1, 
-        			updated_at = $2 
-				WHERE 
-					id = $3 
-					AND vacancies_occupied < vacancies 
-					AND deleted_at IS NULL;`
-	vacanciesOccupied := currentVacancyOccupied + 1
-	resp, err := r.db.ExecContext(
-		context.Background(),
-		query,
-		vacanciesOccupied,
-		time.Now().Format("2006-01-02 15:04:05"),
-		classroomId,
-	)
-
+	err = r.createDiscounts(registration)
 	if err != nil {
-		return 0, err
+		log.Println(err)
+		return errors.New("failed to save registration discounts")
 	}
 
-	rowsAffected, _ := resp.RowsAffected()
-
-	return int(rowsAffected), err
+	return nil
 }
 
 func (r *RegistrationRepository) SearchStudentAlreadyRegistered(studentId uuid.UUID, classRoomId uuid.UUID) (string, error) {
@@ -144,9 +125,320 @@ func (r *RegistrationRepository) SearchStudentAlreadyRegistered(studentId uuid.U
 	}
 
 	registrationCode, err := r.queues.SearchStudentAlreadyRegistered(context.Background(), searchStudentAlready)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+
 	if err != nil {
 		return "", err
 	}
 
 	return registrationCode, nil
 }
+
+func (r *RegistrationRepository) FindById(id string) (*registration.Registration, error) {
+	registrationId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	registrationModel, err := r.queues.FindRegistrationById(context.Background(), registrationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.loadRegistration(registrationModel)
+}
+
+// FindByIdLock Busca a matricula pelo ID fazendo o lock do registro no banco. Deve ser usada dentro de uma transacao
+func (r *RegistrationRepository) FindByIdLock(id string) (*registration.Registration, error) {
+	registrationId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	registrationModel, err := r.queues.FindRegistrationByIdLock(context.Background(), registrationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.loadRegistration(models.FindRegistrationByIdRow(registrationModel))
+}
+
+func (r *RegistrationRepository) UpdateStatus(registration registration.Registration) error {
+	updateParams := models.UpdateRegistrationStatusParams{
+		Status: registration.Status(),
+		Paid:   registration.Paid(),
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		ID: registration.Id(),
+	}
+
+	err := r.queues.UpdateRegistrationStatus(context.Background(), updateParams)
+	if err != nil {
+		return err
+	}
+
+	return r.createStatusChanges(registration)
+}
+
+func (r *RegistrationRepository) CreateStatusHistory(history registration.StatusHistory) error {
+	historyModel := models.CreateRegistrationStatusHistoryParams{
+		ID:             history.Id,
+		RegistrationID: history.RegistrationId,
+		PreviousStatus: sql.NullString{
+			String: history.PreviousStatus,
+			Valid:  history.PreviousStatus != "",
+		},
+		Status:      history.Status,
+		Reason:      history.Reason,
+		ClassRoomID: history.ClassRoomId,
+		CreatedAt:   history.CreatedAt,
+		ApprovedBy: sql.NullString{
+			String: history.ApprovedBy,
+			Valid:  history.ApprovedBy != "",
+		},
+	}
+
+	return r.queues.CreateRegistrationStatusHistory(context.Background(), historyModel)
+}
+
+func (r *RegistrationRepository) FindStatusHistory(registrationId string) ([]registration.StatusHistory, error) {
+	regId, err := uuid.Parse(registrationId)
+	if err != nil {
+		return nil, err
+	}
+
+	historyModels, err := r.queues.FindRegistrationStatusHistory(context.Background(), regId)
+	if err != nil {
+		return nil, err
+	}
+
+	var history []registration.StatusHistory
+
+	for _, historyModel := range historyModels {
+		history = append(history, registration.StatusHistory{
+			Id:             historyModel.ID,
+			RegistrationId: historyModel.RegistrationID,
+			PreviousStatus: historyModel.PreviousStatus.String,
+			Status:         historyModel.Status,
+			Reason:         historyModel.Reason,
+			ApprovedBy:     historyModel.ApprovedBy.String,
+			ClassRoomId:    historyModel.ClassRoomID,
+			CreatedAt:      historyModel.CreatedAt,
+		})
+	}
+
+	return history, nil
+}
+
+func (r *RegistrationRepository) FindDiscounts(registrationId string) ([]registration.Discount, error) {
+	regId, err := uuid.Parse(registrationId)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.findDiscounts(regId)
+}
+
+// CountActiveSiblings Quantidade de matriculas ativas de outros alunos que possuem algum dos responsaveis informados
+func (r *RegistrationRepository) CountActiveSiblings(studentId uuid.UUID, parentCpfs []string) (int, error) {
+	countParams := models.CountActiveSiblingRegistrationsParams{
+		ParentCpfs: parentCpfs,
+		StudentID:  studentId,
+	}
+
+	total, err := r.queues.CountActiveSiblingRegistrations(context.Background(), countParams)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(total), nil
+}
+
+func (r *RegistrationRepository) findDiscounts(registrationId uuid.UUID) ([]registration.Discount, error) {
+	discountModels, err := r.queues.FindRegistrationDiscounts(context.Background(), registrationId)
+	if err != nil {
+		return nil, err
+	}
+
+	var discounts []registration.Discount
+
+	for _, discountModel := range discountModels {
+		value, _ := strconv.ParseFloat(discountModel.Value, 64)
+
+		validUntil := ""
+		if discountModel.ValidUntil.Valid {
+			validUntil = discountModel.ValidUntil.Time.Format("2006-01-02")
+		}
+
+		discount, err := registration.LoadDiscount(
+			discountModel.ID.String(),
+			discountModel.Kind,
+			discountModel.Type,
+			value,
+			discountModel.Reason,
+			discountModel.ValidFrom.Format("2006-01-02"),
+			validUntil,
+			discountModel.ApprovedBy,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		discounts = append(discounts, *discount)
+	}
+
+	return discounts, nil
+}
+
+func (r *RegistrationRepository) createDiscounts(registration registration.Registration) error {
+	for _, discount := range registration.Discounts() {
+		discountModel := models.CreateRegistrationDiscountParams{
+			ID:             discount.Id(),
+			RegistrationID: registration.Id(),
+			Kind:           discount.Kind(),
+			Type:           discount.DiscountType(),
+			Value:          strconv.FormatFloat(discount.Value(), 'f', -1, 64),
+			Reason:         discount.Reason(),
+			ValidFrom:      discount.ValidFrom(),
+			ValidUntil: sql.NullTime{
+				Time:  discount.ValidUntil(),
+				Valid: !discount.ValidUntil().IsZero(),
+			},
+			ApprovedBy: discount.ApprovedBy(),
+			CreatedAt: sql.NullTime{
+				Time:  time.Now(),
+				Valid: true,
+			},
+		}
+
+		err := r.queues.CreateRegistrationDiscount(context.Background(), discountModel)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *RegistrationRepository) createStatusChanges(registration registration.Registration) error {
+	for _, history := range registration.StatusChanges() {
+		err := r.CreateStatusHistory(history)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *RegistrationRepository) loadRegistration(registrationModel models.FindRegistrationByIdRow) (*registration.Registration, error) {
+	ctx := context.Background()
+
+	classRoomModel, err := r.queues.FindClassById(ctx, registrationModel.ClassRoomID.UUID)
+	if err != nil {
+		return nil, err
+	}
+
+	classRoom, err := classroom.Load(
+		classRoomModel.ID.String(),
+		classRoomModel.Active,
+		classRoomModel.Status,
+		int(classRoomModel.VacanciesOccupied),
+		int(classRoomModel.Vacancies),
+		classRoomModel.OpenDate.Format("2006-01-02"),
+		classRoomModel.Shift,
+		classRoomModel.Level,
+		classRoomModel.Identification,
+		classRoomModel.SchoolYearID.String(),
+		classRoomModel.RoomID.UUID.String(),
+		classRoomModel.ScheduleID.String(),
+		classRoomModel.Localization.String,
+		classRoomModel.Type,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	studentModel, err := r.queues.FindStudentById(ctx, registrationModel.StudentID)
+	if err != nil {
+		return nil, err
+	}
+
+	stdent, err := loadStudent(studentModel)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceModel, err := r.queues.FindServiceById(ctx, registrationModel.ServiceID)
+	if err != nil {
+		return nil, err
+	}
+
+	price, _ := strconv.ParseFloat(serviceModel.Price, 64)
+	srvce, err := service.Load(
+		serviceModel.ID.String(),
+		serviceModel.Description,
+		price,
+		loadChargeRules(
+			serviceModel.FinePercentage,
+			serviceModel.DailyInterestPercentage,
+			serviceModel.DiscountPercentage,
+			serviceModel.DiscountDaysBefore,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	monthlyFee, _ := strconv.ParseFloat(registrationModel.MonthlyFee, 64)
+	enrollmentFee, _ := strconv.ParseFloat(registrationModel.EnrollmentFee.String, 64)
+
+	dueDate := ""
+	if registrationModel.DueDate.Valid {
+		dueDate = registrationModel.DueDate.Time.Format("2006-01-02")
+	}
+
+	reg, err := registration.Load(
+		registrationModel.ID.String(),
+		registrationModel.Code,
+		*classRoom,
+		registrationModel.Shift.String,
+		*stdent,
+		*srvce,
+		monthlyFee,
+		int(registrationModel.InstallmentsQuantity),
+		enrollmentFee,
+		dueDate,
+		int(registrationModel.MonthDuration.Int32),
+		registrationModel.Status,
+		registrationModel.EnrollmentDate.Time.Format("2006-01-02"),
+		registrationModel.PaymentDay.String,
+		registrationModel.Paid,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	discounts, err := r.findDiscounts(registrationModel.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	reg.LoadDiscounts(discounts)
+
+	if registrationModel.FinancialResponsibleID.Valid {
+		reg.LoadFinancialResponsible(registration.FinancialResponsible{
+			Id:    registrationModel.FinancialResponsibleID.UUID,
+			Type:  registrationModel.FinancialResponsibleType.String,
+			Name:  registrationModel.FinancialResponsibleName.String,
+			Cpf:   registrationModel.FinancialResponsibleCpf.String,
+			Email: registrationModel.FinancialResponsibleEmail.String,
+		})
+	}
+
+	return reg, nil
+}
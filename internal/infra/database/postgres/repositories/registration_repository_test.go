@@ -5,9 +5,11 @@ import (
 	"github.com/google/uuid"
 	"github.com/henriquerocha2004/sistema-escolar/internal/infra/database/postgres"
 	testtools "github.com/henriquerocha2004/sistema-escolar/internal/infra/database/postgres/test-tools"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/delinquency"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/service"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration/registrationService"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/room"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schedule"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
@@ -16,6 +18,7 @@ import (
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/phone"
 	"github.com/stretchr/testify/suite"
 	"log"
+	"sync"
 	"testing"
 )
 
@@ -113,6 +116,117 @@ func (s *TestRegistrationSuit) TestShouldCreateRegistration() {
 	s.Assert().NoError(err)
 }
 
+func (s *TestRegistrationSuit) TestShouldReserveLastVacancyOnlyOnceWithConcurrentRequests() {
+	schoolYear := s.createSchoolYear()
+	schedule := s.createSchedule(schoolYear)
+	room := s.createRoom()
+	srvce := s.createService()
+
+	cr, _ := classroom.New(
+		1,
+		"morning",
+		"1 ANO",
+		"1AS",
+		schoolYear.String(),
+		room.String(),
+		schedule.String(),
+		"any location",
+		"in_person")
+
+	err := s.classRoomRepository.Create(*cr)
+	s.Assert().NoError(err)
+
+	actions := registrationService.NewRegistrationActions(
+		s.serviceRepository,
+		s.repository,
+		s.schoolYearRepository,
+		s.newRegistrationUow,
+		nil,
+		delinquency.Policy{},
+	)
+
+	cpfDocuments := []string{"84731086043", "82378114028"}
+	results := make(chan error, len(cpfDocuments))
+	var wg sync.WaitGroup
+
+	for _, cpfDocument := range cpfDocuments {
+		wg.Add(1)
+		go func(dto registration.RequestDto) {
+			defer wg.Done()
+			_, err := actions.Create(dto)
+			results <- err
+		}(s.registrationInput(cr.Id().String(), srvce.Id().String(), cpfDocument))
+	}
+
+	wg.Wait()
+	close(results)
+
+	succeeded := 0
+	for err := range results {
+		if err == nil {
+			succeeded++
+			continue
+		}
+		s.Assert().ErrorIs(err, classroom.ErrClassRoomFull)
+	}
+
+	s.Assert().Equal(1, succeeded)
+
+	classRoomDb, err := s.classRoomRepository.FindById(cr.Id().String())
+	s.Assert().NoError(err)
+	s.Assert().Equal(1, classRoomDb.OccupiedVacancies())
+}
+
+func (s *TestRegistrationSuit) newRegistrationUow() registration.RegisterUow {
+	return NewRegistrationUow(
+		s.connection,
+		*NewStudentRepository(s.connection),
+		*NewParentRepository(s.connection),
+		*NewRegistrationRepository(s.connection),
+		*NewClassRoomRepository(s.connection),
+		*NewWaitingListRepository(s.connection),
+		*NewInvoiceRepository(s.connection),
+	)
+}
+
+func (s *TestRegistrationSuit) registrationInput(classRoomId string, serviceId string, cpfDocument string) registration.RequestDto {
+	return registration.RequestDto{
+		ClassRoomId: classRoomId,
+		Shift:       "morning",
+		Student: student.RequestDto{
+			FirstName:          "Pedrinho",
+			LastName:           "Souza " + cpfDocument,
+			Birthday:           "2001-10-15",
+			RgDocument:         "123456789",
+			CpfDocument:        cpfDocument,
+			Email:              "teste@test.com",
+			HimSelfResponsible: true,
+			Addresses: []address.RequestDto{
+				{
+					Street:   "Rua dos Bobos",
+					City:     "SSA",
+					District: "SC",
+					State:    "SP",
+					ZipCode:  "41500030",
+				},
+			},
+			Phones: []phone.RequestDto{
+				{
+					Description: "Pessoal",
+					Phone:       "71589955554",
+				},
+			},
+		},
+		ServiceId:            serviceId,
+		MonthlyFee:           400.00,
+		InstallmentsQuantity: 12,
+		EnrollmentFee:        60.00,
+		EnrollmentDueDate:    "2021-01-10",
+		MonthDuration:        12,
+		PaymentDay:           "10",
+	}
+}
+
 func (s *TestRegistrationSuit) createSchoolYear() uuid.UUID {
 	schoolYear, _ := schoolyear.New("2021", "2021-01-01", "2021-12-30")
 
@@ -3,8 +3,13 @@ package repositories
 import (
 	"database/sql"
 	"errors"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/parent"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/waitinglist"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
@@ -14,14 +19,30 @@ type RegistrationUow struct {
 	db               *sql.DB
 	tx               *sql.Tx
 	studentRepo      StudentRepository
+	parentRepo       ParentRepository
 	registrationRepo RegistrationRepository
+	classRoomRepo    ClassRoomRepository
+	waitingListRepo  WaitingListRepository
+	invoiceRepo      InvoiceRepository
 }
 
-func NewRegistrationUow(db *sql.DB, studentRepo StudentRepository, registerRepo RegistrationRepository) *RegistrationUow {
+func NewRegistrationUow(
+	db *sql.DB,
+	studentRepo StudentRepository,
+	parentRepo ParentRepository,
+	registerRepo RegistrationRepository,
+	classRoomRepo ClassRoomRepository,
+	waitingListRepo WaitingListRepository,
+	invoiceRepo InvoiceRepository,
+) *RegistrationUow {
 	return &RegistrationUow{
 		db:               db,
 		studentRepo:      studentRepo,
+		parentRepo:       parentRepo,
 		registrationRepo: registerRepo,
+		classRoomRepo:    classRoomRepo,
+		waitingListRepo:  waitingListRepo,
+		invoiceRepo:      invoiceRepo,
 	}
 }
 
@@ -84,15 +105,36 @@ func (r *RegistrationUow) CreateRegister(register registration.Registration) err
 	return r.registrationRepo.Create(register)
 }
 
-func (r *RegistrationUow) StudentAlreadyExists(cpf string) (*uuid.UUID, error) {
-	student, err := r.studentRepo.FindByCpf(value_objects.CPF(cpf))
-	if err != nil {
-		return nil, err
+// StudentAlreadyExists Busca o aluno pelo documento de identificacao: CPF ou, para alunos sem CPF,
+// o documento alternativo
+func (r *RegistrationUow) StudentAlreadyExists(document value_objects.Document) (*uuid.UUID, error) {
+	return r.studentRepo.FindByDocument(document)
+}
+
+func (r *RegistrationUow) FindParent(id string) (*parent.Parent, error) {
+	if r.tx == nil {
+		return nil, errors.New("failed to find parent. Transaction not started")
 	}
 
-	id := student.Id()
+	r.parentRepo.SetTransaction(r.tx)
 
-	return &id, nil
+	return r.parentRepo.FindById(id)
+}
+
+// FindParentByCpf Busca o responsavel ja cadastrado com o CPF. Retorna nil quando nao encontrado
+func (r *RegistrationUow) FindParentByCpf(cpf string) (*parent.Parent, error) {
+	if r.tx == nil {
+		return nil, errors.New("failed to find parent. Transaction not started")
+	}
+
+	r.parentRepo.SetTransaction(r.tx)
+
+	prt, err := r.parentRepo.FindByCpf(value_objects.CPF(cpf))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	return prt, err
 }
 
 func (r *RegistrationUow) StudentAlreadyRegisterInClass(studentId uuid.UUID, classRoomId uuid.UUID) (bool, error) {
@@ -108,3 +150,148 @@ func (r *RegistrationUow) StudentAlreadyRegisterInClass(studentId uuid.UUID, cla
 
 	return false, nil
 }
+
+func (r *RegistrationUow) FindRegisterLock(id string) (*registration.Registration, error) {
+	if r.tx == nil {
+		return nil, errors.New("failed in find registration. Transaction not started")
+	}
+
+	r.registrationRepo.SetTransaction(r.tx)
+
+	return r.registrationRepo.FindByIdLock(id)
+}
+
+func (r *RegistrationUow) UpdateRegisterStatus(register registration.Registration) error {
+	if r.tx == nil {
+		return errors.New("failed in update registration status. Transaction not started")
+	}
+
+	r.registrationRepo.SetTransaction(r.tx)
+
+	return r.registrationRepo.UpdateStatus(register)
+}
+
+func (r *RegistrationUow) FindClassRoomLock(id string) (*classroom.ClassRoom, error) {
+	if r.tx == nil {
+		return nil, errors.New("failed in find class room. Transaction not started")
+	}
+
+	r.classRoomRepo.SetTransaction(r.tx)
+
+	return r.classRoomRepo.FindByIdLock(id)
+}
+
+func (r *RegistrationUow) OccupyVacancies(classRoom classroom.ClassRoom) error {
+	if r.tx == nil {
+		return errors.New("failed in occupy vacancies. Transaction not started")
+	}
+
+	r.classRoomRepo.SetTransaction(r.tx)
+
+	return r.classRoomRepo.OccupyVacancies(classRoom)
+}
+
+func (r *RegistrationUow) ReleaseVacancies(classRoom classroom.ClassRoom) error {
+	if r.tx == nil {
+		return errors.New("failed in release vacancies. Transaction not started")
+	}
+
+	r.classRoomRepo.SetTransaction(r.tx)
+
+	return r.classRoomRepo.ReleaseVacancies(classRoom)
+}
+
+func (r *RegistrationUow) CreateWaitingCandidate(candidate waitinglist.Candidate) error {
+	if r.tx == nil {
+		return errors.New("failed in create waiting list candidate. Transaction not started")
+	}
+
+	r.waitingListRepo.SetTransaction(r.tx)
+
+	return r.waitingListRepo.Create(candidate)
+}
+
+func (r *RegistrationUow) FindNextWaitingCandidateLock(classRoomId uuid.UUID) (*waitinglist.Candidate, error) {
+	if r.tx == nil {
+		return nil, errors.New("failed in find waiting list candidate. Transaction not started")
+	}
+
+	r.waitingListRepo.SetTransaction(r.tx)
+
+	return r.waitingListRepo.FindNextWaitingLock(classRoomId)
+}
+
+func (r *RegistrationUow) UpdateWaitingCandidate(candidate waitinglist.Candidate) error {
+	if r.tx == nil {
+		return errors.New("failed in update waiting list candidate. Transaction not started")
+	}
+
+	r.waitingListRepo.SetTransaction(r.tx)
+
+	return r.waitingListRepo.UpdateStatus(candidate)
+}
+
+func (r *RegistrationUow) CreateInvoices(invoices []invoice.Invoice) error {
+	if r.tx == nil {
+		return errors.New("failed in create invoices. Transaction not started")
+	}
+
+	r.invoiceRepo.SetTransaction(r.tx)
+
+	return r.invoiceRepo.Create(invoices)
+}
+
+func (r *RegistrationUow) FindOpenInvoicesLock(registrationId uuid.UUID) ([]invoice.Invoice, error) {
+	if r.tx == nil {
+		return nil, errors.New("failed in find open invoices. Transaction not started")
+	}
+
+	r.invoiceRepo.SetTransaction(r.tx)
+
+	return r.invoiceRepo.FindOpenByRegistrationLock(registrationId)
+}
+
+func (r *RegistrationUow) UpdateInvoiceStatus(inv invoice.Invoice) error {
+	if r.tx == nil {
+		return errors.New("failed in update invoice status. Transaction not started")
+	}
+
+	r.invoiceRepo.SetTransaction(r.tx)
+
+	return r.invoiceRepo.UpdateStatus(inv)
+}
+
+func (r *RegistrationUow) UpdateInvoiceRegistration(inv invoice.Invoice) error {
+	if r.tx == nil {
+		return errors.New("failed in update invoice registration. Transaction not started")
+	}
+
+	r.invoiceRepo.SetTransaction(r.tx)
+
+	return r.invoiceRepo.UpdateRegistration(inv)
+}
+
+func (r *RegistrationUow) HasActiveSiblingRegistration(studentId uuid.UUID, parentCpfs []string) (bool, error) {
+	if r.tx == nil {
+		return false, errors.New("failed in find sibling registrations. Transaction not started")
+	}
+
+	r.registrationRepo.SetTransaction(r.tx)
+
+	total, err := r.registrationRepo.CountActiveSiblings(studentId, parentCpfs)
+	if err != nil {
+		return false, err
+	}
+
+	return total > 0, nil
+}
+
+func (r *RegistrationUow) StudentOverdueDebt(studentId uuid.UUID, dueBefore time.Time) (float64, error) {
+	if r.tx == nil {
+		return 0, errors.New("failed in find student overdue debt. Transaction not started")
+	}
+
+	r.invoiceRepo.SetTransaction(r.tx)
+
+	return r.invoiceRepo.OverdueBalance(studentId, dueBefore)
+}
@@ -47,7 +47,7 @@ func TestUowRegistration(t *testing.T) {
 	testtools.StartTestEnv()
 	db := postgres.Connect()
 	studentRepository := *NewStudentRepository(db)
-	registrationUow := NewRegistrationUow(db, studentRepository, *NewRegistrationRepository(db))
+	registrationUow := NewRegistrationUow(db, studentRepository, *NewParentRepository(db), *NewRegistrationRepository(db), *NewClassRoomRepository(db), *NewWaitingListRepository(db), *NewInvoiceRepository(db))
 
 	_ = registrationUow.BeginTransaction()
 	err = registrationUow.CreateStudent(*std)
@@ -56,6 +56,6 @@ func TestUowRegistration(t *testing.T) {
 	_ = registrationUow.Rollback()
 
 	studentDb, err := studentRepository.FindByCpf(std.Cpf())
-	assert.Error(t, err)
+	assert.NoError(t, err)
 	assert.Nil(t, studentDb)
 }
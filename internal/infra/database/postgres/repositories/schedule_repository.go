@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 
 	"github.com/henriquerocha2004/sistema-escolar/internal/infra/database/postgres/models"
 )
@@ -23,6 +24,7 @@ type scheduleSearchModel struct {
 	Description  string    `json:"description"`
 	StartAt      time.Time `json:"start_at"`
 	EndAt        time.Time `json:"end_at"`
+	Weekdays     []int16   `json:"weekdays"`
 	SchoolYearID uuid.UUID `json:"school_year_id"`
 	Total        int       `json:"total"`
 }
@@ -45,6 +47,7 @@ func (s *ScheduleRoomRepository) Create(schedule schedule.ScheduleClass) error {
 		SchoolYearID: schedule.SchoolYearId(),
 		StartAt:      stDate,
 		EndAt:        edDate,
+		Weekdays:     s.weekdaysToModel(schedule.Weekdays()),
 		CreatedAt: sql.NullTime{
 			Time:  time.Now(),
 			Valid: true,
@@ -88,6 +91,7 @@ func (s *ScheduleRoomRepository) Update(schedule schedule.ScheduleClass) error {
 		Description:  schedule.Description(),
 		StartAt:      stDate,
 		EndAt:        edDate,
+		Weekdays:     s.weekdaysToModel(schedule.Weekdays()),
 		SchoolYearID: schedule.SchoolYearId(),
 		UpdatedAt: sql.NullTime{
 			Time:  time.Now(),
@@ -113,18 +117,86 @@ func (s *ScheduleRoomRepository) FindById(id string) (*schedule.ScheduleClass, e
 		scheduleModel.Description,
 		scheduleModel.StartAt.Format("15:04:05"),
 		scheduleModel.EndAt.Format("15:04:05"),
+		s.weekdaysFromModel(scheduleModel.Weekdays),
 		scheduleModel.ID.String(),
 	)
 
 	return schedule, nil
 }
 
+func (s *ScheduleRoomRepository) FindBySchoolYear(schoolYearId uuid.UUID) ([]schedule.ScheduleClass, error) {
+	schedulesModel, err := s.queues.FindBySchoolYearId(context.Background(), schoolYearId)
+	if err != nil {
+		return nil, err
+	}
+
+	var schedules []schedule.ScheduleClass
+
+	for _, scheduleModel := range schedulesModel {
+		sch, err := schedule.Load(
+			scheduleModel.ScheduleID.String(),
+			scheduleModel.Description,
+			scheduleModel.StartAt.Format("15:04:05"),
+			scheduleModel.EndAt.Format("15:04:05"),
+			s.weekdaysFromModel(scheduleModel.Weekdays),
+			schoolYearId.String(),
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		schedules = append(schedules, *sch)
+	}
+
+	return schedules, nil
+}
+
+func (s *ScheduleRoomRepository) FindByRoom(roomId uuid.UUID, schoolYearId uuid.UUID) ([]schedule.ScheduleClass, error) {
+	schedulesModel, err := s.queues.FindSchedulesByRoom(context.Background(), models.FindSchedulesByRoomParams{
+		RoomID:       roomId,
+		SchoolYearID: schoolYearId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var schedules []schedule.ScheduleClass
+
+	for _, scheduleModel := range schedulesModel {
+		sch, err := schedule.Load(
+			scheduleModel.ScheduleID.String(),
+			scheduleModel.Description,
+			scheduleModel.StartAt.Format("15:04:05"),
+			scheduleModel.EndAt.Format("15:04:05"),
+			s.weekdaysFromModel(scheduleModel.Weekdays),
+			schoolYearId.String(),
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		schedules = append(schedules, *sch)
+	}
+
+	return schedules, nil
+}
+
+// FindRoomIds Salas as quais o horario esta vinculado no ano letivo
+func (s *ScheduleRoomRepository) FindRoomIds(scheduleId uuid.UUID, schoolYearId uuid.UUID) ([]uuid.UUID, error) {
+	return s.queues.FindScheduleRooms(context.Background(), models.FindScheduleRoomsParams{
+		ScheduleID:   scheduleId,
+		SchoolYearID: schoolYearId,
+	})
+}
+
 func (s *ScheduleRoomRepository) FindAll(pagination paginator.Pagination) (*paginator.PaginationResult, error) {
 	ctx, cancelQuery := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancelQuery()
 
 	query := `SELECT 
-    			class_schedule.id, description, class_schedule.start_at, class_schedule.end_at, school_year.id, COUNT(*) OVER() as total
+    			class_schedule.id, description, class_schedule.start_at, class_schedule.end_at, class_schedule.weekdays, school_year.id, COUNT(*) OVER() as total
 			FROM class_schedule 
 			    JOIN school_year ON school_year.id = class_schedule.school_year_id 
 			WHERE (class_schedule.description like $1) 
@@ -158,6 +230,7 @@ func (s *ScheduleRoomRepository) FindAll(pagination paginator.Pagination) (*pagi
 			&scheduleModel.Description,
 			&scheduleModel.StartAt,
 			&scheduleModel.EndAt,
+			pq.Array(&scheduleModel.Weekdays),
 			&scheduleModel.SchoolYearID,
 			&scheduleModel.Total)
 		if err != nil {
@@ -175,6 +248,7 @@ func (s *ScheduleRoomRepository) FindAll(pagination paginator.Pagination) (*pagi
 			scheduleModel.Description,
 			scheduleModel.StartAt.Format("15:04:05"),
 			scheduleModel.EndAt.Format("15:04:05"),
+			s.weekdaysFromModel(scheduleModel.Weekdays),
 			scheduleModel.SchoolYearID.String(),
 		)
 
@@ -200,7 +274,7 @@ func (r *ScheduleRoomRepository) SyncSchedule(scheduleDto schedule.RoomScheduleD
 		return err
 	}
 
-	r.queues.WithTx(tx)
+	queues := r.queues.WithTx(tx)
 	roomId, _ := uuid.Parse(scheduleDto.RoomId)
 	schoolYearId, _ := uuid.Parse(scheduleDto.SchoolYear)
 
@@ -209,7 +283,7 @@ func (r *ScheduleRoomRepository) SyncSchedule(scheduleDto schedule.RoomScheduleD
 		SchoolYearID: schoolYearId,
 	}
 
-	err = r.queues.UnbindSchedule(context.Background(), unbindParams)
+	err = queues.UnbindSchedule(context.Background(), unbindParams)
 	if err != nil {
 		_ = tx.Rollback()
 		return err
@@ -225,7 +299,7 @@ func (r *ScheduleRoomRepository) SyncSchedule(scheduleDto schedule.RoomScheduleD
 			SchoolYearID: schoolYearId,
 		}
 
-		err = r.queues.BindSchedule(context.Background(), bindParams)
+		err = queues.BindSchedule(context.Background(), bindParams)
 		if err != nil {
 			log.Println(err)
 			_ = tx.Rollback()
@@ -241,3 +315,21 @@ func (r *ScheduleRoomRepository) SyncSchedule(scheduleDto schedule.RoomScheduleD
 func (s *ScheduleRoomRepository) parseToTime(t string) (time.Time, error) {
 	return time.Parse("15:04:05", t)
 }
+
+func (s *ScheduleRoomRepository) weekdaysToModel(weekdays []int) []int16 {
+	days := make([]int16, 0, len(weekdays))
+	for _, day := range weekdays {
+		days = append(days, int16(day))
+	}
+
+	return days
+}
+
+func (s *ScheduleRoomRepository) weekdaysFromModel(weekdays []int16) []int {
+	days := make([]int, 0, len(weekdays))
+	for _, day := range weekdays {
+		days = append(days, int(day))
+	}
+
+	return days
+}
@@ -119,7 +119,8 @@ func (s *SchoolYearRepository) FindByYear(year string) (*schoolyear.SchoolYear,
 		return nil, err
 	}
 
-	schoolYear, err := schoolyear.New(
+	schoolYear, err := schoolyear.Load(
+		schoolYearModel.ID.String(),
 		schoolYearModel.Year,
 		schoolYearModel.StartAt.Format("2006-01-02"),
 		schoolYearModel.EndAt.Format("2006-01-02"))
@@ -19,10 +19,14 @@ type ServiceRepository struct {
 }
 
 type serviceSearchModel struct {
-	ID          uuid.UUID `json:"id"`
-	Description string    `json:"description"`
-	Price       string    `json:"price"`
-	Total       int       `json:"total"`
+	ID                      uuid.UUID `json:"id"`
+	Description             string    `json:"description"`
+	Price                   string    `json:"price"`
+	FinePercentage          string    `json:"fine_percentage"`
+	DailyInterestPercentage string    `json:"daily_interest_percentage"`
+	DiscountPercentage      string    `json:"discount_percentage"`
+	DiscountDaysBefore      int32     `json:"discount_days_before"`
+	Total                   int       `json:"total"`
 }
 
 func NewServiceRepository(db *sql.DB) *ServiceRepository {
@@ -35,9 +39,13 @@ func NewServiceRepository(db *sql.DB) *ServiceRepository {
 func (s *ServiceRepository) Create(service service.Service) error {
 
 	serviceModel := models.CreateServiceParams{
-		ID:          service.Id(),
-		Description: service.Description(),
-		Price:       fmt.Sprintf("%f", service.Price()),
+		ID:                      service.Id(),
+		Description:             service.Description(),
+		Price:                   fmt.Sprintf("%f", service.Price()),
+		FinePercentage:          fmt.Sprintf("%f", service.ChargeRules().FinePercentage),
+		DailyInterestPercentage: fmt.Sprintf("%f", service.ChargeRules().DailyInterestPercentage),
+		DiscountPercentage:      fmt.Sprintf("%f", service.ChargeRules().DiscountPercentage),
+		DiscountDaysBefore:      int32(service.ChargeRules().DiscountDaysBefore),
 		CreatedAt: sql.NullTime{
 			Time:  time.Now(),
 			Valid: true,
@@ -69,9 +77,13 @@ func (s *ServiceRepository) Delete(id string) error {
 func (s *ServiceRepository) Update(service service.Service) error {
 
 	serviceModel := models.UpdateServiceParams{
-		ID:          service.Id(),
-		Description: service.Description(),
-		Price:       fmt.Sprintf("%f", service.Price()),
+		ID:                      service.Id(),
+		Description:             service.Description(),
+		Price:                   fmt.Sprintf("%f", service.Price()),
+		FinePercentage:          fmt.Sprintf("%f", service.ChargeRules().FinePercentage),
+		DailyInterestPercentage: fmt.Sprintf("%f", service.ChargeRules().DailyInterestPercentage),
+		DiscountPercentage:      fmt.Sprintf("%f", service.ChargeRules().DiscountPercentage),
+		DiscountDaysBefore:      int32(service.ChargeRules().DiscountDaysBefore),
 		UpdatedAt: sql.NullTime{
 			Time:  time.Now(),
 			Valid: true,
@@ -90,20 +102,26 @@ func (s *ServiceRepository) FindById(id string) (*service.Service, error) {
 	}
 
 	price, _ := strconv.ParseFloat(serviceModel.Price, 64)
-	srvice, err := service.New(serviceModel.Description, price)
-	err = srvice.ChangeId(serviceModel.ID.String())
-	if err != nil {
-		return nil, err
-	}
 
-	return srvice, nil
+	return service.Load(
+		serviceModel.ID.String(),
+		serviceModel.Description,
+		price,
+		loadChargeRules(
+			serviceModel.FinePercentage,
+			serviceModel.DailyInterestPercentage,
+			serviceModel.DiscountPercentage,
+			serviceModel.DiscountDaysBefore,
+		),
+	)
 }
 
 func (s *ServiceRepository) FindAll(pagination paginator.Pagination) (*paginator.PaginationResult, error) {
 	ctx, cancelQuery := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancelQuery()
 
-	query := `SELECT id, description, price, COUNT(*) OVER() as total 
+	query := `SELECT id, description, price, fine_percentage, daily_interest_percentage,
+       				discount_percentage, discount_days_before, COUNT(*) OVER() as total 
 					FROM services 
 				WHERE description like $1 AND deleted_at IS NULL`
 	filters := pagination.FiltersInSql()
@@ -130,7 +148,16 @@ func (s *ServiceRepository) FindAll(pagination paginator.Pagination) (*paginator
 
 	for rows.Next() {
 		var serviceModel serviceSearchModel
-		err = rows.Scan(&serviceModel.ID, &serviceModel.Description, &serviceModel.Price, &serviceModel.Total)
+		err = rows.Scan(
+			&serviceModel.ID,
+			&serviceModel.Description,
+			&serviceModel.Price,
+			&serviceModel.FinePercentage,
+			&serviceModel.DailyInterestPercentage,
+			&serviceModel.DiscountPercentage,
+			&serviceModel.DiscountDaysBefore,
+			&serviceModel.Total,
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -150,6 +177,12 @@ func (s *ServiceRepository) FindAll(pagination paginator.Pagination) (*paginator
 			serviceModel.ID.String(),
 			serviceModel.Description,
 			price,
+			loadChargeRules(
+				serviceModel.FinePercentage,
+				serviceModel.DailyInterestPercentage,
+				serviceModel.DiscountPercentage,
+				serviceModel.DiscountDaysBefore,
+			),
 		)
 
 		if err != nil {
@@ -166,3 +199,17 @@ func (s *ServiceRepository) FindAll(pagination paginator.Pagination) (*paginator
 
 	return &paginationResult, nil
 }
+
+// loadChargeRules Converte as colunas numericas das regras de cobranca, compartilhado entre servicos e cobrancas
+func loadChargeRules(finePercentage string, dailyInterestPercentage string, discountPercentage string, discountDaysBefore int32) service.ChargeRules {
+	fine, _ := strconv.ParseFloat(finePercentage, 64)
+	interest, _ := strconv.ParseFloat(dailyInterestPercentage, 64)
+	discount, _ := strconv.ParseFloat(discountPercentage, 64)
+
+	return service.ChargeRules{
+		FinePercentage:          fine,
+		DailyInterestPercentage: interest,
+		DiscountPercentage:      discount,
+		DiscountDaysBefore:      int(discountDaysBefore),
+	}
+}
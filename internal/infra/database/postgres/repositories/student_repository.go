@@ -3,8 +3,12 @@ package repositories
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/parent"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -45,6 +49,8 @@ func (s *StudentRepository) Create(student student.Student) error {
 			Valid:  true,
 		},
 		HimSelfResponsible: student.HimSelfResponsible(),
+		DocumentType:       student.Document().Type,
+		DocumentNumber:     student.Document().Number,
 		CreatedAt: sql.NullTime{
 			Time:  time.Now(),
 			Valid: true,
@@ -60,12 +66,12 @@ func (s *StudentRepository) Create(student student.Student) error {
 		return err
 	}
 
-	err = s.syncAddress(student.Id(), student.Addresses())
+	err = syncAddress(s.queues, student.Id(), student.Addresses())
 	if err != nil {
 		return err
 	}
 
-	err = s.syncPhones(student.Id(), student.Phones())
+	err = syncPhones(s.queues, student.Id(), student.Phones())
 	if err != nil {
 		return err
 	}
@@ -78,7 +84,7 @@ func (s *StudentRepository) Create(student student.Student) error {
 	return nil
 }
 
-func (s *StudentRepository) syncAddress(ownerId uuid.UUID, addresses []value_objects.Address) error {
+func syncAddress(queues *models.Queries, ownerId uuid.UUID, addresses []value_objects.Address) error {
 
 	deleteAddressParams := models.DeleteAddressByOwnerParams{
 		OwnerID: ownerId,
@@ -88,7 +94,7 @@ func (s *StudentRepository) syncAddress(ownerId uuid.UUID, addresses []value_obj
 		},
 	}
 
-	err := s.queues.DeleteAddressByOwner(context.Background(), deleteAddressParams)
+	err := queues.DeleteAddressByOwner(context.Background(), deleteAddressParams)
 	if err != nil {
 		return err
 	}
@@ -112,7 +118,7 @@ func (s *StudentRepository) syncAddress(ownerId uuid.UUID, addresses []value_obj
 			},
 		}
 
-		err = s.queues.CreateAddress(context.Background(), addressModel)
+		err = queues.CreateAddress(context.Background(), addressModel)
 		if err != nil {
 			return err
 		}
@@ -121,7 +127,7 @@ func (s *StudentRepository) syncAddress(ownerId uuid.UUID, addresses []value_obj
 	return nil
 }
 
-func (s *StudentRepository) syncPhones(ownerId uuid.UUID, phones []value_objects.Phone) error {
+func syncPhones(queues *models.Queries, ownerId uuid.UUID, phones []value_objects.Phone) error {
 
 	deletePhonesParams := models.DeletePhonesByOwnerParams{
 		OwnerID: ownerId,
@@ -131,7 +137,7 @@ func (s *StudentRepository) syncPhones(ownerId uuid.UUID, phones []value_objects
 		},
 	}
 
-	err := s.queues.DeletePhonesByOwner(context.Background(), deletePhonesParams)
+	err := queues.DeletePhonesByOwner(context.Background(), deletePhonesParams)
 	if err != nil {
 		return err
 	}
@@ -144,7 +150,7 @@ func (s *StudentRepository) syncPhones(ownerId uuid.UUID, phones []value_objects
 			OwnerID:     phone.OwnerId,
 		}
 
-		err := s.queues.CreatePhone(context.Background(), phoneModel)
+		err := queues.CreatePhone(context.Background(), phoneModel)
 		if err != nil {
 			return err
 		}
@@ -153,9 +159,11 @@ func (s *StudentRepository) syncPhones(ownerId uuid.UUID, phones []value_objects
 	return nil
 }
 
+// syncParents Refaz os vinculos do aluno com seus responsaveis. Responsaveis ja cadastrados sao
+// apenas vinculados, mantendo um unico cadastro por responsavel entre irmaos
 func (s *StudentRepository) syncParents(studentId uuid.UUID, parents []parent.Parent) error {
 
-	deleteParentsParam := models.DeleteParentsByStudentParams{
+	unlinkParams := models.UnlinkStudentParentsParams{
 		StudentID: studentId,
 		DeletedAt: sql.NullTime{
 			Time:  time.Now(),
@@ -163,24 +171,28 @@ func (s *StudentRepository) syncParents(studentId uuid.UUID, parents []parent.Pa
 		},
 	}
 
-	err := s.queues.DeleteParentsByStudent(context.Background(), deleteParentsParam)
+	err := s.queues.UnlinkStudentParents(context.Background(), unlinkParams)
 	if err != nil {
 		return err
 	}
 
 	for _, parent := range parents {
-		parentModel := models.CreateParentParams{
-			ID:        parent.Id(),
-			FirstName: parent.FirstName(),
-			LastName:  parent.LastName(),
-			Birthday:  *parent.BirthDay(),
-			RgDocument: sql.NullString{
-				String: parent.Rg(),
-				Valid:  true,
-			},
-			CpfDocument: string(parent.Cpf()),
-			StudentID:   parent.StudentId(),
-			Email:       parent.Email(),
+		_, err := s.queues.FindParentById(context.Background(), parent.Id())
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+
+		if errors.Is(err, sql.ErrNoRows) {
+			err = s.createParent(parent)
+			if err != nil {
+				return err
+			}
+		}
+
+		linkParams := models.LinkStudentParentParams{
+			StudentID:    studentId,
+			ParentID:     parent.Id(),
+			Relationship: parent.Relationship(),
 			CreatedAt: sql.NullTime{
 				Time:  time.Now(),
 				Valid: true,
@@ -191,33 +203,595 @@ func (s *StudentRepository) syncParents(studentId uuid.UUID, parents []parent.Pa
 			},
 		}
 
-		err := s.queues.CreateParent(context.Background(), parentModel)
+		err = s.queues.LinkStudentParent(context.Background(), linkParams)
 		if err != nil {
 			return err
 		}
+	}
+
+	return nil
+}
+
+func (s *StudentRepository) createParent(parent parent.Parent) error {
+	parentModel := models.CreateParentParams{
+		ID:        parent.Id(),
+		FirstName: parent.FirstName(),
+		LastName:  parent.LastName(),
+		Birthday:  *parent.BirthDay(),
+		RgDocument: sql.NullString{
+			String: parent.Rg(),
+			Valid:  true,
+		},
+		CpfDocument: string(parent.Cpf()),
+		Email:       parent.Email(),
+		CreatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	}
+
+	err := s.queues.CreateParent(context.Background(), parentModel)
+	if err != nil {
+		return err
+	}
+
+	err = syncAddress(s.queues, parent.Id(), parent.Addresses())
+	if err != nil {
+		return err
+	}
+
+	return syncPhones(s.queues, parent.Id(), parent.Phones())
+}
+
+// FindByCpf Busca o aluno ativo com o CPF. Retorna nil quando nao encontrado
+func (s *StudentRepository) FindByCpf(cpf value_objects.CPF) (*student.Student, error) {
+	if cpf == "" {
+		return nil, nil
+	}
+
+	studentModel, err := s.queues.FindByCPFDocument(context.Background(), string(cpf))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	sdt, err := student.Load(
+		studentModel.ID.String(),
+		studentModel.FirstName,
+		studentModel.LastName,
+		studentModel.Birthday.Format("2006-01-02"),
+		studentModel.RgDocument.String,
+		studentModel.CpfDocument,
+		studentModel.Email.String,
+		studentModel.HimSelfResponsible,
+	)
+
+	return sdt, err
+}
+
+// FindByDocument Id do aluno ativo identificado pelo documento. Retorna nil quando nao encontrado
+func (s *StudentRepository) FindByDocument(document value_objects.Document) (*uuid.UUID, error) {
+	id, err := s.queues.FindStudentByDocument(context.Background(), models.FindStudentByDocumentParams{
+		DocumentType:   document.Type,
+		DocumentNumber: document.Number,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &id, nil
+}
+
+// DocumentAlreadyInUse Informa se o documento pertence a outro aluno ativo
+func (s *StudentRepository) DocumentAlreadyInUse(document value_objects.Document, exceptId uuid.UUID) (bool, error) {
+	id, err := s.FindByDocument(document)
+	if err != nil {
+		return false, err
+	}
+
+	return id != nil && *id != exceptId, nil
+}
+
+func (s *StudentRepository) Update(student student.Student) error {
+	studentModel := models.UpdateStudentParams{
+		ID:        student.Id(),
+		FirstName: student.FirstName(),
+		LastName:  student.LastName(),
+		Birthday:  *student.BirthDay(),
+		RgDocument: sql.NullString{
+			String: student.Rg(),
+			Valid:  true,
+		},
+		CpfDocument: string(student.Cpf()),
+		Email: sql.NullString{
+			String: student.Email(),
+			Valid:  true,
+		},
+		HimSelfResponsible: student.HimSelfResponsible(),
+		DocumentType:       student.Document().Type,
+		DocumentNumber:     student.Document().Number,
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	}
+
+	return s.queues.UpdateStudent(context.Background(), studentModel)
+}
+
+func (s *StudentRepository) UpdateAcademicResponsible(student student.Student) error {
+	return s.queues.UpdateStudentAcademicResponsible(context.Background(), models.UpdateStudentAcademicResponsibleParams{
+		ID: student.Id(),
+		AcademicResponsibleID: uuid.NullUUID{
+			UUID:  student.AcademicResponsible(),
+			Valid: student.AcademicResponsible() != uuid.Nil,
+		},
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	})
+}
+
+// Delete Remove o aluno com seus enderecos e telefones e desfaz os vinculos com os responsaveis,
+// que continuam cadastrados para os irmaos
+func (s *StudentRepository) Delete(id string) error {
+	studentId, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	queues := s.queues.WithTx(tx)
+	deletedAt := sql.NullTime{
+		Time:  time.Now(),
+		Valid: true,
+	}
+
+	err = queues.DeleteAddressByOwner(context.Background(), models.DeleteAddressByOwnerParams{
+		DeletedAt: deletedAt,
+		OwnerID:   studentId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = queues.DeletePhonesByOwner(context.Background(), models.DeletePhonesByOwnerParams{
+		DeletedAt: deletedAt,
+		OwnerID:   studentId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = queues.UnlinkStudentParents(context.Background(), models.UnlinkStudentParentsParams{
+		DeletedAt: deletedAt,
+		StudentID: studentId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = queues.DeleteStudent(context.Background(), models.DeleteStudentParams{
+		DeletedAt: deletedAt,
+		ID:        studentId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Merge Transfere enderecos, telefones, responsaveis, matriculas, registros financeiros e
+// frequencia do cadastro duplicado para o aluno mantido e remove o duplicado
+func (s *StudentRepository) Merge(survivor student.Student, duplicateId uuid.UUID) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	repository := &StudentRepository{db: s.db, queues: s.queues.WithTx(tx)}
+	queues := repository.queues
+	now := sql.NullTime{
+		Time:  time.Now(),
+		Valid: true,
+	}
+
+	err = repository.Update(survivor)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = repository.UpdateAcademicResponsible(survivor)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = queues.MoveAddressesToOwner(context.Background(), models.MoveAddressesToOwnerParams{
+		OwnerID:   survivor.Id(),
+		OwnerID_2: duplicateId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
 
-		err = s.syncAddress(parent.Id(), parent.Addresses())
+	err = queues.MovePhonesToOwner(context.Background(), models.MovePhonesToOwnerParams{
+		OwnerID:   survivor.Id(),
+		OwnerID_2: duplicateId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	for _, parent := range survivor.Parents() {
+		err = queues.LinkStudentParent(context.Background(), models.LinkStudentParentParams{
+			StudentID:    survivor.Id(),
+			ParentID:     parent.Id(),
+			Relationship: parent.Relationship(),
+			CreatedAt:    now,
+			UpdatedAt:    now,
+		})
 		if err != nil {
+			_ = tx.Rollback()
 			return err
 		}
+	}
+
+	err = queues.UnlinkStudentParents(context.Background(), models.UnlinkStudentParentsParams{
+		DeletedAt: now,
+		StudentID: duplicateId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = queues.MoveRegistrationsToStudent(context.Background(), models.MoveRegistrationsToStudentParams{
+		StudentID:   survivor.Id(),
+		StudentID_2: duplicateId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = queues.MoveInvoicesToStudent(context.Background(), models.MoveInvoicesToStudentParams{
+		StudentID:   survivor.Id(),
+		StudentID_2: duplicateId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = queues.MovePaymentsToStudent(context.Background(), models.MovePaymentsToStudentParams{
+		StudentID:   survivor.Id(),
+		StudentID_2: duplicateId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = queues.MoveStudentCreditsToStudent(context.Background(), models.MoveStudentCreditsToStudentParams{
+		StudentID:   survivor.Id(),
+		StudentID_2: duplicateId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = queues.MoveWaitingListToStudent(context.Background(), models.MoveWaitingListToStudentParams{
+		StudentID:   survivor.Id(),
+		StudentID_2: duplicateId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = queues.MovePickupAuthorizationsToStudent(context.Background(), models.MovePickupAuthorizationsToStudentParams{
+		StudentID:   survivor.Id(),
+		StudentID_2: duplicateId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = queues.MoveStudentDocumentsToStudent(context.Background(), models.MoveStudentDocumentsToStudentParams{
+		StudentID:   survivor.Id(),
+		StudentID_2: duplicateId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
 
-		err = s.syncPhones(parent.Id(), parent.Phones())
+	err = queues.MoveReenrollmentProposalsToStudent(context.Background(), models.MoveReenrollmentProposalsToStudentParams{
+		StudentID:   survivor.Id(),
+		StudentID_2: duplicateId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	// A chamada ja lancada para o aluno mantido prevalece sobre a do cadastro duplicado na mesma aula
+	err = queues.DeleteConflictingAttendances(context.Background(), models.DeleteConflictingAttendancesParams{
+		StudentID:   survivor.Id(),
+		StudentID_2: duplicateId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = queues.MoveAttendancesToStudent(context.Background(), models.MoveAttendancesToStudentParams{
+		StudentID:   survivor.Id(),
+		StudentID_2: duplicateId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = queues.DeleteStudent(context.Background(), models.DeleteStudentParams{
+		DeletedAt: now,
+		ID:        duplicateId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// FindDuplicateCandidates Dados de comparacao de todos os alunos ativos com os CPFs dos seus
+// responsaveis
+func (s *StudentRepository) FindDuplicateCandidates() ([]student.DuplicateCandidate, error) {
+	rows, err := s.queues.FindDuplicateCandidates(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []student.DuplicateCandidate
+
+	for _, row := range rows {
+		candidate := student.DuplicateCandidate{
+			Id:        row.ID,
+			FirstName: row.FirstName,
+			LastName:  row.LastName,
+			BirthDay:  row.Birthday,
+			Cpf:       row.CpfDocument,
+			Email:     row.Email.String,
+		}
+
+		if row.ParentCpfs != "" {
+			candidate.ParentCpfs = strings.Split(row.ParentCpfs, ",")
+		}
+
+		candidates = append(candidates, candidate)
+	}
+
+	return candidates, nil
+}
+
+// FindById Busca o aluno com enderecos, telefones e responsaveis. Retorna nil se ele nao existe
+func (s *StudentRepository) FindById(id string) (*student.Student, error) {
+	studentId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	studentModel, err := s.queues.FindStudentById(context.Background(), studentId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	sdt, err := loadStudent(studentModel)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses, err := findAddresses(s.queues, sdt.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	phones, err := findPhones(s.queues, sdt.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	sdt.ChangeAddresses(addresses)
+	sdt.ChangePhones(phones)
+
+	parentsModel, err := s.queues.FindParentsByStudent(context.Background(), sdt.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	var parents []parent.Parent
+
+	for _, parentModel := range parentsModel {
+		p, err := loadParent(s.queues, models.FindParentByIdRow{
+			ID:          parentModel.ID,
+			FirstName:   parentModel.FirstName,
+			LastName:    parentModel.LastName,
+			Birthday:    parentModel.Birthday,
+			RgDocument:  parentModel.RgDocument,
+			CpfDocument: parentModel.CpfDocument,
+			Email:       parentModel.Email,
+		})
 		if err != nil {
-			return err
+			return nil, err
 		}
+
+		err = p.ChangeRelationship(parentModel.Relationship)
+		if err != nil {
+			return nil, err
+		}
+
+		parents = append(parents, *p)
 	}
 
-	return nil
+	sdt.ChangeParents(parents)
+
+	// Um responsavel desvinculado do aluno deixa de ser o contato academico
+	if studentModel.AcademicResponsibleID.Valid {
+		_ = sdt.ChangeAcademicResponsible(studentModel.AcademicResponsibleID.UUID.String())
+	}
+
+	return sdt, nil
 }
 
-func (s *StudentRepository) FindByCpf(cpf value_objects.CPF) (*student.Student, error) {
+func (s *StudentRepository) FindRegistrations(studentId string) ([]student.RegistrationSummary, error) {
+	id, err := uuid.Parse(studentId)
+	if err != nil {
+		return nil, err
+	}
 
-	studentModel, err := s.queues.FindByCPFDocument(context.Background(), string(cpf))
+	registrationsModel, err := s.queues.FindRegistrationsByStudent(context.Background(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	var registrations []student.RegistrationSummary
+
+	for _, registrationModel := range registrationsModel {
+		registrations = append(registrations, student.RegistrationSummary{
+			Id:             registrationModel.ID,
+			Code:           registrationModel.Code,
+			Status:         registrationModel.Status,
+			Shift:          registrationModel.Shift.String,
+			EnrollmentDate: registrationModel.EnrollmentDate.Time,
+			ClassRoomId:    registrationModel.ClassRoomID.UUID,
+			ClassRoom:      registrationModel.Identification.String,
+			SchoolYearId:   registrationModel.SchoolYearID.UUID,
+			SchoolYear:     registrationModel.Year.String,
+		})
+	}
+
+	return registrations, nil
+}
+
+// FindAll Busca paginada de alunos ativos. Os filtros class_room_id e school_year_id consideram as
+// matriculas do aluno; os demais filtros sao aplicados nas colunas do aluno
+func (s *StudentRepository) FindAll(pagination paginator.Pagination) (*paginator.PaginationResult, error) {
+	ctx, cancelQuery := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancelQuery()
+
+	query := `
+		SELECT id, first_name, last_name, birthday, rg_document, cpf_document, email,
+		       him_self_responsible, document_type, document_number, COUNT(*) OVER() as total
+		FROM students
+		WHERE deleted_at IS NULL
+		    AND (first_name || ' ' || last_name ILIKE $1 OR cpf_document LIKE $1 OR document_number ILIKE $1 OR email ILIKE $1)
+	`
+	args := []interface{}{"%" + pagination.Search + "%"}
+
+	var columns []paginator.ColumnSearch
+	for _, column := range pagination.ColumnSearch {
+		if column.Column != "class_room_id" && column.Column != "school_year_id" {
+			columns = append(columns, column)
+			continue
+		}
 
+		args = append(args, column.Value)
+		query += fmt.Sprintf(` AND EXISTS (
+			SELECT 1 FROM registrations r
+			WHERE r.student_id = students.id AND r.deleted_at IS NULL AND r.%s::text = $%d
+		)`, column.Column, len(args))
+	}
+
+	pagination.ColumnSearch = columns
+	query += pagination.FiltersInSql()
+
+	stmt, err := s.db.PrepareContext(ctx, query)
 	if err != nil {
 		return nil, err
 	}
 
+	defer stmt.Close()
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var students []student.Student
+	total := 0
+
+	for rows.Next() {
+		var studentModel models.FindStudentByIdRow
+		err = rows.Scan(
+			&studentModel.ID,
+			&studentModel.FirstName,
+			&studentModel.LastName,
+			&studentModel.Birthday,
+			&studentModel.RgDocument,
+			&studentModel.CpfDocument,
+			&studentModel.Email,
+			&studentModel.HimSelfResponsible,
+			&studentModel.DocumentType,
+			&studentModel.DocumentNumber,
+			&total,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		sdt, err := loadStudent(studentModel)
+		if err != nil {
+			return nil, err
+		}
+
+		students = append(students, *sdt)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &paginator.PaginationResult{
+		Total: total,
+		Data:  students,
+	}, nil
+}
+
+// loadStudent Restaura o aluno a partir do registro. Alunos sem CPF recebem o documento alternativo
+func loadStudent(studentModel models.FindStudentByIdRow) (*student.Student, error) {
 	sdt, err := student.Load(
 		studentModel.ID.String(),
 		studentModel.FirstName,
@@ -228,6 +802,16 @@ func (s *StudentRepository) FindByCpf(cpf value_objects.CPF) (*student.Student,
 		studentModel.Email.String,
 		studentModel.HimSelfResponsible,
 	)
+	if err != nil {
+		return nil, err
+	}
 
-	return sdt, err
+	if studentModel.DocumentType != value_objects.DocumentCpf {
+		err = sdt.ChangeDocument(studentModel.DocumentType, studentModel.DocumentNumber)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return sdt, nil
 }
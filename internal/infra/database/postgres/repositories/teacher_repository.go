@@ -0,0 +1,568 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/database/postgres/models"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/teacher"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
+)
+
+type TeacherRepository struct {
+	db     *sql.DB
+	queues *models.Queries
+}
+
+func NewTeacherRepository(db *sql.DB) *TeacherRepository {
+	return &TeacherRepository{
+		db:     db,
+		queues: models.New(db),
+	}
+}
+
+// Create Grava o professor com enderecos, telefones e formacao em uma unica transacao
+func (t *TeacherRepository) Create(tch teacher.Teacher) error {
+	tx, err := t.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	queues := t.queues.WithTx(tx)
+
+	err = queues.CreateTeacher(context.Background(), models.CreateTeacherParams{
+		ID:        tch.Id(),
+		FirstName: tch.FirstName(),
+		LastName:  tch.LastName(),
+		Birthday:  *tch.BirthDay(),
+		RgDocument: sql.NullString{
+			String: tch.Rg(),
+			Valid:  tch.Rg() != "",
+		},
+		CpfDocument: string(tch.Cpf()),
+		Email:       tch.Email(),
+		Status:      tch.Status(),
+		HiredAt:     tch.HiredAt(),
+		DismissedAt: teacherDismissedAt(tch),
+		CreatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = syncTeacherDetails(queues, tch)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (t *TeacherRepository) Update(tch teacher.Teacher) error {
+	tx, err := t.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	queues := t.queues.WithTx(tx)
+
+	err = queues.UpdateTeacher(context.Background(), models.UpdateTeacherParams{
+		FirstName: tch.FirstName(),
+		LastName:  tch.LastName(),
+		Birthday:  *tch.BirthDay(),
+		RgDocument: sql.NullString{
+			String: tch.Rg(),
+			Valid:  tch.Rg() != "",
+		},
+		CpfDocument: string(tch.Cpf()),
+		Email:       tch.Email(),
+		Status:      tch.Status(),
+		HiredAt:     tch.HiredAt(),
+		DismissedAt: teacherDismissedAt(tch),
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		ID: tch.Id(),
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = syncTeacherDetails(queues, tch)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// Delete Remove o professor, seus enderecos, telefones e atribuicoes
+func (t *TeacherRepository) Delete(id string) error {
+	teacherId, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	tx, err := t.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	queues := t.queues.WithTx(tx)
+	deletedAt := sql.NullTime{
+		Time:  time.Now(),
+		Valid: true,
+	}
+
+	err = queues.DeleteAddressByOwner(context.Background(), models.DeleteAddressByOwnerParams{
+		DeletedAt: deletedAt,
+		OwnerID:   teacherId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = queues.DeletePhonesByOwner(context.Background(), models.DeletePhonesByOwnerParams{
+		DeletedAt: deletedAt,
+		OwnerID:   teacherId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = queues.DeleteTeacherAssignmentsByTeacher(context.Background(), models.DeleteTeacherAssignmentsByTeacherParams{
+		DeletedAt: deletedAt,
+		TeacherID: teacherId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	err = queues.DeleteTeacher(context.Background(), models.DeleteTeacherParams{
+		DeletedAt: deletedAt,
+		ID:        teacherId,
+	})
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// FindById Busca o professor com enderecos, telefones e formacao
+func (t *TeacherRepository) FindById(id string) (*teacher.Teacher, error) {
+	teacherId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	teacherModel, err := t.queues.FindTeacherById(context.Background(), teacherId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return t.loadTeacher(teacherModel)
+}
+
+func (t *TeacherRepository) FindByCpf(cpf value_objects.CPF) (*teacher.Teacher, error) {
+	teacherModel, err := t.queues.FindTeacherByCpf(context.Background(), string(cpf))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return t.loadTeacher(models.FindTeacherByIdRow(teacherModel))
+}
+
+func (t *TeacherRepository) FindAll(pagination paginator.Pagination) (*paginator.PaginationResult, error) {
+	ctx, cancelQuery := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancelQuery()
+
+	query := `
+		SELECT id, first_name, last_name, birthday, rg_document, cpf_document, email, status, hired_at, dismissed_at,
+		       COUNT(*) OVER() as total
+		FROM teachers
+		WHERE deleted_at IS NULL
+		    AND (first_name || ' ' || last_name ILIKE $1 OR cpf_document LIKE $1 OR email ILIKE $1)
+	`
+	query += pagination.FiltersInSql()
+
+	stmt, err := t.db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+	rows, err := stmt.QueryContext(ctx, "%"+pagination.Search+"%")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var teachers []teacher.Teacher
+	total := 0
+
+	for rows.Next() {
+		var teacherModel models.FindTeacherByIdRow
+		err = rows.Scan(
+			&teacherModel.ID,
+			&teacherModel.FirstName,
+			&teacherModel.LastName,
+			&teacherModel.Birthday,
+			&teacherModel.RgDocument,
+			&teacherModel.CpfDocument,
+			&teacherModel.Email,
+			&teacherModel.Status,
+			&teacherModel.HiredAt,
+			&teacherModel.DismissedAt,
+			&total,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		tch, err := newTeacher(teacherModel)
+		if err != nil {
+			return nil, err
+		}
+
+		teachers = append(teachers, *tch)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return &paginator.PaginationResult{
+		Total: total,
+		Data:  teachers,
+	}, nil
+}
+
+func (t *TeacherRepository) Assign(assignment teacher.Assignment) error {
+	return t.queues.CreateTeacherAssignment(context.Background(), models.CreateTeacherAssignmentParams{
+		ID:           assignment.Id(),
+		TeacherID:    assignment.TeacherId(),
+		ClassRoomID:  assignment.ClassRoomId(),
+		SchoolYearID: assignment.SchoolYearId(),
+		SubjectID: uuid.NullUUID{
+			UUID:  assignment.SubjectId(),
+			Valid: true,
+		},
+		Subject: assignment.Subject(),
+		CreatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	})
+}
+
+func (t *TeacherRepository) Unassign(id string) error {
+	assignmentId, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	return t.queues.DeleteTeacherAssignment(context.Background(), models.DeleteTeacherAssignmentParams{
+		DeletedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		ID: assignmentId,
+	})
+}
+
+func (t *TeacherRepository) UnassignAll(teacherId uuid.UUID) error {
+	return t.queues.DeleteTeacherAssignmentsByTeacher(context.Background(), models.DeleteTeacherAssignmentsByTeacherParams{
+		DeletedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		TeacherID: teacherId,
+	})
+}
+
+func (t *TeacherRepository) FindAssignment(classRoomId uuid.UUID, subjectId uuid.UUID) (*teacher.Assignment, error) {
+	assignmentModel, err := t.queues.FindTeacherAssignment(context.Background(), models.FindTeacherAssignmentParams{
+		ClassRoomID: classRoomId,
+		SubjectID: uuid.NullUUID{
+			UUID:  subjectId,
+			Valid: true,
+		},
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return loadAssignment(models.FindClassRoomAssignmentsRow(assignmentModel)), nil
+}
+
+// FindAssignments Atribuicoes do professor, filtradas pelo ano letivo quando informado
+func (t *TeacherRepository) FindAssignments(teacherId string, schoolYearId string) ([]teacher.Assignment, error) {
+	id, err := uuid.Parse(teacherId)
+	if err != nil {
+		return nil, err
+	}
+
+	var assignmentsModel []models.FindClassRoomAssignmentsRow
+
+	if schoolYearId == "" {
+		rows, err := t.queues.FindTeacherAssignments(context.Background(), id)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			assignmentsModel = append(assignmentsModel, models.FindClassRoomAssignmentsRow(row))
+		}
+	} else {
+		yearId, err := uuid.Parse(schoolYearId)
+		if err != nil {
+			return nil, err
+		}
+
+		rows, err := t.queues.FindTeacherAssignmentsBySchoolYear(context.Background(), models.FindTeacherAssignmentsBySchoolYearParams{
+			TeacherID:    id,
+			SchoolYearID: yearId,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range rows {
+			assignmentsModel = append(assignmentsModel, models.FindClassRoomAssignmentsRow(row))
+		}
+	}
+
+	var assignments []teacher.Assignment
+
+	for _, assignmentModel := range assignmentsModel {
+		assignments = append(assignments, *loadAssignment(assignmentModel))
+	}
+
+	return assignments, nil
+}
+
+func (t *TeacherRepository) FindClassRoomAssignments(classRoomId string) ([]teacher.Assignment, error) {
+	id, err := uuid.Parse(classRoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	assignmentsModel, err := t.queues.FindClassRoomAssignments(context.Background(), id)
+	if err != nil {
+		return nil, err
+	}
+
+	var assignments []teacher.Assignment
+
+	for _, assignmentModel := range assignmentsModel {
+		assignments = append(assignments, *loadAssignment(assignmentModel))
+	}
+
+	return assignments, nil
+}
+
+// ChangeAvailability Substitui os periodos de disponibilidade do professor
+func (t *TeacherRepository) ChangeAvailability(teacherId uuid.UUID, availability []teacher.Availability) error {
+	tx, err := t.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	queues := t.queues.WithTx(tx)
+
+	err = queues.DeleteTeacherAvailability(context.Background(), teacherId)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	for _, period := range availability {
+		startAt, _ := time.Parse("15:04:05", period.StartAt)
+		endAt, _ := time.Parse("15:04:05", period.EndAt)
+
+		err = queues.CreateTeacherAvailability(context.Background(), models.CreateTeacherAvailabilityParams{
+			ID:        uuid.New(),
+			TeacherID: teacherId,
+			Weekday:   int16(period.Weekday),
+			StartAt:   startAt,
+			EndAt:     endAt,
+		})
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (t *TeacherRepository) FindAvailability(teacherId uuid.UUID) ([]teacher.Availability, error) {
+	availabilityModel, err := t.queues.FindTeacherAvailability(context.Background(), teacherId)
+	if err != nil {
+		return nil, err
+	}
+
+	var availability []teacher.Availability
+
+	for _, period := range availabilityModel {
+		availability = append(availability, teacher.Availability{
+			Weekday: int(period.Weekday),
+			StartAt: period.StartAt.Format("15:04:05"),
+			EndAt:   period.EndAt.Format("15:04:05"),
+		})
+	}
+
+	return availability, nil
+}
+
+// loadTeacher Carrega o professor com seus enderecos, telefones e formacao
+func (t *TeacherRepository) loadTeacher(teacherModel models.FindTeacherByIdRow) (*teacher.Teacher, error) {
+	tch, err := newTeacher(teacherModel)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses, err := findAddresses(t.queues, tch.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	phones, err := findPhones(t.queues, tch.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	qualificationsModel, err := t.queues.FindTeacherQualifications(context.Background(), tch.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	var qualifications []teacher.Qualification
+
+	for _, qualificationModel := range qualificationsModel {
+		qualifications = append(qualifications, teacher.Qualification{
+			Id:             qualificationModel.ID,
+			Degree:         qualificationModel.Degree,
+			Course:         qualificationModel.Course,
+			Institution:    qualificationModel.Institution,
+			ConclusionYear: int(qualificationModel.ConclusionYear),
+		})
+	}
+
+	tch.ChangeAddresses(addresses)
+	tch.ChangePhones(phones)
+	tch.ChangeQualifications(qualifications)
+
+	return tch, nil
+}
+
+func newTeacher(teacherModel models.FindTeacherByIdRow) (*teacher.Teacher, error) {
+	var dismissedAt *time.Time
+	if teacherModel.DismissedAt.Valid {
+		dismissedAt = &teacherModel.DismissedAt.Time
+	}
+
+	return teacher.Load(
+		teacherModel.ID.String(),
+		teacherModel.FirstName,
+		teacherModel.LastName,
+		teacherModel.Birthday.Format("2006-01-02"),
+		teacherModel.RgDocument.String,
+		teacherModel.CpfDocument,
+		teacherModel.Email,
+		teacherModel.HiredAt.Format("2006-01-02"),
+		teacherModel.Status,
+		dismissedAt,
+	)
+}
+
+// syncTeacherDetails Refaz enderecos, telefones e formacao do professor
+func syncTeacherDetails(queues *models.Queries, tch teacher.Teacher) error {
+	err := syncAddress(queues, tch.Id(), tch.Addresses())
+	if err != nil {
+		return err
+	}
+
+	err = syncPhones(queues, tch.Id(), tch.Phones())
+	if err != nil {
+		return err
+	}
+
+	err = queues.DeleteTeacherQualifications(context.Background(), tch.Id())
+	if err != nil {
+		return err
+	}
+
+	for _, qualification := range tch.Qualifications() {
+		err = queues.CreateTeacherQualification(context.Background(), models.CreateTeacherQualificationParams{
+			ID:             qualification.Id,
+			TeacherID:      tch.Id(),
+			Degree:         qualification.Degree,
+			Course:         qualification.Course,
+			Institution:    qualification.Institution,
+			ConclusionYear: int32(qualification.ConclusionYear),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func teacherDismissedAt(tch teacher.Teacher) sql.NullTime {
+	if tch.DismissedAt() == nil {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{
+		Time:  *tch.DismissedAt(),
+		Valid: true,
+	}
+}
+
+func loadAssignment(assignmentModel models.FindClassRoomAssignmentsRow) *teacher.Assignment {
+	return teacher.LoadAssignment(
+		assignmentModel.ID,
+		assignmentModel.TeacherID,
+		assignmentModel.FirstName+" "+assignmentModel.LastName,
+		assignmentModel.ClassRoomID,
+		assignmentModel.Identification,
+		assignmentModel.SchoolYearID,
+		assignmentModel.SubjectID.UUID,
+		assignmentModel.Subject,
+	)
+}
@@ -0,0 +1,175 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/database/postgres/models"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/timetable"
+)
+
+type TimetableRepository struct {
+	db     *sql.DB
+	queues *models.Queries
+}
+
+func NewTimetableRepository(db *sql.DB) *TimetableRepository {
+	return &TimetableRepository{
+		db:     db,
+		queues: models.New(db),
+	}
+}
+
+// ReplaceLessons Substitui a grade do ano letivo em uma unica transacao
+func (t *TimetableRepository) ReplaceLessons(schoolYearId uuid.UUID, lessons []timetable.Lesson) error {
+	tx, err := t.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	queues := t.queues.WithTx(tx)
+
+	err = queues.DeleteTimetableLessonsBySchoolYear(context.Background(), schoolYearId)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	for _, lesson := range lessons {
+		err = createLesson(queues, lesson)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (t *TimetableRepository) CreateLesson(lesson timetable.Lesson) error {
+	return createLesson(t.queues, lesson)
+}
+
+func (t *TimetableRepository) UpdateLesson(lesson timetable.Lesson) error {
+	return t.queues.UpdateTimetableLesson(context.Background(), models.UpdateTimetableLessonParams{
+		RoomID:     lesson.RoomId(),
+		ScheduleID: lesson.Slot().ScheduleId,
+		Weekday:    int16(lesson.Slot().Weekday),
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		ID: lesson.Id(),
+	})
+}
+
+func (t *TimetableRepository) DeleteLesson(id string) error {
+	lessonId, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+
+	return t.queues.DeleteTimetableLesson(context.Background(), lessonId)
+}
+
+func (t *TimetableRepository) FindLessonById(id string) (*timetable.Lesson, error) {
+	lessonId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	lessonModel, err := t.queues.FindTimetableLessonById(context.Background(), lessonId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return loadLesson(lessonModel)
+}
+
+func (t *TimetableRepository) FindLessons(schoolYearId uuid.UUID) ([]timetable.Lesson, error) {
+	lessonsModel, err := t.queues.FindTimetableLessons(context.Background(), schoolYearId)
+	if err != nil {
+		return nil, err
+	}
+
+	var lessons []timetable.Lesson
+
+	for _, lessonModel := range lessonsModel {
+		lesson, err := loadLesson(models.FindTimetableLessonByIdRow(lessonModel))
+		if err != nil {
+			return nil, err
+		}
+
+		lessons = append(lessons, *lesson)
+	}
+
+	return lessons, nil
+}
+
+func (t *TimetableRepository) FindRooms() ([]timetable.Room, error) {
+	roomsModel, err := t.queues.FindTimetableRooms(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	var rooms []timetable.Room
+
+	for _, roomModel := range roomsModel {
+		rooms = append(rooms, timetable.Room{
+			Id:       roomModel.ID,
+			Code:     roomModel.Code,
+			Capacity: int(roomModel.Capacity),
+		})
+	}
+
+	return rooms, nil
+}
+
+func createLesson(queues *models.Queries, lesson timetable.Lesson) error {
+	return queues.CreateTimetableLesson(context.Background(), models.CreateTimetableLessonParams{
+		ID:           lesson.Id(),
+		SchoolYearID: lesson.SchoolYearId(),
+		ClassRoomID:  lesson.ClassRoomId(),
+		SubjectID:    lesson.SubjectId(),
+		TeacherID:    lesson.TeacherId(),
+		RoomID:       lesson.RoomId(),
+		ScheduleID:   lesson.Slot().ScheduleId,
+		Weekday:      int16(lesson.Slot().Weekday),
+		CreatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	})
+}
+
+func loadLesson(lessonModel models.FindTimetableLessonByIdRow) (*timetable.Lesson, error) {
+	return timetable.LoadLesson(
+		lessonModel.ID.String(),
+		lessonModel.SchoolYearID,
+		lessonModel.ClassRoomID,
+		lessonModel.Identification,
+		lessonModel.SubjectID,
+		lessonModel.Name,
+		lessonModel.TeacherID,
+		lessonModel.FirstName+" "+lessonModel.LastName,
+		lessonModel.RoomID,
+		lessonModel.Code,
+		timetable.Slot{
+			ScheduleId: lessonModel.ScheduleID,
+			Weekday:    int(lessonModel.Weekday),
+			StartAt:    lessonModel.StartAt.Format("15:04:05"),
+			EndAt:      lessonModel.EndAt.Format("15:04:05"),
+		},
+	)
+}
@@ -0,0 +1,265 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/database/postgres/models"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/waitinglist"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+)
+
+type WaitingListRepository struct {
+	db     *sql.DB
+	queues *models.Queries
+}
+
+type waitingListSearchModel struct {
+	Candidate models.FindWaitingListByIdRow
+	Student   models.FindStudentByIdRow
+	Total     int
+}
+
+func NewWaitingListRepository(db *sql.DB) *WaitingListRepository {
+	return &WaitingListRepository{
+		db:     db,
+		queues: models.New(db),
+	}
+}
+
+func (w *WaitingListRepository) SetTransaction(tx *sql.Tx) {
+	w.queues = w.queues.WithTx(tx)
+}
+
+func (w *WaitingListRepository) Create(candidate waitinglist.Candidate) error {
+	createParams := models.CreateWaitingListParams{
+		ID:                   candidate.Id(),
+		ClassRoomID:          candidate.ClassRoomId(),
+		StudentID:            candidate.Student().Id(),
+		ServiceID:            candidate.ServiceId(),
+		Shift:                string(candidate.Shift()),
+		MonthlyFee:           strconv.FormatFloat(candidate.MonthlyFee(), 'f', -1, 64),
+		InstallmentsQuantity: int32(candidate.InstallmentsQuantity()),
+		EnrollmentFee:        strconv.FormatFloat(candidate.EnrollmentFee(), 'f', -1, 64),
+		MonthDuration:        int32(candidate.MonthDuration()),
+		PaymentDay:           candidate.PaymentDay(),
+		Priority:             int32(candidate.Priority()),
+		RequestDate:          candidate.RequestDate(),
+		Status:               candidate.Status(),
+		CreatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+	}
+
+	return w.queues.CreateWaitingList(context.Background(), createParams)
+}
+
+func (w *WaitingListRepository) FindById(id string) (*waitinglist.Candidate, error) {
+	candidateId, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	candidateModel, err := w.queues.FindWaitingListById(context.Background(), candidateId)
+	if err != nil {
+		return nil, err
+	}
+
+	studentModel, err := w.queues.FindStudentById(context.Background(), candidateModel.StudentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.loadCandidate(candidateModel, studentModel)
+}
+
+// FindNextWaitingLock Busca o proximo candidato da fila da turma fazendo o lock do registro.
+// Retorna nil quando nao houver candidatos aguardando. Deve ser usada dentro de uma transacao
+func (w *WaitingListRepository) FindNextWaitingLock(classRoomId uuid.UUID) (*waitinglist.Candidate, error) {
+	candidateModel, err := w.queues.FindNextWaitingListLock(context.Background(), classRoomId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	studentModel, err := w.queues.FindStudentById(context.Background(), candidateModel.StudentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.loadCandidate(models.FindWaitingListByIdRow(candidateModel), studentModel)
+}
+
+func (w *WaitingListRepository) FindByClassRoom(classRoomId string, pagination paginator.Pagination) (*paginator.PaginationResult, error) {
+	classId, err := uuid.Parse(classRoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancelQuery := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancelQuery()
+
+	if pagination.SortField == "" {
+		pagination.SortField = "w.priority DESC, w.request_date"
+		pagination.Sort = "ASC"
+	}
+
+	query := `
+		SELECT w.id, w.class_room_id, w.student_id, w.service_id, w.shift, w.monthly_fee,
+		       w.installments_quantity, w.enrollment_fee, w.month_duration, w.payment_day,
+		       w.priority, w.request_date, w.status, w.registration_id,
+		       s.id, s.first_name, s.last_name, s.birthday, s.rg_document, s.cpf_document,
+		       s.email, s.him_self_responsible,
+		       COUNT(*) OVER() as total
+			FROM waiting_list w
+			JOIN students s ON s.id = w.student_id
+		WHERE w.class_room_id = $1 AND w.status = $2
+	`
+	filters := pagination.FiltersInSql()
+
+	if filters != "" {
+		query += filters
+	}
+
+	stmt, err := w.db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	defer stmt.Close()
+	rows, err := stmt.QueryContext(ctx, classId, waitinglist.StatusWaiting)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var candidatesModel []waitingListSearchModel
+
+	for rows.Next() {
+		var candidateModel waitingListSearchModel
+		err = rows.Scan(
+			&candidateModel.Candidate.ID,
+			&candidateModel.Candidate.ClassRoomID,
+			&candidateModel.Candidate.StudentID,
+			&candidateModel.Candidate.ServiceID,
+			&candidateModel.Candidate.Shift,
+			&candidateModel.Candidate.MonthlyFee,
+			&candidateModel.Candidate.InstallmentsQuantity,
+			&candidateModel.Candidate.EnrollmentFee,
+			&candidateModel.Candidate.MonthDuration,
+			&candidateModel.Candidate.PaymentDay,
+			&candidateModel.Candidate.Priority,
+			&candidateModel.Candidate.RequestDate,
+			&candidateModel.Candidate.Status,
+			&candidateModel.Candidate.RegistrationID,
+			&candidateModel.Student.ID,
+			&candidateModel.Student.FirstName,
+			&candidateModel.Student.LastName,
+			&candidateModel.Student.Birthday,
+			&candidateModel.Student.RgDocument,
+			&candidateModel.Student.CpfDocument,
+			&candidateModel.Student.Email,
+			&candidateModel.Student.HimSelfResponsible,
+			&candidateModel.Total,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		candidatesModel = append(candidatesModel, candidateModel)
+	}
+
+	var candidates []waitinglist.Candidate
+
+	for _, candidateModel := range candidatesModel {
+		candidate, err := w.loadCandidate(candidateModel.Candidate, candidateModel.Student)
+		if err != nil {
+			return nil, err
+		}
+
+		candidates = append(candidates, *candidate)
+	}
+
+	paginationResult := paginator.PaginationResult{
+		Data: candidates,
+	}
+
+	if len(candidatesModel) > 0 {
+		paginationResult.Total = candidatesModel[0].Total
+	}
+
+	return &paginationResult, nil
+}
+
+// CountAhead Quantidade de candidatos aguardando na frente do candidato informado
+func (w *WaitingListRepository) CountAhead(candidate waitinglist.Candidate) (int, error) {
+	countParams := models.CountWaitingListAheadParams{
+		ClassRoomID: candidate.ClassRoomId(),
+		Priority:    int32(candidate.Priority()),
+		RequestDate: candidate.RequestDate(),
+	}
+
+	total, err := w.queues.CountWaitingListAhead(context.Background(), countParams)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(total), nil
+}
+
+func (w *WaitingListRepository) UpdateStatus(candidate waitinglist.Candidate) error {
+	updateParams := models.UpdateWaitingListStatusParams{
+		Status:         candidate.Status(),
+		RegistrationID: candidate.RegistrationId(),
+		UpdatedAt: sql.NullTime{
+			Time:  time.Now(),
+			Valid: true,
+		},
+		ID: candidate.Id(),
+	}
+
+	return w.queues.UpdateWaitingListStatus(context.Background(), updateParams)
+}
+
+func (w *WaitingListRepository) loadCandidate(
+	candidateModel models.FindWaitingListByIdRow,
+	studentModel models.FindStudentByIdRow,
+) (*waitinglist.Candidate, error) {
+
+	stdent, err := loadStudent(studentModel)
+	if err != nil {
+		return nil, err
+	}
+
+	monthlyFee, _ := strconv.ParseFloat(candidateModel.MonthlyFee, 64)
+	enrollmentFee, _ := strconv.ParseFloat(candidateModel.EnrollmentFee, 64)
+
+	return waitinglist.Load(
+		candidateModel.ID.String(),
+		candidateModel.ClassRoomID.String(),
+		*stdent,
+		candidateModel.ServiceID.String(),
+		candidateModel.Shift,
+		monthlyFee,
+		int(candidateModel.InstallmentsQuantity),
+		enrollmentFee,
+		int(candidateModel.MonthDuration),
+		candidateModel.PaymentDay,
+		int(candidateModel.Priority),
+		candidateModel.RequestDate,
+		candidateModel.Status,
+		candidateModel.RegistrationID,
+	)
+}
@@ -6,4 +6,10 @@ VALUES
 ($1,$2,$3,$4,$5,$6,$7,$8,$9);
 
 -- name: DeleteAddressByOwner :exec
-UPDATE addresses SET deleted_at = $1 WHERE owner_id = $2;
\ No newline at end of file
+UPDATE addresses SET deleted_at = $1 WHERE owner_id = $2;
+
+-- name: FindAddressesByOwner :many
+SELECT id, street, city, district, state, zip_code, owner_id FROM addresses WHERE owner_id = $1 AND deleted_at IS NULL ORDER BY created_at;
+
+-- name: MoveAddressesToOwner :exec
+UPDATE addresses SET owner_id = $1 WHERE owner_id = $2 AND deleted_at IS NULL;
\ No newline at end of file
@@ -0,0 +1,67 @@
+-- name: UpsertAttendance :exec
+INSERT INTO attendances (id, class_room_id, subject_id, schedule_id, lesson_date, teacher_id, registration_id, student_id, status, note, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+ON CONFLICT (class_room_id, subject_id, schedule_id, lesson_date, student_id)
+DO UPDATE SET teacher_id = EXCLUDED.teacher_id, registration_id = EXCLUDED.registration_id, status = EXCLUDED.status,
+    note = EXCLUDED.note, updated_at = EXCLUDED.updated_at;
+
+-- name: UpdateAttendance :exec
+UPDATE attendances SET status = $1, note = $2, updated_at = $3 WHERE id = $4;
+
+-- name: FindAttendanceById :one
+SELECT a.id, a.class_room_id, c.identification, a.subject_id, sb.name, a.schedule_id, a.lesson_date, a.teacher_id,
+       a.registration_id, a.student_id, s.first_name, s.last_name, a.status, a.note
+FROM attendances a
+    JOIN class_room c ON c.id = a.class_room_id
+    JOIN subjects sb ON sb.id = a.subject_id
+    JOIN students s ON s.id = a.student_id
+WHERE a.id = $1;
+
+-- name: FindAttendancesByLesson :many
+SELECT a.id, a.class_room_id, c.identification, a.subject_id, sb.name, a.schedule_id, a.lesson_date, a.teacher_id,
+       a.registration_id, a.student_id, s.first_name, s.last_name, a.status, a.note
+FROM attendances a
+    JOIN class_room c ON c.id = a.class_room_id
+    JOIN subjects sb ON sb.id = a.subject_id
+    JOIN students s ON s.id = a.student_id
+WHERE a.class_room_id = $1 AND a.subject_id = $2 AND a.schedule_id = $3 AND a.lesson_date = $4
+ORDER BY s.first_name, s.last_name;
+
+-- name: FindAttendancesByClassRoom :many
+SELECT a.id, a.class_room_id, c.identification, a.subject_id, sb.name, a.schedule_id, a.lesson_date, a.teacher_id,
+       a.registration_id, a.student_id, s.first_name, s.last_name, a.status, a.note
+FROM attendances a
+    JOIN class_room c ON c.id = a.class_room_id
+    JOIN subjects sb ON sb.id = a.subject_id
+    JOIN students s ON s.id = a.student_id
+WHERE a.class_room_id = $1
+ORDER BY a.lesson_date;
+
+-- name: FindAttendancesBySchoolYear :many
+SELECT a.id, a.class_room_id, c.identification, a.subject_id, sb.name, a.schedule_id, a.lesson_date, a.teacher_id,
+       a.registration_id, a.student_id, s.first_name, s.last_name, a.status, a.note
+FROM attendances a
+    JOIN class_room c ON c.id = a.class_room_id
+    JOIN subjects sb ON sb.id = a.subject_id
+    JOIN students s ON s.id = a.student_id
+WHERE c.school_year_id = $1
+ORDER BY a.lesson_date;
+
+-- name: FindAttendanceStudents :many
+SELECT r.id, s.id AS student_id, s.first_name, s.last_name
+FROM registrations r
+    JOIN students s ON s.id = r.student_id
+WHERE r.class_room_id = sqlc.arg(class_room_id)
+    AND COALESCE(r.enrollment_date, r.created_at)::date <= sqlc.arg(lesson_date)::date
+    AND COALESCE(
+        (SELECT h.status FROM registration_status_history h
+            WHERE h.registration_id = r.id AND h.created_at::date <= sqlc.arg(lesson_date)::date
+            ORDER BY h.created_at DESC LIMIT 1),
+        (SELECT h.previous_status FROM registration_status_history h
+            WHERE h.registration_id = r.id AND h.created_at::date > sqlc.arg(lesson_date)::date
+            ORDER BY h.created_at LIMIT 1),
+        r.status
+    ) = 'APPROVED'
+    AND r.deleted_at IS NULL
+    AND s.deleted_at IS NULL
+ORDER BY s.first_name, s.last_name;
@@ -0,0 +1,52 @@
+-- name: CreateBankSlip :exec
+INSERT INTO bank_slips
+(id, invoice_id, our_number, layout, remittance, value, due_date, status, message, created_at, updated_at)
+VALUES
+($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11);
+
+-- name: FindBankSlipByOurNumberLock :one
+SELECT id, invoice_id, our_number, layout, remittance, value, due_date, status, message
+FROM bank_slips
+    WHERE our_number = $1
+    FOR UPDATE;
+
+-- name: FindBankSlipsByInvoice :many
+SELECT id, invoice_id, our_number, layout, remittance, value, due_date, status, message
+FROM bank_slips
+    WHERE invoice_id = $1
+    ORDER BY created_at ASC;
+
+-- name: UpdateBankSlipStatus :exec
+UPDATE bank_slips SET status = $1, message = $2, updated_at = $3 WHERE id = $4;
+
+-- name: NextBankSlipOurNumber :one
+SELECT nextval('bank_slips_our_number_seq')::BIGINT as our_number;
+
+-- name: NextBankRemittanceSequence :one
+SELECT nextval('bank_remittance_seq')::INTEGER as sequence;
+
+-- name: FindInvoicesToRemit :many
+SELECT i.id, i.registration_id, i.student_id, i.description, i.kind, i.installment, i.value, i.paid_value,
+       i.due_date, i.status, i.fine_percentage, i.daily_interest_percentage, i.discount_percentage,
+       i.discount_days_before, i.charges_paid, i.discount_value,
+       COALESCE(r.financial_responsible_name, s.first_name || ' ' || s.last_name)::varchar AS payer_name,
+       COALESCE(r.financial_responsible_cpf, s.cpf_document)::varchar AS payer_document,
+       a.street, a.district, a.zip_code, a.city, a.state
+FROM invoices i
+    JOIN students s ON s.id = i.student_id
+    LEFT JOIN registrations r ON r.id = i.registration_id
+    LEFT JOIN LATERAL (
+        SELECT street, district, zip_code, city, state
+        FROM addresses
+            WHERE owner_id = COALESCE(r.financial_responsible_id, s.id) AND deleted_at IS NULL
+            ORDER BY created_at ASC
+            LIMIT 1
+    ) a ON true
+    WHERE i.status IN ('OPEN', 'PARTIALLY_PAID')
+        AND i.due_date <= sqlc.arg(due_until)
+        AND NOT EXISTS (
+            SELECT 1 FROM bank_slips b
+                WHERE b.invoice_id = i.id AND b.status IN ('SENT', 'REGISTERED')
+        )
+    ORDER BY i.due_date ASC, i.installment ASC
+    FOR UPDATE OF i;
@@ -7,16 +7,15 @@ UPDATE class_room SET
         status = $1,
         identification = $2,
         vacancies = $3,
-        vacancies_occupied = $4,
-        shift = $5,
-        level = $6,
-        localization = $7,
-        open_date = $8,
-        school_year_id = $9,
-        room_id = $10,
-        schedule_id = $11,
-        updated_at = $12
-WHERE id = $13;
+        shift = $4,
+        level = $5,
+        localization = $6,
+        open_date = $7,
+        school_year_id = $8,
+        room_id = $9,
+        schedule_id = $10,
+        updated_at = $11
+WHERE id = $12;
 
 -- name: DeleteClass :exec
 UPDATE class_room SET deleted_at = $1 WHERE id = $2;
@@ -61,13 +60,41 @@ FROM class_room
         AND deleted_at IS NULL
         FOR UPDATE;
 
+-- name: FindClassesBySchoolYear :many
+SELECT id,
+       status,
+       active,
+       identification,
+       vacancies,
+       vacancies_occupied,
+       shift,
+       level,
+       localization,
+       open_date,
+       school_year_id,
+       room_id,
+      schedule_id,
+      type
+FROM class_room
+    WHERE school_year_id = $1
+        AND deleted_at IS NULL
+    ORDER BY identification;
 
--- name: UpdateVacancyOccupied :exec
+
+-- name: UpdateVacancyOccupied :execrows
 UPDATE class_room 
     SET vacancies_occupied = $1, 
         updated_at = $2 
 WHERE 
     id = $3 
     AND vacancies_occupied < vacancies 
-    AND deleted_at IS NULL 
-    RETURNING vacancies_occupied;
\ No newline at end of file
+    AND deleted_at IS NULL;
+
+-- name: ReleaseVacancyOccupied :execrows
+UPDATE class_room
+    SET vacancies_occupied = $1,
+        updated_at = $2
+WHERE
+    id = $3
+    AND vacancies_occupied > 0
+    AND deleted_at IS NULL;
\ No newline at end of file
@@ -0,0 +1,20 @@
+-- name: CreateContractTemplate :exec
+INSERT INTO contract_templates (id, content, hash, created_at) VALUES ($1,$2,$3,$4);
+
+-- name: CreateRegistrationContract :exec
+INSERT INTO registration_contracts
+    (id, registration_id, template_id, template_hash, hash, storage_key, issued_at)
+    VALUES ($1,$2,$3,$4,$5,$6,$7);
+
+-- name: FindCurrentContractTemplate :one
+SELECT id, content, hash, created_at
+FROM contract_templates
+    ORDER BY created_at DESC
+    LIMIT 1;
+
+-- name: FindRegistrationContract :one
+SELECT id, registration_id, template_id, template_hash, hash, storage_key, issued_at
+FROM registration_contracts
+    WHERE registration_id = $1
+    ORDER BY issued_at DESC
+    LIMIT 1;
\ No newline at end of file
@@ -0,0 +1,68 @@
+-- name: CreateSubject :exec
+INSERT INTO subjects (id, code, name, workload, created_at, updated_at) VALUES ($1,$2,$3,$4,$5,$6);
+
+-- name: UpdateSubject :exec
+UPDATE subjects SET code = $1, name = $2, workload = $3, updated_at = $4 WHERE id = $5;
+
+-- name: DeleteSubject :exec
+UPDATE subjects SET deleted_at = $1 WHERE id = $2;
+
+-- name: FindSubjectById :one
+SELECT id, code, name, workload FROM subjects WHERE id = $1 AND deleted_at IS NULL LIMIT 1;
+
+-- name: FindSubjectByCode :one
+SELECT id, code, name, workload FROM subjects WHERE code = $1 AND deleted_at IS NULL LIMIT 1;
+
+-- name: SubjectInUse :one
+SELECT EXISTS (
+    SELECT 1
+    FROM curriculum_matrix_items i
+        JOIN curriculum_matrices m ON m.id = i.matrix_id
+    WHERE i.subject_id = $1
+        AND m.deleted_at IS NULL
+);
+
+-- name: CreateMatrix :exec
+INSERT INTO curriculum_matrices (id, level, school_year_id, created_at, updated_at) VALUES ($1,$2,$3,$4,$5);
+
+-- name: UpdateMatrix :exec
+UPDATE curriculum_matrices SET updated_at = $1 WHERE id = $2;
+
+-- name: DeleteMatrix :exec
+UPDATE curriculum_matrices SET deleted_at = $1 WHERE id = $2;
+
+-- name: CreateMatrixItem :exec
+INSERT INTO curriculum_matrix_items (matrix_id, subject_id, weekly_classes) VALUES ($1,$2,$3);
+
+-- name: DeleteMatrixItems :exec
+DELETE FROM curriculum_matrix_items WHERE matrix_id = $1;
+
+-- name: FindMatrixById :one
+SELECT id, level, school_year_id FROM curriculum_matrices WHERE id = $1 AND deleted_at IS NULL LIMIT 1;
+
+-- name: FindMatrixByLevel :one
+SELECT id, level, school_year_id
+FROM curriculum_matrices
+WHERE LOWER(level) = LOWER(sqlc.arg(level))
+    AND school_year_id = sqlc.arg(school_year_id)
+    AND deleted_at IS NULL
+LIMIT 1;
+
+-- name: FindMatricesBySchoolYear :many
+SELECT id, level, school_year_id FROM curriculum_matrices WHERE school_year_id = $1 AND deleted_at IS NULL ORDER BY level;
+
+-- name: FindMatrixItems :many
+SELECT i.subject_id, s.name, i.weekly_classes
+FROM curriculum_matrix_items i
+    JOIN subjects s ON s.id = i.subject_id
+WHERE i.matrix_id = $1
+ORDER BY s.name;
+
+-- name: MatrixInUse :one
+SELECT EXISTS (
+    SELECT 1
+    FROM class_room
+    WHERE LOWER(level) = LOWER(sqlc.arg(level))
+        AND school_year_id = sqlc.arg(school_year_id)
+        AND deleted_at IS NULL
+);
\ No newline at end of file
@@ -0,0 +1,32 @@
+-- name: CreateDocumentRequirement :exec
+INSERT INTO document_requirements
+    (id, level, kind, description, created_at, updated_at)
+    VALUES ($1,$2,$3,$4,$5,$6);
+
+-- name: CreateStudentDocument :exec
+INSERT INTO student_documents
+    (id, student_id, registration_id, kind, file_name, content_type, size, storage_key, status, reason, uploaded_at, created_at, updated_at)
+    VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13);
+
+-- name: DeleteDocumentRequirement :exec
+UPDATE document_requirements SET deleted_at = $1 WHERE id = $2;
+
+-- name: FindDocumentRequirements :many
+SELECT id, level, kind, description
+FROM document_requirements
+    WHERE deleted_at IS NULL
+    ORDER BY level, kind;
+
+-- name: FindStudentDocumentById :one
+SELECT id, student_id, registration_id, kind, file_name, content_type, size, storage_key, status, reason, uploaded_at
+FROM student_documents
+    WHERE id = $1;
+
+-- name: FindStudentDocumentsByStudent :many
+SELECT id, student_id, registration_id, kind, file_name, content_type, size, storage_key, status, reason, uploaded_at
+FROM student_documents
+    WHERE student_id = $1
+    ORDER BY kind, uploaded_at;
+
+-- name: UpdateStudentDocumentStatus :exec
+UPDATE student_documents SET status = $1, reason = $2, updated_at = $3 WHERE id = $4;
\ No newline at end of file
@@ -0,0 +1,31 @@
+-- name: UpdateStudentPhoto :exec
+UPDATE students SET photo_key = $1, photo_content_type = $2, updated_at = $3 WHERE id = $4 AND deleted_at IS NULL;
+
+-- name: FindStudentPhoto :one
+SELECT id, photo_key, photo_content_type FROM students WHERE id = $1 AND deleted_at IS NULL LIMIT 1;
+
+-- name: FindIdCardsByClassRoom :many
+SELECT s.id AS student_id, s.first_name, s.last_name, s.photo_key, s.photo_content_type,
+       r.code, r.shift, c.identification, y.year
+FROM registrations r
+    JOIN students s ON s.id = r.student_id
+    JOIN class_room c ON c.id = r.class_room_id
+    JOIN school_year y ON y.id = r.school_year_id
+WHERE r.class_room_id = $1
+    AND r.status = 'APPROVED'
+    AND r.deleted_at IS NULL
+    AND s.deleted_at IS NULL
+ORDER BY s.first_name, s.last_name;
+
+-- name: FindIdCardsByStudents :many
+SELECT DISTINCT ON (r.student_id) s.id AS student_id, s.first_name, s.last_name, s.photo_key, s.photo_content_type,
+       r.code, r.shift, c.identification, y.year
+FROM registrations r
+    JOIN students s ON s.id = r.student_id
+    JOIN class_room c ON c.id = r.class_room_id
+    JOIN school_year y ON y.id = r.school_year_id
+WHERE r.student_id = ANY(sqlc.arg(student_ids)::uuid[])
+    AND r.status = 'APPROVED'
+    AND r.deleted_at IS NULL
+    AND s.deleted_at IS NULL
+ORDER BY r.student_id, r.enrollment_date DESC;
\ No newline at end of file
@@ -0,0 +1,66 @@
+-- name: CreateInvoice :exec
+INSERT INTO invoices
+(id, registration_id, student_id, description, kind, installment, value, due_date, status,
+ fine_percentage, daily_interest_percentage, discount_percentage, discount_days_before, created_at, updated_at)
+VALUES
+($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15);
+
+-- name: FindInvoiceById :one
+SELECT id, registration_id, student_id, description, kind, installment, value, paid_value, due_date, status,
+       fine_percentage, daily_interest_percentage, discount_percentage, discount_days_before,
+       charges_paid, discount_value
+FROM invoices
+    WHERE id = $1;
+
+-- name: FindInvoiceByIdLock :one
+SELECT id, registration_id, student_id, description, kind, installment, value, paid_value, due_date, status,
+       fine_percentage, daily_interest_percentage, discount_percentage, discount_days_before,
+       charges_paid, discount_value
+FROM invoices
+    WHERE id = $1
+    FOR UPDATE;
+
+-- name: FindOpenInvoicesByStudentLock :many
+SELECT id, registration_id, student_id, description, kind, installment, value, paid_value, due_date, status,
+       fine_percentage, daily_interest_percentage, discount_percentage, discount_days_before,
+       charges_paid, discount_value
+FROM invoices
+    WHERE student_id = $1
+        AND status IN ('OPEN', 'PARTIALLY_PAID')
+    ORDER BY due_date ASC, installment ASC
+    FOR UPDATE;
+
+-- name: SumStudentOverdueBalance :one
+SELECT COALESCE(SUM(i.value - i.paid_value), 0)::NUMERIC AS balance
+FROM invoices i
+    JOIN registrations reg ON reg.id = i.registration_id
+    WHERE i.student_id = $1
+        AND i.status IN ('OPEN', 'PARTIALLY_PAID')
+        AND i.due_date < $2
+        AND reg.status <> 'CANCELLED';
+
+-- name: UpdateInvoicePayment :exec
+UPDATE invoices SET
+        paid_value = $1,
+        charges_paid = $2,
+        discount_value = $3,
+        status = $4,
+        updated_at = $5
+WHERE id = $6;
+
+
+-- name: FindOpenInvoicesByRegistrationLock :many
+SELECT id, registration_id, student_id, description, kind, installment, value, paid_value, due_date, status,
+       fine_percentage, daily_interest_percentage, discount_percentage, discount_days_before,
+       charges_paid, discount_value
+FROM invoices
+    WHERE registration_id = $1
+        AND status IN ('OPEN', 'PARTIALLY_PAID')
+    ORDER BY due_date ASC, installment ASC
+    FOR UPDATE;
+
+-- name: UpdateInvoiceStatus :exec
+UPDATE invoices SET status = $1, updated_at = $2 WHERE id = $3;
+
+-- name: UpdateInvoiceRegistration :exec
+UPDATE invoices SET registration_id = $1, updated_at = $2 WHERE id = $3;
\ No newline at end of file
@@ -1,9 +1,41 @@
 -- Active: 1691937846246@@127.0.0.1@9500@sistema-escolar
 -- name: CreateParent :exec
 INSERT INTO parents
-(id, first_name, last_name, birthday, rg_document, cpf_document, student_id, email, created_at, updated_at)
+(id, first_name, last_name, birthday, rg_document, cpf_document, email, created_at, updated_at)
 VALUES
-($1,$2,$3,$4,$5,$6,$7,$8,$9,$10);
+($1,$2,$3,$4,$5,$6,$7,$8,$9);
 
--- name: DeleteParentsByStudent :exec
-UPDATE parents SET deleted_at = $1 WHERE student_id = $2;
\ No newline at end of file
+-- name: LinkStudentParent :exec
+INSERT INTO student_parents
+(student_id, parent_id, relationship, created_at, updated_at)
+VALUES
+($1,$2,$3,$4,$5)
+ON CONFLICT (student_id, parent_id)
+DO UPDATE SET relationship = EXCLUDED.relationship, updated_at = EXCLUDED.updated_at, deleted_at = NULL;
+
+-- name: UnlinkStudentParents :exec
+UPDATE student_parents SET deleted_at = $1 WHERE student_id = $2 AND deleted_at IS NULL;
+
+-- name: FindParentById :one
+SELECT id, first_name, last_name, birthday, rg_document, cpf_document, email FROM parents WHERE id = $1 AND deleted_at IS NULL LIMIT 1;
+
+-- name: FindParentByCpf :one
+SELECT id, first_name, last_name, birthday, rg_document, cpf_document, email FROM parents WHERE cpf_document = $1 AND deleted_at IS NULL LIMIT 1;
+
+-- name: FindParentsByStudent :many
+SELECT p.id, p.first_name, p.last_name, p.birthday, p.rg_document, p.cpf_document, p.email, sp.relationship
+FROM parents p
+    JOIN student_parents sp ON sp.parent_id = p.id
+WHERE sp.student_id = $1
+    AND sp.deleted_at IS NULL
+    AND p.deleted_at IS NULL
+ORDER BY sp.created_at;
+
+-- name: FindParentChildren :many
+SELECT s.id, s.first_name, s.last_name, s.cpf_document, sp.relationship
+FROM student_parents sp
+    JOIN students s ON s.id = sp.student_id
+WHERE sp.parent_id = $1
+    AND sp.deleted_at IS NULL
+    AND s.deleted_at IS NULL
+ORDER BY s.first_name, s.last_name;
\ No newline at end of file
@@ -0,0 +1,43 @@
+-- name: CreatePayment :exec
+INSERT INTO payments
+(id, invoice_id, registration_id, student_id, method, value, applied_value, paid_at, created_at, reference)
+VALUES
+($1,$2,$3,$4,$5,$6,$7,$8,$9,$10);
+
+-- name: CountPaymentsByReference :one
+SELECT COUNT(*) FROM payments WHERE reference = $1;
+
+-- name: FindPaymentsByInvoice :many
+SELECT id, invoice_id, registration_id, student_id, method, value, applied_value, paid_at
+FROM payments
+    WHERE invoice_id = $1
+    ORDER BY paid_at ASC;
+
+-- name: CreateStudentCredit :exec
+INSERT INTO student_credits
+(id, student_id, payment_id, value, balance, created_at, updated_at)
+VALUES
+($1,$2,$3,$4,$5,$6,$7);
+
+-- name: FindAvailableStudentCreditsLock :many
+SELECT id, student_id, payment_id, value, balance, created_at
+FROM student_credits
+    WHERE student_id = $1 AND balance > 0
+    ORDER BY created_at ASC
+    FOR UPDATE;
+
+-- name: UpdateStudentCreditBalance :exec
+UPDATE student_credits SET balance = $1, updated_at = $2 WHERE id = $3;
+
+-- name: SumStudentCreditBalance :one
+SELECT COALESCE(SUM(balance), 0)::NUMERIC(10,2) as balance
+FROM student_credits
+    WHERE student_id = $1;
+
+-- name: SumRegistrationInvoices :one
+SELECT student_id,
+       COALESCE(SUM(value), 0)::NUMERIC(10,2) as charged,
+       COALESCE(SUM(paid_value), 0)::NUMERIC(10,2) as paid
+FROM invoices
+    WHERE registration_id = $1 AND status <> 'CANCELLED'
+    GROUP BY student_id;
@@ -6,4 +6,10 @@ VALUES
 ($1,$2,$3,$4);
 
 -- name: DeletePhonesByOwner :exec
-UPDATE phones SET deleted_at = $1 WHERE owner_id = $2;
\ No newline at end of file
+UPDATE phones SET deleted_at = $1 WHERE owner_id = $2;
+
+-- name: FindPhonesByOwner :many
+SELECT id, description, phone, owner_id FROM phones WHERE owner_id = $1 AND deleted_at IS NULL;
+
+-- name: MovePhonesToOwner :exec
+UPDATE phones SET owner_id = $1 WHERE owner_id = $2 AND deleted_at IS NULL;
\ No newline at end of file
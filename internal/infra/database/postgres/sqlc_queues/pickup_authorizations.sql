@@ -0,0 +1,28 @@
+-- name: CreatePickupAuthorization :exec
+INSERT INTO pickup_authorizations
+    (id, student_id, parent_id, name, cpf_document, photo, valid_from, valid_until, created_at, updated_at)
+    VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10);
+
+-- name: DeletePickupAuthorization :exec
+UPDATE pickup_authorizations SET deleted_at = $1 WHERE id = $2;
+
+-- name: FindPickupAuthorizationById :one
+SELECT id, student_id, parent_id, name, cpf_document, photo, valid_from, valid_until
+FROM pickup_authorizations
+    WHERE id = $1
+        AND deleted_at IS NULL;
+
+-- name: FindPickupAuthorizationsByStudent :many
+SELECT id, student_id, parent_id, name, cpf_document, photo, valid_from, valid_until
+FROM pickup_authorizations
+    WHERE student_id = $1
+        AND deleted_at IS NULL
+    ORDER BY name;
+
+-- name: FindPickupAuthorizationsByCpf :many
+SELECT id, student_id, parent_id, name, cpf_document, photo, valid_from, valid_until
+FROM pickup_authorizations
+    WHERE student_id = $1
+        AND cpf_document = $2
+        AND deleted_at IS NULL
+    ORDER BY valid_from;
\ No newline at end of file
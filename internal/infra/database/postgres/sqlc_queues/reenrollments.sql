@@ -0,0 +1,72 @@
+-- name: CreateReenrollmentCampaign :exec
+INSERT INTO reenrollment_campaigns (id, from_school_year_id, to_school_year_id, deadline, created_at) VALUES ($1,$2,$3,$4,$5);
+
+-- name: CreateReenrollmentProposal :exec
+INSERT INTO reenrollment_proposals
+    (id, campaign_id, registration_id, student_id, student_name, class_room_id, class_room, target_level, target_class_room_id, status, reason)
+    VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11);
+
+-- name: FindApprovedRegistrationsBySchoolYear :many
+SELECT id
+FROM registrations
+    WHERE school_year_id = $1
+        AND status = 'APPROVED'
+        AND deleted_at IS NULL
+    ORDER BY created_at;
+
+-- name: FindClassRoomsBySchoolYearAndLevel :many
+SELECT id,
+       status,
+       active,
+       identification,
+       vacancies,
+       vacancies_occupied,
+       shift,
+       level,
+       localization,
+       open_date,
+       school_year_id,
+       room_id,
+       schedule_id,
+       type
+FROM class_room
+    WHERE school_year_id = $1
+        AND level = $2
+        AND status = 'open'
+        AND deleted_at IS NULL
+    ORDER BY identification;
+
+-- name: FindReenrollmentCampaign :one
+SELECT id, from_school_year_id, to_school_year_id, deadline, created_at
+FROM reenrollment_campaigns
+    WHERE id = $1;
+
+-- name: FindReenrollmentCampaignBySchoolYear :one
+SELECT id, from_school_year_id, to_school_year_id, deadline, created_at
+FROM reenrollment_campaigns
+    WHERE from_school_year_id = $1;
+
+-- name: FindReenrollmentProposal :one
+SELECT id, campaign_id, registration_id, student_id, student_name, class_room_id, class_room, target_level,
+       target_class_room_id, status, reason, new_registration_id, responded_at
+FROM reenrollment_proposals
+    WHERE id = $1;
+
+-- name: FindReenrollmentProposalLock :one
+SELECT id, campaign_id, registration_id, student_id, student_name, class_room_id, class_room, target_level,
+       target_class_room_id, status, reason, new_registration_id, responded_at
+FROM reenrollment_proposals
+    WHERE id = $1
+    FOR UPDATE;
+
+-- name: FindReenrollmentProposals :many
+SELECT id, campaign_id, registration_id, student_id, student_name, class_room_id, class_room, target_level,
+       target_class_room_id, status, reason, new_registration_id, responded_at
+FROM reenrollment_proposals
+    WHERE campaign_id = $1
+    ORDER BY class_room, student_name;
+
+-- name: UpdateReenrollmentProposal :exec
+UPDATE reenrollment_proposals
+    SET target_class_room_id = $2, status = $3, reason = $4, new_registration_id = $5, responded_at = $6
+    WHERE id = $1;
\ No newline at end of file
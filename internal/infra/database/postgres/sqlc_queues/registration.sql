@@ -5,8 +5,79 @@ INSERT INTO registrations
     (id, code, class_room_id, shift, student_id, 
      service_id, monthly_fee, installments_quantity,
      enrollment_fee, due_date, month_duration, status,
-     enrollment_date, school_year_id, created_at, updated_at)
-    VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16);
+     enrollment_date, school_year_id, created_at, updated_at,
+     payment_day, paid, financial_responsible_id, financial_responsible_type,
+     financial_responsible_name, financial_responsible_cpf, financial_responsible_email)
+    VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23);
 
     -- name: SearchStudentAlreadyRegistered :one
-    SELECT code FROM registrations WHERE class_room_id = $1 AND student_id = $2 LIMIT 1;
\ No newline at end of file
+    SELECT code FROM registrations
+        WHERE class_room_id = $1
+            AND student_id = $2
+            AND status IN ('WAIT_ENROLLMENT_FEE', 'APPROVED', 'LOCKED')
+            AND deleted_at IS NULL
+        LIMIT 1;
+
+-- name: FindRegistrationById :one
+SELECT id, code, class_room_id, shift, student_id, service_id, monthly_fee,
+       installments_quantity, enrollment_fee, due_date, month_duration, status,
+       enrollment_date, payment_day, paid, financial_responsible_id, financial_responsible_type,
+       financial_responsible_name, financial_responsible_cpf, financial_responsible_email
+FROM registrations
+    WHERE id = $1
+        AND deleted_at IS NULL;
+
+-- name: FindRegistrationByIdLock :one
+SELECT id, code, class_room_id, shift, student_id, service_id, monthly_fee,
+       installments_quantity, enrollment_fee, due_date, month_duration, status,
+       enrollment_date, payment_day, paid, financial_responsible_id, financial_responsible_type,
+       financial_responsible_name, financial_responsible_cpf, financial_responsible_email
+FROM registrations
+    WHERE id = $1
+        AND deleted_at IS NULL
+        FOR UPDATE;
+
+-- name: UpdateRegistrationStatus :exec
+UPDATE registrations SET status = $1, paid = $2, updated_at = $3 WHERE id = $4;
+
+-- name: CreateRegistrationStatusHistory :exec
+INSERT INTO registration_status_history
+    (id, registration_id, previous_status, status, reason, class_room_id, created_at, approved_by)
+    VALUES ($1,$2,$3,$4,$5,$6,$7,$8);
+
+-- name: FindRegistrationStatusHistory :many
+SELECT id, registration_id, previous_status, status, reason, class_room_id, created_at, approved_by
+FROM registration_status_history
+    WHERE registration_id = $1
+    ORDER BY created_at;
+
+-- name: CreateRegistrationDiscount :exec
+INSERT INTO registration_discounts
+    (id, registration_id, kind, type, value, reason, valid_from, valid_until, approved_by, created_at)
+    VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10);
+
+-- name: FindRegistrationDiscounts :many
+SELECT id, registration_id, kind, type, value, reason, valid_from, valid_until, approved_by
+FROM registration_discounts
+    WHERE registration_id = $1
+    ORDER BY created_at;
+
+-- name: CountActiveSiblingRegistrations :one
+SELECT COUNT(DISTINCT r.id)
+FROM registrations r
+    JOIN student_parents sp ON sp.student_id = r.student_id AND sp.deleted_at IS NULL
+    JOIN parents p ON p.id = sp.parent_id AND p.deleted_at IS NULL
+WHERE p.cpf_document = ANY(sqlc.arg(parent_cpfs)::varchar[])
+    AND r.student_id <> sqlc.arg(student_id)
+    AND r.status IN ('WAIT_ENROLLMENT_FEE', 'APPROVED', 'LOCKED')
+    AND r.deleted_at IS NULL;
+
+-- name: FindRegistrationsByStudent :many
+SELECT r.id, r.code, r.status, r.shift, r.enrollment_date,
+       r.class_room_id, c.identification, r.school_year_id, y.year
+FROM registrations r
+    LEFT JOIN class_room c ON c.id = r.class_room_id
+    LEFT JOIN school_year y ON y.id = r.school_year_id
+WHERE r.student_id = $1
+    AND r.deleted_at IS NULL
+ORDER BY r.enrollment_date DESC;
\ No newline at end of file
@@ -1,11 +1,11 @@
 -- name: CreateService :exec
-INSERT into services (id, description, price, created_at, updated_at) VALUES ($1,$2,$3,$4,$5);
+INSERT into services (id, description, price, fine_percentage, daily_interest_percentage, discount_percentage, discount_days_before, created_at, updated_at) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9);
 
 -- name: UpdateService :exec
-UPDATE services SET description = $1, price = $2, updated_at = $3 WHERE id = $4;
+UPDATE services SET description = $1, price = $2, fine_percentage = $3, daily_interest_percentage = $4, discount_percentage = $5, discount_days_before = $6, updated_at = $7 WHERE id = $8;
 
 -- name: DeleteService :exec
 UPDATE services SET deleted_at = $1 WHERE id = $2;
 
 -- name: FindServiceById :one
-SELECT id, description, price FROM services WHERE id = $1 AND deleted_at IS NULL;
\ No newline at end of file
+SELECT id, description, price, fine_percentage, daily_interest_percentage, discount_percentage, discount_days_before FROM services WHERE id = $1 AND deleted_at IS NULL;
\ No newline at end of file
@@ -2,9 +2,78 @@
 
 -- name: CreateStudent :exec
 INSERT INTO students 
-(id, first_name, last_name, birthday, rg_document, cpf_document, email, him_self_responsible, created_at, updated_at)
+(id, first_name, last_name, birthday, rg_document, cpf_document, email, him_self_responsible, created_at, updated_at, document_type, document_number)
 VALUES
-($1,$2,$3,$4,$5,$6,$7,$8,$9,$10);
+($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12);
 
 -- name: FindByCPFDocument :one
-SELECT id, first_name, last_name, birthday, rg_document, cpf_document, email, him_self_responsible FROM students WHERE cpf_document = $1 LIMIT 1;
\ No newline at end of file
+SELECT id, first_name, last_name, birthday, rg_document, cpf_document, email, him_self_responsible FROM students WHERE cpf_document = $1 AND deleted_at IS NULL LIMIT 1;
+
+-- name: FindStudentById :one
+SELECT id, first_name, last_name, birthday, rg_document, cpf_document, email, him_self_responsible, academic_responsible_id, document_type, document_number FROM students WHERE id = $1 AND deleted_at IS NULL LIMIT 1;
+
+-- name: FindStudentByDocument :one
+SELECT id FROM students WHERE document_type = $1 AND document_number = $2 AND deleted_at IS NULL LIMIT 1;
+
+-- name: UpdateStudent :exec
+UPDATE students SET first_name = $1, last_name = $2, birthday = $3, rg_document = $4,
+    cpf_document = $5, email = $6, him_self_responsible = $7, updated_at = $8,
+    document_type = $9, document_number = $10
+WHERE id = $11 AND deleted_at IS NULL;
+
+-- name: UpdateStudentAcademicResponsible :exec
+UPDATE students SET academic_responsible_id = $1, updated_at = $2 WHERE id = $3 AND deleted_at IS NULL;
+
+-- name: DeleteStudent :exec
+UPDATE students SET deleted_at = $1 WHERE id = $2;
+
+-- name: FindDuplicateCandidates :many
+SELECT s.id, s.first_name, s.last_name, s.birthday, s.cpf_document, s.email,
+    COALESCE(string_agg(p.cpf_document, ',' ORDER BY p.cpf_document), '')::text AS parent_cpfs
+FROM students s
+    LEFT JOIN student_parents sp ON sp.student_id = s.id AND sp.deleted_at IS NULL
+    LEFT JOIN parents p ON p.id = sp.parent_id AND p.deleted_at IS NULL
+WHERE s.deleted_at IS NULL
+GROUP BY s.id
+ORDER BY s.first_name, s.last_name;
+
+-- name: MoveRegistrationsToStudent :exec
+UPDATE registrations SET student_id = $1 WHERE student_id = $2;
+
+-- name: MoveInvoicesToStudent :exec
+UPDATE invoices SET student_id = $1 WHERE student_id = $2;
+
+-- name: MovePaymentsToStudent :exec
+UPDATE payments SET student_id = $1 WHERE student_id = $2;
+
+-- name: MoveStudentCreditsToStudent :exec
+UPDATE student_credits SET student_id = $1 WHERE student_id = $2;
+
+-- name: MoveWaitingListToStudent :exec
+UPDATE waiting_list SET student_id = $1
+WHERE student_id = $2
+    AND NOT (status = 'WAITING' AND class_room_id IN (
+        SELECT w.class_room_id FROM waiting_list w WHERE w.student_id = $1 AND w.status = 'WAITING'
+    ));
+
+-- name: MovePickupAuthorizationsToStudent :exec
+UPDATE pickup_authorizations SET student_id = $1 WHERE student_id = $2;
+
+-- name: MoveStudentDocumentsToStudent :exec
+UPDATE student_documents SET student_id = $1 WHERE student_id = $2;
+
+-- name: MoveReenrollmentProposalsToStudent :exec
+UPDATE reenrollment_proposals SET student_id = $1 WHERE student_id = $2;
+
+-- name: DeleteConflictingAttendances :exec
+DELETE FROM attendances
+WHERE student_id = $2
+    AND EXISTS (
+        SELECT 1 FROM attendances a
+        WHERE a.student_id = $1 AND a.class_room_id = attendances.class_room_id
+            AND a.subject_id = attendances.subject_id AND a.schedule_id = attendances.schedule_id
+            AND a.lesson_date = attendances.lesson_date
+    );
+
+-- name: MoveAttendancesToStudent :exec
+UPDATE attendances SET student_id = $1 WHERE student_id = $2;
@@ -0,0 +1,96 @@
+-- name: CreateTeacher :exec
+INSERT INTO teachers
+(id, first_name, last_name, birthday, rg_document, cpf_document, email, status, hired_at, dismissed_at, created_at, updated_at)
+VALUES
+($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12);
+
+-- name: UpdateTeacher :exec
+UPDATE teachers SET
+    first_name = $1, last_name = $2, birthday = $3, rg_document = $4, cpf_document = $5, email = $6,
+    status = $7, hired_at = $8, dismissed_at = $9, updated_at = $10
+WHERE id = $11;
+
+-- name: DeleteTeacher :exec
+UPDATE teachers SET deleted_at = $1 WHERE id = $2;
+
+-- name: FindTeacherById :one
+SELECT id, first_name, last_name, birthday, rg_document, cpf_document, email, status, hired_at, dismissed_at
+FROM teachers WHERE id = $1 AND deleted_at IS NULL LIMIT 1;
+
+-- name: FindTeacherByCpf :one
+SELECT id, first_name, last_name, birthday, rg_document, cpf_document, email, status, hired_at, dismissed_at
+FROM teachers WHERE cpf_document = $1 AND deleted_at IS NULL LIMIT 1;
+
+-- name: CreateTeacherQualification :exec
+INSERT INTO teacher_qualifications
+(id, teacher_id, degree, course, institution, conclusion_year)
+VALUES
+($1,$2,$3,$4,$5,$6);
+
+-- name: DeleteTeacherQualifications :exec
+DELETE FROM teacher_qualifications WHERE teacher_id = $1;
+
+-- name: FindTeacherQualifications :many
+SELECT id, degree, course, institution, conclusion_year FROM teacher_qualifications WHERE teacher_id = $1 ORDER BY conclusion_year;
+
+-- name: CreateTeacherAssignment :exec
+INSERT INTO teacher_assignments
+(id, teacher_id, class_room_id, school_year_id, subject_id, subject, created_at)
+VALUES
+($1,$2,$3,$4,$5,$6,$7);
+
+-- name: DeleteTeacherAssignment :exec
+UPDATE teacher_assignments SET deleted_at = $1 WHERE id = $2 AND deleted_at IS NULL;
+
+-- name: DeleteTeacherAssignmentsByTeacher :exec
+UPDATE teacher_assignments SET deleted_at = $1 WHERE teacher_id = $2 AND deleted_at IS NULL;
+
+-- name: FindTeacherAssignment :one
+SELECT ta.id, ta.teacher_id, t.first_name, t.last_name, ta.class_room_id, c.identification, ta.school_year_id, ta.subject_id, ta.subject
+FROM teacher_assignments ta
+    JOIN teachers t ON t.id = ta.teacher_id
+    JOIN class_room c ON c.id = ta.class_room_id
+WHERE ta.class_room_id = sqlc.arg(class_room_id)
+    AND ta.subject_id = sqlc.arg(subject_id)
+    AND ta.deleted_at IS NULL
+LIMIT 1;
+
+-- name: FindTeacherAssignments :many
+SELECT ta.id, ta.teacher_id, t.first_name, t.last_name, ta.class_room_id, c.identification, ta.school_year_id, ta.subject_id, ta.subject
+FROM teacher_assignments ta
+    JOIN teachers t ON t.id = ta.teacher_id
+    JOIN class_room c ON c.id = ta.class_room_id
+WHERE ta.teacher_id = $1
+    AND ta.deleted_at IS NULL
+ORDER BY c.identification, ta.subject;
+
+-- name: FindTeacherAssignmentsBySchoolYear :many
+SELECT ta.id, ta.teacher_id, t.first_name, t.last_name, ta.class_room_id, c.identification, ta.school_year_id, ta.subject_id, ta.subject
+FROM teacher_assignments ta
+    JOIN teachers t ON t.id = ta.teacher_id
+    JOIN class_room c ON c.id = ta.class_room_id
+WHERE ta.teacher_id = $1
+    AND ta.school_year_id = $2
+    AND ta.deleted_at IS NULL
+ORDER BY c.identification, ta.subject;
+
+-- name: FindClassRoomAssignments :many
+SELECT ta.id, ta.teacher_id, t.first_name, t.last_name, ta.class_room_id, c.identification, ta.school_year_id, ta.subject_id, ta.subject
+FROM teacher_assignments ta
+    JOIN teachers t ON t.id = ta.teacher_id
+    JOIN class_room c ON c.id = ta.class_room_id
+WHERE ta.class_room_id = $1
+    AND ta.deleted_at IS NULL
+ORDER BY ta.subject;
+
+-- name: CreateTeacherAvailability :exec
+INSERT INTO teacher_availabilities (id, teacher_id, weekday, start_at, end_at) VALUES ($1, $2, $3, $4, $5);
+
+-- name: DeleteTeacherAvailability :exec
+DELETE FROM teacher_availabilities WHERE teacher_id = $1;
+
+-- name: FindTeacherAvailability :many
+SELECT weekday, start_at, end_at
+FROM teacher_availabilities
+WHERE teacher_id = $1
+ORDER BY weekday, start_at;
\ No newline at end of file
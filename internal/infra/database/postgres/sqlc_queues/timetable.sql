@@ -0,0 +1,41 @@
+-- name: CreateTimetableLesson :exec
+INSERT INTO timetable_lessons (id, school_year_id, class_room_id, subject_id, teacher_id, room_id, schedule_id, weekday, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+
+-- name: UpdateTimetableLesson :exec
+UPDATE timetable_lessons SET room_id = $1, schedule_id = $2, weekday = $3, updated_at = $4 WHERE id = $5;
+
+-- name: DeleteTimetableLesson :exec
+DELETE FROM timetable_lessons WHERE id = $1;
+
+-- name: DeleteTimetableLessonsBySchoolYear :exec
+DELETE FROM timetable_lessons WHERE school_year_id = $1;
+
+-- name: FindTimetableLessonById :one
+SELECT l.id, l.school_year_id, l.class_room_id, c.identification, l.subject_id, s.name, l.teacher_id, t.first_name, t.last_name,
+       l.room_id, r.code, l.schedule_id, l.weekday, cs.start_at, cs.end_at
+FROM timetable_lessons l
+    JOIN class_room c ON c.id = l.class_room_id
+    JOIN subjects s ON s.id = l.subject_id
+    JOIN teachers t ON t.id = l.teacher_id
+    JOIN rooms r ON r.id = l.room_id
+    JOIN class_schedule cs ON cs.id = l.schedule_id
+WHERE l.id = $1;
+
+-- name: FindTimetableLessons :many
+SELECT l.id, l.school_year_id, l.class_room_id, c.identification, l.subject_id, s.name, l.teacher_id, t.first_name, t.last_name,
+       l.room_id, r.code, l.schedule_id, l.weekday, cs.start_at, cs.end_at
+FROM timetable_lessons l
+    JOIN class_room c ON c.id = l.class_room_id
+    JOIN subjects s ON s.id = l.subject_id
+    JOIN teachers t ON t.id = l.teacher_id
+    JOIN rooms r ON r.id = l.room_id
+    JOIN class_schedule cs ON cs.id = l.schedule_id
+WHERE l.school_year_id = $1
+ORDER BY c.identification, l.weekday, cs.start_at;
+
+-- name: FindTimetableRooms :many
+SELECT id, code, capacity
+FROM rooms
+WHERE deleted_at IS NULL
+ORDER BY code;
\ No newline at end of file
@@ -0,0 +1,32 @@
+-- name: CreateWaitingList :exec
+INSERT INTO waiting_list
+(id, class_room_id, student_id, service_id, shift, monthly_fee, installments_quantity, enrollment_fee, month_duration, payment_day, priority, request_date, status, created_at, updated_at)
+VALUES
+($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15);
+
+-- name: FindWaitingListById :one
+SELECT id, class_room_id, student_id, service_id, shift, monthly_fee, installments_quantity, enrollment_fee, month_duration, payment_day, priority, request_date, status, registration_id
+FROM waiting_list
+    WHERE id = $1;
+
+-- name: FindNextWaitingListLock :one
+SELECT id, class_room_id, student_id, service_id, shift, monthly_fee, installments_quantity, enrollment_fee, month_duration, payment_day, priority, request_date, status, registration_id
+FROM waiting_list
+    WHERE class_room_id = $1
+        AND status = 'WAITING'
+    ORDER BY priority DESC, request_date ASC
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED;
+
+-- name: CountWaitingListAhead :one
+SELECT COUNT(*) FROM waiting_list
+    WHERE class_room_id = $1
+        AND status = 'WAITING'
+        AND (priority > $2 OR (priority = $2 AND request_date < $3));
+
+-- name: UpdateWaitingListStatus :exec
+UPDATE waiting_list SET
+        status = $1,
+        registration_id = $2,
+        updated_at = $3
+WHERE id = $4;
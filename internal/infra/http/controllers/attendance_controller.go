@@ -0,0 +1,199 @@
+package controllers
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	requestvalidator "github.com/henriquerocha2004/sistema-escolar/internal/infra/http/request_validator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/attendance"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/attendance/attendanceService"
+)
+
+type AttendanceController struct {
+	attendanceActions attendanceService.AttendanceActionsInterface
+}
+
+func NewAttendanceController(aa attendanceService.AttendanceActionsInterface) *AttendanceController {
+	return &AttendanceController{
+		attendanceActions: aa,
+	}
+}
+
+// RollCall Registra a chamada de toda a turma em uma aula
+func (a *AttendanceController) RollCall(ctx *fiber.Ctx) error {
+	var dtoRequest attendance.RollCallRequestDto
+
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	records, err := a.attendanceActions.RollCall(dtoRequest)
+	if err != nil {
+		return ctx.Status(attendanceErrorStatus(err)).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(NewResponseDto(
+		"success",
+		"roll call recorded with success",
+		records,
+	))
+}
+
+// FindRollCall Chamada da aula informada nos parametros class_room_id, subject_id, schedule_id e date
+func (a *AttendanceController) FindRollCall(ctx *fiber.Ctx) error {
+	classRoomId := ctx.Query("class_room_id")
+	subjectId := ctx.Query("subject_id")
+	scheduleId := ctx.Query("schedule_id")
+	date := ctx.Query("date")
+
+	if classRoomId == "" || subjectId == "" || scheduleId == "" || date == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"class room, subject, schedule and date must be provided",
+			nil,
+		))
+	}
+
+	records, err := a.attendanceActions.FindRollCall(classRoomId, subjectId, scheduleId, date)
+	if err != nil {
+		return ctx.Status(attendanceErrorStatus(err)).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		records,
+	))
+}
+
+func (a *AttendanceController) ChangeRecord(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"attendance id is not provided",
+			nil,
+		))
+	}
+
+	var dtoRequest attendance.RecordRequestDto
+
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	record, err := a.attendanceActions.ChangeRecord(id, dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"attendance updated with success",
+		record,
+	))
+}
+
+// Frequency Frequencia da turma por aluno e disciplina. Aceita os parametros student_id e terms
+// (quantidade de etapas do ano letivo)
+func (a *AttendanceController) Frequency(ctx *fiber.Ctx) error {
+	classRoomId := ctx.Params("id")
+	if classRoomId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"class room id is not provided",
+			nil,
+		))
+	}
+
+	frequencies, err := a.attendanceActions.Frequency(classRoomId, ctx.Query("student_id"), ctx.QueryInt("terms", 0))
+	if err != nil {
+		return ctx.Status(attendanceErrorStatus(err)).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		frequencies,
+	))
+}
+
+// Alerts Alunos abaixo da frequencia minima no ano letivo informado no parametro school_year_id.
+// A turma pode ser informada no parametro class_room_id
+func (a *AttendanceController) Alerts(ctx *fiber.Ctx) error {
+	schoolYearId := ctx.Query("school_year_id")
+	if schoolYearId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"school year id is not provided",
+			nil,
+		))
+	}
+
+	alerts, err := a.attendanceActions.Alerts(schoolYearId, ctx.Query("class_room_id"))
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		alerts,
+	))
+}
+
+func attendanceErrorStatus(err error) int {
+	if errors.Is(err, attendance.ErrClassRoomNotFound) {
+		return fiber.StatusNotFound
+	}
+
+	return fiber.StatusBadRequest
+}
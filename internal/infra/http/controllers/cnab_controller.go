@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"io"
+
+	"github.com/gofiber/fiber/v2"
+	requestvalidator "github.com/henriquerocha2004/sistema-escolar/internal/infra/http/request_validator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/cnab"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/cnab/cnabService"
+)
+
+type CnabController struct {
+	cnabActions cnabService.CnabActionsInterface
+}
+
+func NewCnabController(ca cnabService.CnabActionsInterface) *CnabController {
+	return &CnabController{
+		cnabActions: ca,
+	}
+}
+
+// Remittance Gera o arquivo de remessa e devolve o arquivo para download
+func (c *CnabController) Remittance(ctx *fiber.Ctx) error {
+	var dtoRequest cnab.RemittanceRequestDto
+
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	file, err := c.cnabActions.Remittance(dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	ctx.Attachment(file.Name)
+
+	return ctx.Status(fiber.StatusCreated).Send(file.Content)
+}
+
+// Return Recebe o arquivo de retorno enviado no campo "file" e o leiaute no campo "layout"
+func (c *CnabController) Return(ctx *fiber.Ctx) error {
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"return file is not provided",
+			nil,
+		))
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid return file provided",
+			nil,
+		))
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid return file provided",
+			nil,
+		))
+	}
+
+	dtoRequest := cnab.ReturnRequestDto{
+		Layout:  ctx.FormValue("layout"),
+		Content: content,
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	result, err := c.cnabActions.Return(dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"return file processed",
+		result,
+	))
+}
+
+func (c *CnabController) FindByInvoice(ctx *fiber.Ctx) error {
+	invoiceId := ctx.Params("invoiceId")
+	if invoiceId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invoice id is not provided",
+			nil,
+		))
+	}
+
+	titles, err := c.cnabActions.FindByInvoice(invoiceId)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		titles,
+	))
+}
@@ -0,0 +1,98 @@
+package controllers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	requestvalidator "github.com/henriquerocha2004/sistema-escolar/internal/infra/http/request_validator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/contract"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/contract/contractService"
+)
+
+type ContractController struct {
+	contractActions contractService.ContractActionsInterface
+}
+
+func NewContractController(ca contractService.ContractActionsInterface) *ContractController {
+	return &ContractController{
+		contractActions: ca,
+	}
+}
+
+// Download Devolve o PDF do contrato da matricula. O hash do arquivo segue no cabecalho X-Contract-Hash
+func (c *ContractController) Download(ctx *fiber.Ctx) error {
+	registrationId := ctx.Params("id")
+	if registrationId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"registration id is not provided",
+			nil,
+		))
+	}
+
+	file, err := c.contractActions.Download(registrationId)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	ctx.Attachment(file.Name)
+	ctx.Set("X-Contract-Hash", file.Hash)
+
+	return ctx.Status(fiber.StatusOK).Send(file.Content)
+}
+
+func (c *ContractController) FindTemplate(ctx *fiber.Ctx) error {
+	template, err := c.contractActions.FindTemplate()
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		template,
+	))
+}
+
+func (c *ContractController) UpdateTemplate(ctx *fiber.Ctx) error {
+	var dtoRequest contract.TemplateRequestDto
+
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	template, err := c.contractActions.UpdateTemplate(dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(NewResponseDto(
+		"success",
+		"contract template updated with success",
+		template,
+	))
+}
@@ -0,0 +1,389 @@
+package controllers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	requestvalidator "github.com/henriquerocha2004/sistema-escolar/internal/infra/http/request_validator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/parsers"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/curriculum"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/curriculum/curriculumService"
+)
+
+type CurriculumController struct {
+	curriculumActions curriculumService.CurriculumActionsInterface
+}
+
+func NewCurriculumController(ca curriculumService.CurriculumActionsInterface) *CurriculumController {
+	return &CurriculumController{
+		curriculumActions: ca,
+	}
+}
+
+func (c *CurriculumController) CreateSubject(ctx *fiber.Ctx) error {
+	var dtoRequest curriculum.SubjectRequestDto
+
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	subject, err := c.curriculumActions.CreateSubject(dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(NewResponseDto(
+		"success",
+		"subject created with success",
+		subject,
+	))
+}
+
+func (c *CurriculumController) UpdateSubject(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"subject id is not provided",
+			nil,
+		))
+	}
+
+	var dtoRequest curriculum.SubjectRequestDto
+
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	err = c.curriculumActions.UpdateSubject(id, dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"subject updated with success",
+		nil,
+	))
+}
+
+func (c *CurriculumController) DeleteSubject(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"subject id is not provided",
+			nil,
+		))
+	}
+
+	err := c.curriculumActions.DeleteSubject(id)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"subject deleted with success",
+		nil,
+	))
+}
+
+func (c *CurriculumController) FindSubject(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"subject id is not provided",
+			nil,
+		))
+	}
+
+	subject, err := c.curriculumActions.FindSubject(id)
+	if err != nil {
+		return ctx.Status(fiber.StatusNotFound).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		subject,
+	))
+}
+
+func (c *CurriculumController) FindSubjects(ctx *fiber.Ctx) error {
+	paginatorRequestDto, err := parsers.ParseRequestPaginator(ctx)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	validateMessages := requestvalidator.ValidateRequest(paginatorRequestDto)
+	if validateMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"failed to validate data",
+			validateMessages,
+		))
+	}
+
+	subjects, err := c.curriculumActions.FindSubjects(*paginatorRequestDto)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		subjects,
+	))
+}
+
+func (c *CurriculumController) CreateMatrix(ctx *fiber.Ctx) error {
+	var dtoRequest curriculum.MatrixRequestDto
+
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	matrix, err := c.curriculumActions.CreateMatrix(dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(NewResponseDto(
+		"success",
+		"curriculum matrix created with success",
+		matrix,
+	))
+}
+
+func (c *CurriculumController) UpdateMatrix(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"curriculum matrix id is not provided",
+			nil,
+		))
+	}
+
+	var dtoRequest curriculum.MatrixRequestDto
+
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	err = c.curriculumActions.UpdateMatrix(id, dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"curriculum matrix updated with success",
+		nil,
+	))
+}
+
+func (c *CurriculumController) DeleteMatrix(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"curriculum matrix id is not provided",
+			nil,
+		))
+	}
+
+	err := c.curriculumActions.DeleteMatrix(id)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"curriculum matrix deleted with success",
+		nil,
+	))
+}
+
+func (c *CurriculumController) FindMatrix(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"curriculum matrix id is not provided",
+			nil,
+		))
+	}
+
+	matrix, err := c.curriculumActions.FindMatrix(id)
+	if err != nil {
+		return ctx.Status(fiber.StatusNotFound).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		matrix,
+	))
+}
+
+// FindMatrices Matrizes do ano letivo informado no parametro school_year_id
+func (c *CurriculumController) FindMatrices(ctx *fiber.Ctx) error {
+	schoolYearId := ctx.Query("school_year_id")
+	if schoolYearId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"school year id is not provided",
+			nil,
+		))
+	}
+
+	matrices, err := c.curriculumActions.FindMatrices(schoolYearId)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		matrices,
+	))
+}
+
+// Clone Copia as matrizes de um ano letivo para outro
+func (c *CurriculumController) Clone(ctx *fiber.Ctx) error {
+	var dtoRequest curriculum.CloneRequestDto
+
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	result, err := c.curriculumActions.Clone(dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(NewResponseDto(
+		"success",
+		"curriculum matrices cloned with success",
+		result,
+	))
+}
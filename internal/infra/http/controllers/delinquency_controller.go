@@ -0,0 +1,70 @@
+package controllers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	requestvalidator "github.com/henriquerocha2004/sistema-escolar/internal/infra/http/request_validator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/parsers"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/delinquency/delinquencyService"
+)
+
+type DelinquencyController struct {
+	delinquencyActions delinquencyService.DelinquencyActionsInterface
+}
+
+func NewDelinquencyController(da delinquencyService.DelinquencyActionsInterface) *DelinquencyController {
+	return &DelinquencyController{
+		delinquencyActions: da,
+	}
+}
+
+// Report Relatorio paginado de inadimplencia na data informada na query date
+func (d *DelinquencyController) Report(ctx *fiber.Ctx) error {
+	paginatorRequestDto, err := parsers.ParseRequestPaginator(ctx)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	validateMessages := requestvalidator.ValidateRequest(paginatorRequestDto)
+	if validateMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"failed to validate data",
+			validateMessages,
+		))
+	}
+
+	debtors, err := d.delinquencyActions.Report(ctx.Query("date"), *paginatorRequestDto)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		debtors,
+	))
+}
+
+// Export Relatorio de inadimplencia em CSV para download
+func (d *DelinquencyController) Export(ctx *fiber.Ctx) error {
+	content, err := d.delinquencyActions.Export(ctx.Query("date"))
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	ctx.Attachment("delinquency.csv")
+
+	return ctx.Status(fiber.StatusOK).Send(content)
+}
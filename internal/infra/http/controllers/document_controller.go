@@ -0,0 +1,305 @@
+package controllers
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	requestvalidator "github.com/henriquerocha2004/sistema-escolar/internal/infra/http/request_validator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/document"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/document/documentService"
+)
+
+type DocumentController struct {
+	documentActions documentService.DocumentActionsInterface
+}
+
+func NewDocumentController(da documentService.DocumentActionsInterface) *DocumentController {
+	return &DocumentController{
+		documentActions: da,
+	}
+}
+
+// Upload Recebe o arquivo no campo "file", o tipo do documento no campo "kind" e, opcionalmente,
+// a matricula no campo "registration_id". O tipo do arquivo e identificado pelo conteudo
+func (d *DocumentController) Upload(ctx *fiber.Ctx) error {
+	studentId := ctx.Params("studentId")
+	if studentId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"student id is not provided",
+			nil,
+		))
+	}
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"document file is not provided",
+			nil,
+		))
+	}
+
+	if fileHeader.Size > document.MaxFileSize {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"document file exceeds the maximum size of 10 MB",
+			nil,
+		))
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid document file provided",
+			nil,
+		))
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid document file provided",
+			nil,
+		))
+	}
+
+	dtoRequest := document.UploadRequestDto{
+		Kind:           ctx.FormValue("kind"),
+		RegistrationId: ctx.FormValue("registration_id"),
+		FileName:       fileHeader.Filename,
+		ContentType:    http.DetectContentType(content),
+		Content:        content,
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	doc, err := d.documentActions.Upload(studentId, dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(NewResponseDto(
+		"success",
+		"document uploaded with success",
+		doc,
+	))
+}
+
+func (d *DocumentController) FindByStudent(ctx *fiber.Ctx) error {
+	studentId := ctx.Params("studentId")
+	if studentId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"student id is not provided",
+			nil,
+		))
+	}
+
+	documents, err := d.documentActions.FindByStudent(studentId)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		documents,
+	))
+}
+
+func (d *DocumentController) Download(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"document id is not provided",
+			nil,
+		))
+	}
+
+	file, err := d.documentActions.Download(id)
+	if err != nil {
+		return ctx.Status(fiber.StatusNotFound).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	ctx.Attachment(file.Name)
+	ctx.Set(fiber.HeaderContentType, file.ContentType)
+
+	return ctx.Status(fiber.StatusOK).Send(file.Content)
+}
+
+func (d *DocumentController) ChangeStatus(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"document id is not provided",
+			nil,
+		))
+	}
+
+	var dtoRequest document.StatusRequestDto
+
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	doc, err := d.documentActions.ChangeStatus(id, dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"document status updated with success",
+		doc,
+	))
+}
+
+func (d *DocumentController) Checklist(ctx *fiber.Ctx) error {
+	registrationId := ctx.Params("registrationId")
+	if registrationId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"registration id is not provided",
+			nil,
+		))
+	}
+
+	checklist, err := d.documentActions.Checklist(registrationId)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		checklist,
+	))
+}
+
+func (d *DocumentController) CreateRequirement(ctx *fiber.Ctx) error {
+	var dtoRequest document.RequirementRequestDto
+
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	requirement, err := d.documentActions.CreateRequirement(dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(NewResponseDto(
+		"success",
+		"document requirement created with success",
+		requirement,
+	))
+}
+
+func (d *DocumentController) DeleteRequirement(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"requirement id is not provided",
+			nil,
+		))
+	}
+
+	err := d.documentActions.DeleteRequirement(id)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"document requirement deleted with success",
+		nil,
+	))
+}
+
+func (d *DocumentController) FindRequirements(ctx *fiber.Ctx) error {
+	requirements, err := d.documentActions.FindRequirements()
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		requirements,
+	))
+}
@@ -0,0 +1,158 @@
+package controllers
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/gofiber/fiber/v2"
+	requestvalidator "github.com/henriquerocha2004/sistema-escolar/internal/infra/http/request_validator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/idcard"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/idcard/idcardService"
+)
+
+type IdCardController struct {
+	idCardActions idcardService.IdCardActionsInterface
+}
+
+func NewIdCardController(ia idcardService.IdCardActionsInterface) *IdCardController {
+	return &IdCardController{
+		idCardActions: ia,
+	}
+}
+
+// UploadPhoto Recebe a foto do aluno no campo "file". O tipo da imagem e identificado pelo conteudo
+func (i *IdCardController) UploadPhoto(ctx *fiber.Ctx) error {
+	studentId := ctx.Params("studentId")
+	if studentId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"student id is not provided",
+			nil,
+		))
+	}
+
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"photo file is not provided",
+			nil,
+		))
+	}
+
+	if fileHeader.Size > idcard.MaxPhotoSize {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"photo file exceeds the maximum size of 2 MB",
+			nil,
+		))
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid photo file provided",
+			nil,
+		))
+	}
+	defer file.Close()
+
+	content, err := io.ReadAll(file)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid photo file provided",
+			nil,
+		))
+	}
+
+	dtoRequest := idcard.PhotoRequestDto{
+		ContentType: http.DetectContentType(content),
+		Content:     content,
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	err = i.idCardActions.UploadPhoto(studentId, dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(NewResponseDto(
+		"success",
+		"student photo uploaded with success",
+		nil,
+	))
+}
+
+func (i *IdCardController) DownloadPhoto(ctx *fiber.Ctx) error {
+	studentId := ctx.Params("studentId")
+	if studentId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"student id is not provided",
+			nil,
+		))
+	}
+
+	file, err := i.idCardActions.DownloadPhoto(studentId)
+	if err != nil {
+		return ctx.Status(fiber.StatusNotFound).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	ctx.Set(fiber.HeaderContentType, file.ContentType)
+
+	return ctx.Status(fiber.StatusOK).Send(file.Content)
+}
+
+func (i *IdCardController) Cards(ctx *fiber.Ctx) error {
+	var dtoRequest idcard.CardRequestDto
+
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	file, err := i.idCardActions.Cards(dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	ctx.Attachment(file.Name)
+	ctx.Set(fiber.HeaderContentType, "application/pdf")
+
+	return ctx.Status(fiber.StatusOK).Send(file.Content)
+}
@@ -0,0 +1,128 @@
+package controllers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	requestvalidator "github.com/henriquerocha2004/sistema-escolar/internal/infra/http/request_validator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/parsers"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice/invoiceService"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+)
+
+type InvoiceController struct {
+	invoiceActions invoiceService.InvoiceActionsInterface
+}
+
+func NewInvoiceController(ia invoiceService.InvoiceActionsInterface) *InvoiceController {
+	return &InvoiceController{
+		invoiceActions: ia,
+	}
+}
+
+func (i *InvoiceController) Find(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invoice id is not provided",
+			nil,
+		))
+	}
+
+	inv, err := i.invoiceActions.FindById(id)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		inv,
+	))
+}
+
+func (i *InvoiceController) AmountDue(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invoice id is not provided",
+			nil,
+		))
+	}
+
+	amountDue, err := i.invoiceActions.AmountDue(id, ctx.Query("date"))
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		amountDue,
+	))
+}
+
+func (i *InvoiceController) FindByStudent(ctx *fiber.Ctx) error {
+	return i.list(ctx, "studentId", "student id is not provided", i.invoiceActions.FindByStudent)
+}
+
+func (i *InvoiceController) FindByRegistration(ctx *fiber.Ctx) error {
+	return i.list(ctx, "registrationId", "registration id is not provided", i.invoiceActions.FindByRegistration)
+}
+
+func (i *InvoiceController) list(
+	ctx *fiber.Ctx,
+	param string,
+	missingMessage string,
+	find func(id string, dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error),
+) error {
+	id := ctx.Params(param)
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			missingMessage,
+			nil,
+		))
+	}
+
+	paginatorRequestDto, err := parsers.ParseRequestPaginator(ctx)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	validateMessages := requestvalidator.ValidateRequest(paginatorRequestDto)
+	if validateMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"failed to validate data",
+			validateMessages,
+		))
+	}
+
+	invoices, err := find(id, *paginatorRequestDto)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		invoices,
+	))
+}
@@ -0,0 +1,105 @@
+package controllers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	requestvalidator "github.com/henriquerocha2004/sistema-escolar/internal/infra/http/request_validator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/parsers"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/parent/parentService"
+)
+
+type ParentController struct {
+	parentActions parentService.ParentActionsInterface
+}
+
+func NewParentController(pa parentService.ParentActionsInterface) *ParentController {
+	return &ParentController{
+		parentActions: pa,
+	}
+}
+
+func (c *ParentController) Find(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"parent id is not provided",
+			nil,
+		))
+	}
+
+	parent, err := c.parentActions.Find(id)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		parent,
+	))
+}
+
+func (c *ParentController) FindAll(ctx *fiber.Ctx) error {
+	paginatorRequestDto, err := parsers.ParseRequestPaginator(ctx)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	validateMessages := requestvalidator.ValidateRequest(paginatorRequestDto)
+	if validateMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"failed to validate data",
+			validateMessages,
+		))
+	}
+
+	parents, err := c.parentActions.FindAll(*paginatorRequestDto)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		parents,
+	))
+}
+
+func (c *ParentController) Children(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"parent id is not provided",
+			nil,
+		))
+	}
+
+	children, err := c.parentActions.FindChildren(id)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		children,
+	))
+}
@@ -0,0 +1,175 @@
+package controllers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	requestvalidator "github.com/henriquerocha2004/sistema-escolar/internal/infra/http/request_validator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/parsers"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/payment"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/payment/paymentService"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+)
+
+type PaymentController struct {
+	paymentActions paymentService.PaymentActionsInterface
+}
+
+func NewPaymentController(pa paymentService.PaymentActionsInterface) *PaymentController {
+	return &PaymentController{
+		paymentActions: pa,
+	}
+}
+
+func (p *PaymentController) Register(ctx *fiber.Ctx) error {
+	invoiceId := ctx.Params("invoiceId")
+	if invoiceId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invoice id is not provided",
+			nil,
+		))
+	}
+
+	var dtoRequest payment.RequestDto
+
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	response, err := p.paymentActions.Register(invoiceId, dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(NewResponseDto(
+		"success",
+		"payment registered with success",
+		response,
+	))
+}
+
+func (p *PaymentController) FindByInvoice(ctx *fiber.Ctx) error {
+	invoiceId := ctx.Params("invoiceId")
+	if invoiceId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invoice id is not provided",
+			nil,
+		))
+	}
+
+	payments, err := p.paymentActions.FindByInvoice(invoiceId)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		payments,
+	))
+}
+
+func (p *PaymentController) FindByStudent(ctx *fiber.Ctx) error {
+	return p.list(ctx, "studentId", "student id is not provided", p.paymentActions.FindByStudent)
+}
+
+func (p *PaymentController) FindByRegistration(ctx *fiber.Ctx) error {
+	return p.list(ctx, "registrationId", "registration id is not provided", p.paymentActions.FindByRegistration)
+}
+
+func (p *PaymentController) Balance(ctx *fiber.Ctx) error {
+	registrationId := ctx.Params("registrationId")
+	if registrationId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"registration id is not provided",
+			nil,
+		))
+	}
+
+	balance, err := p.paymentActions.Balance(registrationId)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		balance,
+	))
+}
+
+func (p *PaymentController) list(
+	ctx *fiber.Ctx,
+	param string,
+	missingMessage string,
+	find func(id string, dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error),
+) error {
+	id := ctx.Params(param)
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			missingMessage,
+			nil,
+		))
+	}
+
+	paginatorRequestDto, err := parsers.ParseRequestPaginator(ctx)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	validateMessages := requestvalidator.ValidateRequest(paginatorRequestDto)
+	if validateMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"failed to validate data",
+			validateMessages,
+		))
+	}
+
+	payments, err := find(id, *paginatorRequestDto)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		payments,
+	))
+}
@@ -0,0 +1,144 @@
+package controllers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	requestvalidator "github.com/henriquerocha2004/sistema-escolar/internal/infra/http/request_validator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/pickup"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/pickup/pickupService"
+)
+
+type PickupController struct {
+	pickupActions pickupService.PickupActionsInterface
+}
+
+func NewPickupController(pa pickupService.PickupActionsInterface) *PickupController {
+	return &PickupController{
+		pickupActions: pa,
+	}
+}
+
+func (p *PickupController) Authorize(ctx *fiber.Ctx) error {
+	studentId := ctx.Params("studentId")
+	if studentId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"student id is not provided",
+			nil,
+		))
+	}
+
+	var dtoRequest pickup.RequestDto
+
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	authorization, err := p.pickupActions.Authorize(studentId, dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(NewResponseDto(
+		"success",
+		"pickup authorization created with success",
+		authorization,
+	))
+}
+
+func (p *PickupController) FindByStudent(ctx *fiber.Ctx) error {
+	studentId := ctx.Params("studentId")
+	if studentId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"student id is not provided",
+			nil,
+		))
+	}
+
+	authorizations, err := p.pickupActions.FindByStudent(studentId)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		authorizations,
+	))
+}
+
+func (p *PickupController) Revoke(ctx *fiber.Ctx) error {
+	studentId := ctx.Params("studentId")
+	id := ctx.Params("id")
+	if studentId == "" || id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"pickup authorization id is not provided",
+			nil,
+		))
+	}
+
+	err := p.pickupActions.Revoke(studentId, id)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"pickup authorization revoked with success",
+		nil,
+	))
+}
+
+// Check Conferencia na portaria: informa se o CPF recebido em ?cpf= pode retirar o aluno hoje
+func (p *PickupController) Check(ctx *fiber.Ctx) error {
+	studentId := ctx.Params("studentId")
+	if studentId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"student id is not provided",
+			nil,
+		))
+	}
+
+	response, err := p.pickupActions.Check(studentId, ctx.Query("cpf"))
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		response,
+	))
+}
@@ -0,0 +1,55 @@
+package controllers
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/pix/pixService"
+)
+
+type PixController struct {
+	pixActions pixService.PixActionsInterface
+}
+
+func NewPixController(pa pixService.PixActionsInterface) *PixController {
+	return &PixController{
+		pixActions: pa,
+	}
+}
+
+// Charge Gera o BR Code (copia e cola) e o QR Code em PNG (base64) da fatura. O tipo da cobranca
+// e informado pela query kind (static ou dynamic)
+func (p *PixController) Charge(ctx *fiber.Ctx) error {
+	invoiceId := ctx.Params("invoiceId")
+	if invoiceId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invoice id is not provided",
+			nil,
+		))
+	}
+
+	charge, err := p.pixActions.Charge(invoiceId, ctx.Query("kind"))
+	if err != nil {
+		return ctx.Status(pixErrorStatus(err)).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		charge,
+	))
+}
+
+func pixErrorStatus(err error) int {
+	if errors.Is(err, invoice.ErrInvoiceNotFound) {
+		return fiber.StatusNotFound
+	}
+
+	return fiber.StatusBadRequest
+}
@@ -0,0 +1,54 @@
+package controllers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/henriquerocha2004/sistema-escolar/internal/mocks"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/pix"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldReturnPixChargeErrors(t *testing.T) {
+	scenaries := []struct {
+		Description          string
+		InvoiceId            string
+		Err                  error
+		ExpectedCodeResponse int
+	}{
+		{
+			Description:          "when invoice does not exist",
+			InvoiceId:            "a1c003f8-8a56-4a49-892a-825b364cc076",
+			Err:                  invoice.ErrInvoiceNotFound,
+			ExpectedCodeResponse: 404,
+		},
+		{
+			Description:          "when charge cannot be created",
+			InvoiceId:            "76d0f9b7-3d7d-4cfc-892a-94c0704b4deb",
+			Err:                  errors.New("invoice is not payable"),
+			ExpectedCodeResponse: 400,
+		},
+	}
+
+	for _, scenario := range scenaries {
+		t.Run(scenario.Description, func(t *testing.T) {
+			pixActions := new(mocks.PixActionsMock)
+			pixActions.On("Charge", scenario.InvoiceId, "").Return((*pix.Charge)(nil), scenario.Err)
+			pixController := NewPixController(pixActions)
+
+			app := fiber.New()
+			app.Get("/pix/invoice/:invoiceId", pixController.Charge)
+			request := httptest.NewRequest("GET", "/pix/invoice/"+scenario.InvoiceId, nil)
+			response, _ := app.Test(request)
+			var m map[string]interface{}
+			_ = json.NewDecoder(response.Body).Decode(&m)
+			_ = response.Body.Close()
+			assert.Equal(t, scenario.ExpectedCodeResponse, response.StatusCode)
+			assert.Equal(t, scenario.Err.Error(), m["message"])
+		})
+	}
+}
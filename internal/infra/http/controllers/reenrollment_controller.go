@@ -0,0 +1,201 @@
+package controllers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	requestvalidator "github.com/henriquerocha2004/sistema-escolar/internal/infra/http/request_validator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/reenrollment"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/reenrollment/reenrollmentService"
+)
+
+type ReenrollmentController struct {
+	reenrollmentActions reenrollmentService.ReenrollmentActionsInterface
+}
+
+func NewReenrollmentController(ra reenrollmentService.ReenrollmentActionsInterface) *ReenrollmentController {
+	return &ReenrollmentController{
+		reenrollmentActions: ra,
+	}
+}
+
+func (r *ReenrollmentController) CreateCampaign(ctx *fiber.Ctx) error {
+	var dtoRequest reenrollment.CampaignRequestDto
+
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	campaign, err := r.reenrollmentActions.CreateCampaign(dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(NewResponseDto(
+		"success",
+		"re-enrollment campaign created with success",
+		campaign,
+	))
+}
+
+func (r *ReenrollmentController) FindProposals(ctx *fiber.Ctx) error {
+	campaignId := ctx.Params("id")
+	if campaignId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"campaign id is not provided",
+			nil,
+		))
+	}
+
+	proposals, err := r.reenrollmentActions.FindProposals(campaignId)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		proposals,
+	))
+}
+
+func (r *ReenrollmentController) Report(ctx *fiber.Ctx) error {
+	campaignId := ctx.Params("id")
+	if campaignId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"campaign id is not provided",
+			nil,
+		))
+	}
+
+	report, err := r.reenrollmentActions.Report(campaignId)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		report,
+	))
+}
+
+func (r *ReenrollmentController) Confirm(ctx *fiber.Ctx) error {
+	proposalId := ctx.Params("id")
+	if proposalId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"proposal id is not provided",
+			nil,
+		))
+	}
+
+	var dtoRequest reenrollment.ConfirmRequestDto
+
+	if len(ctx.Body()) > 0 {
+		err := ctx.BodyParser(&dtoRequest)
+		if err != nil {
+			return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+				"error",
+				"invalid data provided",
+				nil,
+			))
+		}
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	response, err := r.reenrollmentActions.Confirm(proposalId, dtoRequest)
+	if err != nil {
+		return ctx.Status(registrationErrorStatus(err)).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(NewResponseDto(
+		"success",
+		"re-enrollment confirmed with success",
+		response,
+	))
+}
+
+func (r *ReenrollmentController) Decline(ctx *fiber.Ctx) error {
+	proposalId := ctx.Params("id")
+	if proposalId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"proposal id is not provided",
+			nil,
+		))
+	}
+
+	var dtoRequest reenrollment.DeclineRequestDto
+
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	err = r.reenrollmentActions.Decline(proposalId, dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"re-enrollment declined with success",
+		nil,
+	))
+}
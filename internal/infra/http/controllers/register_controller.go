@@ -1,9 +1,14 @@
 package controllers
 
 import (
+	"errors"
+	"log"
+
+	requestvalidator "github.com/henriquerocha2004/sistema-escolar/internal/infra/http/request_validator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/delinquency"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration/registrationService"
-	"log"
 
 	"github.com/gofiber/fiber/v2"
 )
@@ -33,9 +38,9 @@ func (r *RegisterController) Create(ctx *fiber.Ctx) error {
 
 	registrationResponse, err := r.registerActions.Create(registerDto)
 	if err != nil {
-		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+		return ctx.Status(registrationErrorStatus(err)).JSON(NewResponseDto(
 			"error",
-			"error in create registration",
+			err.Error(),
 			nil,
 		))
 	}
@@ -46,3 +51,181 @@ func (r *RegisterController) Create(ctx *fiber.Ctx) error {
 		registrationResponse,
 	))
 }
+
+func (r *RegisterController) Cancel(ctx *fiber.Ctx) error {
+	return r.changeStatus(ctx, r.registerActions.Cancel, "registration cancelled with success")
+}
+
+func (r *RegisterController) Lock(ctx *fiber.Ctx) error {
+	return r.changeStatus(ctx, r.registerActions.Lock, "registration locked with success")
+}
+
+func (r *RegisterController) Reactivate(ctx *fiber.Ctx) error {
+	return r.changeStatus(ctx, r.registerActions.Reactivate, "registration reactivated with success")
+}
+
+func (r *RegisterController) Conclude(ctx *fiber.Ctx) error {
+	return r.changeStatus(ctx, r.registerActions.Conclude, "registration concluded with success")
+}
+
+func (r *RegisterController) Transfer(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"registration id is not provided",
+			nil,
+		))
+	}
+
+	var transferDto registration.TransferRequestDto
+	err := ctx.BodyParser(&transferDto)
+	if err != nil {
+		log.Println(err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Data provided is invalid",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&transferDto)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	registrationResponse, err := r.registerActions.Transfer(id, transferDto)
+	if err != nil {
+		return ctx.Status(registrationErrorStatus(err)).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"registration transferred with success",
+		registrationResponse,
+	))
+}
+
+func (r *RegisterController) History(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"registration id is not provided",
+			nil,
+		))
+	}
+
+	history, err := r.registerActions.History(id)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		history,
+	))
+}
+
+func (r *RegisterController) Discounts(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"registration id is not provided",
+			nil,
+		))
+	}
+
+	discounts, err := r.registerActions.Discounts(id)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		discounts,
+	))
+}
+
+func (r *RegisterController) changeStatus(
+	ctx *fiber.Ctx,
+	action func(id string, dto registration.StatusRequestDto) error,
+	successMessage string,
+) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"registration id is not provided",
+			nil,
+		))
+	}
+
+	var statusDto registration.StatusRequestDto
+	err := ctx.BodyParser(&statusDto)
+	if err != nil {
+		log.Println(err)
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Data provided is invalid",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&statusDto)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	err = action(id, statusDto)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		successMessage,
+		nil,
+	))
+}
+
+// registrationErrorStatus Turma sem vagas retorna 409 e liberacao de debito sem aprovador autorizado
+// retorna 403, para o cliente diferenciar de dados invalidos
+func registrationErrorStatus(err error) int {
+	if errors.Is(err, classroom.ErrClassRoomFull) {
+		return fiber.StatusConflict
+	}
+
+	if errors.Is(err, delinquency.ErrUnauthorizedApprover) {
+		return fiber.StatusForbidden
+	}
+
+	return fiber.StatusBadRequest
+}
@@ -224,3 +224,57 @@ func (s *ScheduleController) SyncSchedule(ctx *fiber.Ctx) error {
 		nil,
 	))
 }
+
+// RoomAgenda Agenda semanal da sala no ano letivo informado no parametro school_year_id
+func (s *ScheduleController) RoomAgenda(ctx *fiber.Ctx) error {
+	roomId := ctx.Params("id")
+	schoolYearId := ctx.Query("school_year_id")
+	if roomId == "" || schoolYearId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"room id and school year id must be provided",
+			nil,
+		))
+	}
+
+	agenda, err := s.scheduleActions.RoomAgenda(roomId, schoolYearId)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		agenda,
+	))
+}
+
+func (s *ScheduleController) ClassRoomAgenda(ctx *fiber.Ctx) error {
+	classRoomId := ctx.Params("id")
+	if classRoomId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"class room id is not provided",
+			nil,
+		))
+	}
+
+	agenda, err := s.scheduleActions.ClassRoomAgenda(classRoomId)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		agenda,
+	))
+}
@@ -56,12 +56,12 @@ func TestShouldReturnErrorIfNotPassAllNecessaryParamsInCreateService(t *testing.
 func TestShouldCreateServiceWithSuccess(t *testing.T) {
 
 	actionsService := new(mocks.ServiceActionsMock)
-	actionsService.On("Create", mock.AnythingOfType("paginator.ServiceRequestDto")).Return(nil)
+	actionsService.On("Create", mock.AnythingOfType("service.Request")).Return(nil)
 	serviceController := NewServiceController(actionsService)
 
 	app := fiber.New()
 	app.Post("/service", serviceController.Create)
-	data := `{"description": "any description"}`
+	data := `{"description": "any description", "value": 150.00}`
 	request := httptest.NewRequest("POST", "/service", bytes.NewReader([]byte(data)))
 	request.Header.Set("Content-Type", "application/json")
 	response, _ := app.Test(request)
@@ -115,9 +115,9 @@ func TestShouldReturnErrorIfNotPassAllNecessaryDataInUpdateService(t *testing.T)
 
 func TestShouldUpdateServiceWithSuccess(t *testing.T) {
 	actionsService := new(mocks.ServiceActionsMock)
-	actionsService.On("Update", "1da90050-e182-4551-923d-2c60f72b545a", mock.AnythingOfType("paginator.ServiceRequestDto")).Return(nil)
+	actionsService.On("Update", "1da90050-e182-4551-923d-2c60f72b545a", mock.AnythingOfType("service.Request")).Return(nil)
 	serviceController := NewServiceController(actionsService)
-	data := `{"description": "2020"}`
+	data := `{"description": "2020", "value": 150.00}`
 	app := fiber.New()
 	app.Put("/service/:id", serviceController.Update)
 	request := httptest.NewRequest("PUT", "/service/1da90050-e182-4551-923d-2c60f72b545a", bytes.NewReader([]byte(data)))
@@ -0,0 +1,269 @@
+package controllers
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	requestvalidator "github.com/henriquerocha2004/sistema-escolar/internal/infra/http/request_validator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/parsers"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student/studentService"
+)
+
+type StudentController struct {
+	studentActions studentService.StudentActionsInterface
+}
+
+func NewStudentController(sa studentService.StudentActionsInterface) *StudentController {
+	return &StudentController{
+		studentActions: sa,
+	}
+}
+
+func (c *StudentController) Update(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"student id is not provided",
+			nil,
+		))
+	}
+
+	var dtoRequest student.UpdateRequestDto
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	err = c.studentActions.Update(id, dtoRequest)
+	if err != nil {
+		return ctx.Status(studentErrorStatus(err)).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"student updated with success",
+		nil,
+	))
+}
+
+func (c *StudentController) ChangeAcademicResponsible(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"student id is not provided",
+			nil,
+		))
+	}
+
+	var dtoRequest student.AcademicResponsibleRequestDto
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	err = c.studentActions.ChangeAcademicResponsible(id, dtoRequest)
+	if err != nil {
+		return ctx.Status(studentErrorStatus(err)).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"academic responsible updated with success",
+		nil,
+	))
+}
+
+func (c *StudentController) Delete(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"student id is not provided",
+			nil,
+		))
+	}
+
+	err := c.studentActions.Delete(id)
+	if err != nil {
+		return ctx.Status(studentErrorStatus(err)).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"student deleted with success",
+		nil,
+	))
+}
+
+func (c *StudentController) Find(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"student id is not provided",
+			nil,
+		))
+	}
+
+	detail, err := c.studentActions.Find(id)
+	if err != nil {
+		return ctx.Status(studentErrorStatus(err)).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		detail,
+	))
+}
+
+func (c *StudentController) FindAll(ctx *fiber.Ctx) error {
+	paginatorRequestDto, err := parsers.ParseRequestPaginator(ctx)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	validateMessages := requestvalidator.ValidateRequest(paginatorRequestDto)
+	if validateMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"failed to validate data",
+			validateMessages,
+		))
+	}
+
+	students, err := c.studentActions.FindAll(*paginatorRequestDto)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		students,
+	))
+}
+
+func (c *StudentController) FindDuplicates(ctx *fiber.Ctx) error {
+	duplicates, err := c.studentActions.FindDuplicates()
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		duplicates,
+	))
+}
+
+func (c *StudentController) Merge(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"student id is not provided",
+			nil,
+		))
+	}
+
+	var dtoRequest student.MergeRequestDto
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	err = c.studentActions.Merge(id, dtoRequest)
+	if err != nil {
+		return ctx.Status(studentErrorStatus(err)).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"students merged with success",
+		nil,
+	))
+}
+
+// studentErrorStatus Aluno inexistente retorna 404; os demais erros mantem o 500
+func studentErrorStatus(err error) int {
+	if errors.Is(err, student.ErrStudentNotFound) {
+		return fiber.StatusNotFound
+	}
+
+	return fiber.StatusInternalServerError
+}
@@ -0,0 +1,218 @@
+package controllers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	requestvalidator "github.com/henriquerocha2004/sistema-escolar/internal/infra/http/request_validator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/timetable"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/timetable/timetableService"
+)
+
+type TimetableController struct {
+	timetableActions timetableService.TimetableActionsInterface
+}
+
+func NewTimetableController(ta timetableService.TimetableActionsInterface) *TimetableController {
+	return &TimetableController{
+		timetableActions: ta,
+	}
+}
+
+// Generate Gera a grade horaria do ano letivo. As restricoes nao atendidas acompanham a grade gerada
+func (t *TimetableController) Generate(ctx *fiber.Ctx) error {
+	var dtoRequest timetable.GenerateRequestDto
+
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	result, err := t.timetableActions.Generate(dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(NewResponseDto(
+		"success",
+		"timetable generated with success",
+		result,
+	))
+}
+
+// Find Grade do ano letivo. A turma pode ser informada no parametro class_room_id
+func (t *TimetableController) Find(ctx *fiber.Ctx) error {
+	schoolYearId := ctx.Params("schoolYearId")
+	if schoolYearId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"school year id is not provided",
+			nil,
+		))
+	}
+
+	lessons, err := t.timetableActions.Find(schoolYearId, ctx.Query("class_room_id"))
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		lessons,
+	))
+}
+
+func (t *TimetableController) Validate(ctx *fiber.Ctx) error {
+	schoolYearId := ctx.Params("schoolYearId")
+	if schoolYearId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"school year id is not provided",
+			nil,
+		))
+	}
+
+	conflicts, err := t.timetableActions.Validate(schoolYearId)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		conflicts,
+	))
+}
+
+func (t *TimetableController) CreateLesson(ctx *fiber.Ctx) error {
+	var dtoRequest timetable.LessonRequestDto
+
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	lesson, err := t.timetableActions.CreateLesson(dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusCreated).JSON(NewResponseDto(
+		"success",
+		"lesson created with success",
+		lesson,
+	))
+}
+
+func (t *TimetableController) UpdateLesson(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"lesson id is not provided",
+			nil,
+		))
+	}
+
+	var dtoRequest timetable.MoveLessonRequestDto
+
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	lesson, err := t.timetableActions.UpdateLesson(id, dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"lesson updated with success",
+		lesson,
+	))
+}
+
+func (t *TimetableController) DeleteLesson(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"lesson id is not provided",
+			nil,
+		))
+	}
+
+	err := t.timetableActions.DeleteLesson(id)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"lesson deleted with success",
+		nil,
+	))
+}
@@ -0,0 +1,152 @@
+package controllers
+
+import (
+	"github.com/gofiber/fiber/v2"
+	requestvalidator "github.com/henriquerocha2004/sistema-escolar/internal/infra/http/request_validator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/parsers"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/waitinglist"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/waitinglist/waitingListService"
+)
+
+type WaitingListController struct {
+	waitingListActions waitingListService.WaitingListActionsInterface
+}
+
+func NewWaitingListController(wa waitingListService.WaitingListActionsInterface) *WaitingListController {
+	return &WaitingListController{
+		waitingListActions: wa,
+	}
+}
+
+func (w *WaitingListController) Join(ctx *fiber.Ctx) error {
+	var dtoRequest waitinglist.RequestDto
+
+	err := ctx.BodyParser(&dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"invalid data provided",
+			nil,
+		))
+	}
+
+	validationMessages := requestvalidator.ValidateRequest(&dtoRequest)
+	if validationMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"Failed to validate data",
+			validationMessages,
+		))
+	}
+
+	candidate, err := w.waitingListActions.Join(dtoRequest)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"student added to waiting list with success",
+		candidate,
+	))
+}
+
+func (w *WaitingListController) FindByClassRoom(ctx *fiber.Ctx) error {
+	classRoomId := ctx.Params("classRoomId")
+	if classRoomId == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"class room id is not provided",
+			nil,
+		))
+	}
+
+	paginatorRequestDto, err := parsers.ParseRequestPaginator(ctx)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	validateMessages := requestvalidator.ValidateRequest(paginatorRequestDto)
+	if validateMessages != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"failed to validate data",
+			validateMessages,
+		))
+	}
+
+	candidates, err := w.waitingListActions.FindByClassRoom(classRoomId, *paginatorRequestDto)
+	if err != nil {
+		return ctx.Status(fiber.StatusInternalServerError).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		candidates,
+	))
+}
+
+func (w *WaitingListController) Position(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"waiting list id is not provided",
+			nil,
+		))
+	}
+
+	position, err := w.waitingListActions.Position(id)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"",
+		position,
+	))
+}
+
+func (w *WaitingListController) Withdraw(ctx *fiber.Ctx) error {
+	id := ctx.Params("id")
+	if id == "" {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			"waiting list id is not provided",
+			nil,
+		))
+	}
+
+	err := w.waitingListActions.Withdraw(id)
+	if err != nil {
+		return ctx.Status(fiber.StatusBadRequest).JSON(NewResponseDto(
+			"error",
+			err.Error(),
+			nil,
+		))
+	}
+
+	return ctx.Status(fiber.StatusOK).JSON(NewResponseDto(
+		"success",
+		"student removed from waiting list with success",
+		nil,
+	))
+}
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/container"
+)
+
+func setAttendanceRoutes(app *fiber.App, container *container.ContainerDependency) {
+	attendance := app.Group("attendance")
+	attendance.Post("/roll-call", container.GetAttendanceController().RollCall)
+	attendance.Get("/roll-call", container.GetAttendanceController().FindRollCall)
+	attendance.Get("/alerts", container.GetAttendanceController().Alerts)
+	attendance.Get("/class-room/:id/frequency", container.GetAttendanceController().Frequency)
+	attendance.Put("/:id", container.GetAttendanceController().ChangeRecord)
+}
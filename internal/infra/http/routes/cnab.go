@@ -0,0 +1,13 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/container"
+)
+
+func setCnabRoutes(app *fiber.App, container *container.ContainerDependency) {
+	cnab := app.Group("cnab")
+	cnab.Post("/remittance", container.GetCnabController().Remittance)
+	cnab.Post("/return", container.GetCnabController().Return)
+	cnab.Get("/invoice/:invoiceId", container.GetCnabController().FindByInvoice)
+}
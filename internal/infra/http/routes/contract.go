@@ -0,0 +1,12 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/container"
+)
+
+func setContractRoutes(app *fiber.App, container *container.ContainerDependency) {
+	contract := app.Group("contract")
+	contract.Get("/template", container.GetContractController().FindTemplate)
+	contract.Put("/template", container.GetContractController().UpdateTemplate)
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/container"
+)
+
+func setCurriculumRoutes(app *fiber.App, container *container.ContainerDependency) {
+	curriculum := app.Group("curriculum")
+	curriculum.Get("/subject", container.GetCurriculumController().FindSubjects)
+	curriculum.Post("/subject", container.GetCurriculumController().CreateSubject)
+	curriculum.Get("/subject/:id", container.GetCurriculumController().FindSubject)
+	curriculum.Put("/subject/:id", container.GetCurriculumController().UpdateSubject)
+	curriculum.Delete("/subject/:id", container.GetCurriculumController().DeleteSubject)
+	curriculum.Get("/matrix", container.GetCurriculumController().FindMatrices)
+	curriculum.Post("/matrix", container.GetCurriculumController().CreateMatrix)
+	curriculum.Post("/matrix/clone", container.GetCurriculumController().Clone)
+	curriculum.Get("/matrix/:id", container.GetCurriculumController().FindMatrix)
+	curriculum.Put("/matrix/:id", container.GetCurriculumController().UpdateMatrix)
+	curriculum.Delete("/matrix/:id", container.GetCurriculumController().DeleteMatrix)
+}
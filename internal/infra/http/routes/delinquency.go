@@ -0,0 +1,12 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/container"
+)
+
+func setDelinquencyRoutes(app *fiber.App, container *container.ContainerDependency) {
+	delinquency := app.Group("delinquency")
+	delinquency.Get("/", container.GetDelinquencyController().Report)
+	delinquency.Get("/export", container.GetDelinquencyController().Export)
+}
@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/container"
+)
+
+func setDocumentRoutes(app *fiber.App, container *container.ContainerDependency) {
+	document := app.Group("document")
+	document.Get("/requirement", container.GetDocumentController().FindRequirements)
+	document.Post("/requirement", container.GetDocumentController().CreateRequirement)
+	document.Delete("/requirement/:id", container.GetDocumentController().DeleteRequirement)
+	document.Get("/student/:studentId", container.GetDocumentController().FindByStudent)
+	document.Post("/student/:studentId", container.GetDocumentController().Upload)
+	document.Get("/registration/:registrationId/check", container.GetDocumentController().Checklist)
+	document.Get("/:id/file", container.GetDocumentController().Download)
+	document.Put("/:id/status", container.GetDocumentController().ChangeStatus)
+}
@@ -0,0 +1,13 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/container"
+)
+
+func setIdCardRoutes(app *fiber.App, container *container.ContainerDependency) {
+	idCard := app.Group("id-card")
+	idCard.Post("/", container.GetIdCardController().Cards)
+	idCard.Get("/student/:studentId/photo", container.GetIdCardController().DownloadPhoto)
+	idCard.Post("/student/:studentId/photo", container.GetIdCardController().UploadPhoto)
+}
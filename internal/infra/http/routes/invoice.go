@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/container"
+)
+
+func setInvoiceRoutes(app *fiber.App, container *container.ContainerDependency) {
+	invoice := app.Group("invoice")
+	invoice.Get("/student/:studentId", container.GetInvoiceController().FindByStudent)
+	invoice.Get("/registration/:registrationId", container.GetInvoiceController().FindByRegistration)
+	invoice.Get("/:id/amount-due", container.GetInvoiceController().AmountDue)
+	invoice.Get("/:id", container.GetInvoiceController().Find)
+}
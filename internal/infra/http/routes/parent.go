@@ -0,0 +1,13 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/container"
+)
+
+func setParentRoutes(app *fiber.App, container *container.ContainerDependency) {
+	parent := app.Group("parent")
+	parent.Get("/", container.GetParentController().FindAll)
+	parent.Get("/:id", container.GetParentController().Find)
+	parent.Get("/:id/children", container.GetParentController().Children)
+}
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/container"
+)
+
+func setPaymentRoutes(app *fiber.App, container *container.ContainerDependency) {
+	payment := app.Group("payment")
+	payment.Post("/invoice/:invoiceId", container.GetPaymentController().Register)
+	payment.Get("/invoice/:invoiceId", container.GetPaymentController().FindByInvoice)
+	payment.Get("/student/:studentId", container.GetPaymentController().FindByStudent)
+	payment.Get("/registration/:registrationId/balance", container.GetPaymentController().Balance)
+	payment.Get("/registration/:registrationId", container.GetPaymentController().FindByRegistration)
+}
@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/container"
+)
+
+func setPickupRoutes(app *fiber.App, container *container.ContainerDependency) {
+	pickup := app.Group("pickup")
+	pickup.Get("/student/:studentId", container.GetPickupController().FindByStudent)
+	pickup.Post("/student/:studentId", container.GetPickupController().Authorize)
+	pickup.Get("/student/:studentId/check", container.GetPickupController().Check)
+	pickup.Delete("/student/:studentId/:id", container.GetPickupController().Revoke)
+}
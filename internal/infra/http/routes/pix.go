@@ -0,0 +1,11 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/container"
+)
+
+func setPixRoutes(app *fiber.App, container *container.ContainerDependency) {
+	pix := app.Group("pix")
+	pix.Get("/invoice/:invoiceId", container.GetPixController().Charge)
+}
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/container"
+)
+
+func setReenrollmentRoutes(app *fiber.App, container *container.ContainerDependency) {
+	reenrollment := app.Group("reenrollment")
+	reenrollment.Post("/", container.GetReenrollmentController().CreateCampaign)
+	reenrollment.Get("/:id/proposals", container.GetReenrollmentController().FindProposals)
+	reenrollment.Get("/:id/report", container.GetReenrollmentController().Report)
+	reenrollment.Post("/proposal/:id/confirm", container.GetReenrollmentController().Confirm)
+	reenrollment.Post("/proposal/:id/decline", container.GetReenrollmentController().Decline)
+}
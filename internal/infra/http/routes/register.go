@@ -8,4 +8,12 @@ import (
 func setRegisterRoutes(app *fiber.App, container *container.ContainerDependency) {
 	register := app.Group("register")
 	register.Post("/", container.GetRegisterController().Create)
+	register.Get("/:id/history", container.GetRegisterController().History)
+	register.Get("/:id/discounts", container.GetRegisterController().Discounts)
+	register.Post("/:id/cancel", container.GetRegisterController().Cancel)
+	register.Post("/:id/lock", container.GetRegisterController().Lock)
+	register.Post("/:id/reactivate", container.GetRegisterController().Reactivate)
+	register.Post("/:id/conclude", container.GetRegisterController().Conclude)
+	register.Post("/:id/transfer", container.GetRegisterController().Transfer)
+	register.Get("/:id/contract", container.GetContractController().Download)
 }
@@ -13,4 +13,21 @@ func GetRoutes(app *fiber.App) {
 	setClassRoomRoutes(app, di)
 	setServiceRoutes(app, di)
 	setRegisterRoutes(app, di)
+	setWaitingListRoutes(app, di)
+	setInvoiceRoutes(app, di)
+	setPaymentRoutes(app, di)
+	setCnabRoutes(app, di)
+	setPixRoutes(app, di)
+	setDelinquencyRoutes(app, di)
+	setStudentRoutes(app, di)
+	setParentRoutes(app, di)
+	setPickupRoutes(app, di)
+	setDocumentRoutes(app, di)
+	setContractRoutes(app, di)
+	setReenrollmentRoutes(app, di)
+	setIdCardRoutes(app, di)
+	setTeacherRoutes(app, di)
+	setCurriculumRoutes(app, di)
+	setTimetableRoutes(app, di)
+	setAttendanceRoutes(app, di)
 }
@@ -13,4 +13,6 @@ func setSchedulesRoutes(app *fiber.App, container *container.ContainerDependency
 	schedules.Put("/:id", container.GetScheduleRoomController().Update)
 	schedules.Delete("/:id", container.GetScheduleRoomController().Delete)
 	schedules.Post("sync-schedule", container.GetScheduleRoomController().SyncSchedule)
+	schedules.Get("/agenda/room/:id", container.GetScheduleRoomController().RoomAgenda)
+	schedules.Get("/agenda/class-room/:id", container.GetScheduleRoomController().ClassRoomAgenda)
 }
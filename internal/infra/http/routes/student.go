@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/container"
+)
+
+func setStudentRoutes(app *fiber.App, container *container.ContainerDependency) {
+	student := app.Group("student")
+	student.Get("/", container.GetStudentController().FindAll)
+	student.Get("/duplicates", container.GetStudentController().FindDuplicates)
+	student.Get("/:id", container.GetStudentController().Find)
+	student.Put("/:id", container.GetStudentController().Update)
+	student.Delete("/:id", container.GetStudentController().Delete)
+	student.Put("/:id/academic-responsible", container.GetStudentController().ChangeAcademicResponsible)
+	student.Post("/:id/merge", container.GetStudentController().Merge)
+}
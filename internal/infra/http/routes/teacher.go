@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/container"
+)
+
+func setTeacherRoutes(app *fiber.App, container *container.ContainerDependency) {
+	teacher := app.Group("teacher")
+	teacher.Get("/", container.GetTeacherController().FindAll)
+	teacher.Post("/", container.GetTeacherController().Create)
+	teacher.Get("/class-room/:classRoomId/assignments", container.GetTeacherController().FindClassRoomAssignments)
+	teacher.Delete("/assignment/:assignmentId", container.GetTeacherController().Unassign)
+	teacher.Get("/:id", container.GetTeacherController().Find)
+	teacher.Put("/:id", container.GetTeacherController().Update)
+	teacher.Delete("/:id", container.GetTeacherController().Delete)
+	teacher.Put("/:id/status", container.GetTeacherController().ChangeStatus)
+	teacher.Get("/:id/assignments", container.GetTeacherController().FindAssignments)
+	teacher.Post("/:id/assignments", container.GetTeacherController().Assign)
+	teacher.Get("/:id/availability", container.GetTeacherController().FindAvailability)
+	teacher.Put("/:id/availability", container.GetTeacherController().ChangeAvailability)
+}
@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/container"
+)
+
+func setTimetableRoutes(app *fiber.App, container *container.ContainerDependency) {
+	timetable := app.Group("timetable")
+	timetable.Post("/", container.GetTimetableController().Generate)
+	timetable.Post("/lesson", container.GetTimetableController().CreateLesson)
+	timetable.Put("/lesson/:id", container.GetTimetableController().UpdateLesson)
+	timetable.Delete("/lesson/:id", container.GetTimetableController().DeleteLesson)
+	timetable.Get("/:schoolYearId", container.GetTimetableController().Find)
+	timetable.Get("/:schoolYearId/conflicts", container.GetTimetableController().Validate)
+}
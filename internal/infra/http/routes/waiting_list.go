@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/henriquerocha2004/sistema-escolar/internal/infra/container"
+)
+
+func setWaitingListRoutes(app *fiber.App, container *container.ContainerDependency) {
+	waitingList := app.Group("waiting-list")
+	waitingList.Post("/", container.GetWaitingListController().Join)
+	waitingList.Get("/class-room/:classRoomId", container.GetWaitingListController().FindByClassRoom)
+	waitingList.Get("/:id/position", container.GetWaitingListController().Position)
+	waitingList.Delete("/:id", container.GetWaitingListController().Withdraw)
+}
@@ -0,0 +1,219 @@
+package psp
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/pix"
+)
+
+const (
+	cobExpiration      = 86400
+	payerRequestMaxLen = 140
+	requestTimeout     = 15 * time.Second
+)
+
+// PixConfig Credenciais da API PIX do PSP (padrao do Banco Central). Certificado e chave sao
+// exigidos pelos PSPs que usam mTLS
+type PixConfig struct {
+	BaseUrl      string
+	TokenUrl     string
+	ClientId     string
+	ClientSecret string
+	CertFile     string
+	KeyFile      string
+}
+
+// PixClient Cria as cobrancas imediatas (cob) na API PIX do PSP
+type PixClient struct {
+	config      PixConfig
+	httpClient  *http.Client
+	mutex       sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+func NewPixClient(config PixConfig) (*PixClient, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if config.CertFile != "" {
+		certificate, err := tls.LoadX509KeyPair(config.CertFile, config.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		transport.TLSClientConfig = &tls.Config{
+			Certificates: []tls.Certificate{certificate},
+			MinVersion:   tls.VersionTLS12,
+		}
+	}
+
+	return &PixClient{
+		config: config,
+		httpClient: &http.Client{
+			Timeout:   requestTimeout,
+			Transport: transport,
+		},
+	}, nil
+}
+
+type cobCalendar struct {
+	Expiration int `json:"expiracao"`
+}
+
+type cobValue struct {
+	Original string `json:"original"`
+}
+
+type cobRequest struct {
+	Calendar     *cobCalendar `json:"calendario,omitempty"`
+	Value        cobValue     `json:"valor"`
+	Key          string       `json:"chave,omitempty"`
+	PayerRequest string       `json:"solicitacaoPagador,omitempty"`
+}
+
+type cobResponse struct {
+	Location string `json:"location"`
+}
+
+// CreateCob Cria a cobranca com o txid informado. Se a cobranca ja existir (nova geracao do QR Code
+// da mesma fatura), o valor e revisado para o valor devido no dia
+func (p *PixClient) CreateCob(cob pix.Cob) (string, error) {
+	path := "/cob/" + url.PathEscape(cob.TxId)
+	value := cobValue{Original: strconv.FormatFloat(cob.Amount, 'f', 2, 64)}
+
+	response, found, err := p.send(http.MethodGet, path, nil)
+	if err != nil {
+		return "", err
+	}
+
+	if found {
+		response, _, err = p.send(http.MethodPatch, path, cobRequest{Value: value})
+		if err != nil {
+			return "", err
+		}
+
+		return response.Location, nil
+	}
+
+	payerRequest := []rune(cob.Description)
+	if len(payerRequest) > payerRequestMaxLen {
+		payerRequest = payerRequest[:payerRequestMaxLen]
+	}
+
+	response, _, err = p.send(http.MethodPut, path, cobRequest{
+		Calendar:     &cobCalendar{Expiration: cobExpiration},
+		Value:        value,
+		Key:          cob.Key,
+		PayerRequest: string(payerRequest),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return response.Location, nil
+}
+
+// send Executa a chamada na API. Cobranca inexistente (404) nao e erro: found retorna falso
+func (p *PixClient) send(method string, path string, body interface{}) (*cobResponse, bool, error) {
+	token, err := p.token()
+	if err != nil {
+		return nil, false, err
+	}
+
+	var payload bytes.Buffer
+	if body != nil {
+		err = json.NewEncoder(&payload).Encode(body)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	request, err := http.NewRequest(method, strings.TrimSuffix(p.config.BaseUrl, "/")+path, &payload)
+	if err != nil {
+		return nil, false, err
+	}
+
+	request.Header.Set("Authorization", "Bearer "+token)
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := p.httpClient.Do(request)
+	if err != nil {
+		return nil, false, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotFound && method == http.MethodGet {
+		return nil, false, nil
+	}
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return nil, false, fmt.Errorf("pix psp %s %s returned status %d", method, path, response.StatusCode)
+	}
+
+	var cob cobResponse
+
+	err = json.NewDecoder(response.Body).Decode(&cob)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if cob.Location == "" {
+		return nil, false, fmt.Errorf("pix psp %s %s returned no location", method, path)
+	}
+
+	return &cob, true, nil
+}
+
+// token Token OAuth2 (client credentials), reaproveitado ate perto de expirar
+func (p *PixClient) token() (string, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.accessToken != "" && time.Now().Before(p.expiresAt) {
+		return p.accessToken, nil
+	}
+
+	form := url.Values{"grant_type": {"client_credentials"}}
+
+	request, err := http.NewRequest(http.MethodPost, p.config.TokenUrl, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+
+	request.SetBasicAuth(p.config.ClientId, p.config.ClientSecret)
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	response, err := p.httpClient.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("pix psp token request returned status %d", response.StatusCode)
+	}
+
+	var credentials struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+
+	err = json.NewDecoder(response.Body).Decode(&credentials)
+	if err != nil {
+		return "", err
+	}
+
+	p.accessToken = credentials.AccessToken
+	p.expiresAt = time.Now().Add(time.Duration(credentials.ExpiresIn)*time.Second - time.Minute)
+
+	return p.accessToken, nil
+}
@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LocalStorage Grava os arquivos no sistema de arquivos local a partir do diretorio base
+type LocalStorage struct {
+	basePath string
+}
+
+func NewLocalStorage(basePath string) *LocalStorage {
+	return &LocalStorage{
+		basePath: basePath,
+	}
+}
+
+func (l *LocalStorage) Save(key string, content []byte) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o750)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, content, 0o640)
+}
+
+func (l *LocalStorage) Open(key string) ([]byte, error) {
+	path, err := l.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return os.ReadFile(path)
+}
+
+func (l *LocalStorage) Delete(key string) error {
+	path, err := l.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+// path Caminho do arquivo no disco. Chaves que apontam para fora do diretorio base sao recusadas
+func (l *LocalStorage) path(key string) (string, error) {
+	cleanKey := filepath.Clean("/" + key)
+	if cleanKey == "/" || strings.Contains(key, "..") {
+		return "", errors.New("invalid storage key provided")
+	}
+
+	return filepath.Join(l.basePath, cleanKey), nil
+}
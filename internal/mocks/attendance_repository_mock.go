@@ -0,0 +1,48 @@
+package mocks
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/attendance"
+	"github.com/stretchr/testify/mock"
+)
+
+type AttendanceRepository struct {
+	mock.Mock
+}
+
+func (a *AttendanceRepository) SaveRollCall(records []attendance.Record) error {
+	args := a.Called(records)
+	return args.Error(0)
+}
+
+func (a *AttendanceRepository) Update(record attendance.Record) error {
+	args := a.Called(record)
+	return args.Error(0)
+}
+
+func (a *AttendanceRepository) FindById(id string) (*attendance.Record, error) {
+	args := a.Called(id)
+	return args.Get(0).(*attendance.Record), args.Error(1)
+}
+
+func (a *AttendanceRepository) FindByLesson(lesson attendance.Lesson) ([]attendance.Record, error) {
+	args := a.Called(lesson)
+	return args.Get(0).([]attendance.Record), args.Error(1)
+}
+
+func (a *AttendanceRepository) FindByClassRoom(classRoomId uuid.UUID) ([]attendance.Record, error) {
+	args := a.Called(classRoomId)
+	return args.Get(0).([]attendance.Record), args.Error(1)
+}
+
+func (a *AttendanceRepository) FindBySchoolYear(schoolYearId uuid.UUID) ([]attendance.Record, error) {
+	args := a.Called(schoolYearId)
+	return args.Get(0).([]attendance.Record), args.Error(1)
+}
+
+func (a *AttendanceRepository) FindStudents(classRoomId uuid.UUID, date time.Time) ([]attendance.Student, error) {
+	args := a.Called(classRoomId, date)
+	return args.Get(0).([]attendance.Student), args.Error(1)
+}
@@ -0,0 +1,57 @@
+package mocks
+
+import (
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+	"github.com/stretchr/testify/mock"
+)
+
+type ClassRoomRepository struct {
+	mock.Mock
+}
+
+func (c *ClassRoomRepository) Create(classRoom classroom.ClassRoom) error {
+	args := c.Called(classRoom)
+	return args.Error(0)
+}
+
+func (c *ClassRoomRepository) Delete(id string) error {
+	args := c.Called(id)
+	return args.Error(0)
+}
+
+func (c *ClassRoomRepository) Update(classRoom classroom.ClassRoom) error {
+	args := c.Called(classRoom)
+	return args.Error(0)
+}
+
+func (c *ClassRoomRepository) FindAll(pagination paginator.Pagination) (*paginator.PaginationResult, error) {
+	args := c.Called(pagination)
+	return args.Get(0).(*paginator.PaginationResult), args.Error(1)
+}
+
+func (c *ClassRoomRepository) FindById(id string) (*classroom.ClassRoom, error) {
+	args := c.Called(id)
+	return args.Get(0).(*classroom.ClassRoom), args.Error(1)
+}
+
+func (c *ClassRoomRepository) FindByIdLock(id string) (*classroom.ClassRoom, error) {
+	args := c.Called(id)
+	return args.Get(0).(*classroom.ClassRoom), args.Error(1)
+}
+
+func (c *ClassRoomRepository) FindBySchoolYear(schoolYearId uuid.UUID) ([]classroom.ClassRoom, error) {
+	args := c.Called(schoolYearId)
+	return args.Get(0).([]classroom.ClassRoom), args.Error(1)
+}
+
+func (c *ClassRoomRepository) OccupyVacancies(classRoom classroom.ClassRoom) error {
+	args := c.Called(classRoom)
+	return args.Error(0)
+}
+
+func (c *ClassRoomRepository) ReleaseVacancies(classRoom classroom.ClassRoom) error {
+	args := c.Called(classRoom)
+	return args.Error(0)
+}
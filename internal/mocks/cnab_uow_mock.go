@@ -0,0 +1,57 @@
+package mocks
+
+import (
+	"time"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/cnab"
+	"github.com/stretchr/testify/mock"
+)
+
+type CnabUowMock struct {
+	mock.Mock
+}
+
+func (c *CnabUowMock) BeginTransaction() error {
+	args := c.Called()
+	return args.Error(0)
+}
+
+func (c *CnabUowMock) Commit() error {
+	args := c.Called()
+	return args.Error(0)
+}
+
+func (c *CnabUowMock) Rollback() error {
+	args := c.Called()
+	return args.Error(0)
+}
+
+func (c *CnabUowMock) NextRemittanceSequence() (int, error) {
+	args := c.Called()
+	return args.Int(0), args.Error(1)
+}
+
+func (c *CnabUowMock) NextOurNumber() (int64, error) {
+	args := c.Called()
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (c *CnabUowMock) FindInvoicesToRemit(dueUntil time.Time) ([]cnab.RemittanceItem, error) {
+	args := c.Called(dueUntil)
+	return args.Get(0).([]cnab.RemittanceItem), args.Error(1)
+}
+
+func (c *CnabUowMock) CreateTitles(titles []cnab.Title) error {
+	args := c.Called(titles)
+	return args.Error(0)
+}
+
+func (c *CnabUowMock) FindTitleByOurNumberLock(ourNumber int64) (*cnab.Title, error) {
+	args := c.Called(ourNumber)
+	return args.Get(0).(*cnab.Title), args.Error(1)
+}
+
+func (c *CnabUowMock) UpdateTitleStatus(title cnab.Title) error {
+	args := c.Called(title)
+	return args.Error(0)
+}
@@ -0,0 +1,30 @@
+package mocks
+
+import (
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/contract"
+	"github.com/stretchr/testify/mock"
+)
+
+type ContractRepository struct {
+	mock.Mock
+}
+
+func (c *ContractRepository) Create(issued contract.Contract) error {
+	args := c.Called(issued)
+	return args.Error(0)
+}
+
+func (c *ContractRepository) FindByRegistration(registrationId string) (*contract.Contract, error) {
+	args := c.Called(registrationId)
+	return args.Get(0).(*contract.Contract), args.Error(1)
+}
+
+func (c *ContractRepository) CreateTemplate(template contract.Template) error {
+	args := c.Called(template)
+	return args.Error(0)
+}
+
+func (c *ContractRepository) FindCurrentTemplate() (*contract.Template, error) {
+	args := c.Called()
+	return args.Get(0).(*contract.Template), args.Error(1)
+}
@@ -0,0 +1,82 @@
+package mocks
+
+import (
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/curriculum"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+	"github.com/stretchr/testify/mock"
+)
+
+type CurriculumRepository struct {
+	mock.Mock
+}
+
+func (c *CurriculumRepository) CreateSubject(subject curriculum.Subject) error {
+	args := c.Called(subject)
+	return args.Error(0)
+}
+
+func (c *CurriculumRepository) UpdateSubject(subject curriculum.Subject) error {
+	args := c.Called(subject)
+	return args.Error(0)
+}
+
+func (c *CurriculumRepository) DeleteSubject(id string) error {
+	args := c.Called(id)
+	return args.Error(0)
+}
+
+func (c *CurriculumRepository) FindSubjectById(id string) (*curriculum.Subject, error) {
+	args := c.Called(id)
+	return args.Get(0).(*curriculum.Subject), args.Error(1)
+}
+
+func (c *CurriculumRepository) FindSubjectByCode(code string) (*curriculum.Subject, error) {
+	args := c.Called(code)
+	return args.Get(0).(*curriculum.Subject), args.Error(1)
+}
+
+func (c *CurriculumRepository) FindSubjects(pagination paginator.Pagination) (*paginator.PaginationResult, error) {
+	args := c.Called(pagination)
+	return args.Get(0).(*paginator.PaginationResult), args.Error(1)
+}
+
+func (c *CurriculumRepository) SubjectInUse(subjectId uuid.UUID) (bool, error) {
+	args := c.Called(subjectId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (c *CurriculumRepository) CreateMatrices(matrices []curriculum.Matrix) error {
+	args := c.Called(matrices)
+	return args.Error(0)
+}
+
+func (c *CurriculumRepository) UpdateMatrix(matrix curriculum.Matrix) error {
+	args := c.Called(matrix)
+	return args.Error(0)
+}
+
+func (c *CurriculumRepository) DeleteMatrix(id string) error {
+	args := c.Called(id)
+	return args.Error(0)
+}
+
+func (c *CurriculumRepository) FindMatrixById(id string) (*curriculum.Matrix, error) {
+	args := c.Called(id)
+	return args.Get(0).(*curriculum.Matrix), args.Error(1)
+}
+
+func (c *CurriculumRepository) FindMatrix(level string, schoolYearId uuid.UUID) (*curriculum.Matrix, error) {
+	args := c.Called(level, schoolYearId)
+	return args.Get(0).(*curriculum.Matrix), args.Error(1)
+}
+
+func (c *CurriculumRepository) FindMatrices(schoolYearId uuid.UUID) ([]curriculum.Matrix, error) {
+	args := c.Called(schoolYearId)
+	return args.Get(0).([]curriculum.Matrix), args.Error(1)
+}
+
+func (c *CurriculumRepository) MatrixInUse(matrix curriculum.Matrix) (bool, error) {
+	args := c.Called(matrix)
+	return args.Bool(0), args.Error(1)
+}
@@ -0,0 +1,64 @@
+package mocks
+
+import (
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/document"
+	"github.com/stretchr/testify/mock"
+)
+
+type DocumentRepository struct {
+	mock.Mock
+}
+
+func (d *DocumentRepository) Create(doc document.Document) error {
+	args := d.Called(doc)
+	return args.Error(0)
+}
+
+func (d *DocumentRepository) UpdateStatus(doc document.Document) error {
+	args := d.Called(doc)
+	return args.Error(0)
+}
+
+func (d *DocumentRepository) FindById(id string) (*document.Document, error) {
+	args := d.Called(id)
+	return args.Get(0).(*document.Document), args.Error(1)
+}
+
+func (d *DocumentRepository) FindByStudent(studentId string) ([]document.Document, error) {
+	args := d.Called(studentId)
+	return args.Get(0).([]document.Document), args.Error(1)
+}
+
+func (d *DocumentRepository) CreateRequirement(requirement document.Requirement) error {
+	args := d.Called(requirement)
+	return args.Error(0)
+}
+
+func (d *DocumentRepository) DeleteRequirement(id string) error {
+	args := d.Called(id)
+	return args.Error(0)
+}
+
+func (d *DocumentRepository) FindRequirements() ([]document.Requirement, error) {
+	args := d.Called()
+	return args.Get(0).([]document.Requirement), args.Error(1)
+}
+
+type DocumentStorage struct {
+	mock.Mock
+}
+
+func (d *DocumentStorage) Save(key string, content []byte) error {
+	args := d.Called(key, content)
+	return args.Error(0)
+}
+
+func (d *DocumentStorage) Open(key string) ([]byte, error) {
+	args := d.Called(key)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
+func (d *DocumentStorage) Delete(key string) error {
+	args := d.Called(key)
+	return args.Error(0)
+}
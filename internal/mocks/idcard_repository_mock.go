@@ -0,0 +1,30 @@
+package mocks
+
+import (
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/idcard"
+	"github.com/stretchr/testify/mock"
+)
+
+type IdCardRepository struct {
+	mock.Mock
+}
+
+func (i *IdCardRepository) UpdatePhoto(photo idcard.Photo) error {
+	args := i.Called(photo)
+	return args.Error(0)
+}
+
+func (i *IdCardRepository) FindPhoto(studentId string) (*idcard.Photo, error) {
+	args := i.Called(studentId)
+	return args.Get(0).(*idcard.Photo), args.Error(1)
+}
+
+func (i *IdCardRepository) FindByClassRoom(classRoomId string) ([]idcard.Card, error) {
+	args := i.Called(classRoomId)
+	return args.Get(0).([]idcard.Card), args.Error(1)
+}
+
+func (i *IdCardRepository) FindByStudents(studentIds []string) ([]idcard.Card, error) {
+	args := i.Called(studentIds)
+	return args.Get(0).([]idcard.Card), args.Error(1)
+}
@@ -0,0 +1,26 @@
+package mocks
+
+import (
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+	"github.com/stretchr/testify/mock"
+)
+
+type InvoiceRepositoryMock struct {
+	mock.Mock
+}
+
+func (i *InvoiceRepositoryMock) FindById(id string) (*invoice.Invoice, error) {
+	args := i.Called(id)
+	return args.Get(0).(*invoice.Invoice), args.Error(1)
+}
+
+func (i *InvoiceRepositoryMock) FindByStudent(studentId string, pagination paginator.Pagination) (*paginator.PaginationResult, error) {
+	args := i.Called(studentId, pagination)
+	return args.Get(0).(*paginator.PaginationResult), args.Error(1)
+}
+
+func (i *InvoiceRepositoryMock) FindByRegistration(registrationId string, pagination paginator.Pagination) (*paginator.PaginationResult, error) {
+	args := i.Called(registrationId, pagination)
+	return args.Get(0).(*paginator.PaginationResult), args.Error(1)
+}
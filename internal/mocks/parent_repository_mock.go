@@ -2,6 +2,8 @@ package mocks
 
 import (
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/parent"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -9,7 +11,22 @@ type ParentRepository struct {
 	mock.Mock
 }
 
-func (p *ParentRepository) Create(parent parent.Parent) error {
-	args := p.Called(parent)
-	return args.Error(0)
+func (p *ParentRepository) FindById(id string) (*parent.Parent, error) {
+	args := p.Called(id)
+	return args.Get(0).(*parent.Parent), args.Error(1)
+}
+
+func (p *ParentRepository) FindByCpf(cpf value_objects.CPF) (*parent.Parent, error) {
+	args := p.Called(cpf)
+	return args.Get(0).(*parent.Parent), args.Error(1)
+}
+
+func (p *ParentRepository) FindAll(pagination paginator.Pagination) (*paginator.PaginationResult, error) {
+	args := p.Called(pagination)
+	return args.Get(0).(*paginator.PaginationResult), args.Error(1)
+}
+
+func (p *ParentRepository) FindChildren(parentId string) ([]parent.Child, error) {
+	args := p.Called(parentId)
+	return args.Get(0).([]parent.Child), args.Error(1)
 }
@@ -0,0 +1,36 @@
+package mocks
+
+import (
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/payment"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+	"github.com/stretchr/testify/mock"
+)
+
+type PaymentActionsMock struct {
+	mock.Mock
+}
+
+func (p *PaymentActionsMock) Register(invoiceId string, dto payment.RequestDto) (*payment.Response, error) {
+	args := p.Called(invoiceId, dto)
+	return args.Get(0).(*payment.Response), args.Error(1)
+}
+
+func (p *PaymentActionsMock) FindByInvoice(invoiceId string) ([]payment.Payment, error) {
+	args := p.Called(invoiceId)
+	return args.Get(0).([]payment.Payment), args.Error(1)
+}
+
+func (p *PaymentActionsMock) FindByStudent(studentId string, dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error) {
+	args := p.Called(studentId, dtoRequest)
+	return args.Get(0).(*paginator.PaginationResult), args.Error(1)
+}
+
+func (p *PaymentActionsMock) FindByRegistration(registrationId string, dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error) {
+	args := p.Called(registrationId, dtoRequest)
+	return args.Get(0).(*paginator.PaginationResult), args.Error(1)
+}
+
+func (p *PaymentActionsMock) Balance(registrationId string) (*payment.Balance, error) {
+	args := p.Called(registrationId)
+	return args.Get(0).(*payment.Balance), args.Error(1)
+}
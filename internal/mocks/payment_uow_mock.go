@@ -0,0 +1,78 @@
+package mocks
+
+import (
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/payment"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+	"github.com/stretchr/testify/mock"
+)
+
+type PaymentUowMock struct {
+	mock.Mock
+}
+
+func (p *PaymentUowMock) BeginTransaction() error {
+	args := p.Called()
+	return args.Error(0)
+}
+
+func (p *PaymentUowMock) Commit() error {
+	args := p.Called()
+	return args.Error(0)
+}
+
+func (p *PaymentUowMock) Rollback() error {
+	args := p.Called()
+	return args.Error(0)
+}
+
+func (p *PaymentUowMock) FindInvoiceLock(id string) (*invoice.Invoice, error) {
+	args := p.Called(id)
+	return args.Get(0).(*invoice.Invoice), args.Error(1)
+}
+
+func (p *PaymentUowMock) FindOpenInvoicesLock(studentId uuid.UUID) ([]invoice.Invoice, error) {
+	args := p.Called(studentId)
+	return args.Get(0).([]invoice.Invoice), args.Error(1)
+}
+
+func (p *PaymentUowMock) UpdateInvoicePayment(inv invoice.Invoice) error {
+	args := p.Called(inv)
+	return args.Error(0)
+}
+
+func (p *PaymentUowMock) CreatePayment(pay payment.Payment) error {
+	args := p.Called(pay)
+	return args.Error(0)
+}
+
+func (p *PaymentUowMock) PaymentReferenceExists(reference string) (bool, error) {
+	args := p.Called(reference)
+	return args.Bool(0), args.Error(1)
+}
+
+func (p *PaymentUowMock) CreateCredit(credit payment.Credit) error {
+	args := p.Called(credit)
+	return args.Error(0)
+}
+
+func (p *PaymentUowMock) FindAvailableCreditsLock(studentId uuid.UUID) ([]payment.Credit, error) {
+	args := p.Called(studentId)
+	return args.Get(0).([]payment.Credit), args.Error(1)
+}
+
+func (p *PaymentUowMock) UpdateCredit(credit payment.Credit) error {
+	args := p.Called(credit)
+	return args.Error(0)
+}
+
+func (p *PaymentUowMock) FindRegisterLock(id string) (*registration.Registration, error) {
+	args := p.Called(id)
+	return args.Get(0).(*registration.Registration), args.Error(1)
+}
+
+func (p *PaymentUowMock) UpdateRegisterStatus(register registration.Registration) error {
+	args := p.Called(register)
+	return args.Error(0)
+}
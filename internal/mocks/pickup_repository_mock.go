@@ -0,0 +1,36 @@
+package mocks
+
+import (
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/pickup"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
+	"github.com/stretchr/testify/mock"
+)
+
+type PickupRepository struct {
+	mock.Mock
+}
+
+func (p *PickupRepository) Create(authorization pickup.Authorization) error {
+	args := p.Called(authorization)
+	return args.Error(0)
+}
+
+func (p *PickupRepository) Delete(id string) error {
+	args := p.Called(id)
+	return args.Error(0)
+}
+
+func (p *PickupRepository) FindById(id string) (*pickup.Authorization, error) {
+	args := p.Called(id)
+	return args.Get(0).(*pickup.Authorization), args.Error(1)
+}
+
+func (p *PickupRepository) FindByStudent(studentId string) ([]pickup.Authorization, error) {
+	args := p.Called(studentId)
+	return args.Get(0).([]pickup.Authorization), args.Error(1)
+}
+
+func (p *PickupRepository) FindByCpf(studentId string, cpf value_objects.CPF) ([]pickup.Authorization, error) {
+	args := p.Called(studentId, cpf)
+	return args.Get(0).([]pickup.Authorization), args.Error(1)
+}
@@ -0,0 +1,15 @@
+package mocks
+
+import (
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/pix"
+	"github.com/stretchr/testify/mock"
+)
+
+type PixActionsMock struct {
+	mock.Mock
+}
+
+func (p *PixActionsMock) Charge(invoiceId string, kind string) (*pix.Charge, error) {
+	args := p.Called(invoiceId, kind)
+	return args.Get(0).(*pix.Charge), args.Error(1)
+}
@@ -0,0 +1,15 @@
+package mocks
+
+import (
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/pix"
+	"github.com/stretchr/testify/mock"
+)
+
+type PixPspMock struct {
+	mock.Mock
+}
+
+func (p *PixPspMock) CreateCob(cob pix.Cob) (string, error) {
+	args := p.Called(cob)
+	return args.String(0), args.Error(1)
+}
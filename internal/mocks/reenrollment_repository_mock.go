@@ -0,0 +1,62 @@
+package mocks
+
+import (
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/reenrollment"
+	"github.com/stretchr/testify/mock"
+)
+
+type ReenrollmentRepository struct {
+	mock.Mock
+}
+
+func (r *ReenrollmentRepository) CreateCampaign(campaign reenrollment.Campaign) error {
+	args := r.Called(campaign)
+	return args.Error(0)
+}
+
+func (r *ReenrollmentRepository) FindCampaignById(id string) (*reenrollment.Campaign, error) {
+	args := r.Called(id)
+	return args.Get(0).(*reenrollment.Campaign), args.Error(1)
+}
+
+func (r *ReenrollmentRepository) FindCampaignBySchoolYear(fromSchoolYearId uuid.UUID) (*reenrollment.Campaign, error) {
+	args := r.Called(fromSchoolYearId)
+	return args.Get(0).(*reenrollment.Campaign), args.Error(1)
+}
+
+func (r *ReenrollmentRepository) CreateProposals(proposals []reenrollment.Proposal) error {
+	args := r.Called(proposals)
+	return args.Error(0)
+}
+
+func (r *ReenrollmentRepository) UpdateProposal(proposal reenrollment.Proposal) error {
+	args := r.Called(proposal)
+	return args.Error(0)
+}
+
+func (r *ReenrollmentRepository) FindProposals(campaignId string) ([]reenrollment.Proposal, error) {
+	args := r.Called(campaignId)
+	return args.Get(0).([]reenrollment.Proposal), args.Error(1)
+}
+
+func (r *ReenrollmentRepository) FindProposalById(id string) (*reenrollment.Proposal, error) {
+	args := r.Called(id)
+	return args.Get(0).(*reenrollment.Proposal), args.Error(1)
+}
+
+func (r *ReenrollmentRepository) FindProposalByIdLock(id string) (*reenrollment.Proposal, error) {
+	args := r.Called(id)
+	return args.Get(0).(*reenrollment.Proposal), args.Error(1)
+}
+
+func (r *ReenrollmentRepository) FindApprovedRegistrations(schoolYearId uuid.UUID) ([]uuid.UUID, error) {
+	args := r.Called(schoolYearId)
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (r *ReenrollmentRepository) FindClassRooms(schoolYearId uuid.UUID, level string) ([]classroom.ClassRoom, error) {
+	args := r.Called(schoolYearId, level)
+	return args.Get(0).([]classroom.ClassRoom), args.Error(1)
+}
@@ -0,0 +1,86 @@
+package mocks
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/reenrollment"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+	"github.com/stretchr/testify/mock"
+)
+
+type ReenrollmentUowMock struct {
+	mock.Mock
+}
+
+func (r *ReenrollmentUowMock) BeginTransaction() error {
+	args := r.Called()
+	return args.Error(0)
+}
+
+func (r *ReenrollmentUowMock) Commit() error {
+	args := r.Called()
+	return args.Error(0)
+}
+
+func (r *ReenrollmentUowMock) Rollback() error {
+	args := r.Called()
+	return args.Error(0)
+}
+
+func (r *ReenrollmentUowMock) CreateCampaign(campaign reenrollment.Campaign) error {
+	args := r.Called(campaign)
+	return args.Error(0)
+}
+
+func (r *ReenrollmentUowMock) CreateProposals(proposals []reenrollment.Proposal) error {
+	args := r.Called(proposals)
+	return args.Error(0)
+}
+
+func (r *ReenrollmentUowMock) FindProposalLock(id string) (*reenrollment.Proposal, error) {
+	args := r.Called(id)
+	return args.Get(0).(*reenrollment.Proposal), args.Error(1)
+}
+
+func (r *ReenrollmentUowMock) UpdateProposal(proposal reenrollment.Proposal) error {
+	args := r.Called(proposal)
+	return args.Error(0)
+}
+
+func (r *ReenrollmentUowMock) FindRegisterLock(id string) (*registration.Registration, error) {
+	args := r.Called(id)
+	return args.Get(0).(*registration.Registration), args.Error(1)
+}
+
+func (r *ReenrollmentUowMock) FindClassRoomLock(id string) (*classroom.ClassRoom, error) {
+	args := r.Called(id)
+	return args.Get(0).(*classroom.ClassRoom), args.Error(1)
+}
+
+func (r *ReenrollmentUowMock) OccupyVacancies(classRoom classroom.ClassRoom) error {
+	args := r.Called(classRoom)
+	return args.Error(0)
+}
+
+func (r *ReenrollmentUowMock) StudentAlreadyRegisterInClass(studentId uuid.UUID, classRoomId uuid.UUID) (bool, error) {
+	args := r.Called(studentId, classRoomId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (r *ReenrollmentUowMock) StudentOverdueDebt(studentId uuid.UUID, dueBefore time.Time) (float64, error) {
+	args := r.Called(studentId, dueBefore)
+	return args.Get(0).(float64), args.Error(1)
+}
+
+func (r *ReenrollmentUowMock) CreateRegister(register registration.Registration) error {
+	args := r.Called(register)
+	return args.Error(0)
+}
+
+func (r *ReenrollmentUowMock) CreateInvoices(invoices []invoice.Invoice) error {
+	args := r.Called(invoices)
+	return args.Error(0)
+}
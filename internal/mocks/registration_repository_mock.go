@@ -0,0 +1,46 @@
+package mocks
+
+import (
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+	"github.com/stretchr/testify/mock"
+)
+
+type RegistrationRepository struct {
+	mock.Mock
+}
+
+func (r *RegistrationRepository) Create(registration registration.Registration) error {
+	args := r.Called(registration)
+	return args.Error(0)
+}
+
+func (r *RegistrationRepository) SearchStudentAlreadyRegistered(studentId uuid.UUID, classRoomId uuid.UUID) (string, error) {
+	args := r.Called(studentId, classRoomId)
+	return args.String(0), args.Error(1)
+}
+
+func (r *RegistrationRepository) FindById(id string) (*registration.Registration, error) {
+	args := r.Called(id)
+	return args.Get(0).(*registration.Registration), args.Error(1)
+}
+
+func (r *RegistrationRepository) UpdateStatus(registration registration.Registration) error {
+	args := r.Called(registration)
+	return args.Error(0)
+}
+
+func (r *RegistrationRepository) CreateStatusHistory(history registration.StatusHistory) error {
+	args := r.Called(history)
+	return args.Error(0)
+}
+
+func (r *RegistrationRepository) FindStatusHistory(registrationId string) ([]registration.StatusHistory, error) {
+	args := r.Called(registrationId)
+	return args.Get(0).([]registration.StatusHistory), args.Error(1)
+}
+
+func (r *RegistrationRepository) FindDiscounts(registrationId string) ([]registration.Discount, error) {
+	args := r.Called(registrationId)
+	return args.Get(0).([]registration.Discount), args.Error(1)
+}
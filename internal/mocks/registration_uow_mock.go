@@ -0,0 +1,134 @@
+package mocks
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/parent"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/waitinglist"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
+	"github.com/stretchr/testify/mock"
+)
+
+type RegistrationUowMock struct {
+	mock.Mock
+}
+
+func (r *RegistrationUowMock) BeginTransaction() error {
+	args := r.Called()
+	return args.Error(0)
+}
+
+func (r *RegistrationUowMock) Commit() error {
+	args := r.Called()
+	return args.Error(0)
+}
+
+func (r *RegistrationUowMock) Rollback() error {
+	args := r.Called()
+	return args.Error(0)
+}
+
+func (r *RegistrationUowMock) CreateStudent(student student.Student) error {
+	args := r.Called(student)
+	return args.Error(0)
+}
+
+func (r *RegistrationUowMock) CreateRegister(register registration.Registration) error {
+	args := r.Called(register)
+	return args.Error(0)
+}
+
+func (r *RegistrationUowMock) StudentAlreadyExists(document value_objects.Document) (*uuid.UUID, error) {
+	args := r.Called(document)
+	return args.Get(0).(*uuid.UUID), args.Error(1)
+}
+
+func (r *RegistrationUowMock) FindParent(id string) (*parent.Parent, error) {
+	args := r.Called(id)
+	return args.Get(0).(*parent.Parent), args.Error(1)
+}
+
+func (r *RegistrationUowMock) FindParentByCpf(cpf string) (*parent.Parent, error) {
+	args := r.Called(cpf)
+	return args.Get(0).(*parent.Parent), args.Error(1)
+}
+
+func (r *RegistrationUowMock) StudentAlreadyRegisterInClass(studentId uuid.UUID, classRoomId uuid.UUID) (bool, error) {
+	args := r.Called(studentId, classRoomId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (r *RegistrationUowMock) FindRegisterLock(id string) (*registration.Registration, error) {
+	args := r.Called(id)
+	return args.Get(0).(*registration.Registration), args.Error(1)
+}
+
+func (r *RegistrationUowMock) UpdateRegisterStatus(register registration.Registration) error {
+	args := r.Called(register)
+	return args.Error(0)
+}
+
+func (r *RegistrationUowMock) FindClassRoomLock(id string) (*classroom.ClassRoom, error) {
+	args := r.Called(id)
+	return args.Get(0).(*classroom.ClassRoom), args.Error(1)
+}
+
+func (r *RegistrationUowMock) OccupyVacancies(classRoom classroom.ClassRoom) error {
+	args := r.Called(classRoom)
+	return args.Error(0)
+}
+
+func (r *RegistrationUowMock) ReleaseVacancies(classRoom classroom.ClassRoom) error {
+	args := r.Called(classRoom)
+	return args.Error(0)
+}
+
+func (r *RegistrationUowMock) CreateWaitingCandidate(candidate waitinglist.Candidate) error {
+	args := r.Called(candidate)
+	return args.Error(0)
+}
+
+func (r *RegistrationUowMock) FindNextWaitingCandidateLock(classRoomId uuid.UUID) (*waitinglist.Candidate, error) {
+	args := r.Called(classRoomId)
+	return args.Get(0).(*waitinglist.Candidate), args.Error(1)
+}
+
+func (r *RegistrationUowMock) UpdateWaitingCandidate(candidate waitinglist.Candidate) error {
+	args := r.Called(candidate)
+	return args.Error(0)
+}
+
+func (r *RegistrationUowMock) CreateInvoices(invoices []invoice.Invoice) error {
+	args := r.Called(invoices)
+	return args.Error(0)
+}
+
+func (r *RegistrationUowMock) FindOpenInvoicesLock(registrationId uuid.UUID) ([]invoice.Invoice, error) {
+	args := r.Called(registrationId)
+	return args.Get(0).([]invoice.Invoice), args.Error(1)
+}
+
+func (r *RegistrationUowMock) UpdateInvoiceStatus(inv invoice.Invoice) error {
+	args := r.Called(inv)
+	return args.Error(0)
+}
+
+func (r *RegistrationUowMock) UpdateInvoiceRegistration(inv invoice.Invoice) error {
+	args := r.Called(inv)
+	return args.Error(0)
+}
+
+func (r *RegistrationUowMock) HasActiveSiblingRegistration(studentId uuid.UUID, parentCpfs []string) (bool, error) {
+	args := r.Called(studentId, parentCpfs)
+	return args.Bool(0), args.Error(1)
+}
+
+func (r *RegistrationUowMock) StudentOverdueDebt(studentId uuid.UUID, dueBefore time.Time) (float64, error) {
+	args := r.Called(studentId, dueBefore)
+	return args.Get(0).(float64), args.Error(1)
+}
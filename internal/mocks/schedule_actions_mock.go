@@ -34,3 +34,18 @@ func (s *ScheduleActionsMock) FindAll(dtoRequest paginator.PaginatorRequest) (*p
 	args := s.Called(dtoRequest)
 	return args.Get(0).(*paginator.PaginationResult), args.Error(1)
 }
+
+func (s *ScheduleActionsMock) SyncSchedule(scheduleRoomDto schedule.RoomScheduleDto) error {
+	args := s.Called(scheduleRoomDto)
+	return args.Error(0)
+}
+
+func (s *ScheduleActionsMock) RoomAgenda(roomId string, schoolYearId string) (*schedule.Agenda, error) {
+	args := s.Called(roomId, schoolYearId)
+	return args.Get(0).(*schedule.Agenda), args.Error(1)
+}
+
+func (s *ScheduleActionsMock) ClassRoomAgenda(classRoomId string) (*schedule.Agenda, error) {
+	args := s.Called(classRoomId)
+	return args.Get(0).(*schedule.Agenda), args.Error(1)
+}
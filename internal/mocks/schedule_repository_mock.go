@@ -0,0 +1,57 @@
+package mocks
+
+import (
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schedule"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+	"github.com/stretchr/testify/mock"
+)
+
+type ScheduleRepository struct {
+	mock.Mock
+}
+
+func (s *ScheduleRepository) Create(schedule schedule.ScheduleClass) error {
+	args := s.Called(schedule)
+	return args.Error(0)
+}
+
+func (s *ScheduleRepository) Delete(id string) error {
+	args := s.Called(id)
+	return args.Error(0)
+}
+
+func (s *ScheduleRepository) Update(schedule schedule.ScheduleClass) error {
+	args := s.Called(schedule)
+	return args.Error(0)
+}
+
+func (s *ScheduleRepository) FindById(id string) (*schedule.ScheduleClass, error) {
+	args := s.Called(id)
+	return args.Get(0).(*schedule.ScheduleClass), args.Error(1)
+}
+
+func (s *ScheduleRepository) FindAll(pagination paginator.Pagination) (*paginator.PaginationResult, error) {
+	args := s.Called(pagination)
+	return args.Get(0).(*paginator.PaginationResult), args.Error(1)
+}
+
+func (s *ScheduleRepository) FindBySchoolYear(schoolYearId uuid.UUID) ([]schedule.ScheduleClass, error) {
+	args := s.Called(schoolYearId)
+	return args.Get(0).([]schedule.ScheduleClass), args.Error(1)
+}
+
+func (s *ScheduleRepository) FindByRoom(roomId uuid.UUID, schoolYearId uuid.UUID) ([]schedule.ScheduleClass, error) {
+	args := s.Called(roomId, schoolYearId)
+	return args.Get(0).([]schedule.ScheduleClass), args.Error(1)
+}
+
+func (s *ScheduleRepository) FindRoomIds(scheduleId uuid.UUID, schoolYearId uuid.UUID) ([]uuid.UUID, error) {
+	args := s.Called(scheduleId, schoolYearId)
+	return args.Get(0).([]uuid.UUID), args.Error(1)
+}
+
+func (s *ScheduleRepository) SyncSchedule(scheduleDto schedule.RoomScheduleDto) error {
+	args := s.Called(scheduleDto)
+	return args.Error(0)
+}
@@ -0,0 +1,41 @@
+package mocks
+
+import (
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+	"github.com/stretchr/testify/mock"
+)
+
+type SchoolYearRepository struct {
+	mock.Mock
+}
+
+func (s *SchoolYearRepository) Create(schoolYear *schoolyear.SchoolYear) error {
+	args := s.Called(schoolYear)
+	return args.Error(0)
+}
+
+func (s *SchoolYearRepository) Delete(id string) error {
+	args := s.Called(id)
+	return args.Error(0)
+}
+
+func (s *SchoolYearRepository) Update(schoolYear *schoolyear.SchoolYear) error {
+	args := s.Called(schoolYear)
+	return args.Error(0)
+}
+
+func (s *SchoolYearRepository) FindById(id string) (*schoolyear.SchoolYear, error) {
+	args := s.Called(id)
+	return args.Get(0).(*schoolyear.SchoolYear), args.Error(1)
+}
+
+func (s *SchoolYearRepository) FindByYear(year string) (*schoolyear.SchoolYear, error) {
+	args := s.Called(year)
+	return args.Get(0).(*schoolyear.SchoolYear), args.Error(1)
+}
+
+func (s *SchoolYearRepository) FindAll(pagination paginator.Pagination) (*paginator.PaginationResult, error) {
+	args := s.Called(pagination)
+	return args.Get(0).(*paginator.PaginationResult), args.Error(1)
+}
@@ -1,7 +1,10 @@
 package mocks
 
 import (
+	"github.com/google/uuid"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -13,3 +16,48 @@ func (s *StudentRepository) Create(student student.Student) error {
 	args := s.Called(student)
 	return args.Error(0)
 }
+
+func (s *StudentRepository) Update(student student.Student) error {
+	args := s.Called(student)
+	return args.Error(0)
+}
+
+func (s *StudentRepository) UpdateAcademicResponsible(student student.Student) error {
+	args := s.Called(student)
+	return args.Error(0)
+}
+
+func (s *StudentRepository) Delete(id string) error {
+	args := s.Called(id)
+	return args.Error(0)
+}
+
+func (s *StudentRepository) FindById(id string) (*student.Student, error) {
+	args := s.Called(id)
+	return args.Get(0).(*student.Student), args.Error(1)
+}
+
+func (s *StudentRepository) DocumentAlreadyInUse(document value_objects.Document, exceptId uuid.UUID) (bool, error) {
+	args := s.Called(document, exceptId)
+	return args.Bool(0), args.Error(1)
+}
+
+func (s *StudentRepository) FindAll(pagination paginator.Pagination) (*paginator.PaginationResult, error) {
+	args := s.Called(pagination)
+	return args.Get(0).(*paginator.PaginationResult), args.Error(1)
+}
+
+func (s *StudentRepository) FindRegistrations(studentId string) ([]student.RegistrationSummary, error) {
+	args := s.Called(studentId)
+	return args.Get(0).([]student.RegistrationSummary), args.Error(1)
+}
+
+func (s *StudentRepository) FindDuplicateCandidates() ([]student.DuplicateCandidate, error) {
+	args := s.Called()
+	return args.Get(0).([]student.DuplicateCandidate), args.Error(1)
+}
+
+func (s *StudentRepository) Merge(survivor student.Student, duplicateId uuid.UUID) error {
+	args := s.Called(survivor, duplicateId)
+	return args.Error(0)
+}
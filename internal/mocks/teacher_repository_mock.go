@@ -0,0 +1,83 @@
+package mocks
+
+import (
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/teacher"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
+	"github.com/stretchr/testify/mock"
+)
+
+type TeacherRepository struct {
+	mock.Mock
+}
+
+func (t *TeacherRepository) Create(tch teacher.Teacher) error {
+	args := t.Called(tch)
+	return args.Error(0)
+}
+
+func (t *TeacherRepository) Update(tch teacher.Teacher) error {
+	args := t.Called(tch)
+	return args.Error(0)
+}
+
+func (t *TeacherRepository) Delete(id string) error {
+	args := t.Called(id)
+	return args.Error(0)
+}
+
+func (t *TeacherRepository) FindById(id string) (*teacher.Teacher, error) {
+	args := t.Called(id)
+	return args.Get(0).(*teacher.Teacher), args.Error(1)
+}
+
+func (t *TeacherRepository) FindByCpf(cpf value_objects.CPF) (*teacher.Teacher, error) {
+	args := t.Called(cpf)
+	return args.Get(0).(*teacher.Teacher), args.Error(1)
+}
+
+func (t *TeacherRepository) FindAll(pagination paginator.Pagination) (*paginator.PaginationResult, error) {
+	args := t.Called(pagination)
+	return args.Get(0).(*paginator.PaginationResult), args.Error(1)
+}
+
+func (t *TeacherRepository) Assign(assignment teacher.Assignment) error {
+	args := t.Called(assignment)
+	return args.Error(0)
+}
+
+func (t *TeacherRepository) Unassign(id string) error {
+	args := t.Called(id)
+	return args.Error(0)
+}
+
+func (t *TeacherRepository) UnassignAll(teacherId uuid.UUID) error {
+	args := t.Called(teacherId)
+	return args.Error(0)
+}
+
+func (t *TeacherRepository) FindAssignment(classRoomId uuid.UUID, subjectId uuid.UUID) (*teacher.Assignment, error) {
+	args := t.Called(classRoomId, subjectId)
+	return args.Get(0).(*teacher.Assignment), args.Error(1)
+}
+
+func (t *TeacherRepository) FindAssignments(teacherId string, schoolYearId string) ([]teacher.Assignment, error) {
+	args := t.Called(teacherId, schoolYearId)
+	return args.Get(0).([]teacher.Assignment), args.Error(1)
+}
+
+func (t *TeacherRepository) FindClassRoomAssignments(classRoomId string) ([]teacher.Assignment, error) {
+	args := t.Called(classRoomId)
+	return args.Get(0).([]teacher.Assignment), args.Error(1)
+}
+
+func (t *TeacherRepository) ChangeAvailability(teacherId uuid.UUID, availability []teacher.Availability) error {
+	args := t.Called(teacherId, availability)
+	return args.Error(0)
+}
+
+func (t *TeacherRepository) FindAvailability(teacherId uuid.UUID) ([]teacher.Availability, error) {
+	args := t.Called(teacherId)
+	return args.Get(0).([]teacher.Availability), args.Error(1)
+}
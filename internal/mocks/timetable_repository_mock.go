@@ -0,0 +1,46 @@
+package mocks
+
+import (
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/timetable"
+	"github.com/stretchr/testify/mock"
+)
+
+type TimetableRepository struct {
+	mock.Mock
+}
+
+func (t *TimetableRepository) ReplaceLessons(schoolYearId uuid.UUID, lessons []timetable.Lesson) error {
+	args := t.Called(schoolYearId, lessons)
+	return args.Error(0)
+}
+
+func (t *TimetableRepository) CreateLesson(lesson timetable.Lesson) error {
+	args := t.Called(lesson)
+	return args.Error(0)
+}
+
+func (t *TimetableRepository) UpdateLesson(lesson timetable.Lesson) error {
+	args := t.Called(lesson)
+	return args.Error(0)
+}
+
+func (t *TimetableRepository) DeleteLesson(id string) error {
+	args := t.Called(id)
+	return args.Error(0)
+}
+
+func (t *TimetableRepository) FindLessonById(id string) (*timetable.Lesson, error) {
+	args := t.Called(id)
+	return args.Get(0).(*timetable.Lesson), args.Error(1)
+}
+
+func (t *TimetableRepository) FindLessons(schoolYearId uuid.UUID) ([]timetable.Lesson, error) {
+	args := t.Called(schoolYearId)
+	return args.Get(0).([]timetable.Lesson), args.Error(1)
+}
+
+func (t *TimetableRepository) FindRooms() ([]timetable.Room, error) {
+	args := t.Called()
+	return args.Get(0).([]timetable.Room), args.Error(1)
+}
@@ -0,0 +1,36 @@
+package mocks
+
+import (
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/waitinglist"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+	"github.com/stretchr/testify/mock"
+)
+
+type WaitingListActionsMock struct {
+	mock.Mock
+}
+
+func (w *WaitingListActionsMock) Join(dto waitinglist.RequestDto) (*waitinglist.Candidate, error) {
+	args := w.Called(dto)
+	return args.Get(0).(*waitinglist.Candidate), args.Error(1)
+}
+
+func (w *WaitingListActionsMock) FindByClassRoom(classRoomId string, dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error) {
+	args := w.Called(classRoomId, dtoRequest)
+	return args.Get(0).(*paginator.PaginationResult), args.Error(1)
+}
+
+func (w *WaitingListActionsMock) Position(id string) (*waitinglist.PositionResponse, error) {
+	args := w.Called(id)
+	return args.Get(0).(*waitinglist.PositionResponse), args.Error(1)
+}
+
+func (w *WaitingListActionsMock) Withdraw(id string) error {
+	args := w.Called(id)
+	return args.Error(0)
+}
+
+func (w *WaitingListActionsMock) OfferVacancies(classRoomId string) error {
+	args := w.Called(classRoomId)
+	return args.Error(0)
+}
@@ -0,0 +1,42 @@
+package cnab
+
+import "github.com/go-playground/validator"
+
+// Beneficiary Dados da escola (beneficiario) no convenio de cobranca com o banco
+type Beneficiary struct {
+	BankCode        string `validate:"required,len=3,numeric"`
+	BankName        string `validate:"required"`
+	CompanyName     string `validate:"required"`
+	CompanyDocument string `validate:"required"`
+	CompanyCode     string `validate:"required"`
+	Agency          string `validate:"required"`
+	AgencyDigit     string
+	Account         string `validate:"required"`
+	AccountDigit    string
+	Wallet          string `validate:"required"`
+}
+
+func (b Beneficiary) Validate() error {
+	v := validator.New()
+	return v.Struct(b)
+}
+
+// Payer Dados do pagador impressos no boleto
+type Payer struct {
+	Name     string
+	Document string
+	Street   string
+	District string
+	ZipCode  string
+	City     string
+	State    string
+}
+
+// DocumentType Tipo de inscricao do pagador: 1 para CPF e 2 para CNPJ
+func (p Payer) DocumentType() int64 {
+	if len(onlyDigits(p.Document)) > 11 {
+		return 2
+	}
+
+	return 1
+}
@@ -0,0 +1,331 @@
+package cnab
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"time"
+)
+
+const cnab240Size = 240
+
+// Cnab240 Leiaute de cobranca CNAB 240 padrao FEBRABAN. O banco e definido pelo beneficiario
+type Cnab240 struct{}
+
+func (c *Cnab240) Name() string {
+	return LayoutCnab240
+}
+
+func (c *Cnab240) Remittance(beneficiary Beneficiary, sequence int, generatedAt time.Time, titles []Title) ([]byte, error) {
+	err := beneficiary.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(titles) == 0 {
+		return nil, errors.New("no titles to remit")
+	}
+
+	var content bytes.Buffer
+
+	c.write(&content, c.fileHeader(beneficiary, sequence, generatedAt))
+	c.write(&content, c.batchHeader(beneficiary, sequence, generatedAt))
+
+	batchRecords := int64(0)
+	total := 0.0
+
+	for _, title := range titles {
+		c.write(&content, c.segmentP(beneficiary, title, generatedAt, batchRecords+1))
+		c.write(&content, c.segmentQ(beneficiary, title, batchRecords+2))
+		c.write(&content, c.segmentR(beneficiary, title, batchRecords+3))
+		batchRecords += 3
+		total += title.Value()
+	}
+
+	batchTrailer := newRecord(cnab240Size)
+	batchTrailer.digits(1, 3, beneficiary.BankCode)
+	batchTrailer.numeric(4, 7, 1)
+	batchTrailer.set(8, 8, "5")
+	batchTrailer.numeric(18, 23, batchRecords+2)
+	batchTrailer.numeric(24, 29, int64(len(titles)))
+	batchTrailer.money(30, 46, total)
+	c.write(&content, batchTrailer)
+
+	fileTrailer := newRecord(cnab240Size)
+	fileTrailer.digits(1, 3, beneficiary.BankCode)
+	fileTrailer.set(4, 7, "9999")
+	fileTrailer.set(8, 8, "9")
+	fileTrailer.numeric(18, 23, 1)
+	fileTrailer.numeric(24, 29, batchRecords+4)
+	fileTrailer.numeric(30, 35, 0)
+	c.write(&content, fileTrailer)
+
+	return content.Bytes(), nil
+}
+
+func (c *Cnab240) fileHeader(beneficiary Beneficiary, sequence int, generatedAt time.Time) record {
+	header := newRecord(cnab240Size)
+	header.digits(1, 3, beneficiary.BankCode)
+	header.numeric(4, 7, 0)
+	header.set(8, 8, "0")
+	header.set(18, 18, "2")
+	header.digits(19, 32, beneficiary.CompanyDocument)
+	header.alpha(33, 52, beneficiary.CompanyCode)
+	header.digits(53, 57, beneficiary.Agency)
+	header.alpha(58, 58, beneficiary.AgencyDigit)
+	header.digits(59, 70, beneficiary.Account)
+	header.alpha(71, 71, beneficiary.AccountDigit)
+	header.alpha(73, 102, beneficiary.CompanyName)
+	header.alpha(103, 132, beneficiary.BankName)
+	header.set(143, 143, "1")
+	header.longDate(144, 151, generatedAt)
+	header.set(152, 157, generatedAt.Format("150405"))
+	header.numeric(158, 163, int64(sequence))
+	header.set(164, 166, "087")
+	header.numeric(167, 171, 0)
+
+	return header
+}
+
+func (c *Cnab240) batchHeader(beneficiary Beneficiary, sequence int, generatedAt time.Time) record {
+	header := newRecord(cnab240Size)
+	header.digits(1, 3, beneficiary.BankCode)
+	header.numeric(4, 7, 1)
+	header.set(8, 8, "1")
+	header.set(9, 9, "R")
+	header.set(10, 11, "01")
+	header.set(14, 16, "045")
+	header.set(18, 18, "2")
+	header.digits(19, 33, beneficiary.CompanyDocument)
+	header.alpha(34, 53, beneficiary.CompanyCode)
+	header.digits(54, 58, beneficiary.Agency)
+	header.alpha(59, 59, beneficiary.AgencyDigit)
+	header.digits(60, 71, beneficiary.Account)
+	header.alpha(72, 72, beneficiary.AccountDigit)
+	header.alpha(74, 103, beneficiary.CompanyName)
+	header.numeric(184, 191, int64(sequence))
+	header.longDate(192, 199, generatedAt)
+	header.numeric(200, 207, 0)
+
+	return header
+}
+
+func (c *Cnab240) segment(beneficiary Beneficiary, segment string, recordSequence int64) record {
+	line := newRecord(cnab240Size)
+	line.digits(1, 3, beneficiary.BankCode)
+	line.numeric(4, 7, 1)
+	line.set(8, 8, "3")
+	line.numeric(9, 13, recordSequence)
+	line.set(14, 14, segment)
+	line.set(16, 17, "01")
+
+	return line
+}
+
+func (c *Cnab240) segmentP(beneficiary Beneficiary, title Title, generatedAt time.Time, recordSequence int64) record {
+	p := c.segment(beneficiary, "P", recordSequence)
+	p.digits(18, 22, beneficiary.Agency)
+	p.alpha(23, 23, beneficiary.AgencyDigit)
+	p.digits(24, 35, beneficiary.Account)
+	p.alpha(36, 36, beneficiary.AccountDigit)
+	p.numeric(38, 57, title.OurNumber())
+	p.set(58, 58, "1")
+	p.set(59, 59, "1")
+	p.set(60, 60, "1")
+	p.set(61, 61, "2")
+	p.set(62, 62, "2")
+	p.alpha(63, 77, strconv.FormatInt(title.OurNumber(), 10))
+	p.longDate(78, 85, title.DueDate())
+	p.money(86, 100, title.Value())
+	p.numeric(101, 105, 0)
+	p.set(107, 108, "04")
+	p.set(109, 109, "N")
+	p.longDate(110, 117, generatedAt)
+
+	p.set(118, 118, "3")
+	p.numeric(119, 141, 0)
+	if title.InterestPerDay() > 0 {
+		p.set(118, 118, "1")
+		p.longDate(119, 126, title.DueDate().AddDate(0, 0, 1))
+		p.money(127, 141, title.InterestPerDay())
+	}
+
+	p.set(142, 142, "0")
+	p.numeric(143, 165, 0)
+	if title.Discount() > 0 {
+		p.set(142, 142, "1")
+		p.longDate(143, 150, title.DiscountUntil())
+		p.money(151, 165, title.Discount())
+	}
+
+	p.numeric(166, 195, 0)
+	p.alpha(196, 220, strconv.FormatInt(title.OurNumber(), 10))
+	p.set(221, 221, "3")
+	p.numeric(222, 223, 0)
+	p.set(224, 224, "0")
+	p.numeric(225, 227, 0)
+	p.set(228, 229, "09")
+	p.numeric(230, 239, 0)
+
+	return p
+}
+
+func (c *Cnab240) segmentQ(beneficiary Beneficiary, title Title, recordSequence int64) record {
+	payer := title.Payer()
+
+	q := c.segment(beneficiary, "Q", recordSequence)
+	q.numeric(18, 18, payer.DocumentType())
+	q.digits(19, 33, payer.Document)
+	q.alpha(34, 73, payer.Name)
+	q.alpha(74, 113, payer.Street)
+	q.alpha(114, 128, payer.District)
+	q.digits(129, 136, payer.ZipCode)
+	q.alpha(137, 151, payer.City)
+	q.alpha(152, 153, payer.State)
+	q.set(154, 154, "0")
+	q.numeric(155, 169, 0)
+	q.numeric(210, 212, 0)
+
+	return q
+}
+
+func (c *Cnab240) segmentR(beneficiary Beneficiary, title Title, recordSequence int64) record {
+	r := c.segment(beneficiary, "R", recordSequence)
+	r.set(18, 18, "0")
+	r.numeric(19, 41, 0)
+	r.set(42, 42, "0")
+	r.numeric(43, 65, 0)
+
+	r.set(66, 66, "0")
+	r.numeric(67, 89, 0)
+	if title.FinePercentage() > 0 {
+		r.set(66, 66, "2")
+		r.longDate(67, 74, title.DueDate().AddDate(0, 0, 1))
+		r.money(75, 89, title.FinePercentage())
+	}
+
+	r.numeric(200, 215, 0)
+	r.numeric(217, 228, 0)
+	r.set(231, 231, "0")
+
+	return r
+}
+
+func (c *Cnab240) write(content *bytes.Buffer, line record) {
+	content.Write(line)
+	content.WriteString(lineBreak)
+}
+
+// Return Le os segmentos T e U do retorno. O segmento T identifica o boleto e a ocorrencia, o
+// segmento U seguinte traz os valores pagos e as datas da ocorrencia
+func (c *Cnab240) Return(content []byte) ([]Occurrence, error) {
+	records, err := lines(content, cnab240Size)
+	if err != nil {
+		return nil, err
+	}
+
+	if field(records[0], 8, 8) != "0" || field(records[0], 143, 143) != "2" {
+		return nil, errors.New("file is not a cnab 240 return")
+	}
+
+	var occurrences []Occurrence
+	var current *Occurrence
+
+	for i, line := range records {
+		if field(line, 8, 8) != "3" {
+			continue
+		}
+
+		switch field(line, 14, 14) {
+		case "T":
+			if current != nil {
+				occurrences = append(occurrences, *current)
+			}
+
+			current, err = c.segmentT(line)
+		case "U":
+			if current == nil {
+				return nil, errors.New("segment U without segment T at line " + strconv.Itoa(i+1))
+			}
+
+			err = c.segmentU(line, current)
+		}
+
+		if err != nil {
+			return nil, errors.New("invalid detail record at line " + strconv.Itoa(i+1) + ": " + err.Error())
+		}
+	}
+
+	if current != nil {
+		occurrences = append(occurrences, *current)
+	}
+
+	return occurrences, nil
+}
+
+func (c *Cnab240) segmentT(line string) (*Occurrence, error) {
+	ourNumber, err := parseNumber(line, 38, 57)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrence := &Occurrence{
+		OurNumber: ourNumber,
+		Code:      field(line, 16, 17),
+		Kind:      febrabanOccurrenceKind(field(line, 16, 17)),
+		Reasons:   reasonCodes(field(line, 214, 223)),
+	}
+
+	occurrence.Value, err = parseMoney(line, 82, 96)
+	if err != nil {
+		return nil, err
+	}
+
+	return occurrence, nil
+}
+
+func (c *Cnab240) segmentU(line string, occurrence *Occurrence) error {
+	var err error
+
+	occurrence.Charges, err = parseMoney(line, 18, 32)
+	if err != nil {
+		return err
+	}
+
+	occurrence.Discount, err = parseMoney(line, 33, 47)
+	if err != nil {
+		return err
+	}
+
+	occurrence.PaidValue, err = parseMoney(line, 78, 92)
+	if err != nil {
+		return err
+	}
+
+	occurrence.Date, err = parseDate(line, 138, 145, "02012006")
+	if err != nil {
+		return err
+	}
+
+	occurrence.CreditDate, err = parseDate(line, 146, 153, "02012006")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func febrabanOccurrenceKind(code string) string {
+	switch code {
+	case "02":
+		return OccurrenceRegistered
+	case "03", "26", "30":
+		return OccurrenceRejected
+	case "06", "17":
+		return OccurrencePaid
+	case "09":
+		return OccurrenceWrittenOff
+	}
+
+	return OccurrenceOther
+}
@@ -0,0 +1,53 @@
+package cnab
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCnab240ShouldGenerateRemittanceFile(t *testing.T) {
+	expected, err := os.ReadFile("testdata/febraban_cnab240_remessa.rem")
+	assert.NoError(t, err)
+
+	layout := &Cnab240{}
+	content, err := layout.Remittance(getBeneficiary(), 7, generatedAt(), getTitles())
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), string(content))
+}
+
+func TestCnab240ShouldReadReturnFile(t *testing.T) {
+	content, err := os.ReadFile("testdata/febraban_cnab240_retorno.ret")
+	assert.NoError(t, err)
+
+	layout := &Cnab240{}
+	occurrences, err := layout.Return(content)
+	assert.NoError(t, err)
+	assert.Len(t, occurrences, 3)
+
+	paid := occurrences[0]
+	assert.Equal(t, int64(1), paid.OurNumber)
+	assert.Equal(t, OccurrencePaid, paid.Kind)
+	assert.Equal(t, 400.00, paid.Value)
+	assert.Equal(t, 8.40, paid.Charges)
+	assert.Equal(t, 408.40, paid.PaidValue)
+	assert.Equal(t, date("2023-10-15"), paid.Date)
+	assert.Equal(t, date("2023-10-16"), paid.CreditDate)
+
+	assert.Equal(t, OccurrenceRegistered, occurrences[1].Kind)
+
+	rejected := occurrences[2]
+	assert.Equal(t, int64(3), rejected.OurNumber)
+	assert.Equal(t, OccurrenceRejected, rejected.Kind)
+	assert.Equal(t, []string{"09", "16"}, rejected.Reasons)
+}
+
+func TestCnab240ShouldNotReadRemittanceAsReturn(t *testing.T) {
+	content, _ := os.ReadFile("testdata/febraban_cnab240_remessa.rem")
+
+	layout := &Cnab240{}
+	_, err := layout.Return(content)
+	assert.Error(t, err)
+	assert.Equal(t, "file is not a cnab 240 return", err.Error())
+}
@@ -0,0 +1,227 @@
+package cnab
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"time"
+)
+
+const cnab400Size = 400
+
+// Cnab400 Leiaute de cobranca CNAB 400 do Bradesco (banco 237)
+type Cnab400 struct{}
+
+func (c *Cnab400) Name() string {
+	return LayoutCnab400
+}
+
+func (c *Cnab400) Remittance(beneficiary Beneficiary, sequence int, generatedAt time.Time, titles []Title) ([]byte, error) {
+	err := beneficiary.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(titles) == 0 {
+		return nil, errors.New("no titles to remit")
+	}
+
+	var content bytes.Buffer
+	recordSequence := int64(1)
+
+	header := newRecord(cnab400Size)
+	header.set(1, 1, "0")
+	header.set(2, 2, "1")
+	header.set(3, 9, "REMESSA")
+	header.set(10, 11, "01")
+	header.alpha(12, 26, "COBRANCA")
+	header.digits(27, 46, beneficiary.CompanyCode)
+	header.alpha(47, 76, beneficiary.CompanyName)
+	header.set(77, 79, "237")
+	header.alpha(80, 94, "BRADESCO")
+	header.shortDate(95, 100, generatedAt)
+	header.set(109, 110, "MX")
+	header.numeric(111, 117, int64(sequence))
+	header.numeric(395, 400, recordSequence)
+	c.write(&content, header)
+
+	for _, title := range titles {
+		recordSequence++
+		c.write(&content, c.detail(beneficiary, title, generatedAt, recordSequence))
+	}
+
+	recordSequence++
+	trailer := newRecord(cnab400Size)
+	trailer.set(1, 1, "9")
+	trailer.numeric(395, 400, recordSequence)
+	c.write(&content, trailer)
+
+	return content.Bytes(), nil
+}
+
+func (c *Cnab400) detail(beneficiary Beneficiary, title Title, generatedAt time.Time, recordSequence int64) record {
+	detail := newRecord(cnab400Size)
+	detail.set(1, 1, "1")
+	detail.numeric(2, 20, 0)
+	detail.set(21, 21, "0")
+	detail.digits(22, 24, beneficiary.Wallet)
+	detail.digits(25, 29, beneficiary.Agency)
+	detail.digits(30, 36, beneficiary.Account)
+	detail.alpha(37, 37, beneficiary.AccountDigit)
+	detail.numeric(38, 62, title.OurNumber())
+	detail.numeric(63, 65, 0)
+
+	detail.set(66, 66, "0")
+	detail.numeric(67, 70, 0)
+	if title.FinePercentage() > 0 {
+		detail.set(66, 66, "2")
+		detail.money(67, 70, title.FinePercentage())
+	}
+
+	detail.numeric(71, 81, title.OurNumber())
+	detail.set(82, 82, bradescoOurNumberDigit(beneficiary.Wallet, title.OurNumber()))
+	detail.numeric(83, 92, 0)
+	detail.set(93, 93, "2")
+	detail.set(94, 94, "N")
+	detail.set(106, 106, "2")
+	detail.set(109, 110, "01")
+	detail.numeric(111, 120, title.OurNumber())
+	detail.shortDate(121, 126, title.DueDate())
+	detail.money(127, 139, title.Value())
+	detail.numeric(140, 147, 0)
+	detail.set(148, 149, "12")
+	detail.set(150, 150, "N")
+	detail.shortDate(151, 156, generatedAt)
+	detail.numeric(157, 160, 0)
+	detail.money(161, 173, title.InterestPerDay())
+	detail.shortDate(174, 179, title.DiscountUntil())
+	detail.money(180, 192, title.Discount())
+	detail.numeric(193, 218, 0)
+	detail.numeric(219, 220, title.Payer().DocumentType())
+	detail.digits(221, 234, title.Payer().Document)
+	detail.alpha(235, 274, title.Payer().Name)
+	detail.alpha(275, 314, title.Payer().Street)
+	detail.digits(327, 334, title.Payer().ZipCode)
+	detail.numeric(395, 400, recordSequence)
+
+	return detail
+}
+
+func (c *Cnab400) write(content *bytes.Buffer, line record) {
+	content.Write(line)
+	content.WriteString(lineBreak)
+}
+
+func (c *Cnab400) Return(content []byte) ([]Occurrence, error) {
+	records, err := lines(content, cnab400Size)
+	if err != nil {
+		return nil, err
+	}
+
+	if field(records[0], 1, 9) != "02RETORNO" || field(records[0], 77, 79) != "237" {
+		return nil, errors.New("file is not a cnab 400 bradesco return")
+	}
+
+	var occurrences []Occurrence
+
+	for i, line := range records[1:] {
+		if field(line, 1, 1) != "1" {
+			continue
+		}
+
+		occurrence, err := c.occurrence(line)
+		if err != nil {
+			return nil, errors.New("invalid detail record at line " + strconv.Itoa(i+2) + ": " + err.Error())
+		}
+
+		occurrences = append(occurrences, *occurrence)
+	}
+
+	return occurrences, nil
+}
+
+func (c *Cnab400) occurrence(line string) (*Occurrence, error) {
+	ourNumber, err := parseNumber(line, 71, 81)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrence := &Occurrence{
+		OurNumber: ourNumber,
+		Code:      field(line, 109, 110),
+		Kind:      bradescoOccurrenceKind(field(line, 109, 110)),
+		Reasons:   reasonCodes(field(line, 319, 328)),
+	}
+
+	occurrence.Date, err = parseDate(line, 111, 116, "020106")
+	if err != nil {
+		return nil, err
+	}
+
+	occurrence.CreditDate, err = parseDate(line, 296, 301, "020106")
+	if err != nil {
+		return nil, err
+	}
+
+	occurrence.Value, err = parseMoney(line, 153, 165)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrence.Discount, err = parseMoney(line, 241, 253)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrence.PaidValue, err = parseMoney(line, 254, 266)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrence.Charges, err = parseMoney(line, 267, 279)
+	if err != nil {
+		return nil, err
+	}
+
+	return occurrence, nil
+}
+
+func bradescoOccurrenceKind(code string) string {
+	switch code {
+	case "02":
+		return OccurrenceRegistered
+	case "03":
+		return OccurrenceRejected
+	case "06", "15", "17":
+		return OccurrencePaid
+	case "09", "10":
+		return OccurrenceWrittenOff
+	}
+
+	return OccurrenceOther
+}
+
+// bradescoOurNumberDigit Digito verificador do nosso numero: modulo 11 (pesos 2 a 7) sobre a
+// carteira e o nosso numero. Resto 1 gera o digito P e resto 0 gera o digito 0
+func bradescoOurNumberDigit(wallet string, ourNumber int64) string {
+	value := digits(wallet, 2) + numeric(ourNumber, 11)
+
+	sum := 0
+	weight := 2
+	for i := len(value) - 1; i >= 0; i-- {
+		sum += int(value[i]-'0') * weight
+		weight++
+		if weight > 7 {
+			weight = 2
+		}
+	}
+
+	switch rest := sum % 11; rest {
+	case 0:
+		return "0"
+	case 1:
+		return "P"
+	default:
+		return strconv.Itoa(11 - rest)
+	}
+}
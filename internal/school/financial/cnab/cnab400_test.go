@@ -0,0 +1,78 @@
+package cnab
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCnab400ShouldGenerateRemittanceFile(t *testing.T) {
+	expected, err := os.ReadFile("testdata/bradesco_cnab400_remessa.rem")
+	assert.NoError(t, err)
+
+	layout := &Cnab400{}
+	content, err := layout.Remittance(getBeneficiary(), 7, generatedAt(), getTitles())
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), string(content))
+}
+
+func TestCnab400ShouldNotGenerateRemittanceWithoutTitles(t *testing.T) {
+	layout := &Cnab400{}
+	_, err := layout.Remittance(getBeneficiary(), 7, generatedAt(), nil)
+	assert.Error(t, err)
+	assert.Equal(t, "no titles to remit", err.Error())
+}
+
+func TestCnab400ShouldReadReturnFile(t *testing.T) {
+	content, err := os.ReadFile("testdata/bradesco_cnab400_retorno.ret")
+	assert.NoError(t, err)
+
+	layout := &Cnab400{}
+	occurrences, err := layout.Return(content)
+	assert.NoError(t, err)
+	assert.Len(t, occurrences, 4)
+
+	paid := occurrences[0]
+	assert.Equal(t, int64(1), paid.OurNumber)
+	assert.Equal(t, OccurrencePaid, paid.Kind)
+	assert.Equal(t, 400.00, paid.Value)
+	assert.Equal(t, 380.00, paid.PaidValue)
+	assert.Equal(t, 20.00, paid.Discount)
+	assert.Equal(t, date("2023-10-05"), paid.Date)
+	assert.Equal(t, date("2023-10-06"), paid.CreditDate)
+	assert.Empty(t, paid.Reasons)
+
+	rejected := occurrences[1]
+	assert.Equal(t, int64(2), rejected.OurNumber)
+	assert.Equal(t, OccurrenceRejected, rejected.Kind)
+	assert.Equal(t, []string{"16", "48"}, rejected.Reasons)
+	assert.Equal(t, "occurrence 03, reasons 16,48", rejected.Reason())
+	assert.True(t, rejected.CreditDate.IsZero())
+
+	assert.Equal(t, OccurrenceRegistered, occurrences[2].Kind)
+	assert.Equal(t, OccurrenceOther, occurrences[3].Kind)
+	assert.Equal(t, "28", occurrences[3].Code)
+}
+
+func TestCnab400ShouldNotReadInvalidReturnFile(t *testing.T) {
+	layout := &Cnab400{}
+
+	_, err := layout.Return([]byte("02RETORNO\r\n"))
+	assert.Error(t, err)
+	assert.Equal(t, "invalid record size 9 at line 1, expected 400", err.Error())
+
+	_, err = layout.Return(nil)
+	assert.Error(t, err)
+	assert.Equal(t, "empty return file", err.Error())
+
+	content, _ := os.ReadFile("testdata/febraban_cnab240_retorno.ret")
+	_, err = layout.Return(content)
+	assert.Error(t, err)
+}
+
+func TestBradescoOurNumberDigit(t *testing.T) {
+	assert.Equal(t, "8", bradescoOurNumberDigit("19", 2))
+	assert.Equal(t, "1", bradescoOurNumberDigit("09", 1))
+	assert.Equal(t, "P", bradescoOurNumberDigit("09", 2))
+}
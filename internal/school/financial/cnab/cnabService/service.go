@@ -0,0 +1,285 @@
+package cnabService
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/cnab"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/payment"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/payment/paymentService"
+)
+
+type CnabActionsInterface interface {
+	Remittance(dto cnab.RemittanceRequestDto) (*cnab.RemittanceFile, error)
+	Return(dto cnab.ReturnRequestDto) (*cnab.ImportResult, error)
+	FindByInvoice(invoiceId string) ([]cnab.Title, error)
+}
+
+type CnabActions struct {
+	repository  cnab.Repository
+	newUow      cnab.CnabUowFactory
+	payments    paymentService.PaymentActionsInterface
+	beneficiary cnab.Beneficiary
+}
+
+func New(
+	repository cnab.Repository,
+	uowFactory cnab.CnabUowFactory,
+	payments paymentService.PaymentActionsInterface,
+	beneficiary cnab.Beneficiary,
+) *CnabActions {
+	return &CnabActions{
+		repository:  repository,
+		newUow:      uowFactory,
+		payments:    payments,
+		beneficiary: beneficiary,
+	}
+}
+
+// Remittance Gera o arquivo de remessa com um boleto para cada cobranca em aberto ainda nao
+// enviada ao banco. Os boletos so sao gravados se o arquivo for gerado com sucesso
+func (c *CnabActions) Remittance(dto cnab.RemittanceRequestDto) (*cnab.RemittanceFile, error) {
+	layout, err := cnab.NewLayout(dto.Layout)
+	if err != nil {
+		return nil, err
+	}
+
+	var dueUntil time.Time
+	if dto.DueUntil != "" {
+		dueUntil, err = time.Parse("2006-01-02", dto.DueUntil)
+		if err != nil {
+			return nil, errors.New("invalid due until date provided")
+		}
+	}
+
+	uow := c.newUow()
+
+	err = uow.BeginTransaction()
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to generate remittance file")
+	}
+
+	items, err := uow.FindInvoicesToRemit(dueUntil)
+	if err != nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return nil, errors.New("failed to get invoices to remit")
+	}
+
+	if len(items) == 0 {
+		_ = uow.Rollback()
+		return nil, errors.New("no open invoices to remit")
+	}
+
+	sequence, err := uow.NextRemittanceSequence()
+	if err != nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return nil, errors.New("failed to generate remittance file")
+	}
+
+	var titles []cnab.Title
+
+	for _, item := range items {
+		ourNumber, err := uow.NextOurNumber()
+		if err != nil {
+			_ = uow.Rollback()
+			log.Println(err)
+			return nil, errors.New("failed to generate remittance file")
+		}
+
+		title, err := cnab.NewTitle(item.Invoice, ourNumber, item.Payer, layout.Name(), sequence)
+		if err != nil {
+			_ = uow.Rollback()
+			return nil, errors.New("invoice " + item.Invoice.Id().String() + ": " + err.Error())
+		}
+
+		titles = append(titles, *title)
+	}
+
+	content, err := layout.Remittance(c.beneficiary, sequence, time.Now(), titles)
+	if err != nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return nil, errors.New("failed to generate remittance file")
+	}
+
+	err = uow.CreateTitles(titles)
+	if err != nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return nil, errors.New("failed to save titles")
+	}
+
+	err = uow.Commit()
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to generate remittance file")
+	}
+
+	return &cnab.RemittanceFile{
+		Name:     cnab.RemittanceFileName(layout.Name(), sequence),
+		Sequence: sequence,
+		Titles:   titles,
+		Content:  content,
+	}, nil
+}
+
+// Return Processa as ocorrencias do arquivo de retorno. Cada ocorrencia e tratada de forma
+// independente: falhas sao listadas no resultado sem impedir o processamento das demais, e
+// ocorrencias ja processadas sao ignoradas, permitindo reimportar o mesmo arquivo
+func (c *CnabActions) Return(dto cnab.ReturnRequestDto) (*cnab.ImportResult, error) {
+	layout, err := cnab.NewLayout(dto.Layout)
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences, err := layout.Return(dto.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &cnab.ImportResult{}
+
+	for _, occurrence := range occurrences {
+		processed, err := c.processOccurrence(occurrence)
+		if err != nil {
+			result.Failures = append(result.Failures, cnab.ImportFailure{
+				OurNumber: occurrence.OurNumber,
+				Message:   err.Error(),
+			})
+			continue
+		}
+
+		if !processed {
+			result.Ignored++
+			continue
+		}
+
+		switch occurrence.Kind {
+		case cnab.OccurrenceRegistered:
+			result.Registered++
+		case cnab.OccurrenceRejected:
+			result.Rejected++
+		case cnab.OccurrencePaid:
+			result.Paid++
+		case cnab.OccurrenceWrittenOff:
+			result.WrittenOff++
+		}
+	}
+
+	return result, nil
+}
+
+func (c *CnabActions) FindByInvoice(invoiceId string) ([]cnab.Title, error) {
+	titles, err := c.repository.FindByInvoice(invoiceId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve titles")
+	}
+
+	return titles, nil
+}
+
+// processOccurrence Aplica a ocorrencia ao boleto. Na liquidacao o pagamento e registrado na
+// cobranca enquanto o boleto permanece bloqueado. O pagamento usa o boleto como referencia, entao
+// se a atualizacao do boleto falhar a reimportacao do retorno nao paga a cobranca novamente
+func (c *CnabActions) processOccurrence(occurrence cnab.Occurrence) (bool, error) {
+	if occurrence.Kind == cnab.OccurrenceOther {
+		return false, nil
+	}
+
+	uow := c.newUow()
+
+	err := uow.BeginTransaction()
+	if err != nil {
+		log.Println(err)
+		return false, errors.New("failed to process occurrence")
+	}
+
+	title, err := uow.FindTitleByOurNumberLock(occurrence.OurNumber)
+	if err != nil || title == nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return false, errors.New("title not found")
+	}
+
+	if !c.pending(title, occurrence) {
+		_ = uow.Rollback()
+		return false, nil
+	}
+
+	switch occurrence.Kind {
+	case cnab.OccurrenceRegistered:
+		err = title.Register()
+	case cnab.OccurrenceRejected:
+		err = title.Reject(occurrence.Reason())
+	case cnab.OccurrenceWrittenOff:
+		err = title.WriteOff(occurrence.Reason())
+	case cnab.OccurrencePaid:
+		err = title.Settle()
+		if err == nil {
+			err = c.registerPayment(title, occurrence)
+		}
+	}
+
+	if err != nil {
+		_ = uow.Rollback()
+		return false, err
+	}
+
+	err = uow.UpdateTitleStatus(*title)
+	if err != nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return false, errors.New("failed to update title status")
+	}
+
+	err = uow.Commit()
+	if err != nil {
+		log.Println(err)
+		return false, errors.New("failed to process occurrence")
+	}
+
+	return true, nil
+}
+
+// pending Informa se a ocorrencia ainda altera o boleto. Registro e recusa so valem para boletos
+// aguardando registro; liquidacao e baixa para boletos ativos
+func (c *CnabActions) pending(title *cnab.Title, occurrence cnab.Occurrence) bool {
+	switch occurrence.Kind {
+	case cnab.OccurrenceRegistered, cnab.OccurrenceRejected:
+		return title.Status() == cnab.TitleStatusSent
+	case cnab.OccurrencePaid:
+		return title.Status() != cnab.TitleStatusPaid
+	}
+
+	return title.Active()
+}
+
+// registerPayment Registra a liquidacao na cobranca. Pagamento ja registrado para o boleto
+// em importacao anterior nao e duplicado
+func (c *CnabActions) registerPayment(title *cnab.Title, occurrence cnab.Occurrence) error {
+	if occurrence.PaidValue <= 0 {
+		return errors.New("paid value not informed")
+	}
+
+	paidAt := ""
+	if !occurrence.Date.IsZero() {
+		paidAt = occurrence.Date.Format("2006-01-02")
+	}
+
+	_, err := c.payments.Register(title.InvoiceId().String(), payment.RequestDto{
+		Method:    payment.MethodBoleto,
+		Value:     occurrence.PaidValue,
+		PaidAt:    paidAt,
+		Reference: title.Id().String(),
+	})
+	if errors.Is(err, payment.ErrAlreadyRegistered) {
+		return nil
+	}
+
+	return err
+}
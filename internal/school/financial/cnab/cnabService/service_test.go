@@ -0,0 +1,208 @@
+package cnabService
+
+import (
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/mocks"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/cnab"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/payment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestShouldGenerateRemittanceFile(t *testing.T) {
+	inv, _ := invoice.New(uuid.New(), uuid.New(), "Mensalidade 1/12", invoice.KindInstallment, 1, 400.00, time.Now().AddDate(0, 1, 0))
+
+	uow := new(mocks.CnabUowMock)
+	uow.On("BeginTransaction").Return(nil)
+	uow.On("FindInvoicesToRemit", mock.Anything).Return([]cnab.RemittanceItem{{Invoice: *inv, Payer: getPayer()}}, nil)
+	uow.On("NextRemittanceSequence").Return(3, nil)
+	uow.On("NextOurNumber").Return(int64(15), nil)
+	uow.On("CreateTitles", mock.Anything).Return(nil)
+	uow.On("Commit").Return(nil)
+
+	actions := New(nil, func() cnab.CnabUow { return uow }, nil, getBeneficiary())
+
+	file, err := actions.Remittance(cnab.RemittanceRequestDto{Layout: cnab.LayoutCnab400})
+	assert.NoError(t, err)
+	assert.Equal(t, "CNAB400_000003.REM", file.Name)
+	assert.Len(t, file.Titles, 1)
+	assert.Equal(t, int64(15), file.Titles[0].OurNumber())
+	assert.Equal(t, inv.Id(), file.Titles[0].InvoiceId())
+	assert.Len(t, file.Content, 3*402)
+	uow.AssertCalled(t, "CreateTitles", file.Titles)
+}
+
+func TestShouldNotGenerateRemittanceWithoutOpenInvoices(t *testing.T) {
+	uow := new(mocks.CnabUowMock)
+	uow.On("BeginTransaction").Return(nil)
+	uow.On("FindInvoicesToRemit", mock.Anything).Return([]cnab.RemittanceItem{}, nil)
+	uow.On("Rollback").Return(nil)
+
+	actions := New(nil, func() cnab.CnabUow { return uow }, nil, getBeneficiary())
+
+	_, err := actions.Remittance(cnab.RemittanceRequestDto{Layout: cnab.LayoutCnab240, DueUntil: "2023-10-31"})
+	assert.Error(t, err)
+	assert.Equal(t, "no open invoices to remit", err.Error())
+	uow.AssertNotCalled(t, "NextRemittanceSequence")
+}
+
+func TestShouldProcessReturnFile(t *testing.T) {
+	content, err := os.ReadFile("../testdata/bradesco_cnab400_retorno.ret")
+	assert.NoError(t, err)
+
+	paid := getTitle(1, cnab.TitleStatusRegistered)
+	rejected := getTitle(2, cnab.TitleStatusSent)
+
+	uow := new(mocks.CnabUowMock)
+	uow.On("BeginTransaction").Return(nil)
+	uow.On("FindTitleByOurNumberLock", int64(1)).Return(paid, nil)
+	uow.On("FindTitleByOurNumberLock", int64(2)).Return(rejected, nil)
+	uow.On("FindTitleByOurNumberLock", int64(3)).Return((*cnab.Title)(nil), errors.New("sql: no rows in result set"))
+	uow.On("UpdateTitleStatus", mock.Anything).Return(nil)
+	uow.On("Rollback").Return(nil)
+	uow.On("Commit").Return(nil)
+
+	payments := new(mocks.PaymentActionsMock)
+	payments.On("Register", paid.InvoiceId().String(), payment.RequestDto{
+		Method:    payment.MethodBoleto,
+		Value:     380.00,
+		PaidAt:    "2023-10-05",
+		Reference: paid.Id().String(),
+	}).Return(&payment.Response{}, nil)
+
+	actions := New(nil, func() cnab.CnabUow { return uow }, payments, getBeneficiary())
+
+	result, err := actions.Return(cnab.ReturnRequestDto{Layout: cnab.LayoutCnab400, Content: content})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.Paid)
+	assert.Equal(t, 1, result.Rejected)
+	assert.Equal(t, 0, result.Registered)
+	assert.Equal(t, 1, result.Ignored)
+	assert.Len(t, result.Failures, 1)
+	assert.Equal(t, int64(3), result.Failures[0].OurNumber)
+	assert.Equal(t, "title not found", result.Failures[0].Message)
+
+	assert.Equal(t, cnab.TitleStatusPaid, paid.Status())
+	assert.Equal(t, cnab.TitleStatusRejected, rejected.Status())
+	assert.Equal(t, "occurrence 03, reasons 16,48", rejected.Message())
+	payments.AssertNumberOfCalls(t, "Register", 1)
+}
+
+func TestShouldIgnoreOccurrencesAlreadyProcessed(t *testing.T) {
+	content, err := os.ReadFile("../testdata/bradesco_cnab400_retorno.ret")
+	assert.NoError(t, err)
+
+	uow := new(mocks.CnabUowMock)
+	uow.On("BeginTransaction").Return(nil)
+	uow.On("FindTitleByOurNumberLock", int64(1)).Return(getTitle(1, cnab.TitleStatusPaid), nil)
+	uow.On("FindTitleByOurNumberLock", int64(2)).Return(getTitle(2, cnab.TitleStatusRejected), nil)
+	uow.On("FindTitleByOurNumberLock", int64(3)).Return(getTitle(3, cnab.TitleStatusRegistered), nil)
+	uow.On("Rollback").Return(nil)
+
+	payments := new(mocks.PaymentActionsMock)
+
+	actions := New(nil, func() cnab.CnabUow { return uow }, payments, getBeneficiary())
+
+	result, err := actions.Return(cnab.ReturnRequestDto{Layout: cnab.LayoutCnab400, Content: content})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, result.Ignored)
+	assert.Empty(t, result.Failures)
+	uow.AssertNotCalled(t, "UpdateTitleStatus", mock.Anything)
+	payments.AssertNotCalled(t, "Register", mock.Anything, mock.Anything)
+}
+
+func TestShouldKeepTitleOpenWhenPaymentFails(t *testing.T) {
+	content, err := os.ReadFile("../testdata/febraban_cnab240_retorno.ret")
+	assert.NoError(t, err)
+
+	paid := getTitle(1, cnab.TitleStatusRegistered)
+
+	uow := new(mocks.CnabUowMock)
+	uow.On("BeginTransaction").Return(nil)
+	uow.On("FindTitleByOurNumberLock", int64(1)).Return(paid, nil)
+	uow.On("FindTitleByOurNumberLock", int64(2)).Return(getTitle(2, cnab.TitleStatusSent), nil)
+	uow.On("FindTitleByOurNumberLock", int64(3)).Return(getTitle(3, cnab.TitleStatusSent), nil)
+	uow.On("UpdateTitleStatus", mock.Anything).Return(nil)
+	uow.On("Rollback").Return(nil)
+	uow.On("Commit").Return(nil)
+
+	payments := new(mocks.PaymentActionsMock)
+	payments.On("Register", mock.Anything, mock.Anything).Return((*payment.Response)(nil), errors.New("failed to register payment"))
+
+	actions := New(nil, func() cnab.CnabUow { return uow }, payments, getBeneficiary())
+
+	result, err := actions.Return(cnab.ReturnRequestDto{Layout: cnab.LayoutCnab240, Content: content})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, result.Paid)
+	assert.Equal(t, 1, result.Registered)
+	assert.Equal(t, 1, result.Rejected)
+	assert.Len(t, result.Failures, 1)
+	assert.Equal(t, "failed to register payment", result.Failures[0].Message)
+	uow.AssertNumberOfCalls(t, "UpdateTitleStatus", 2)
+}
+
+func TestShouldSettleTitleWhenPaymentAlreadyRegistered(t *testing.T) {
+	content, err := os.ReadFile("../testdata/bradesco_cnab400_retorno.ret")
+	assert.NoError(t, err)
+
+	paid := getTitle(1, cnab.TitleStatusRegistered)
+
+	uow := new(mocks.CnabUowMock)
+	uow.On("BeginTransaction").Return(nil)
+	uow.On("FindTitleByOurNumberLock", int64(1)).Return(paid, nil)
+	uow.On("FindTitleByOurNumberLock", int64(2)).Return(getTitle(2, cnab.TitleStatusRejected), nil)
+	uow.On("FindTitleByOurNumberLock", int64(3)).Return(getTitle(3, cnab.TitleStatusRegistered), nil)
+	uow.On("UpdateTitleStatus", mock.Anything).Return(nil)
+	uow.On("Rollback").Return(nil)
+	uow.On("Commit").Return(nil)
+
+	payments := new(mocks.PaymentActionsMock)
+	payments.On("Register", paid.InvoiceId().String(), mock.Anything).Return((*payment.Response)(nil), payment.ErrAlreadyRegistered)
+
+	actions := New(nil, func() cnab.CnabUow { return uow }, payments, getBeneficiary())
+
+	result, err := actions.Return(cnab.ReturnRequestDto{Layout: cnab.LayoutCnab400, Content: content})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.Paid)
+	assert.Empty(t, result.Failures)
+	assert.Equal(t, cnab.TitleStatusPaid, paid.Status())
+	uow.AssertCalled(t, "UpdateTitleStatus", *paid)
+}
+
+func getTitle(ourNumber int64, status string) *cnab.Title {
+	title, _ := cnab.LoadTitle(uuid.NewString(), uuid.New(), ourNumber, cnab.LayoutCnab400, 1, 400.00,
+		time.Date(2023, 10, 10, 0, 0, 0, 0, time.UTC), status, "")
+	return title
+}
+
+func getPayer() cnab.Payer {
+	return cnab.Payer{
+		Name:     "Joao da Silva",
+		Document: "823.781.140-28",
+		Street:   "Rua das Flores, 10",
+		ZipCode:  "41500-030",
+		City:     "Salvador",
+		State:    "BA",
+	}
+}
+
+func getBeneficiary() cnab.Beneficiary {
+	return cnab.Beneficiary{
+		BankCode:        "237",
+		BankName:        "BRADESCO",
+		CompanyName:     "Escola Sao Joao",
+		CompanyDocument: "12.345.678/0001-90",
+		CompanyCode:     "4567890",
+		Agency:          "1234",
+		AgencyDigit:     "5",
+		Account:         "123456",
+		AccountDigit:    "7",
+		Wallet:          "09",
+	}
+}
@@ -0,0 +1,161 @@
+package cnab
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/text"
+)
+
+const lineBreak = "\r\n"
+
+// record Registro de tamanho fixo preenchido por posicao, seguindo a numeracao dos manuais
+// dos bancos (posicoes iniciando em 1 e inclusivas)
+type record []byte
+
+func newRecord(size int) record {
+	return record(bytes.Repeat([]byte(" "), size))
+}
+
+func (r record) set(start int, end int, value string) {
+	copy(r[start-1:end], value)
+}
+
+func (r record) alpha(start int, end int, value string) {
+	r.set(start, end, alpha(value, end-start+1))
+}
+
+func (r record) numeric(start int, end int, value int64) {
+	r.set(start, end, numeric(value, end-start+1))
+}
+
+func (r record) digits(start int, end int, value string) {
+	r.set(start, end, digits(value, end-start+1))
+}
+
+func (r record) money(start int, end int, value float64) {
+	r.numeric(start, end, int64(math.Round(value*100)))
+}
+
+func (r record) shortDate(start int, end int, date time.Time) {
+	if date.IsZero() {
+		r.numeric(start, end, 0)
+		return
+	}
+
+	r.set(start, end, date.Format("020106"))
+}
+
+func (r record) longDate(start int, end int, date time.Time) {
+	if date.IsZero() {
+		r.numeric(start, end, 0)
+		return
+	}
+
+	r.set(start, end, date.Format("02012006"))
+}
+
+// alpha Campo alfanumerico: maiusculo, sem acentos, alinhado a esquerda e completado com brancos
+func alpha(value string, size int) string {
+	value = text.RemoveAccents(strings.ToUpper(value))
+
+	if len(value) > size {
+		value = value[:size]
+	}
+
+	return value + strings.Repeat(" ", size-len(value))
+}
+
+// numeric Campo numerico: alinhado a direita e completado com zeros
+func numeric(value int64, size int) string {
+	formatted := fmt.Sprintf("%0*d", size, value)
+	return formatted[len(formatted)-size:]
+}
+
+// digits Mantem apenas os digitos do valor (documentos, CEP, agencia) no formato numerico
+func digits(value string, size int) string {
+	value = onlyDigits(value)
+
+	if len(value) > size {
+		return value[len(value)-size:]
+	}
+
+	return strings.Repeat("0", size-len(value)) + value
+}
+
+func onlyDigits(value string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, value)
+}
+
+// lines Separa o conteudo do arquivo em registros do tamanho do layout, ignorando linhas em branco
+func lines(content []byte, size int) ([]string, error) {
+	var records []string
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r\n\x1a")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if len(line) != size {
+			return nil, fmt.Errorf("invalid record size %d at line %d, expected %d", len(line), len(records)+1, size)
+		}
+
+		records = append(records, line)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, errors.New("empty return file")
+	}
+
+	return records, nil
+}
+
+// field Conteudo da linha entre as posicoes informadas (iniciando em 1 e inclusivas)
+func field(line string, start int, end int) string {
+	return line[start-1 : end]
+}
+
+func parseNumber(line string, start int, end int) (int64, error) {
+	value := strings.TrimSpace(field(line, start, end))
+	if value == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseInt(value, 10, 64)
+}
+
+func parseMoney(line string, start int, end int) (float64, error) {
+	cents, err := parseNumber(line, start, end)
+	if err != nil {
+		return 0, err
+	}
+
+	return float64(cents) / 100, nil
+}
+
+func parseDate(line string, start int, end int, layout string) (time.Time, error) {
+	value := field(line, start, end)
+	if strings.Trim(value, "0 ") == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(layout, value)
+}
@@ -0,0 +1,91 @@
+package cnab
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	LayoutCnab240 = "CNAB240"
+	LayoutCnab400 = "CNAB400"
+)
+
+const (
+	OccurrenceRegistered = "REGISTERED"
+	OccurrenceRejected   = "REJECTED"
+	OccurrencePaid       = "PAID"
+	OccurrenceWrittenOff = "WRITTEN_OFF"
+	OccurrenceOther      = "OTHER"
+)
+
+// Layout Leiaute de arquivos de cobranca trocados com o banco: gera a remessa com os boletos
+// a registrar e interpreta as ocorrencias do arquivo de retorno
+type Layout interface {
+	Name() string
+	Remittance(beneficiary Beneficiary, sequence int, generatedAt time.Time, titles []Title) ([]byte, error)
+	Return(content []byte) ([]Occurrence, error)
+}
+
+// Occurrence Ocorrencia de um boleto informada pelo banco no arquivo de retorno
+type Occurrence struct {
+	OurNumber  int64     `json:"our_number"`
+	Kind       string    `json:"kind"`
+	Code       string    `json:"code"`
+	Reasons    []string  `json:"reasons"`
+	Value      float64   `json:"value"`
+	PaidValue  float64   `json:"paid_value"`
+	Charges    float64   `json:"charges"`
+	Discount   float64   `json:"discount"`
+	Date       time.Time `json:"date"`
+	CreditDate time.Time `json:"credit_date"`
+}
+
+// Reason Motivos informados pelo banco para a ocorrencia
+func (o Occurrence) Reason() string {
+	if len(o.Reasons) == 0 {
+		return "occurrence " + o.Code
+	}
+
+	return "occurrence " + o.Code + ", reasons " + strings.Join(o.Reasons, ",")
+}
+
+func NewLayout(name string) (Layout, error) {
+	switch name {
+	case LayoutCnab240:
+		return &Cnab240{}, nil
+	case LayoutCnab400:
+		return &Cnab400{}, nil
+	}
+
+	return nil, errors.New("invalid cnab layout provided")
+}
+
+// RemittanceFile Arquivo de remessa gerado para envio ao banco
+type RemittanceFile struct {
+	Name     string
+	Sequence int
+	Titles   []Title
+	Content  []byte
+}
+
+// RemittanceFileName Nome do arquivo de remessa: leiaute e sequencial do arquivo
+func RemittanceFileName(layout string, sequence int) string {
+	return fmt.Sprintf("%s_%06d.REM", layout, sequence)
+}
+
+// reasonCodes Separa os codigos de motivo (2 digitos cada) ignorando posicoes nao preenchidas
+func reasonCodes(value string) []string {
+	var reasons []string
+
+	for i := 0; i+2 <= len(value); i += 2 {
+		code := strings.TrimSpace(value[i : i+2])
+		if code == "" || code == "00" {
+			continue
+		}
+		reasons = append(reasons, code)
+	}
+
+	return reasons
+}
@@ -0,0 +1,5 @@
+package cnab
+
+type Repository interface {
+	FindByInvoice(invoiceId string) ([]Title, error)
+}
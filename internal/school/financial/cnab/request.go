@@ -0,0 +1,39 @@
+package cnab
+
+import "github.com/go-playground/validator"
+
+type RemittanceRequestDto struct {
+	Layout   string `json:"layout" validate:"required,oneof=CNAB240 CNAB400"`
+	DueUntil string `json:"due_until"`
+}
+
+func (r *RemittanceRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(r)
+}
+
+type ReturnRequestDto struct {
+	Layout  string `validate:"required,oneof=CNAB240 CNAB400"`
+	Content []byte `validate:"required"`
+}
+
+func (r *ReturnRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(r)
+}
+
+// ImportFailure Ocorrencia do retorno que nao pode ser processada
+type ImportFailure struct {
+	OurNumber int64  `json:"our_number"`
+	Message   string `json:"message"`
+}
+
+// ImportResult Resumo do processamento do arquivo de retorno
+type ImportResult struct {
+	Registered int             `json:"registered"`
+	Rejected   int             `json:"rejected"`
+	Paid       int             `json:"paid"`
+	WrittenOff int             `json:"written_off"`
+	Ignored    int             `json:"ignored"`
+	Failures   []ImportFailure `json:"failures"`
+}
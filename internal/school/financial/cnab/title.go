@@ -0,0 +1,246 @@
+package cnab
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+)
+
+const (
+	TitleStatusSent       = "SENT"
+	TitleStatusRegistered = "REGISTERED"
+	TitleStatusRejected   = "REJECTED"
+	TitleStatusPaid       = "PAID"
+	TitleStatusWrittenOff = "WRITTEN_OFF"
+)
+
+// Title Boleto de uma cobranca enviado ao banco em um arquivo de remessa. O nosso numero
+// identifica o boleto nos arquivos de retorno
+type Title struct {
+	id             uuid.UUID
+	invoiceId      uuid.UUID
+	ourNumber      int64
+	layout         string
+	remittance     int
+	value          float64
+	dueDate        time.Time
+	finePercentage float64
+	interestPerDay float64
+	discount       float64
+	discountUntil  time.Time
+	payer          Payer
+	status         string
+	message        string
+}
+
+// NewTitle Cria o boleto do saldo em aberto da cobranca, com multa, juros por dia e desconto de
+// pontualidade calculados a partir das regras de cobranca da propria cobranca
+func NewTitle(inv invoice.Invoice, ourNumber int64, payer Payer, layout string, remittance int) (*Title, error) {
+	if !inv.Payable() || inv.Balance() <= 0 {
+		return nil, errors.New("invoice has no open balance to remit")
+	}
+
+	if ourNumber <= 0 {
+		return nil, errors.New("invalid our number provided")
+	}
+
+	if payer.Name == "" || onlyDigits(payer.Document) == "" {
+		return nil, errors.New("payer name and document are required")
+	}
+
+	rules := inv.ChargeRules()
+
+	t := &Title{
+		id:             uuid.New(),
+		invoiceId:      inv.Id(),
+		ourNumber:      ourNumber,
+		layout:         layout,
+		remittance:     remittance,
+		value:          inv.Balance(),
+		dueDate:        inv.DueDate(),
+		finePercentage: rules.FinePercentage,
+		interestPerDay: roundMoney(inv.Balance() * rules.DailyInterestPercentage / 100),
+		payer:          payer,
+		status:         TitleStatusSent,
+	}
+
+	if rules.DiscountPercentage > 0 {
+		t.discount = roundMoney(inv.Balance() * rules.DiscountPercentage / 100)
+		t.discountUntil = inv.DueDate().AddDate(0, 0, -rules.DiscountDaysBefore)
+	}
+
+	return t, nil
+}
+
+func LoadTitle(
+	id string,
+	invoiceId uuid.UUID,
+	ourNumber int64,
+	layout string,
+	remittance int,
+	value float64,
+	dueDate time.Time,
+	status string,
+	message string,
+) (*Title, error) {
+
+	titleId, err := uuid.Parse(id)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to change title id")
+	}
+
+	return &Title{
+		id:         titleId,
+		invoiceId:  invoiceId,
+		ourNumber:  ourNumber,
+		layout:     layout,
+		remittance: remittance,
+		value:      value,
+		dueDate:    dueDate,
+		status:     status,
+		message:    message,
+	}, nil
+}
+
+// Register Confirma o registro do boleto no banco
+func (t *Title) Register() error {
+	if t.status != TitleStatusSent {
+		return errors.New("title is not awaiting registration")
+	}
+
+	t.status = TitleStatusRegistered
+	t.message = ""
+
+	return nil
+}
+
+// Reject Registra a recusa do boleto pelo banco. A cobranca volta a ser enviada na proxima remessa
+func (t *Title) Reject(reason string) error {
+	if t.status != TitleStatusSent {
+		return errors.New("title is not awaiting registration")
+	}
+
+	t.status = TitleStatusRejected
+	t.message = reason
+
+	return nil
+}
+
+// Settle Registra a liquidacao do boleto. Boletos baixados ainda podem ser liquidados pelo banco
+func (t *Title) Settle() error {
+	if !t.Active() && t.status != TitleStatusWrittenOff {
+		return errors.New("title cannot be settled with status " + t.status)
+	}
+
+	t.status = TitleStatusPaid
+	t.message = ""
+
+	return nil
+}
+
+// WriteOff Registra a baixa do boleto no banco sem pagamento
+func (t *Title) WriteOff(reason string) error {
+	if !t.Active() {
+		return errors.New("title cannot be written off with status " + t.status)
+	}
+
+	t.status = TitleStatusWrittenOff
+	t.message = reason
+
+	return nil
+}
+
+// Active Boletos enviados ou registrados ainda podem ser pagos no banco
+func (t *Title) Active() bool {
+	return t.status == TitleStatusSent || t.status == TitleStatusRegistered
+}
+
+func (t *Title) Id() uuid.UUID {
+	return t.id
+}
+
+func (t *Title) InvoiceId() uuid.UUID {
+	return t.invoiceId
+}
+
+func (t *Title) OurNumber() int64 {
+	return t.ourNumber
+}
+
+func (t *Title) Layout() string {
+	return t.layout
+}
+
+func (t *Title) Remittance() int {
+	return t.remittance
+}
+
+func (t *Title) Value() float64 {
+	return t.value
+}
+
+func (t *Title) DueDate() time.Time {
+	return t.dueDate
+}
+
+func (t *Title) FinePercentage() float64 {
+	return t.finePercentage
+}
+
+func (t *Title) InterestPerDay() float64 {
+	return t.interestPerDay
+}
+
+func (t *Title) Discount() float64 {
+	return t.discount
+}
+
+func (t *Title) DiscountUntil() time.Time {
+	return t.discountUntil
+}
+
+func (t *Title) Payer() Payer {
+	return t.payer
+}
+
+func (t *Title) Status() string {
+	return t.status
+}
+
+func (t *Title) Message() string {
+	return t.message
+}
+
+func (t *Title) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Id         string  `json:"id"`
+		InvoiceId  string  `json:"invoice_id"`
+		OurNumber  int64   `json:"our_number"`
+		Layout     string  `json:"layout"`
+		Remittance int     `json:"remittance"`
+		Value      float64 `json:"value"`
+		DueDate    string  `json:"due_date"`
+		Status     string  `json:"status"`
+		Message    string  `json:"message"`
+	}{
+		Id:         t.Id().String(),
+		InvoiceId:  t.InvoiceId().String(),
+		OurNumber:  t.OurNumber(),
+		Layout:     t.Layout(),
+		Remittance: t.Remittance(),
+		Value:      t.Value(),
+		DueDate:    t.DueDate().Format("2006-01-02"),
+		Status:     t.Status(),
+		Message:    t.Message(),
+	})
+}
+
+func roundMoney(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,133 @@
+package cnab
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldCreateTitleWithChargeRules(t *testing.T) {
+	titles := getTitles()
+
+	assert.Equal(t, 400.00, titles[0].Value())
+	assert.Equal(t, 2.00, titles[0].FinePercentage())
+	assert.Equal(t, 0.13, titles[0].InterestPerDay())
+	assert.Equal(t, 20.00, titles[0].Discount())
+	assert.Equal(t, date("2023-10-05"), titles[0].DiscountUntil())
+	assert.Equal(t, TitleStatusSent, titles[0].Status())
+
+	assert.Equal(t, 300.00, titles[1].Value())
+	assert.Equal(t, 0.0, titles[1].InterestPerDay())
+	assert.True(t, titles[1].DiscountUntil().IsZero())
+}
+
+func TestShouldNotCreateTitleForPaidInvoice(t *testing.T) {
+	inv, _ := invoice.Load(uuid.NewString(), uuid.New(), uuid.New(), "Mensalidade 1/12", invoice.KindInstallment, 1,
+		400.00, 400.00, date("2023-10-10"), invoice.StatusPaid, service.ChargeRules{}, 0, 0)
+
+	_, err := NewTitle(*inv, 1, getPayers()[0], LayoutCnab400, 1)
+	assert.Error(t, err)
+	assert.Equal(t, "invoice has no open balance to remit", err.Error())
+}
+
+func TestShouldNotCreateTitleWithoutPayerDocument(t *testing.T) {
+	inv, _ := invoice.New(uuid.New(), uuid.New(), "Mensalidade 1/12", invoice.KindInstallment, 1, 400.00, date("2023-10-10"))
+
+	_, err := NewTitle(*inv, 1, Payer{Name: "Joao da Silva"}, LayoutCnab400, 1)
+	assert.Error(t, err)
+	assert.Equal(t, "payer name and document are required", err.Error())
+}
+
+func TestTitleStatusTransitions(t *testing.T) {
+	t.Run("should register and settle title", func(t *testing.T) {
+		title := getTitles()[0]
+		assert.NoError(t, title.Register())
+		assert.NoError(t, title.Settle())
+		assert.Equal(t, TitleStatusPaid, title.Status())
+	})
+
+	t.Run("should not register rejected title", func(t *testing.T) {
+		title := getTitles()[0]
+		assert.NoError(t, title.Reject("occurrence 03, reasons 16"))
+		assert.Equal(t, "occurrence 03, reasons 16", title.Message())
+		assert.Error(t, title.Register())
+		assert.Error(t, title.Settle())
+	})
+
+	t.Run("should settle title written off", func(t *testing.T) {
+		title := getTitles()[0]
+		assert.NoError(t, title.WriteOff("occurrence 09"))
+		assert.Error(t, title.WriteOff("occurrence 09"))
+		assert.NoError(t, title.Settle())
+	})
+}
+
+func getBeneficiary() Beneficiary {
+	return Beneficiary{
+		BankCode:        "237",
+		BankName:        "BRADESCO",
+		CompanyName:     "Escola Sao Joao",
+		CompanyDocument: "12.345.678/0001-90",
+		CompanyCode:     "4567890",
+		Agency:          "1234",
+		AgencyDigit:     "5",
+		Account:         "123456",
+		AccountDigit:    "7",
+		Wallet:          "09",
+	}
+}
+
+func getPayers() []Payer {
+	return []Payer{
+		{
+			Name:     "João da Silva",
+			Document: "823.781.140-28",
+			Street:   "Rua das Flores, 10",
+			District: "Centro",
+			ZipCode:  "41500-030",
+			City:     "Salvador",
+			State:    "BA",
+		},
+		{
+			Name:     "Empresa Ltda",
+			Document: "11.222.333/0001-81",
+			Street:   "Av Sete de Setembro, 100",
+			ZipCode:  "40060-001",
+			City:     "Salvador",
+			State:    "BA",
+		},
+	}
+}
+
+func getTitles() []Title {
+	payers := getPayers()
+
+	first, _ := invoice.Load(uuid.NewString(), uuid.New(), uuid.New(), "Mensalidade 1/12", invoice.KindInstallment, 1,
+		400.00, 0, date("2023-10-10"), invoice.StatusOpen, service.ChargeRules{
+			FinePercentage:          2,
+			DailyInterestPercentage: 0.033,
+			DiscountPercentage:      5,
+			DiscountDaysBefore:      5,
+		}, 0, 0)
+
+	second, _ := invoice.Load(uuid.NewString(), uuid.New(), uuid.New(), "Mensalidade 2/12", invoice.KindInstallment, 2,
+		400.00, 100.00, date("2023-11-10"), invoice.StatusPartiallyPaid, service.ChargeRules{}, 0, 0)
+
+	firstTitle, _ := NewTitle(*first, 1, payers[0], LayoutCnab400, 7)
+	secondTitle, _ := NewTitle(*second, 2, payers[1], LayoutCnab400, 7)
+
+	return []Title{*firstTitle, *secondTitle}
+}
+
+func generatedAt() time.Time {
+	return time.Date(2023, 9, 13, 10, 30, 0, 0, time.UTC)
+}
+
+func date(value string) time.Time {
+	d, _ := time.Parse("2006-01-02", value)
+	return d
+}
@@ -0,0 +1,28 @@
+package cnab
+
+import (
+	"time"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+)
+
+// RemittanceItem Cobranca em aberto a ser enviada ao banco com os dados do seu pagador
+type RemittanceItem struct {
+	Invoice invoice.Invoice
+	Payer   Payer
+}
+
+type CnabUow interface {
+	BeginTransaction() error
+	Commit() error
+	Rollback() error
+	NextRemittanceSequence() (int, error)
+	NextOurNumber() (int64, error)
+	FindInvoicesToRemit(dueUntil time.Time) ([]RemittanceItem, error)
+	CreateTitles(titles []Title) error
+	FindTitleByOurNumberLock(ourNumber int64) (*Title, error)
+	UpdateTitleStatus(title Title) error
+}
+
+// CnabUowFactory Cria uma nova unidade de trabalho para cada remessa ou ocorrencia de retorno
+type CnabUowFactory func() CnabUow
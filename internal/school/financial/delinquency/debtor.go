@@ -0,0 +1,88 @@
+package delinquency
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Aging Saldo em atraso distribuido pela quantidade de dias desde o vencimento
+type Aging struct {
+	UpTo30 float64 `json:"0_30"`
+	UpTo60 float64 `json:"31_60"`
+	UpTo90 float64 `json:"61_90"`
+	Over90 float64 `json:"90_plus"`
+}
+
+// Debtor Aluno com cobrancas vencidas e o responsavel pelo pagamento. Quando o aluno e o
+// proprio responsavel, os dados do responsavel sao os do aluno
+type Debtor struct {
+	StudentId        uuid.UUID `json:"student_id"`
+	StudentName      string    `json:"student_name"`
+	StudentCpf       string    `json:"student_cpf"`
+	ResponsibleName  string    `json:"responsible_name"`
+	ResponsibleCpf   string    `json:"responsible_cpf"`
+	ResponsibleEmail string    `json:"responsible_email"`
+	OverdueInvoices  int       `json:"overdue_invoices"`
+	OldestDueDate    time.Time `json:"oldest_due_date"`
+	Aging            Aging     `json:"aging"`
+	Total            float64   `json:"total"`
+}
+
+var csvHeader = []string{
+	"student_id",
+	"student_name",
+	"student_cpf",
+	"responsible_name",
+	"responsible_cpf",
+	"responsible_email",
+	"overdue_invoices",
+	"oldest_due_date",
+	"0_30",
+	"31_60",
+	"61_90",
+	"90_plus",
+	"total",
+}
+
+// WriteCsv Exporta o relatorio de inadimplencia em CSV, uma linha por aluno
+func WriteCsv(w io.Writer, debtors []Debtor) error {
+	writer := csv.NewWriter(w)
+
+	err := writer.Write(csvHeader)
+	if err != nil {
+		return err
+	}
+
+	for _, debtor := range debtors {
+		err = writer.Write([]string{
+			debtor.StudentId.String(),
+			debtor.StudentName,
+			debtor.StudentCpf,
+			debtor.ResponsibleName,
+			debtor.ResponsibleCpf,
+			debtor.ResponsibleEmail,
+			strconv.Itoa(debtor.OverdueInvoices),
+			debtor.OldestDueDate.Format("2006-01-02"),
+			money(debtor.Aging.UpTo30),
+			money(debtor.Aging.UpTo60),
+			money(debtor.Aging.UpTo90),
+			money(debtor.Aging.Over90),
+			money(debtor.Total),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
+
+func money(value float64) string {
+	return strconv.FormatFloat(value, 'f', 2, 64)
+}
@@ -0,0 +1,37 @@
+package delinquency
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldWriteDebtorsCsv(t *testing.T) {
+	studentId := uuid.MustParse("0ef07ac8-9172-40d6-af85-1577fff8e167")
+
+	var content bytes.Buffer
+	err := WriteCsv(&content, []Debtor{
+		{
+			StudentId:        studentId,
+			StudentName:      "Henrique Rocha",
+			StudentCpf:       "82378114028",
+			ResponsibleName:  "Silva, Maria",
+			ResponsibleCpf:   "62449972048",
+			ResponsibleEmail: "parent@mail.com",
+			OverdueInvoices:  3,
+			OldestDueDate:    time.Date(2023, 6, 10, 0, 0, 0, 0, time.UTC),
+			Aging:            Aging{UpTo30: 400, UpTo60: 0, UpTo90: 400, Over90: 150.5},
+			Total:            950.5,
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t,
+		"student_id,student_name,student_cpf,responsible_name,responsible_cpf,responsible_email,overdue_invoices,oldest_due_date,0_30,31_60,61_90,90_plus,total\n"+
+			"0ef07ac8-9172-40d6-af85-1577fff8e167,Henrique Rocha,82378114028,\"Silva, Maria\",62449972048,parent@mail.com,3,2023-06-10,400.00,0.00,400.00,150.50,950.50\n",
+		content.String(),
+	)
+}
@@ -0,0 +1,83 @@
+package delinquencyService
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/delinquency"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+)
+
+type DelinquencyActionsInterface interface {
+	Report(date string, dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error)
+	Export(date string) ([]byte, error)
+}
+
+type DelinquencyActions struct {
+	repository delinquency.Repository
+}
+
+func New(repository delinquency.Repository) *DelinquencyActions {
+	return &DelinquencyActions{
+		repository: repository,
+	}
+}
+
+// Report Alunos com cobrancas vencidas na data informada (formato 2006-01-02), agrupados por
+// aluno e responsavel. Sem data, considera o dia atual
+func (d *DelinquencyActions) Report(date string, dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error) {
+	referenceDate, err := d.referenceDate(date)
+	if err != nil {
+		return nil, err
+	}
+
+	pg := paginator.Pagination{}
+	pg.FillFromDto(dtoRequest)
+
+	debtors, err := d.repository.FindDebtors(referenceDate, pg)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve debtors")
+	}
+
+	return debtors, nil
+}
+
+// Export Relatorio de inadimplencia completo em CSV
+func (d *DelinquencyActions) Export(date string) ([]byte, error) {
+	referenceDate, err := d.referenceDate(date)
+	if err != nil {
+		return nil, err
+	}
+
+	debtors, err := d.repository.FindAllDebtors(referenceDate)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve debtors")
+	}
+
+	var content bytes.Buffer
+
+	err = delinquency.WriteCsv(&content, debtors)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to export debtors")
+	}
+
+	return content.Bytes(), nil
+}
+
+func (d *DelinquencyActions) referenceDate(date string) (time.Time, error) {
+	if date == "" {
+		return time.Now(), nil
+	}
+
+	referenceDate, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return time.Time{}, errors.New("invalid date provided")
+	}
+
+	return referenceDate, nil
+}
@@ -0,0 +1,69 @@
+package delinquency
+
+import (
+	"crypto/subtle"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrOverdueDebt Retornado quando a matricula e bloqueada por debito em atraso
+var ErrOverdueDebt = errors.New("student has overdue debt")
+
+// ErrUnauthorizedApprover Retornado quando a liberacao e feita por quem nao tem permissao ou com chave invalida
+var ErrUnauthorizedApprover = errors.New("approver is not authorized to override overdue debt block")
+
+// Policy Regra de bloqueio de matricula para alunos inadimplentes. Debitos vencidos ha mais
+// de ToleranceDays dias e acima de MinimumDebt bloqueiam a matricula, salvo liberacao
+// justificada registrada no historico da matricula
+type Policy struct {
+	Enabled       bool
+	ToleranceDays int
+	MinimumDebt   float64
+	Approvers     map[string]string
+}
+
+// ParseApprovers Le os autorizados a liberar a matricula no formato "aprovador:chave", separados por
+// virgulas (ex: "coordenacao:chave1,diretoria:chave2")
+func ParseApprovers(value string) map[string]string {
+	approvers := map[string]string{}
+
+	for _, pair := range strings.Split(value, ",") {
+		approver, key, found := strings.Cut(pair, ":")
+		if !found || strings.TrimSpace(approver) == "" || strings.TrimSpace(key) == "" {
+			continue
+		}
+
+		approvers[strings.TrimSpace(approver)] = strings.TrimSpace(key)
+	}
+
+	return approvers
+}
+
+// OverdueBefore Data limite de vencimento considerada no bloqueio: cobrancas vencidas antes dela
+func (p Policy) OverdueBefore(date time.Time) time.Time {
+	year, month, day := date.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, date.Location()).AddDate(0, 0, -p.ToleranceDays)
+}
+
+// Blocks Informa se o valor em atraso impede a matricula
+func (p Policy) Blocks(debt float64) bool {
+	return p.Enabled && debt > 0 && debt > p.MinimumDebt
+}
+
+// Override Valida a liberacao da matricula de um aluno inadimplente e retorna a justificativa
+// registrada no historico da matricula. So libera quem esta entre os aprovadores configurados e
+// informa a propria chave, ja que a aplicacao nao autentica usuarios
+func (p Policy) Override(debt float64, approver string, key string, reason string) (string, error) {
+	if strings.TrimSpace(reason) == "" {
+		return "", errors.New("reason is required to override overdue debt block")
+	}
+
+	expected, ok := p.Approvers[strings.TrimSpace(approver)]
+	if !ok || subtle.ConstantTimeCompare([]byte(expected), []byte(key)) != 1 {
+		return "", ErrUnauthorizedApprover
+	}
+
+	return "overdue debt of " + strconv.FormatFloat(debt, 'f', 2, 64) + " overridden: " + reason, nil
+}
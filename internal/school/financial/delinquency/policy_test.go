@@ -0,0 +1,46 @@
+package delinquency
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelinquencyPolicy(t *testing.T) {
+	policy := Policy{Enabled: true, ToleranceDays: 5, MinimumDebt: 50, Approvers: ParseApprovers("coordenacao:secret, :empty,invalid")}
+
+	t.Run("should consider invoices overdue after tolerance days", func(t *testing.T) {
+		date := time.Date(2023, 9, 13, 15, 30, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2023, 9, 8, 0, 0, 0, 0, time.UTC), policy.OverdueBefore(date))
+	})
+
+	t.Run("should block only debts above minimum", func(t *testing.T) {
+		assert.True(t, policy.Blocks(50.01))
+		assert.False(t, policy.Blocks(50))
+		assert.False(t, Policy{}.Blocks(1000))
+	})
+
+	t.Run("should override block with reason", func(t *testing.T) {
+		reason, err := policy.Override(120, "coordenacao", "secret", "payment agreement")
+		assert.NoError(t, err)
+		assert.Equal(t, "overdue debt of 120.00 overridden: payment agreement", reason)
+	})
+
+	t.Run("should not override block without reason", func(t *testing.T) {
+		_, err := policy.Override(120, "coordenacao", "secret", " ")
+		assert.Error(t, err)
+		assert.Equal(t, "reason is required to override overdue debt block", err.Error())
+	})
+
+	t.Run("should not override block without an authorized approver", func(t *testing.T) {
+		_, err := policy.Override(120, "secretaria", "secret", "payment agreement")
+		assert.ErrorIs(t, err, ErrUnauthorizedApprover)
+
+		_, err = policy.Override(120, "coordenacao", "wrong", "payment agreement")
+		assert.ErrorIs(t, err, ErrUnauthorizedApprover)
+
+		_, err = Policy{Enabled: true}.Override(120, "", "", "payment agreement")
+		assert.ErrorIs(t, err, ErrUnauthorizedApprover)
+	})
+}
@@ -0,0 +1,12 @@
+package delinquency
+
+import (
+	"time"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+)
+
+type Repository interface {
+	FindDebtors(referenceDate time.Time, pagination paginator.Pagination) (*paginator.PaginationResult, error)
+	FindAllDebtors(referenceDate time.Time) ([]Debtor, error)
+}
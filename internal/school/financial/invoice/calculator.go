@@ -0,0 +1,66 @@
+package invoice
+
+import (
+	"math"
+	"time"
+)
+
+// AmountDue Valor devido da cobranca em uma data: saldo principal acrescido de multa e juros
+// de mora quando em atraso, ou abatido do desconto de pontualidade quando pago antecipadamente
+type AmountDue struct {
+	Date        time.Time `json:"-"`
+	Principal   float64   `json:"principal"`
+	Fine        float64   `json:"fine"`
+	Interest    float64   `json:"interest"`
+	Discount    float64   `json:"discount"`
+	Total       float64   `json:"total"`
+	DaysOverdue int       `json:"days_overdue"`
+}
+
+// AmountDue Calcula o valor devido na data informada com base nas regras de cobranca da cobranca.
+// Multa e juros incidem sobre o saldo principal; os juros sao simples e contados por dia de atraso
+func (i *Invoice) AmountDue(date time.Time) AmountDue {
+	return i.amountDue(date, true)
+}
+
+func (i *Invoice) amountDue(date time.Time, withDiscount bool) AmountDue {
+	due := AmountDue{
+		Date:      date,
+		Principal: i.Balance(),
+	}
+
+	if !i.Payable() {
+		return due
+	}
+
+	daysOverdue := daysBetween(i.dueDate, date)
+
+	if daysOverdue > 0 {
+		due.DaysOverdue = daysOverdue
+		due.Fine = roundMoney(due.Principal * i.chargeRules.FinePercentage / 100)
+		due.Interest = roundMoney(due.Principal * i.chargeRules.DailyInterestPercentage / 100 * float64(daysOverdue))
+	}
+
+	if withDiscount && i.discountAvailableAt(date) {
+		due.Discount = roundMoney(due.Principal * i.chargeRules.DiscountPercentage / 100)
+	}
+
+	due.Total = roundMoney(due.Principal + due.Fine + due.Interest - due.Discount)
+
+	return due
+}
+
+// discountAvailableAt O desconto vale para pagamentos ate N dias antes do vencimento
+func (i *Invoice) discountAvailableAt(date time.Time) bool {
+	if i.chargeRules.DiscountPercentage <= 0 {
+		return false
+	}
+
+	return daysBetween(date, i.dueDate) >= i.chargeRules.DiscountDaysBefore
+}
+
+// daysBetween Quantidade de dias corridos entre as datas, desconsiderando o horario
+func daysBetween(from time.Time, to time.Time) int {
+	hours := truncateDate(to).Sub(truncateDate(from)).Hours()
+	return int(math.Round(hours / 24))
+}
@@ -0,0 +1,84 @@
+package invoice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvoiceAmountDue(t *testing.T) {
+	dueDate := time.Date(2023, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	newInvoice := func() *Invoice {
+		inv, _ := New(uuid.New(), uuid.New(), "Mensalidade 1/12", KindInstallment, 1, 1000.00, dueDate)
+		inv.ChangeChargeRules(service.ChargeRules{
+			FinePercentage:          2,
+			DailyInterestPercentage: 0.033,
+			DiscountPercentage:      5,
+			DiscountDaysBefore:      5,
+		})
+		return inv
+	}
+
+	t.Run("should apply discount when paid until N days before due date", func(t *testing.T) {
+		due := newInvoice().AmountDue(time.Date(2023, time.March, 5, 15, 30, 0, 0, time.UTC))
+		assert.Equal(t, 50.00, due.Discount)
+		assert.Equal(t, 0.0, due.Fine)
+		assert.Equal(t, 950.00, due.Total)
+	})
+
+	t.Run("should charge face value between discount limit and due date", func(t *testing.T) {
+		due := newInvoice().AmountDue(dueDate)
+		assert.Equal(t, 0.0, due.Discount)
+		assert.Equal(t, 0, due.DaysOverdue)
+		assert.Equal(t, 1000.00, due.Total)
+	})
+
+	t.Run("should charge fine and daily interest when overdue", func(t *testing.T) {
+		due := newInvoice().AmountDue(time.Date(2023, time.March, 20, 0, 0, 0, 0, time.UTC))
+		assert.Equal(t, 10, due.DaysOverdue)
+		assert.Equal(t, 20.00, due.Fine)
+		assert.Equal(t, 3.30, due.Interest)
+		assert.Equal(t, 1023.30, due.Total)
+	})
+
+	t.Run("should split partial payment of overdue invoice between principal and charges", func(t *testing.T) {
+		inv := newInvoice()
+		paidAt := time.Date(2023, time.March, 20, 0, 0, 0, 0, time.UTC)
+
+		applied, excess, err := inv.Pay(1000.00, paidAt)
+		assert.NoError(t, err)
+		assert.Equal(t, 1000.00, applied)
+		assert.Equal(t, 0.0, excess)
+		assert.Equal(t, StatusPartiallyPaid, inv.Status())
+		assert.Greater(t, inv.Balance(), 0.0)
+
+		due := inv.AmountDue(paidAt)
+		applied, _, err = inv.Pay(due.Total, paidAt)
+		assert.NoError(t, err)
+		assert.Equal(t, due.Total, applied)
+		assert.Equal(t, StatusPaid, inv.Status())
+		assert.InDelta(t, 23.30, inv.ChargesPaid(), 0.01)
+	})
+
+	t.Run("should grant discount only when payment covers the discounted total", func(t *testing.T) {
+		inv := newInvoice()
+		paidAt := time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+		applied, excess, err := inv.Pay(950.00, paidAt)
+		assert.NoError(t, err)
+		assert.Equal(t, 950.00, applied)
+		assert.Equal(t, 0.0, excess)
+		assert.Equal(t, StatusPaid, inv.Status())
+		assert.Equal(t, 50.00, inv.DiscountValue())
+
+		partial := newInvoice()
+		_, _, err = partial.Pay(500.00, paidAt)
+		assert.NoError(t, err)
+		assert.Equal(t, 0.0, partial.DiscountValue())
+		assert.Equal(t, 500.00, partial.Balance())
+	})
+}
@@ -0,0 +1,170 @@
+package invoice
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/service"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
+)
+
+// Billing Condicoes financeiras da matricula usadas para gerar as cobrancas
+type Billing struct {
+	RegistrationId       uuid.UUID
+	StudentId            uuid.UUID
+	MonthlyFee           float64
+	InstallmentsQuantity int
+	EnrollmentFee        float64
+	EnrollmentFeePaid    bool
+	EnrollmentDueDate    time.Time
+	EnrollmentDate       time.Time
+	PaymentDay           string
+	ChargeRules          service.ChargeRules
+	Discounts            []InstallmentDiscount
+}
+
+// InstallmentDiscount Desconto abatido das mensalidades que vencem dentro do periodo de vigencia.
+// Sem data final o desconto vale para todas as mensalidades a partir do inicio
+type InstallmentDiscount struct {
+	Amount     float64
+	ValidFrom  time.Time
+	ValidUntil time.Time
+}
+
+func (d InstallmentDiscount) validAt(date time.Time) bool {
+	if date.Before(d.ValidFrom) {
+		return false
+	}
+
+	return d.ValidUntil.IsZero() || !date.After(d.ValidUntil)
+}
+
+// installmentValue Valor da mensalidade com os descontos vigentes no vencimento
+func (b Billing) installmentValue(dueDate time.Time) float64 {
+	value := b.MonthlyFee
+
+	for _, discount := range b.Discounts {
+		if discount.validAt(dueDate) {
+			value -= discount.Amount
+		}
+	}
+
+	return roundMoney(math.Max(value, 0))
+}
+
+// Generate Gera a cobranca da taxa de matricula e uma parcela por mes a partir do inicio do ano letivo
+// (ou da data da matricula, se posterior). Parcelas que ultrapassarem o fim do ano letivo vencem no ultimo dia do periodo.
+// Cada mensalidade e abatida dos descontos vigentes no seu vencimento; parcelas cobertas pelos descontos nao sao geradas
+func Generate(billing Billing, schoolYear schoolyear.SchoolYear) ([]Invoice, error) {
+	dueDates, err := InstallmentDueDates(billing, schoolYear)
+	if err != nil {
+		return nil, err
+	}
+
+	var invoices []Invoice
+
+	if billing.EnrollmentFee > 0 && !billing.EnrollmentFeePaid {
+		dueDate := billing.EnrollmentDueDate
+		if dueDate.IsZero() {
+			dueDate = truncateDate(billing.EnrollmentDate)
+		}
+
+		enrollmentInvoice, err := New(
+			billing.RegistrationId,
+			billing.StudentId,
+			"Taxa de matricula",
+			KindEnrollmentFee,
+			0,
+			billing.EnrollmentFee,
+			dueDate,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		enrollmentInvoice.ChangeChargeRules(billing.ChargeRules)
+		invoices = append(invoices, *enrollmentInvoice)
+	}
+
+	for index, dueDate := range dueDates {
+		installment := index + 1
+		value := billing.installmentValue(dueDate)
+		if value == 0 {
+			continue
+		}
+
+		installmentInvoice, err := New(
+			billing.RegistrationId,
+			billing.StudentId,
+			"Mensalidade "+strconv.Itoa(installment)+"/"+strconv.Itoa(billing.InstallmentsQuantity),
+			KindInstallment,
+			installment,
+			value,
+			dueDate,
+		)
+
+		if err != nil {
+			return nil, err
+		}
+
+		installmentInvoice.ChangeChargeRules(billing.ChargeRules)
+		invoices = append(invoices, *installmentInvoice)
+	}
+
+	return invoices, nil
+}
+
+// InstallmentDueDates Vencimentos das mensalidades: um por mes a partir do inicio do ano letivo (ou da data
+// da matricula, se posterior). Parcelas que ultrapassarem o fim do ano letivo vencem no ultimo dia do periodo
+func InstallmentDueDates(billing Billing, schoolYear schoolyear.SchoolYear) ([]time.Time, error) {
+	paymentDay, err := strconv.Atoi(billing.PaymentDay)
+	if err != nil || paymentDay < 1 || paymentDay > 31 {
+		return nil, errors.New("invalid payment day provided")
+	}
+
+	start := truncateDate(*schoolYear.StartAt())
+	if billing.EnrollmentDate.After(start) {
+		start = truncateDate(billing.EnrollmentDate)
+	}
+
+	end := truncateDate(*schoolYear.EndAt())
+
+	firstDueDate := dueDateIn(start.Year(), start.Month(), paymentDay)
+	if firstDueDate.Before(start) {
+		firstDueDate = dueDateIn(start.Year(), start.Month()+1, paymentDay)
+	}
+
+	var dueDates []time.Time
+
+	for installment := 1; installment <= billing.InstallmentsQuantity; installment++ {
+		dueDate := dueDateIn(firstDueDate.Year(), firstDueDate.Month()+time.Month(installment-1), paymentDay)
+		if dueDate.After(end) {
+			dueDate = end
+		}
+
+		dueDates = append(dueDates, dueDate)
+	}
+
+	return dueDates, nil
+}
+
+// dueDateIn Monta a data de vencimento no mes informado, usando o ultimo dia do mes
+// quando o dia de pagamento nao existir nele (ex: dia 31 em fevereiro)
+func dueDateIn(year int, month time.Month, day int) time.Time {
+	firstDay := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	lastDay := firstDay.AddDate(0, 1, -1).Day()
+
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return time.Date(firstDay.Year(), firstDay.Month(), day, 0, 0, 0, 0, time.UTC)
+}
+
+func truncateDate(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
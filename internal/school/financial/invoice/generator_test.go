@@ -0,0 +1,113 @@
+package invoice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldGenerateEnrollmentFeeAndInstallments(t *testing.T) {
+	schoolYear, _ := schoolyear.New("2024", "2024-02-01", "2024-12-15")
+	billing := getBilling("31", date("2024-01-10"))
+
+	invoices, err := Generate(billing, *schoolYear)
+	assert.NoError(t, err)
+	assert.Len(t, invoices, 11)
+
+	assert.Equal(t, KindEnrollmentFee, invoices[0].Kind())
+	assert.Equal(t, 60.00, invoices[0].Value())
+	assert.Equal(t, date("2024-01-20"), invoices[0].DueDate())
+
+	assert.Equal(t, KindInstallment, invoices[1].Kind())
+	assert.Equal(t, 1, invoices[1].Installment())
+	assert.Equal(t, date("2024-02-29"), invoices[1].DueDate())
+	assert.Equal(t, date("2024-03-31"), invoices[2].DueDate())
+	assert.Equal(t, date("2024-04-30"), invoices[3].DueDate())
+	assert.Equal(t, date("2024-11-30"), invoices[10].DueDate())
+
+	for _, inv := range invoices {
+		assert.Equal(t, StatusOpen, inv.Status())
+		assert.Equal(t, billing.RegistrationId, inv.RegistrationId())
+	}
+}
+
+func TestShouldStartInstallmentsAfterEnrollmentDate(t *testing.T) {
+	schoolYear, _ := schoolyear.New("2024", "2024-02-01", "2024-12-15")
+	billing := getBilling("10", date("2024-03-20"))
+	billing.EnrollmentFeePaid = true
+	billing.InstallmentsQuantity = 12
+
+	invoices, err := Generate(billing, *schoolYear)
+	assert.NoError(t, err)
+	assert.Len(t, invoices, 12)
+	assert.Equal(t, KindInstallment, invoices[0].Kind())
+	assert.Equal(t, date("2024-04-10"), invoices[0].DueDate())
+	assert.Equal(t, date("2024-12-10"), invoices[8].DueDate())
+	assert.Equal(t, date("2024-12-15"), invoices[9].DueDate())
+	assert.Equal(t, date("2024-12-15"), invoices[11].DueDate())
+}
+
+func TestShouldReturnErrorWhenPaymentDayIsInvalid(t *testing.T) {
+	schoolYear, _ := schoolyear.New("2024", "2024-02-01", "2024-12-15")
+
+	_, err := Generate(getBilling("45", date("2024-01-10")), *schoolYear)
+	assert.Error(t, err)
+	assert.Equal(t, "invalid payment day provided", err.Error())
+}
+
+func TestShouldApplyDiscountsValidAtInstallmentDueDate(t *testing.T) {
+	schoolYear, _ := schoolyear.New("2024", "2024-02-01", "2024-12-15")
+	billing := getBilling("10", date("2024-01-10"))
+	billing.EnrollmentFeePaid = true
+	billing.Discounts = []InstallmentDiscount{
+		{Amount: 200.00, ValidFrom: date("2024-01-10"), ValidUntil: date("2024-06-30")},
+		{Amount: 40.00, ValidFrom: date("2024-05-01")},
+	}
+
+	invoices, err := Generate(billing, *schoolYear)
+	assert.NoError(t, err)
+	assert.Len(t, invoices, 10)
+	assert.Equal(t, 200.00, invoices[0].Value())
+	assert.Equal(t, 200.00, invoices[2].Value())
+	assert.Equal(t, 160.00, invoices[3].Value())
+	assert.Equal(t, 160.00, invoices[4].Value())
+	assert.Equal(t, 360.00, invoices[5].Value())
+	assert.Equal(t, 360.00, invoices[9].Value())
+}
+
+func TestShouldSkipInstallmentsCoveredByDiscounts(t *testing.T) {
+	schoolYear, _ := schoolyear.New("2024", "2024-02-01", "2024-12-15")
+	billing := getBilling("10", date("2024-01-10"))
+	billing.EnrollmentFeePaid = true
+	billing.Discounts = []InstallmentDiscount{
+		{Amount: 400.00, ValidFrom: date("2024-01-10"), ValidUntil: date("2024-03-31")},
+	}
+
+	invoices, err := Generate(billing, *schoolYear)
+	assert.NoError(t, err)
+	assert.Len(t, invoices, 8)
+	assert.Equal(t, 3, invoices[0].Installment())
+	assert.Equal(t, date("2024-04-10"), invoices[0].DueDate())
+	assert.Equal(t, 400.00, invoices[0].Value())
+}
+
+func getBilling(paymentDay string, enrollmentDate time.Time) Billing {
+	return Billing{
+		RegistrationId:       uuid.New(),
+		StudentId:            uuid.New(),
+		MonthlyFee:           400.00,
+		InstallmentsQuantity: 10,
+		EnrollmentFee:        60.00,
+		EnrollmentDueDate:    enrollmentDate.AddDate(0, 0, 10),
+		EnrollmentDate:       enrollmentDate,
+		PaymentDay:           paymentDay,
+	}
+}
+
+func date(value string) time.Time {
+	d, _ := time.Parse("2006-01-02", value)
+	return d
+}
@@ -0,0 +1,316 @@
+package invoice
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/service"
+)
+
+const (
+	KindEnrollmentFee = "ENROLLMENT_FEE"
+	KindInstallment   = "INSTALLMENT"
+)
+
+const (
+	StatusOpen          = "OPEN"
+	StatusPartiallyPaid = "PARTIALLY_PAID"
+	StatusPaid          = "PAID"
+	StatusCancelled     = "CANCELLED"
+)
+
+// ErrInvoiceNotFound Retornado quando a cobranca informada nao existe
+var ErrInvoiceNotFound = errors.New("invoice not found")
+
+type Invoice struct {
+	id             uuid.UUID
+	registrationId uuid.UUID
+	studentId      uuid.UUID
+	description    string
+	kind           string
+	installment    int
+	value          float64
+	paidValue      float64
+	dueDate        time.Time
+	status         string
+	chargeRules    service.ChargeRules
+	chargesPaid    float64
+	discountValue  float64
+}
+
+func New(
+	registrationId uuid.UUID,
+	studentId uuid.UUID,
+	description string,
+	kind string,
+	installment int,
+	value float64,
+	dueDate time.Time,
+) (*Invoice, error) {
+
+	i := &Invoice{
+		id:             uuid.New(),
+		registrationId: registrationId,
+		studentId:      studentId,
+		installment:    installment,
+		dueDate:        dueDate,
+		status:         StatusOpen,
+	}
+
+	err := i.ChangeDescription(description)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.ChangeKind(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.ChangeValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return i, nil
+}
+
+func Load(
+	id string,
+	registrationId uuid.UUID,
+	studentId uuid.UUID,
+	description string,
+	kind string,
+	installment int,
+	value float64,
+	paidValue float64,
+	dueDate time.Time,
+	status string,
+	chargeRules service.ChargeRules,
+	chargesPaid float64,
+	discountValue float64,
+) (*Invoice, error) {
+
+	i, err := New(registrationId, studentId, description, kind, installment, value, dueDate)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.ChangeId(id)
+	if err != nil {
+		return nil, err
+	}
+
+	i.paidValue = paidValue
+	i.status = status
+	i.chargeRules = chargeRules
+	i.chargesPaid = chargesPaid
+	i.discountValue = discountValue
+
+	return i, nil
+}
+
+func (i *Invoice) ChangeId(id string) error {
+	invoiceId, err := uuid.Parse(id)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to change invoice id")
+	}
+
+	i.id = invoiceId
+
+	return nil
+}
+
+func (i *Invoice) ChangeDescription(description string) error {
+	if description == "" {
+		return errors.New("description cannot be empty")
+	}
+
+	i.description = description
+
+	return nil
+}
+
+func (i *Invoice) ChangeKind(kind string) error {
+	if kind != KindEnrollmentFee && kind != KindInstallment {
+		return errors.New("invalid invoice kind provided")
+	}
+
+	i.kind = kind
+
+	return nil
+}
+
+func (i *Invoice) ChangeValue(value float64) error {
+	if value <= 0 {
+		return errors.New("invoice value must be greater than zero")
+	}
+
+	i.value = value
+
+	return nil
+}
+
+// ChangeChargeRules Regras de multa, juros e desconto vigentes no servico quando a cobranca foi gerada
+func (i *Invoice) ChangeChargeRules(chargeRules service.ChargeRules) {
+	i.chargeRules = chargeRules
+}
+
+// Pay Abate o valor recebido na data informada. Quando o valor cobre o total devido (ja com multa,
+// juros ou desconto) a cobranca e quitada; caso contrario o valor e rateado proporcionalmente entre
+// o saldo principal e os encargos, e o desconto de pontualidade nao e concedido.
+// Retorna o valor efetivamente aplicado e o excedente, que deve virar credito para o aluno
+func (i *Invoice) Pay(value float64, date time.Time) (float64, float64, error) {
+	if value <= 0 {
+		return 0, 0, errors.New("payment value must be greater than zero")
+	}
+
+	if !i.Payable() {
+		return 0, 0, errors.New("invoice cannot receive payments with status " + i.status)
+	}
+
+	due := i.AmountDue(date)
+	if value < due.Total && due.Discount > 0 {
+		due = i.amountDue(date, false)
+	}
+
+	if value >= due.Total {
+		i.paidValue = i.value
+		i.chargesPaid = roundMoney(i.chargesPaid + due.Fine + due.Interest)
+		i.discountValue = roundMoney(i.discountValue + due.Discount)
+		i.status = StatusPaid
+
+		return due.Total, roundMoney(value - due.Total), nil
+	}
+
+	principal := roundMoney(due.Principal * value / due.Total)
+
+	i.paidValue = roundMoney(i.paidValue + principal)
+	i.chargesPaid = roundMoney(i.chargesPaid + value - principal)
+	i.status = StatusPartiallyPaid
+
+	if i.Balance() == 0 {
+		i.status = StatusPaid
+	}
+
+	return value, 0, nil
+}
+
+// ChangeRegistration Passa a cobranca para outra matricula do aluno, como a criada na transferencia
+func (i *Invoice) ChangeRegistration(registrationId uuid.UUID) {
+	i.registrationId = registrationId
+}
+
+// Cancel Cancela o saldo da cobranca. Cobrancas parcialmente pagas mantem o valor ja recebido
+func (i *Invoice) Cancel() error {
+	if !i.Payable() {
+		return errors.New("invoice cannot be cancelled with status " + i.status)
+	}
+
+	i.status = StatusCancelled
+
+	return nil
+}
+
+func (i *Invoice) Payable() bool {
+	return i.status == StatusOpen || i.status == StatusPartiallyPaid
+}
+
+func (i *Invoice) Balance() float64 {
+	return roundMoney(i.value - i.paidValue)
+}
+
+func (i *Invoice) Id() uuid.UUID {
+	return i.id
+}
+
+func (i *Invoice) RegistrationId() uuid.UUID {
+	return i.registrationId
+}
+
+func (i *Invoice) StudentId() uuid.UUID {
+	return i.studentId
+}
+
+func (i *Invoice) Description() string {
+	return i.description
+}
+
+func (i *Invoice) Kind() string {
+	return i.kind
+}
+
+func (i *Invoice) Installment() int {
+	return i.installment
+}
+
+func (i *Invoice) Value() float64 {
+	return i.value
+}
+
+func (i *Invoice) PaidValue() float64 {
+	return i.paidValue
+}
+
+func (i *Invoice) DueDate() time.Time {
+	return i.dueDate
+}
+
+func (i *Invoice) Status() string {
+	return i.status
+}
+
+func (i *Invoice) ChargeRules() service.ChargeRules {
+	return i.chargeRules
+}
+
+// ChargesPaid Total recebido de multa e juros de mora
+func (i *Invoice) ChargesPaid() float64 {
+	return i.chargesPaid
+}
+
+// DiscountValue Total de desconto de pontualidade concedido
+func (i *Invoice) DiscountValue() float64 {
+	return i.discountValue
+}
+
+func (i *Invoice) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Id             string  `json:"id"`
+		RegistrationId string  `json:"registration_id"`
+		StudentId      string  `json:"student_id"`
+		Description    string  `json:"description"`
+		Kind           string  `json:"kind"`
+		Installment    int     `json:"installment"`
+		Value          float64 `json:"value"`
+		PaidValue      float64 `json:"paid_value"`
+		Balance        float64 `json:"balance"`
+		DueDate        string  `json:"due_date"`
+		Status         string  `json:"status"`
+		ChargesPaid    float64 `json:"charges_paid"`
+		DiscountValue  float64 `json:"discount_value"`
+	}{
+		Id:             i.Id().String(),
+		RegistrationId: i.RegistrationId().String(),
+		StudentId:      i.StudentId().String(),
+		Description:    i.Description(),
+		Kind:           i.Kind(),
+		Installment:    i.Installment(),
+		Value:          i.Value(),
+		PaidValue:      i.PaidValue(),
+		Balance:        i.Balance(),
+		DueDate:        i.DueDate().Format("2006-01-02"),
+		Status:         i.Status(),
+		ChargesPaid:    i.ChargesPaid(),
+		DiscountValue:  i.DiscountValue(),
+	})
+}
+
+func roundMoney(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,86 @@
+package invoiceService
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+)
+
+type InvoiceActionsInterface interface {
+	FindById(id string) (*invoice.Invoice, error)
+	FindByStudent(studentId string, dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error)
+	FindByRegistration(registrationId string, dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error)
+	AmountDue(id string, date string) (*invoice.AmountDue, error)
+}
+
+type InvoiceActions struct {
+	repository invoice.Repository
+}
+
+func New(repository invoice.Repository) *InvoiceActions {
+	return &InvoiceActions{
+		repository: repository,
+	}
+}
+
+func (i *InvoiceActions) FindById(id string) (*invoice.Invoice, error) {
+	inv, err := i.repository.FindById(id)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve invoice")
+	}
+
+	return inv, nil
+}
+
+func (i *InvoiceActions) FindByStudent(studentId string, dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error) {
+	pg := paginator.Pagination{}
+	pg.FillFromDto(dtoRequest)
+
+	invoices, err := i.repository.FindByStudent(studentId, pg)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve invoices")
+	}
+
+	return invoices, nil
+}
+
+func (i *InvoiceActions) FindByRegistration(registrationId string, dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error) {
+	pg := paginator.Pagination{}
+	pg.FillFromDto(dtoRequest)
+
+	invoices, err := i.repository.FindByRegistration(registrationId, pg)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve invoices")
+	}
+
+	return invoices, nil
+}
+
+// AmountDue Valor devido da cobranca na data informada (formato 2006-01-02). Sem data, considera o dia atual
+func (i *InvoiceActions) AmountDue(id string, date string) (*invoice.AmountDue, error) {
+	referenceDate := time.Now()
+
+	if date != "" {
+		parsedDate, err := time.Parse("2006-01-02", date)
+		if err != nil {
+			return nil, errors.New("invalid date provided")
+		}
+		referenceDate = parsedDate
+	}
+
+	inv, err := i.repository.FindById(id)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve invoice")
+	}
+
+	amountDue := inv.AmountDue(referenceDate)
+
+	return &amountDue, nil
+}
@@ -0,0 +1,85 @@
+package invoice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvoicePayment(t *testing.T) {
+	newInvoice := func() *Invoice {
+		inv, _ := New(uuid.New(), uuid.New(), "Mensalidade 1/12", KindInstallment, 1, 400.00, time.Now())
+		return inv
+	}
+
+	t.Run("should keep invoice partially paid when payment is lower than balance", func(t *testing.T) {
+		inv := newInvoice()
+		applied, excess, err := inv.Pay(150.50, time.Now())
+		assert.NoError(t, err)
+		assert.Equal(t, 150.50, applied)
+		assert.Equal(t, 0.0, excess)
+		assert.Equal(t, StatusPartiallyPaid, inv.Status())
+		assert.Equal(t, 249.50, inv.Balance())
+	})
+
+	t.Run("should settle invoice with successive payments", func(t *testing.T) {
+		inv := newInvoice()
+		_, _, _ = inv.Pay(150.50, time.Now())
+		applied, excess, err := inv.Pay(249.50, time.Now())
+		assert.NoError(t, err)
+		assert.Equal(t, 249.50, applied)
+		assert.Equal(t, 0.0, excess)
+		assert.Equal(t, StatusPaid, inv.Status())
+		assert.Equal(t, 0.0, inv.Balance())
+	})
+
+	t.Run("should return excess when payment is greater than balance", func(t *testing.T) {
+		inv := newInvoice()
+		applied, excess, err := inv.Pay(450.00, time.Now())
+		assert.NoError(t, err)
+		assert.Equal(t, 400.00, applied)
+		assert.Equal(t, 50.00, excess)
+		assert.Equal(t, StatusPaid, inv.Status())
+	})
+
+	t.Run("should not receive payment when invoice is already paid", func(t *testing.T) {
+		inv := newInvoice()
+		_, _, _ = inv.Pay(400.00, time.Now())
+		_, _, err := inv.Pay(10.00, time.Now())
+		assert.Error(t, err)
+	})
+
+	t.Run("should not receive payment with value zero", func(t *testing.T) {
+		inv := newInvoice()
+		_, _, err := inv.Pay(0, time.Now())
+		assert.Error(t, err)
+		assert.Equal(t, "payment value must be greater than zero", err.Error())
+	})
+}
+
+func TestInvoiceCancel(t *testing.T) {
+	t.Run("should cancel open invoice", func(t *testing.T) {
+		inv, _ := New(uuid.New(), uuid.New(), "Mensalidade 1/12", KindInstallment, 1, 400.00, time.Now())
+		assert.NoError(t, inv.Cancel())
+		assert.Equal(t, StatusCancelled, inv.Status())
+		assert.False(t, inv.Payable())
+	})
+
+	t.Run("should cancel partially paid invoice keeping the paid value", func(t *testing.T) {
+		inv, _ := New(uuid.New(), uuid.New(), "Mensalidade 1/12", KindInstallment, 1, 400.00, time.Now())
+		_, _, _ = inv.Pay(100.00, time.Now())
+		assert.NoError(t, inv.Cancel())
+		assert.Equal(t, StatusCancelled, inv.Status())
+		assert.Equal(t, 100.00, inv.PaidValue())
+	})
+
+	t.Run("should not cancel paid invoice", func(t *testing.T) {
+		inv, _ := New(uuid.New(), uuid.New(), "Mensalidade 1/12", KindInstallment, 1, 400.00, time.Now())
+		_, _, _ = inv.Pay(400.00, time.Now())
+		err := inv.Cancel()
+		assert.Error(t, err)
+		assert.Equal(t, "invoice cannot be cancelled with status PAID", err.Error())
+	})
+}
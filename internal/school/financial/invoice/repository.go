@@ -0,0 +1,9 @@
+package invoice
+
+import "github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+
+type Repository interface {
+	FindById(id string) (*Invoice, error)
+	FindByStudent(studentId string, pagination paginator.Pagination) (*paginator.PaginationResult, error)
+	FindByRegistration(registrationId string, pagination paginator.Pagination) (*paginator.PaginationResult, error)
+}
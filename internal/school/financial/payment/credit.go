@@ -0,0 +1,122 @@
+package payment
+
+import (
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+)
+
+// Credit Valor pago a mais pelo aluno, consumido pelas proximas cobrancas em aberto
+type Credit struct {
+	id        uuid.UUID
+	studentId uuid.UUID
+	paymentId uuid.UUID
+	value     float64
+	balance   float64
+	createdAt time.Time
+}
+
+func NewCredit(studentId uuid.UUID, paymentId uuid.UUID, value float64) *Credit {
+	return &Credit{
+		id:        uuid.New(),
+		studentId: studentId,
+		paymentId: paymentId,
+		value:     value,
+		balance:   value,
+		createdAt: time.Now(),
+	}
+}
+
+func LoadCredit(id uuid.UUID, studentId uuid.UUID, paymentId uuid.UUID, value float64, balance float64, createdAt time.Time) *Credit {
+	return &Credit{
+		id:        id,
+		studentId: studentId,
+		paymentId: paymentId,
+		value:     value,
+		balance:   balance,
+		createdAt: createdAt,
+	}
+}
+
+func (c *Credit) Id() uuid.UUID {
+	return c.id
+}
+
+func (c *Credit) StudentId() uuid.UUID {
+	return c.studentId
+}
+
+func (c *Credit) PaymentId() uuid.UUID {
+	return c.paymentId
+}
+
+func (c *Credit) Value() float64 {
+	return c.value
+}
+
+func (c *Credit) Balance() float64 {
+	return c.balance
+}
+
+func (c *Credit) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *Credit) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Id        string  `json:"id"`
+		StudentId string  `json:"student_id"`
+		PaymentId string  `json:"payment_id"`
+		Value     float64 `json:"value"`
+		Balance   float64 `json:"balance"`
+		CreatedAt string  `json:"created_at"`
+	}{
+		Id:        c.Id().String(),
+		StudentId: c.StudentId().String(),
+		PaymentId: c.PaymentId().String(),
+		Value:     c.Value(),
+		Balance:   c.Balance(),
+		CreatedAt: c.CreatedAt().Format("2006-01-02 15:04:05"),
+	})
+}
+
+// ApplyCredits Consome os creditos, do mais antigo para o mais novo, nas cobrancas em aberto
+// seguindo a ordem informada e considerando o valor devido na data do pagamento. Retorna um
+// pagamento do tipo CREDIT para cada abatimento realizado
+func ApplyCredits(credits []*Credit, invoices []*invoice.Invoice, paidAt time.Time) ([]Payment, error) {
+	var payments []Payment
+
+	for _, inv := range invoices {
+		for _, credit := range credits {
+			if !inv.Payable() {
+				break
+			}
+
+			if credit.balance <= 0 {
+				continue
+			}
+
+			amount := math.Min(credit.balance, inv.AmountDue(paidAt).Total)
+
+			applied, _, err := inv.Pay(amount, paidAt)
+			if err != nil {
+				return nil, err
+			}
+
+			credit.balance = math.Round((credit.balance-applied)*100) / 100
+
+			creditPayment, err := New(inv.Id(), inv.RegistrationId(), inv.StudentId(), MethodCredit, applied, paidAt)
+			if err != nil {
+				return nil, err
+			}
+
+			creditPayment.ChangeAppliedValue(applied)
+			payments = append(payments, *creditPayment)
+		}
+	}
+
+	return payments, nil
+}
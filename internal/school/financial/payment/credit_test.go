@@ -0,0 +1,48 @@
+package payment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyCredits(t *testing.T) {
+	studentId := uuid.New()
+	registrationId := uuid.New()
+
+	newInvoice := func(installment int, value float64) *invoice.Invoice {
+		inv, _ := invoice.New(registrationId, studentId, "Mensalidade", invoice.KindInstallment, installment, value, time.Now())
+		return inv
+	}
+
+	t.Run("should consume credit in the next open invoices", func(t *testing.T) {
+		credits := []*Credit{NewCredit(studentId, uuid.New(), 150.00)}
+		invoices := []*invoice.Invoice{newInvoice(1, 100.00), newInvoice(2, 100.00)}
+
+		payments, err := ApplyCredits(credits, invoices, time.Now())
+		assert.NoError(t, err)
+		assert.Len(t, payments, 2)
+		assert.Equal(t, MethodCredit, payments[0].Method())
+		assert.Equal(t, 100.00, payments[0].Value())
+		assert.Equal(t, 50.00, payments[1].Value())
+		assert.Equal(t, invoice.StatusPaid, invoices[0].Status())
+		assert.Equal(t, invoice.StatusPartiallyPaid, invoices[1].Status())
+		assert.Equal(t, 50.00, invoices[1].Balance())
+		assert.Equal(t, 0.0, credits[0].Balance())
+	})
+
+	t.Run("should keep remaining credit when there are no more open invoices", func(t *testing.T) {
+		credits := []*Credit{NewCredit(studentId, uuid.New(), 30.00), NewCredit(studentId, uuid.New(), 100.00)}
+		invoices := []*invoice.Invoice{newInvoice(1, 80.00)}
+
+		payments, err := ApplyCredits(credits, invoices, time.Now())
+		assert.NoError(t, err)
+		assert.Len(t, payments, 2)
+		assert.Equal(t, invoice.StatusPaid, invoices[0].Status())
+		assert.Equal(t, 0.0, credits[0].Balance())
+		assert.Equal(t, 50.00, credits[1].Balance())
+	})
+}
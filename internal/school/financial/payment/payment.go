@@ -0,0 +1,193 @@
+package payment
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MethodCash   = "CASH"
+	MethodCard   = "CARD"
+	MethodPix    = "PIX"
+	MethodBoleto = "BOLETO"
+	MethodCredit = "CREDIT"
+)
+
+// ErrAlreadyRegistered Retornado quando ja existe pagamento com a mesma referencia externa
+var ErrAlreadyRegistered = errors.New("payment already registered")
+
+type Payment struct {
+	id             uuid.UUID
+	invoiceId      uuid.UUID
+	registrationId uuid.UUID
+	studentId      uuid.UUID
+	method         string
+	value          float64
+	appliedValue   float64
+	paidAt         time.Time
+	reference      string
+}
+
+func New(
+	invoiceId uuid.UUID,
+	registrationId uuid.UUID,
+	studentId uuid.UUID,
+	method string,
+	value float64,
+	paidAt time.Time,
+) (*Payment, error) {
+
+	p := &Payment{
+		id:             uuid.New(),
+		invoiceId:      invoiceId,
+		registrationId: registrationId,
+		studentId:      studentId,
+		paidAt:         paidAt,
+	}
+
+	err := p.ChangeMethod(method)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.ChangeValue(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+func Load(
+	id string,
+	invoiceId uuid.UUID,
+	registrationId uuid.UUID,
+	studentId uuid.UUID,
+	method string,
+	value float64,
+	appliedValue float64,
+	paidAt time.Time,
+) (*Payment, error) {
+
+	p, err := New(invoiceId, registrationId, studentId, method, value, paidAt)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.ChangeId(id)
+	if err != nil {
+		return nil, err
+	}
+
+	p.appliedValue = appliedValue
+
+	return p, nil
+}
+
+func (p *Payment) ChangeId(id string) error {
+	paymentId, err := uuid.Parse(id)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to change payment id")
+	}
+
+	p.id = paymentId
+
+	return nil
+}
+
+func (p *Payment) ChangeMethod(method string) error {
+	allowedMethods := []string{MethodCash, MethodCard, MethodPix, MethodBoleto, MethodCredit}
+
+	for _, allowed := range allowedMethods {
+		if method == allowed {
+			p.method = method
+			return nil
+		}
+	}
+
+	return errors.New("invalid payment method provided")
+}
+
+func (p *Payment) ChangeValue(value float64) error {
+	if value <= 0 {
+		return errors.New("payment value must be greater than zero")
+	}
+
+	p.value = value
+
+	return nil
+}
+
+// ChangeAppliedValue Parte do valor recebido que foi abatida da cobranca
+func (p *Payment) ChangeAppliedValue(value float64) {
+	p.appliedValue = value
+}
+
+// ChangeReference Identificador externo do pagamento (ex: titulo do retorno bancario)
+// usado para impedir que o mesmo recebimento seja registrado duas vezes
+func (p *Payment) ChangeReference(reference string) {
+	p.reference = reference
+}
+
+func (p *Payment) Id() uuid.UUID {
+	return p.id
+}
+
+func (p *Payment) InvoiceId() uuid.UUID {
+	return p.invoiceId
+}
+
+func (p *Payment) RegistrationId() uuid.UUID {
+	return p.registrationId
+}
+
+func (p *Payment) StudentId() uuid.UUID {
+	return p.studentId
+}
+
+func (p *Payment) Method() string {
+	return p.method
+}
+
+func (p *Payment) Value() float64 {
+	return p.value
+}
+
+func (p *Payment) AppliedValue() float64 {
+	return p.appliedValue
+}
+
+func (p *Payment) PaidAt() time.Time {
+	return p.paidAt
+}
+
+func (p *Payment) Reference() string {
+	return p.reference
+}
+
+func (p *Payment) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Id             string  `json:"id"`
+		InvoiceId      string  `json:"invoice_id"`
+		RegistrationId string  `json:"registration_id"`
+		StudentId      string  `json:"student_id"`
+		Method         string  `json:"method"`
+		Value          float64 `json:"value"`
+		AppliedValue   float64 `json:"applied_value"`
+		PaidAt         string  `json:"paid_at"`
+	}{
+		Id:             p.Id().String(),
+		InvoiceId:      p.InvoiceId().String(),
+		RegistrationId: p.RegistrationId().String(),
+		StudentId:      p.StudentId().String(),
+		Method:         p.Method(),
+		Value:          p.Value(),
+		AppliedValue:   p.AppliedValue(),
+		PaidAt:         p.PaidAt().Format("2006-01-02 15:04:05"),
+	})
+}
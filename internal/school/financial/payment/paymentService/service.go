@@ -0,0 +1,298 @@
+package paymentService
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/payment"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+)
+
+type PaymentActionsInterface interface {
+	Register(invoiceId string, dto payment.RequestDto) (*payment.Response, error)
+	FindByInvoice(invoiceId string) ([]payment.Payment, error)
+	FindByStudent(studentId string, dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error)
+	FindByRegistration(registrationId string, dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error)
+	Balance(registrationId string) (*payment.Balance, error)
+}
+
+type PaymentActions struct {
+	repository payment.Repository
+	newUow     payment.PaymentUowFactory
+}
+
+func New(repository payment.Repository, uowFactory payment.PaymentUowFactory) *PaymentActions {
+	return &PaymentActions{
+		repository: repository,
+		newUow:     uowFactory,
+	}
+}
+
+// Register Registra o pagamento total ou parcial de uma cobranca. O valor excedente vira credito
+// do aluno, que e consumido em seguida pelas proximas cobrancas em aberto. Quando informada,
+// a referencia externa garante que o mesmo recebimento nao seja registrado duas vezes
+func (p *PaymentActions) Register(invoiceId string, dto payment.RequestDto) (*payment.Response, error) {
+	paidAt := time.Now()
+
+	if dto.PaidAt != "" {
+		date, err := time.Parse("2006-01-02", dto.PaidAt)
+		if err != nil {
+			return nil, errors.New("invalid payment date provided")
+		}
+		paidAt = date
+	}
+
+	uow := p.newUow()
+
+	err := uow.BeginTransaction()
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to register payment")
+	}
+
+	inv, err := uow.FindInvoiceLock(invoiceId)
+	if err != nil || inv == nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return nil, errors.New("failed to get invoice information")
+	}
+
+	if dto.Reference != "" {
+		exists, err := uow.PaymentReferenceExists(dto.Reference)
+		if err != nil {
+			_ = uow.Rollback()
+			log.Println(err)
+			return nil, errors.New("failed to check payment reference")
+		}
+
+		if exists {
+			_ = uow.Rollback()
+			return nil, payment.ErrAlreadyRegistered
+		}
+	}
+
+	applied, excess, err := inv.Pay(dto.Value, paidAt)
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
+
+	pay, err := payment.New(inv.Id(), inv.RegistrationId(), inv.StudentId(), dto.Method, dto.Value, paidAt)
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
+
+	pay.ChangeAppliedValue(applied)
+	pay.ChangeReference(dto.Reference)
+
+	err = p.updateInvoice(uow, inv)
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
+
+	err = uow.CreatePayment(*pay)
+	if err != nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return nil, errors.New("failed to save payment")
+	}
+
+	response := payment.Response{
+		Payment: pay,
+		Invoice: inv,
+	}
+
+	if excess > 0 {
+		credit := payment.NewCredit(inv.StudentId(), pay.Id(), excess)
+
+		err = uow.CreateCredit(*credit)
+		if err != nil {
+			_ = uow.Rollback()
+			log.Println(err)
+			return nil, errors.New("failed to save student credit")
+		}
+
+		response.Credit = credit
+	}
+
+	response.CreditsApplied, err = p.applyCredits(uow, inv.StudentId(), paidAt)
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
+
+	err = uow.Commit()
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to register payment")
+	}
+
+	return &response, nil
+}
+
+func (p *PaymentActions) FindByInvoice(invoiceId string) ([]payment.Payment, error) {
+	payments, err := p.repository.FindByInvoice(invoiceId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve payments")
+	}
+
+	return payments, nil
+}
+
+func (p *PaymentActions) FindByStudent(studentId string, dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error) {
+	pg := paginator.Pagination{}
+	pg.FillFromDto(dtoRequest)
+
+	payments, err := p.repository.FindByStudent(studentId, pg)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve payments")
+	}
+
+	return payments, nil
+}
+
+func (p *PaymentActions) FindByRegistration(registrationId string, dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error) {
+	pg := paginator.Pagination{}
+	pg.FillFromDto(dtoRequest)
+
+	payments, err := p.repository.FindByRegistration(registrationId, pg)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve payments")
+	}
+
+	return payments, nil
+}
+
+func (p *PaymentActions) Balance(registrationId string) (*payment.Balance, error) {
+	balance, err := p.repository.RegistrationBalance(registrationId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve registration balance")
+	}
+
+	return balance, nil
+}
+
+// updateInvoice Persiste o pagamento da cobranca e, quando a taxa de matricula e quitada,
+// marca a matricula como paga liberando a aprovacao
+func (p *PaymentActions) updateInvoice(uow payment.PaymentUow, inv *invoice.Invoice) error {
+	err := uow.UpdateInvoicePayment(*inv)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to update invoice payment")
+	}
+
+	if inv.Kind() != invoice.KindEnrollmentFee || inv.Status() != invoice.StatusPaid {
+		return nil
+	}
+
+	reg, err := uow.FindRegisterLock(inv.RegistrationId().String())
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to get registration information")
+	}
+
+	if reg.Paid() {
+		return nil
+	}
+
+	err = reg.PayEnrollmentFee()
+	if err != nil {
+		return err
+	}
+
+	err = uow.UpdateRegisterStatus(*reg)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to update registration status")
+	}
+
+	return nil
+}
+
+// applyCredits Consome os creditos disponiveis do aluno nas cobrancas em aberto, da mais antiga
+// para a mais nova
+func (p *PaymentActions) applyCredits(uow payment.PaymentUow, studentId uuid.UUID, paidAt time.Time) ([]payment.Payment, error) {
+	availableCredits, err := uow.FindAvailableCreditsLock(studentId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to get student credits")
+	}
+
+	if len(availableCredits) == 0 {
+		return nil, nil
+	}
+
+	openInvoices, err := uow.FindOpenInvoicesLock(studentId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to get open invoices")
+	}
+
+	if len(openInvoices) == 0 {
+		return nil, nil
+	}
+
+	var credits []*payment.Credit
+	for i := range availableCredits {
+		credits = append(credits, &availableCredits[i])
+	}
+
+	var invoices []*invoice.Invoice
+	paidBefore := make(map[uuid.UUID]float64)
+	for i := range openInvoices {
+		invoices = append(invoices, &openInvoices[i])
+		paidBefore[openInvoices[i].Id()] = openInvoices[i].PaidValue()
+	}
+
+	balanceBefore := make(map[uuid.UUID]float64)
+	for _, credit := range credits {
+		balanceBefore[credit.Id()] = credit.Balance()
+	}
+
+	creditPayments, err := payment.ApplyCredits(credits, invoices, paidAt)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, inv := range invoices {
+		if inv.PaidValue() == paidBefore[inv.Id()] {
+			continue
+		}
+
+		err = p.updateInvoice(uow, inv)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, credit := range credits {
+		if credit.Balance() == balanceBefore[credit.Id()] {
+			continue
+		}
+
+		err = uow.UpdateCredit(*credit)
+		if err != nil {
+			log.Println(err)
+			return nil, errors.New("failed to update student credit")
+		}
+	}
+
+	for _, creditPayment := range creditPayments {
+		err = uow.CreatePayment(creditPayment)
+		if err != nil {
+			log.Println(err)
+			return nil, errors.New("failed to save payment")
+		}
+	}
+
+	return creditPayments, nil
+}
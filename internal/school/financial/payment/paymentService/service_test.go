@@ -0,0 +1,166 @@
+package paymentService
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/mocks"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/payment"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/service"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestShouldRegisterPartialPayment(t *testing.T) {
+	inv := getInvoice(invoice.KindInstallment, 400.00)
+
+	uow := new(mocks.PaymentUowMock)
+	uow.On("BeginTransaction").Return(nil)
+	uow.On("FindInvoiceLock", inv.Id().String()).Return(inv, nil)
+	uow.On("UpdateInvoicePayment", mock.Anything).Return(nil)
+	uow.On("CreatePayment", mock.Anything).Return(nil)
+	uow.On("FindAvailableCreditsLock", inv.StudentId()).Return([]payment.Credit{}, nil)
+	uow.On("Commit").Return(nil)
+
+	actions := New(nil, func() payment.PaymentUow { return uow })
+
+	response, err := actions.Register(inv.Id().String(), payment.RequestDto{
+		Method: payment.MethodPix,
+		Value:  150.00,
+		PaidAt: "2023-09-10",
+	})
+
+	assert.NoError(t, err)
+	assert.Nil(t, response.Credit)
+	assert.Equal(t, invoice.StatusPartiallyPaid, response.Invoice.Status())
+	assert.Equal(t, 250.00, response.Invoice.Balance())
+	assert.Equal(t, 150.00, response.Payment.AppliedValue())
+	uow.AssertNotCalled(t, "FindRegisterLock", mock.Anything)
+	uow.AssertNotCalled(t, "CreateCredit", mock.Anything)
+}
+
+func TestShouldApproveRegistrationAndCreditOverpayment(t *testing.T) {
+	reg := getRegistration()
+	inv := getInvoice(invoice.KindEnrollmentFee, 200.00)
+	nextInvoice, _ := invoice.New(inv.RegistrationId(), inv.StudentId(), "Mensalidade 1/10", invoice.KindInstallment, 1, 500.00, time.Now())
+	storedCredit := payment.NewCredit(inv.StudentId(), uuid.New(), 50.00)
+
+	uow := new(mocks.PaymentUowMock)
+	uow.On("BeginTransaction").Return(nil)
+	uow.On("FindInvoiceLock", inv.Id().String()).Return(inv, nil)
+	uow.On("UpdateInvoicePayment", mock.Anything).Return(nil)
+	uow.On("CreatePayment", mock.Anything).Return(nil)
+	uow.On("FindRegisterLock", inv.RegistrationId().String()).Return(reg, nil)
+	uow.On("UpdateRegisterStatus", mock.Anything).Return(nil)
+	uow.On("CreateCredit", mock.Anything).Return(nil)
+	uow.On("FindAvailableCreditsLock", inv.StudentId()).Return([]payment.Credit{*storedCredit}, nil)
+	uow.On("FindOpenInvoicesLock", inv.StudentId()).Return([]invoice.Invoice{*nextInvoice}, nil)
+	uow.On("UpdateCredit", mock.Anything).Return(nil)
+	uow.On("Commit").Return(nil)
+
+	actions := New(nil, func() payment.PaymentUow { return uow })
+
+	response, err := actions.Register(inv.Id().String(), payment.RequestDto{
+		Method: payment.MethodCash,
+		Value:  250.00,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, invoice.StatusPaid, response.Invoice.Status())
+	assert.Equal(t, 200.00, response.Payment.AppliedValue())
+	assert.Equal(t, 50.00, response.Credit.Value())
+	assert.True(t, reg.Paid())
+	assert.Equal(t, registration.StatusApproved, reg.Status())
+	assert.Len(t, response.CreditsApplied, 1)
+	assert.Equal(t, payment.MethodCredit, response.CreditsApplied[0].Method())
+	assert.Equal(t, nextInvoice.Id(), response.CreditsApplied[0].InvoiceId())
+	assert.Equal(t, 50.00, response.CreditsApplied[0].Value())
+	uow.AssertNumberOfCalls(t, "UpdateRegisterStatus", 1)
+	uow.AssertNumberOfCalls(t, "UpdateCredit", 1)
+	uow.AssertNumberOfCalls(t, "CreatePayment", 2)
+}
+
+func TestShouldNotRegisterPaymentForPaidInvoice(t *testing.T) {
+	inv := getInvoice(invoice.KindInstallment, 100.00)
+	_, _, _ = inv.Pay(100.00, time.Now())
+
+	uow := new(mocks.PaymentUowMock)
+	uow.On("BeginTransaction").Return(nil)
+	uow.On("FindInvoiceLock", inv.Id().String()).Return(inv, nil)
+	uow.On("Rollback").Return(nil)
+
+	actions := New(nil, func() payment.PaymentUow { return uow })
+
+	response, err := actions.Register(inv.Id().String(), payment.RequestDto{
+		Method: payment.MethodCard,
+		Value:  100.00,
+	})
+
+	assert.Nil(t, response)
+	assert.Error(t, err)
+	assert.Equal(t, "invoice cannot receive payments with status PAID", err.Error())
+	uow.AssertCalled(t, "Rollback")
+	uow.AssertNotCalled(t, "CreatePayment", mock.Anything)
+}
+
+func TestShouldNotRegisterPaymentTwiceForSameReference(t *testing.T) {
+	inv := getInvoice(invoice.KindInstallment, 400.00)
+	reference := uuid.NewString()
+
+	uow := new(mocks.PaymentUowMock)
+	uow.On("BeginTransaction").Return(nil)
+	uow.On("FindInvoiceLock", inv.Id().String()).Return(inv, nil)
+	uow.On("PaymentReferenceExists", reference).Return(true, nil)
+	uow.On("Rollback").Return(nil)
+
+	actions := New(nil, func() payment.PaymentUow { return uow })
+
+	response, err := actions.Register(inv.Id().String(), payment.RequestDto{
+		Method:    payment.MethodBoleto,
+		Value:     400.00,
+		Reference: reference,
+	})
+
+	assert.Nil(t, response)
+	assert.ErrorIs(t, err, payment.ErrAlreadyRegistered)
+	assert.Equal(t, 400.00, inv.Balance())
+	uow.AssertCalled(t, "Rollback")
+	uow.AssertNotCalled(t, "UpdateInvoicePayment", mock.Anything)
+	uow.AssertNotCalled(t, "CreatePayment", mock.Anything)
+}
+
+func getInvoice(kind string, value float64) *invoice.Invoice {
+	inv, _ := invoice.New(uuid.New(), uuid.New(), "Taxa", kind, 0, value, time.Now())
+	return inv
+}
+
+func getRegistration() *registration.Registration {
+	svc, _ := service.New("Ensino Fundamental", 5000.00)
+	clr, _ := classroom.New(10, "morning", "Jardim", "TUR-001", uuid.New().String(), uuid.New().String(), uuid.New().String(), "ANY", "remote")
+	std, _ := student.New("Henrique", "Rocha", "2010-01-01", "123456789", "84731086043", "student@mail.com", true)
+
+	reg, _ := registration.Load(
+		uuid.New().String(),
+		"2023000001",
+		*clr,
+		"morning",
+		*std,
+		*svc,
+		500.00,
+		10,
+		200.00,
+		"2023-12-10",
+		10,
+		registration.StatusWaitEnrollmentFee,
+		"2023-09-01",
+		"10",
+		false,
+	)
+
+	return reg
+}
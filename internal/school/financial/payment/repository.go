@@ -0,0 +1,10 @@
+package payment
+
+import "github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+
+type Repository interface {
+	FindByInvoice(invoiceId string) ([]Payment, error)
+	FindByStudent(studentId string, pagination paginator.Pagination) (*paginator.PaginationResult, error)
+	FindByRegistration(registrationId string, pagination paginator.Pagination) (*paginator.PaginationResult, error)
+	RegistrationBalance(registrationId string) (*Balance, error)
+}
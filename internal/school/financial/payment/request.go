@@ -0,0 +1,36 @@
+package payment
+
+import (
+	"github.com/go-playground/validator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+)
+
+type RequestDto struct {
+	Method string  `json:"method" validate:"required,oneof=CASH CARD PIX BOLETO"`
+	Value  float64 `json:"value" validate:"required,gt=0"`
+	PaidAt string  `json:"paid_at"`
+	// Reference Preenchido apenas por integracoes (retorno bancario), nunca pelo corpo da requisicao
+	Reference string `json:"-"`
+}
+
+func (r *RequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(r)
+}
+
+type Response struct {
+	Payment        *Payment         `json:"payment"`
+	Invoice        *invoice.Invoice `json:"invoice"`
+	Credit         *Credit          `json:"credit,omitempty"`
+	CreditsApplied []Payment        `json:"credits_applied,omitempty"`
+}
+
+// Balance Resumo financeiro da matricula: total cobrado, total pago, saldo devedor
+// e credito disponivel do aluno
+type Balance struct {
+	RegistrationId string  `json:"registration_id"`
+	Charged        float64 `json:"charged"`
+	Paid           float64 `json:"paid"`
+	Outstanding    float64 `json:"outstanding"`
+	Credit         float64 `json:"credit"`
+}
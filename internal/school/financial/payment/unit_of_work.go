@@ -0,0 +1,26 @@
+package payment
+
+import (
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+)
+
+type PaymentUow interface {
+	BeginTransaction() error
+	Commit() error
+	Rollback() error
+	FindInvoiceLock(id string) (*invoice.Invoice, error)
+	FindOpenInvoicesLock(studentId uuid.UUID) ([]invoice.Invoice, error)
+	UpdateInvoicePayment(inv invoice.Invoice) error
+	CreatePayment(payment Payment) error
+	PaymentReferenceExists(reference string) (bool, error)
+	CreateCredit(credit Credit) error
+	FindAvailableCreditsLock(studentId uuid.UUID) ([]Credit, error)
+	UpdateCredit(credit Credit) error
+	FindRegisterLock(id string) (*registration.Registration, error)
+	UpdateRegisterStatus(register registration.Registration) error
+}
+
+// PaymentUowFactory Cria uma nova unidade de trabalho para cada pagamento registrado
+type PaymentUowFactory func() PaymentUow
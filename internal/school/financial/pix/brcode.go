@@ -0,0 +1,157 @@
+package pix
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/text"
+)
+
+const (
+	payloadFormatIndicator   = "00"
+	pointOfInitiationMethod  = "01"
+	merchantAccountInfo      = "26"
+	merchantCategoryCode     = "52"
+	transactionCurrency      = "53"
+	transactionAmount        = "54"
+	countryCode              = "58"
+	merchantName             = "59"
+	merchantCity             = "60"
+	additionalDataField      = "62"
+	crc16                    = "63"
+	gui                      = "br.gov.bcb.pix"
+	merchantAccountGui       = "00"
+	merchantAccountKey       = "01"
+	merchantAccountInfoText  = "02"
+	merchantAccountUrl       = "25"
+	additionalDataTxId       = "05"
+	withoutTxId              = "***"
+	staticTxIdMaxLength      = 25
+	merchantNameMaxLength    = 25
+	merchantCityMaxLength    = 15
+	merchantAccountMaxLength = 99
+)
+
+// StaticPayload Gera o BR Code (copia e cola) de uma cobranca estatica: a chave do recebedor e o
+// valor ficam no proprio codigo. Sem valor o pagador informa o valor no aplicativo
+func StaticPayload(merchant Merchant, amount float64, txId string, description string) (string, error) {
+	err := merchant.Validate()
+	if err != nil {
+		return "", err
+	}
+
+	if amount < 0 {
+		return "", errors.New("invalid amount provided")
+	}
+
+	if txId == "" {
+		txId = withoutTxId
+	}
+
+	if txId != withoutTxId && (len(txId) > staticTxIdMaxLength || !alphanumeric(txId)) {
+		return "", errors.New("transaction id must have up to 25 alphanumeric characters")
+	}
+
+	account := emv(merchantAccountGui, gui) + emv(merchantAccountKey, merchant.Key)
+	if description != "" {
+		account += emv(merchantAccountInfoText, text.RemoveAccents(description))
+	}
+
+	if len(account) > merchantAccountMaxLength {
+		return "", errors.New("pix key and description are too long")
+	}
+
+	var amountField string
+	if amount > 0 {
+		amountField = emv(transactionAmount, strconv.FormatFloat(amount, 'f', 2, 64))
+	}
+
+	return payload(merchant, "", account, amountField, txId), nil
+}
+
+// DynamicPayload Gera o BR Code de uma cobranca dinamica: o codigo aponta para a URL da cobranca
+// criada no PSP, que informa valor e vencimento ao aplicativo do pagador
+func DynamicPayload(merchant Merchant, location string) (string, error) {
+	err := merchant.Validate()
+	if err != nil {
+		return "", err
+	}
+
+	location = strings.TrimPrefix(location, "https://")
+	if location == "" {
+		return "", errors.New("location url is required for dynamic charges")
+	}
+
+	account := emv(merchantAccountGui, gui) + emv(merchantAccountUrl, location)
+	if len(account) > merchantAccountMaxLength {
+		return "", errors.New("location url is too long")
+	}
+
+	return payload(merchant, "12", account, "", withoutTxId), nil
+}
+
+func payload(merchant Merchant, initiationMethod string, account string, amount string, txId string) string {
+	var b strings.Builder
+
+	b.WriteString(emv(payloadFormatIndicator, "01"))
+	if initiationMethod != "" {
+		b.WriteString(emv(pointOfInitiationMethod, initiationMethod))
+	}
+	b.WriteString(emv(merchantAccountInfo, account))
+	b.WriteString(emv(merchantCategoryCode, "0000"))
+	b.WriteString(emv(transactionCurrency, "986"))
+	b.WriteString(amount)
+	b.WriteString(emv(countryCode, "BR"))
+	b.WriteString(emv(merchantName, truncate(text.RemoveAccents(merchant.Name), merchantNameMaxLength)))
+	b.WriteString(emv(merchantCity, truncate(text.RemoveAccents(merchant.City), merchantCityMaxLength)))
+	b.WriteString(emv(additionalDataField, emv(additionalDataTxId, txId)))
+	b.WriteString(crc16 + "04")
+
+	return b.String() + fmt.Sprintf("%04X", checksum(b.String()))
+}
+
+// emv Campo no formato ID + tamanho (2 digitos) + valor
+func emv(id string, value string) string {
+	return fmt.Sprintf("%s%02d%s", id, len(value), value)
+}
+
+// checksum CRC16-CCITT (polinomio 0x1021, valor inicial 0xFFFF) calculado sobre todo o payload,
+// incluindo o ID e o tamanho do proprio campo do CRC
+func checksum(payload string) uint16 {
+	crc := uint16(0xFFFF)
+
+	for i := 0; i < len(payload); i++ {
+		crc ^= uint16(payload[i]) << 8
+		for bit := 0; bit < 8; bit++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+
+	return crc
+}
+
+func truncate(value string, size int) string {
+	value = strings.TrimSpace(value)
+	if len(value) > size {
+		return strings.TrimSpace(value[:size])
+	}
+
+	return value
+}
+
+func alphanumeric(value string) bool {
+	for _, r := range value {
+		if r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return false
+		}
+	}
+
+	return true
+}
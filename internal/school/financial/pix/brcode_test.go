@@ -0,0 +1,46 @@
+package pix
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldGenerateStaticPayloadFromCentralBankExample(t *testing.T) {
+	merchant := Merchant{Key: "123e4567-e12b-12d1-a456-426655440000", Name: "Fulano de Tal", City: "BRASILIA"}
+
+	payload, err := StaticPayload(merchant, 0, "", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "00020126580014br.gov.bcb.pix0136123e4567-e12b-12d1-a456-4266554400005204000053039865802BR5913Fulano de Tal6008BRASILIA62070503***63041D3D", payload)
+}
+
+func TestShouldGenerateStaticPayloadWithAmount(t *testing.T) {
+	payload, err := StaticPayload(getMerchant(), 400.00, "TX123", "Mensalidade 1/12")
+	assert.NoError(t, err)
+	assert.Equal(t, "00020126600014br.gov.bcb.pix0118escola@example.com0216Mensalidade 1/125204000053039865406400.005802BR5915ESCOLA SAO JOAO6008SALVADOR62090505TX1236304FD88", payload)
+}
+
+func TestShouldGenerateDynamicPayload(t *testing.T) {
+	payload, err := DynamicPayload(getMerchant(), "https://pix.example.com/qr/v2/9d36b84fc70b478fb95c12729b90ca25")
+	assert.NoError(t, err)
+	assert.Equal(t, "00020101021226760014br.gov.bcb.pix2554pix.example.com/qr/v2/9d36b84fc70b478fb95c12729b90ca255204000053039865802BR5915ESCOLA SAO JOAO6008SALVADOR62070503***630495B1", payload)
+}
+
+func TestShouldNotGenerateStaticPayloadWithInvalidTxId(t *testing.T) {
+	_, err := StaticPayload(getMerchant(), 10, "TX-123", "")
+	assert.Error(t, err)
+	assert.Equal(t, "transaction id must have up to 25 alphanumeric characters", err.Error())
+}
+
+func TestShouldNotGeneratePayloadWithoutMerchantKey(t *testing.T) {
+	_, err := StaticPayload(Merchant{Name: "Escola", City: "Salvador"}, 10, "", "")
+	assert.Error(t, err)
+}
+
+func getMerchant() Merchant {
+	return Merchant{
+		Key:  "escola@example.com",
+		Name: "ESCOLA SÃO JOÃO",
+		City: "SALVADOR",
+	}
+}
@@ -0,0 +1,91 @@
+package pix
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/skip2/go-qrcode"
+)
+
+const (
+	KindStatic  = "STATIC"
+	KindDynamic = "DYNAMIC"
+)
+
+const qrCodeSize = 256
+
+// Charge Cobranca PIX de uma fatura: o BR Code para copiar e colar e a imagem PNG do QR Code
+type Charge struct {
+	InvoiceId string  `json:"invoice_id"`
+	Kind      string  `json:"kind"`
+	TxId      string  `json:"txid"`
+	Amount    float64 `json:"amount"`
+	Payload   string  `json:"payload"`
+	QrCode    []byte  `json:"qr_code"`
+}
+
+// NewCharge Gera a cobranca PIX do valor devido da fatura na data informada. O txid e derivado do
+// id da fatura para permitir a conciliacao do pagamento. Cobrancas dinamicas sao criadas no PSP,
+// que devolve a URL apontada pelo BR Code
+func NewCharge(inv invoice.Invoice, merchant Merchant, kind string, date time.Time, psp Psp) (*Charge, error) {
+	if !inv.Payable() {
+		return nil, errors.New("invoice is not payable")
+	}
+
+	amountDue := inv.AmountDue(date)
+	if amountDue.Total <= 0 {
+		return nil, errors.New("invoice has no amount due")
+	}
+
+	txId := strings.ToUpper(strings.ReplaceAll(inv.Id().String(), "-", ""))
+
+	charge := &Charge{
+		InvoiceId: inv.Id().String(),
+		Kind:      kind,
+		Amount:    amountDue.Total,
+	}
+
+	var err error
+
+	switch kind {
+	case KindStatic:
+		charge.TxId = txId[:staticTxIdMaxLength]
+		charge.Payload, err = StaticPayload(merchant, charge.Amount, charge.TxId, inv.Description())
+	case KindDynamic:
+		if psp == nil {
+			return nil, errors.New("pix psp is not configured")
+		}
+		charge.TxId = txId
+
+		var location string
+		location, err = psp.CreateCob(Cob{
+			TxId:        charge.TxId,
+			Key:         merchant.Key,
+			Amount:      charge.Amount,
+			Description: inv.Description(),
+		})
+		if err != nil {
+			log.Println(err)
+			return nil, errors.New("failed to create pix charge at psp")
+		}
+
+		charge.Payload, err = DynamicPayload(merchant, location)
+	default:
+		return nil, errors.New("invalid pix charge kind provided")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	charge.QrCode, err = qrcode.Encode(charge.Payload, qrcode.Medium, qrCodeSize)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to generate qr code")
+	}
+
+	return charge, nil
+}
@@ -0,0 +1,76 @@
+package pix
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldCreateStaticChargeWithAmountDue(t *testing.T) {
+	dueDate := time.Date(2023, 10, 10, 0, 0, 0, 0, time.UTC)
+	inv, _ := invoice.Load(uuid.NewString(), uuid.New(), uuid.New(), "Mensalidade 1/12", invoice.KindInstallment, 1,
+		400.00, 0, dueDate, invoice.StatusOpen, service.ChargeRules{FinePercentage: 2}, 0, 0)
+
+	charge, err := NewCharge(*inv, getMerchant(), KindStatic, dueDate.AddDate(0, 0, 1), nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 408.00, charge.Amount)
+	assert.Len(t, charge.TxId, 25)
+	assert.Contains(t, charge.Payload, "5406408.00")
+	assert.Contains(t, charge.Payload, "0525"+charge.TxId)
+	assert.True(t, bytes.HasPrefix(charge.QrCode, []byte("\x89PNG")))
+}
+
+func TestShouldCreateDynamicChargeAtPsp(t *testing.T) {
+	inv, _ := invoice.New(uuid.New(), uuid.New(), "Mensalidade 1/12", invoice.KindInstallment, 1, 400.00, time.Now())
+	psp := &pspStub{location: "pix.example.com/qr/v2/9d36b84fc70b478fb95c12729b90ca25"}
+
+	charge, err := NewCharge(*inv, getMerchant(), KindDynamic, time.Now(), psp)
+	assert.NoError(t, err)
+	assert.Equal(t, strings.ToUpper(strings.ReplaceAll(inv.Id().String(), "-", "")), charge.TxId)
+	assert.Equal(t, Cob{TxId: charge.TxId, Key: "escola@example.com", Amount: 400.00, Description: "Mensalidade 1/12"}, psp.cob)
+	assert.Contains(t, charge.Payload, psp.location)
+	assert.NotContains(t, charge.Payload, "5406")
+}
+
+func TestShouldNotCreateChargeForPaidInvoice(t *testing.T) {
+	inv, _ := invoice.New(uuid.New(), uuid.New(), "Mensalidade 1/12", invoice.KindInstallment, 1, 400.00, time.Now())
+	_, _, _ = inv.Pay(400.00, time.Now())
+
+	_, err := NewCharge(*inv, getMerchant(), KindStatic, time.Now(), nil)
+	assert.Error(t, err)
+	assert.Equal(t, "invoice is not payable", err.Error())
+}
+
+func TestShouldNotCreateDynamicChargeWithoutPsp(t *testing.T) {
+	inv, _ := invoice.New(uuid.New(), uuid.New(), "Mensalidade 1/12", invoice.KindInstallment, 1, 400.00, time.Now())
+
+	_, err := NewCharge(*inv, getMerchant(), KindDynamic, time.Now(), nil)
+	assert.Error(t, err)
+	assert.Equal(t, "pix psp is not configured", err.Error())
+}
+
+func TestShouldNotCreateDynamicChargeWhenPspFails(t *testing.T) {
+	inv, _ := invoice.New(uuid.New(), uuid.New(), "Mensalidade 1/12", invoice.KindInstallment, 1, 400.00, time.Now())
+
+	_, err := NewCharge(*inv, getMerchant(), KindDynamic, time.Now(), &pspStub{err: errors.New("unauthorized")})
+	assert.Error(t, err)
+	assert.Equal(t, "failed to create pix charge at psp", err.Error())
+}
+
+type pspStub struct {
+	location string
+	err      error
+	cob      Cob
+}
+
+func (p *pspStub) CreateCob(cob Cob) (string, error) {
+	p.cob = cob
+	return p.location, p.err
+}
@@ -0,0 +1,15 @@
+package pix
+
+import "github.com/go-playground/validator"
+
+// Merchant Dados do recebedor (escola) impressos no BR Code
+type Merchant struct {
+	Key  string `validate:"required,max=77"`
+	Name string `validate:"required"`
+	City string `validate:"required"`
+}
+
+func (m Merchant) Validate() error {
+	v := validator.New()
+	return v.Struct(m)
+}
@@ -0,0 +1,50 @@
+package pixService
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/pix"
+)
+
+type PixActionsInterface interface {
+	Charge(invoiceId string, kind string) (*pix.Charge, error)
+}
+
+type PixActions struct {
+	invoiceRepository invoice.Repository
+	merchant          pix.Merchant
+	psp               pix.Psp
+}
+
+func New(invoiceRepository invoice.Repository, merchant pix.Merchant, psp pix.Psp) *PixActions {
+	return &PixActions{
+		invoiceRepository: invoiceRepository,
+		merchant:          merchant,
+		psp:               psp,
+	}
+}
+
+// Charge Gera a cobranca PIX da fatura com o valor devido no dia. Sem tipo informado, gera uma
+// cobranca estatica. Retorna invoice.ErrInvoiceNotFound quando a fatura nao existe
+func (p *PixActions) Charge(invoiceId string, kind string) (*pix.Charge, error) {
+	kind = strings.ToUpper(kind)
+	if kind == "" {
+		kind = pix.KindStatic
+	}
+
+	inv, err := p.invoiceRepository.FindById(invoiceId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve invoice")
+	}
+
+	if inv == nil {
+		return nil, invoice.ErrInvoiceNotFound
+	}
+
+	return pix.NewCharge(*inv, p.merchant, kind, time.Now(), p.psp)
+}
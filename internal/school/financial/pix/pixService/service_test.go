@@ -0,0 +1,81 @@
+package pixService
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/mocks"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/pix"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestShouldCreateStaticChargeByDefault(t *testing.T) {
+	inv, _ := invoice.New(uuid.New(), uuid.New(), "Mensalidade 1/12", invoice.KindInstallment, 1, 400.00, time.Now().AddDate(0, 0, 10))
+
+	repository := new(mocks.InvoiceRepositoryMock)
+	repository.On("FindById", inv.Id().String()).Return(inv, nil)
+
+	actions := New(repository, getMerchant(), nil)
+
+	charge, err := actions.Charge(inv.Id().String(), "")
+	assert.NoError(t, err)
+	assert.Equal(t, pix.KindStatic, charge.Kind)
+	assert.Equal(t, 400.00, charge.Amount)
+	assert.NotEmpty(t, charge.QrCode)
+}
+
+func TestShouldCreateDynamicChargeAtPsp(t *testing.T) {
+	inv, _ := invoice.New(uuid.New(), uuid.New(), "Mensalidade 1/12", invoice.KindInstallment, 1, 400.00, time.Now().AddDate(0, 0, 10))
+
+	repository := new(mocks.InvoiceRepositoryMock)
+	repository.On("FindById", inv.Id().String()).Return(inv, nil)
+
+	psp := new(mocks.PixPspMock)
+	psp.On("CreateCob", mock.Anything).Return("pix.example.com/qr/v2/cob", nil)
+
+	actions := New(repository, getMerchant(), psp)
+
+	charge, err := actions.Charge(inv.Id().String(), "dynamic")
+	assert.NoError(t, err)
+	assert.Equal(t, pix.KindDynamic, charge.Kind)
+	assert.Contains(t, charge.Payload, "pix.example.com/qr/v2/cob")
+	psp.AssertCalled(t, "CreateCob", pix.Cob{
+		TxId:        charge.TxId,
+		Key:         "escola@example.com",
+		Amount:      400.00,
+		Description: "Mensalidade 1/12",
+	})
+}
+
+func TestShouldReturnErrorWhenInvoiceNotFound(t *testing.T) {
+	repository := new(mocks.InvoiceRepositoryMock)
+	repository.On("FindById", "a1c003f8-8a56-4a49-892a-825b364cc076").Return((*invoice.Invoice)(nil), nil)
+
+	actions := New(repository, getMerchant(), nil)
+
+	_, err := actions.Charge("a1c003f8-8a56-4a49-892a-825b364cc076", "dynamic")
+	assert.ErrorIs(t, err, invoice.ErrInvoiceNotFound)
+}
+
+func TestShouldReturnErrorWhenInvoiceCannotBeRetrieved(t *testing.T) {
+	repository := new(mocks.InvoiceRepositoryMock)
+	repository.On("FindById", "invalid").Return((*invoice.Invoice)(nil), errors.New("connection lost"))
+
+	actions := New(repository, getMerchant(), nil)
+
+	_, err := actions.Charge("invalid", "dynamic")
+	assert.Error(t, err)
+	assert.Equal(t, "failed to retrieve invoice", err.Error())
+}
+
+func getMerchant() pix.Merchant {
+	return pix.Merchant{
+		Key:  "escola@example.com",
+		Name: "Escola Sao Joao",
+		City: "Salvador",
+	}
+}
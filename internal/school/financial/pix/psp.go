@@ -0,0 +1,15 @@
+package pix
+
+// Cob Cobranca imediata registrada no PSP para as cobrancas dinamicas
+type Cob struct {
+	TxId        string
+	Key         string
+	Amount      float64
+	Description string
+}
+
+// Psp Provedor de servicos de pagamento que hospeda as cobrancas dinamicas. CreateCob cria (ou
+// revisa, quando o txid ja existe) a cobranca e devolve a URL de localizacao do payload
+type Psp interface {
+	CreateCob(cob Cob) (string, error)
+}
@@ -0,0 +1,32 @@
+package service
+
+import "errors"
+
+// ChargeRules Regras de cobranca do servico: multa e juros de mora sobre parcelas em atraso
+// e desconto para pagamento ate N dias antes do vencimento. Percentuais de 0 a 100
+type ChargeRules struct {
+	FinePercentage          float64 `json:"fine_percentage"`
+	DailyInterestPercentage float64 `json:"daily_interest_percentage"`
+	DiscountPercentage      float64 `json:"discount_percentage"`
+	DiscountDaysBefore      int     `json:"discount_days_before"`
+}
+
+func (c ChargeRules) Validate() error {
+	if c.FinePercentage < 0 || c.FinePercentage > 100 {
+		return errors.New("fine percentage must be between 0 and 100")
+	}
+
+	if c.DailyInterestPercentage < 0 || c.DailyInterestPercentage > 100 {
+		return errors.New("daily interest percentage must be between 0 and 100")
+	}
+
+	if c.DiscountPercentage < 0 || c.DiscountPercentage > 100 {
+		return errors.New("discount percentage must be between 0 and 100")
+	}
+
+	if c.DiscountDaysBefore < 0 {
+		return errors.New("discount days before due date cannot be negative")
+	}
+
+	return nil
+}
@@ -5,9 +5,23 @@ import "github.com/go-playground/validator"
 type Request struct {
 	Description string  `json:"description" validate:"required"`
 	Value       float64 `json:"value" validate:"required"`
+
+	FinePercentage          float64 `json:"fine_percentage" validate:"min=0,max=100"`
+	DailyInterestPercentage float64 `json:"daily_interest_percentage" validate:"min=0,max=100"`
+	DiscountPercentage      float64 `json:"discount_percentage" validate:"min=0,max=100"`
+	DiscountDaysBefore      int     `json:"discount_days_before" validate:"min=0"`
 }
 
 func (s *Request) Validate() error {
 	v := validator.New()
 	return v.Struct(s)
 }
+
+func (s *Request) ChargeRules() ChargeRules {
+	return ChargeRules{
+		FinePercentage:          s.FinePercentage,
+		DailyInterestPercentage: s.DailyInterestPercentage,
+		DiscountPercentage:      s.DiscountPercentage,
+		DiscountDaysBefore:      s.DiscountDaysBefore,
+	}
+}
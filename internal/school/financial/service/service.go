@@ -11,6 +11,7 @@ type Service struct {
 	id          uuid.UUID
 	description string
 	price       float64
+	chargeRules ChargeRules
 }
 
 func New(description string, price float64) (*Service, error) {
@@ -31,12 +32,17 @@ func New(description string, price float64) (*Service, error) {
 	return s, nil
 }
 
-func Load(id string, description string, price float64) (*Service, error) {
+func Load(id string, description string, price float64, chargeRules ChargeRules) (*Service, error) {
 	srvce, err := New(description, price)
 	if err != nil {
 		return nil, err
 	}
 
+	err = srvce.ChangeChargeRules(chargeRules)
+	if err != nil {
+		return nil, err
+	}
+
 	err = srvce.ChangeId(id)
 
 	return srvce, err
@@ -50,6 +56,10 @@ func (s *Service) Price() float64 {
 	return s.price
 }
 
+func (s *Service) ChargeRules() ChargeRules {
+	return s.chargeRules
+}
+
 func (s *Service) Id() uuid.UUID {
 	return s.id
 }
@@ -90,14 +100,27 @@ func (s *Service) ChangePrice(price float64) error {
 	return nil
 }
 
+func (s *Service) ChangeChargeRules(chargeRules ChargeRules) error {
+	err := chargeRules.Validate()
+	if err != nil {
+		return err
+	}
+
+	s.chargeRules = chargeRules
+
+	return nil
+}
+
 func (s *Service) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
-		Id          string  `json:"id"`
-		Description string  `json:"description"`
-		Price       float64 `json:"price"`
+		Id          string      `json:"id"`
+		Description string      `json:"description"`
+		Price       float64     `json:"price"`
+		ChargeRules ChargeRules `json:"charge_rules"`
 	}{
 		Id:          s.Id().String(),
 		Description: s.Description(),
 		Price:       s.Price(),
+		ChargeRules: s.ChargeRules(),
 	})
 }
@@ -28,6 +28,14 @@ func New(repository service.Repository) *ServiceActions {
 
 func (s *ServiceActions) Create(dto service.Request) error {
 	serv, err := service.New(dto.Description, dto.Value)
+	if err != nil {
+		return err
+	}
+
+	err = serv.ChangeChargeRules(dto.ChargeRules())
+	if err != nil {
+		return err
+	}
 
 	err = s.serviceRepository.Create(*serv)
 	if err != nil {
@@ -50,6 +58,11 @@ func (s *ServiceActions) Update(id string, dto service.Request) error {
 		return err
 	}
 
+	err = serv.ChangeChargeRules(dto.ChargeRules())
+	if err != nil {
+		return err
+	}
+
 	err = s.serviceRepository.Update(*serv)
 	if err != nil {
 		log.Println(err)
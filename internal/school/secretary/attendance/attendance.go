@@ -0,0 +1,192 @@
+package attendance
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrClassRoomNotFound Retornado quando a turma informada nao existe
+var ErrClassRoomNotFound = errors.New("class room not found")
+
+const (
+	StatusPresent   = "PRESENT"
+	StatusAbsent    = "ABSENT"
+	StatusJustified = "JUSTIFIED"
+)
+
+// Lesson Aula ministrada em que a chamada e feita: disciplina da turma em uma data e horario
+type Lesson struct {
+	ClassRoomId uuid.UUID `json:"class_room_id"`
+	SubjectId   uuid.UUID `json:"subject_id"`
+	ScheduleId  uuid.UUID `json:"schedule_id"`
+	Date        time.Time `json:"date"`
+}
+
+// Student Aluno com matricula aprovada na turma
+type Student struct {
+	RegistrationId uuid.UUID `json:"registration_id"`
+	StudentId      uuid.UUID `json:"student_id"`
+	Name           string    `json:"name"`
+}
+
+// Record Registro de frequencia de um aluno em uma aula
+type Record struct {
+	id             uuid.UUID
+	lesson         Lesson
+	classRoom      string
+	subject        string
+	teacherId      uuid.UUID
+	registrationId uuid.UUID
+	studentId      uuid.UUID
+	student        string
+	status         string
+	note           string
+}
+
+func NewRecord(lesson Lesson, teacherId uuid.UUID, student Student, status string, note string) (*Record, error) {
+	r := &Record{
+		id:             uuid.New(),
+		lesson:         lesson,
+		teacherId:      teacherId,
+		registrationId: student.RegistrationId,
+		studentId:      student.StudentId,
+		student:        student.Name,
+	}
+
+	err := r.ChangeStatus(status, note)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func LoadRecord(
+	id string,
+	lesson Lesson,
+	classRoom string,
+	subject string,
+	teacherId uuid.UUID,
+	student Student,
+	status string,
+	note string,
+) (*Record, error) {
+
+	recordId, err := uuid.Parse(id)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to change attendance id")
+	}
+
+	return &Record{
+		id:             recordId,
+		lesson:         lesson,
+		classRoom:      classRoom,
+		subject:        subject,
+		teacherId:      teacherId,
+		registrationId: student.RegistrationId,
+		studentId:      student.StudentId,
+		student:        student.Name,
+		status:         status,
+		note:           note,
+	}, nil
+}
+
+// ChangeStatus Altera a situacao do aluno na aula. A observacao e obrigatoria para faltas justificadas
+func (r *Record) ChangeStatus(status string, note string) error {
+	switch status {
+	case StatusPresent, StatusAbsent:
+	case StatusJustified:
+		if note == "" {
+			return errors.New("justification is required for justified absences")
+		}
+	default:
+		return errors.New("invalid attendance status provided")
+	}
+
+	r.status = status
+	r.note = note
+
+	return nil
+}
+
+// Attended Faltas justificadas sao abonadas e nao reduzem a frequencia do aluno
+func (r *Record) Attended() bool {
+	return r.status != StatusAbsent
+}
+
+func (r *Record) Id() uuid.UUID {
+	return r.id
+}
+
+func (r *Record) Lesson() Lesson {
+	return r.lesson
+}
+
+func (r *Record) ClassRoom() string {
+	return r.classRoom
+}
+
+func (r *Record) Subject() string {
+	return r.subject
+}
+
+func (r *Record) TeacherId() uuid.UUID {
+	return r.teacherId
+}
+
+func (r *Record) RegistrationId() uuid.UUID {
+	return r.registrationId
+}
+
+func (r *Record) StudentId() uuid.UUID {
+	return r.studentId
+}
+
+func (r *Record) Student() string {
+	return r.student
+}
+
+func (r *Record) Status() string {
+	return r.status
+}
+
+func (r *Record) Note() string {
+	return r.note
+}
+
+func (r *Record) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Id             string `json:"id"`
+		ClassRoomId    string `json:"class_room_id"`
+		ClassRoom      string `json:"class_room"`
+		SubjectId      string `json:"subject_id"`
+		Subject        string `json:"subject"`
+		ScheduleId     string `json:"schedule_id"`
+		Date           string `json:"date"`
+		TeacherId      string `json:"teacher_id"`
+		RegistrationId string `json:"registration_id"`
+		StudentId      string `json:"student_id"`
+		Student        string `json:"student"`
+		Status         string `json:"status"`
+		Note           string `json:"note"`
+	}{
+		Id:             r.Id().String(),
+		ClassRoomId:    r.Lesson().ClassRoomId.String(),
+		ClassRoom:      r.ClassRoom(),
+		SubjectId:      r.Lesson().SubjectId.String(),
+		Subject:        r.Subject(),
+		ScheduleId:     r.Lesson().ScheduleId.String(),
+		Date:           r.Lesson().Date.Format("2006-01-02"),
+		TeacherId:      r.TeacherId().String(),
+		RegistrationId: r.RegistrationId().String(),
+		StudentId:      r.StudentId().String(),
+		Student:        r.Student(),
+		Status:         r.Status(),
+		Note:           r.Note(),
+	})
+}
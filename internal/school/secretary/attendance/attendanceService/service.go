@@ -0,0 +1,333 @@
+package attendanceService
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/attendance"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schedule"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/teacher"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/timetable"
+)
+
+// defaultTerms Quantidade de etapas do ano letivo quando nao informada: bimestres
+const defaultTerms = 4
+
+type AttendanceActionsInterface interface {
+	RollCall(dto attendance.RollCallRequestDto) ([]attendance.Record, error)
+	FindRollCall(classRoomId string, subjectId string, scheduleId string, date string) ([]attendance.Record, error)
+	ChangeRecord(id string, dto attendance.RecordRequestDto) (*attendance.Record, error)
+	Frequency(classRoomId string, studentId string, terms int) ([]attendance.StudentFrequency, error)
+	Alerts(schoolYearId string, classRoomId string) ([]attendance.Alert, error)
+}
+
+type AttendanceActions struct {
+	repository           attendance.Repository
+	classRoomRepository  classroom.Repository
+	schoolYearRepository schoolyear.Repository
+	scheduleRepository   schedule.Repository
+	teacherRepository    teacher.Repository
+	timetableRepository  timetable.Repository
+}
+
+func New(
+	repository attendance.Repository,
+	classRoomRepository classroom.Repository,
+	schoolYearRepository schoolyear.Repository,
+	scheduleRepository schedule.Repository,
+	teacherRepository teacher.Repository,
+	timetableRepository timetable.Repository,
+) *AttendanceActions {
+	return &AttendanceActions{
+		repository:           repository,
+		classRoomRepository:  classRoomRepository,
+		schoolYearRepository: schoolYearRepository,
+		scheduleRepository:   scheduleRepository,
+		teacherRepository:    teacherRepository,
+		timetableRepository:  timetableRepository,
+	}
+}
+
+// RollCall Registra a chamada de todos os alunos matriculados na turma na data da aula. Uma nova
+// chamada da mesma aula atualiza a situacao dos alunos chamados
+func (a *AttendanceActions) RollCall(dto attendance.RollCallRequestDto) ([]attendance.Record, error) {
+	lesson, err := a.lesson(dto.ClassRoomId, dto.SubjectId, dto.ScheduleId, dto.Date)
+	if err != nil {
+		return nil, err
+	}
+
+	assignment, err := a.teacherRepository.FindAssignment(lesson.ClassRoomId, lesson.SubjectId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve teacher assignment")
+	}
+
+	if assignment == nil {
+		return nil, errors.New("subject has no teacher assigned in the class room")
+	}
+
+	students, err := a.repository.FindStudents(lesson.ClassRoomId, lesson.Date)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve class room students")
+	}
+
+	if len(students) == 0 {
+		return nil, errors.New("class room has no registered students")
+	}
+
+	registered := map[string]bool{}
+	for _, student := range students {
+		registered[student.StudentId.String()] = true
+	}
+
+	statuses := map[string]attendance.StudentStatusRequestDto{}
+	for _, studentStatus := range dto.Students {
+		if !registered[studentStatus.StudentId] {
+			return nil, errors.New("student " + studentStatus.StudentId + " is not registered in the class room")
+		}
+
+		statuses[studentStatus.StudentId] = studentStatus
+	}
+
+	defaultStatus := dto.DefaultStatus
+	if defaultStatus == "" {
+		defaultStatus = attendance.StatusPresent
+	}
+
+	var records []attendance.Record
+
+	for _, student := range students {
+		status, note := defaultStatus, ""
+
+		studentStatus, ok := statuses[student.StudentId.String()]
+		if ok {
+			status, note = studentStatus.Status, studentStatus.Note
+		}
+
+		record, err := attendance.NewRecord(*lesson, assignment.TeacherId(), student, status, note)
+		if err != nil {
+			return nil, errors.New("student " + student.Name + ": " + err.Error())
+		}
+
+		records = append(records, *record)
+	}
+
+	err = a.repository.SaveRollCall(records)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to save roll call")
+	}
+
+	records, err = a.repository.FindByLesson(*lesson)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve roll call")
+	}
+
+	return records, nil
+}
+
+func (a *AttendanceActions) FindRollCall(classRoomId string, subjectId string, scheduleId string, date string) ([]attendance.Record, error) {
+	lesson, err := a.lesson(classRoomId, subjectId, scheduleId, date)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := a.repository.FindByLesson(*lesson)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve roll call")
+	}
+
+	return records, nil
+}
+
+func (a *AttendanceActions) ChangeRecord(id string, dto attendance.RecordRequestDto) (*attendance.Record, error) {
+	record, err := a.repository.FindById(id)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve attendance record")
+	}
+
+	if record == nil {
+		return nil, errors.New("attendance record not found")
+	}
+
+	err = record.ChangeStatus(dto.Status, dto.Note)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.repository.Update(*record)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to update attendance record")
+	}
+
+	return record, nil
+}
+
+// Frequency Frequencia dos alunos da turma por disciplina, no ano e em cada etapa do ano letivo.
+// Com studentId informado retorna apenas a frequencia do aluno
+func (a *AttendanceActions) Frequency(classRoomId string, studentId string, terms int) ([]attendance.StudentFrequency, error) {
+	classRoom, err := a.classRoomRepository.FindById(classRoomId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve class room")
+	}
+
+	if classRoom == nil {
+		return nil, attendance.ErrClassRoomNotFound
+	}
+
+	schoolYear, err := a.schoolYearRepository.FindById(classRoom.SchoolYearId().String())
+	if err != nil || schoolYear == nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve school year")
+	}
+
+	if terms == 0 {
+		terms = defaultTerms
+	}
+
+	yearTerms, err := attendance.NewTerms(*schoolYear.StartAt(), *schoolYear.EndAt(), terms)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := a.repository.FindByClassRoom(classRoom.Id())
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve attendance records")
+	}
+
+	if studentId != "" {
+		var studentRecords []attendance.Record
+
+		for _, record := range records {
+			if record.StudentId().String() == studentId {
+				studentRecords = append(studentRecords, record)
+			}
+		}
+
+		records = studentRecords
+	}
+
+	return attendance.Summarize(records, yearTerms), nil
+}
+
+// Alerts Alunos do ano letivo com frequencia abaixo de 75% em alguma disciplina, opcionalmente
+// restritos a uma turma
+func (a *AttendanceActions) Alerts(schoolYearId string, classRoomId string) ([]attendance.Alert, error) {
+	yearId, err := uuid.Parse(schoolYearId)
+	if err != nil {
+		return nil, errors.New("invalid school year id provided")
+	}
+
+	records, err := a.repository.FindBySchoolYear(yearId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve attendance records")
+	}
+
+	if classRoomId != "" {
+		var classRoomRecords []attendance.Record
+
+		for _, record := range records {
+			if record.Lesson().ClassRoomId.String() == classRoomId {
+				classRoomRecords = append(classRoomRecords, record)
+			}
+		}
+
+		records = classRoomRecords
+	}
+
+	return attendance.Alerts(attendance.Summarize(records, nil)), nil
+}
+
+// lesson Confere a aula da chamada: data dentro do ano letivo e nao futura, horario do ano letivo
+// da turma que acontece no dia da semana da data e aula da disciplina na grade da turma
+func (a *AttendanceActions) lesson(classRoomId string, subjectId string, scheduleId string, date string) (*attendance.Lesson, error) {
+	lessonDate, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return nil, errors.New("invalid date provided")
+	}
+
+	if lessonDate.After(time.Now()) {
+		return nil, errors.New("attendance cannot be recorded for a future date")
+	}
+
+	subject, err := uuid.Parse(subjectId)
+	if err != nil {
+		return nil, errors.New("invalid subject id provided")
+	}
+
+	classRoom, err := a.classRoomRepository.FindById(classRoomId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve class room")
+	}
+
+	if classRoom == nil {
+		return nil, attendance.ErrClassRoomNotFound
+	}
+
+	schoolYear, err := a.schoolYearRepository.FindById(classRoom.SchoolYearId().String())
+	if err != nil || schoolYear == nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve school year")
+	}
+
+	if lessonDate.Before(*schoolYear.StartAt()) || lessonDate.After(*schoolYear.EndAt()) {
+		return nil, errors.New("date is outside the school year")
+	}
+
+	scheduleClass, err := a.scheduleRepository.FindById(scheduleId)
+	if err != nil || scheduleClass == nil {
+		log.Println(err)
+		return nil, errors.New("schedule not found")
+	}
+
+	if scheduleClass.SchoolYearId() != classRoom.SchoolYearId() {
+		return nil, errors.New("schedule does not belong to the class room school year")
+	}
+
+	if !scheduleClass.HasWeekday(int(lessonDate.Weekday())) {
+		return nil, errors.New("schedule does not happen on the weekday of the date")
+	}
+
+	lessons, err := a.timetableRepository.FindLessons(classRoom.SchoolYearId())
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve timetable")
+	}
+
+	if !inTimetable(lessons, classRoom.Id(), subject, scheduleClass.Id(), int(lessonDate.Weekday())) {
+		return nil, errors.New("subject has no lesson in the class room timetable at this schedule and weekday")
+	}
+
+	return &attendance.Lesson{
+		ClassRoomId: classRoom.Id(),
+		SubjectId:   subject,
+		ScheduleId:  scheduleClass.Id(),
+		Date:        lessonDate,
+	}, nil
+}
+
+// inTimetable Informa se a grade possui aula da disciplina na turma no horario e dia da semana
+func inTimetable(lessons []timetable.Lesson, classRoomId uuid.UUID, subjectId uuid.UUID, scheduleId uuid.UUID, weekday int) bool {
+	for _, lesson := range lessons {
+		slot := lesson.Slot()
+		if lesson.ClassRoomId() == classRoomId && lesson.SubjectId() == subjectId &&
+			slot.ScheduleId == scheduleId && slot.Weekday == weekday {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,196 @@
+package attendanceService
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/mocks"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/attendance"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schedule"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/teacher"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/timetable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type scenario struct {
+	classRoom            *classroom.ClassRoom
+	schedule             *schedule.ScheduleClass
+	subjectId            uuid.UUID
+	students             []attendance.Student
+	repository           *mocks.AttendanceRepository
+	classRoomRepository  *mocks.ClassRoomRepository
+	schoolYearRepository *mocks.SchoolYearRepository
+	scheduleRepository   *mocks.ScheduleRepository
+	teacherRepository    *mocks.TeacherRepository
+	timetableRepository  *mocks.TimetableRepository
+}
+
+func TestRollCall(t *testing.T) {
+	t.Run("should record every registered student with the default status", func(t *testing.T) {
+		s := getScenario()
+		s.repository.On("SaveRollCall", mock.MatchedBy(func(records []attendance.Record) bool {
+			return len(records) == 2 &&
+				records[0].Status() == attendance.StatusPresent &&
+				records[1].Status() == attendance.StatusAbsent
+		})).Return(nil)
+		s.repository.On("FindByLesson", mock.Anything).Return([]attendance.Record{}, nil)
+
+		_, err := s.actions().RollCall(s.request("2023-03-06", attendance.StudentStatusRequestDto{
+			StudentId: s.students[1].StudentId.String(),
+			Status:    attendance.StatusAbsent,
+		}))
+		assert.NoError(t, err)
+		s.repository.AssertCalled(t, "SaveRollCall", mock.Anything)
+	})
+
+	t.Run("should look for students registered on the lesson date", func(t *testing.T) {
+		s := getScenario()
+		s.repository.On("SaveRollCall", mock.Anything).Return(nil)
+		s.repository.On("FindByLesson", mock.Anything).Return([]attendance.Record{}, nil)
+
+		_, err := s.actions().RollCall(s.request("2023-03-06"))
+		assert.NoError(t, err)
+		s.repository.AssertCalled(t, "FindStudents", s.classRoom.Id(), time.Date(2023, 3, 6, 0, 0, 0, 0, time.UTC))
+	})
+
+	t.Run("should not record student outside the class room", func(t *testing.T) {
+		s := getScenario()
+
+		_, err := s.actions().RollCall(s.request("2023-03-06", attendance.StudentStatusRequestDto{
+			StudentId: uuid.New().String(),
+			Status:    attendance.StatusAbsent,
+		}))
+		assert.ErrorContains(t, err, "is not registered in the class room")
+		s.repository.AssertNotCalled(t, "SaveRollCall", mock.Anything)
+	})
+
+	t.Run("should not record lesson on a weekday without the schedule", func(t *testing.T) {
+		s := getScenario()
+
+		_, err := s.actions().RollCall(s.request("2023-03-05"))
+		assert.EqualError(t, err, "schedule does not happen on the weekday of the date")
+	})
+
+	t.Run("should not record lesson outside the school year", func(t *testing.T) {
+		s := getScenario()
+
+		_, err := s.actions().RollCall(s.request("2023-01-16"))
+		assert.EqualError(t, err, "date is outside the school year")
+	})
+
+	t.Run("should not record lesson missing from the timetable", func(t *testing.T) {
+		s := getScenario()
+
+		_, err := s.actions().RollCall(s.request("2023-03-07"))
+		assert.EqualError(t, err, "subject has no lesson in the class room timetable at this schedule and weekday")
+		s.repository.AssertNotCalled(t, "SaveRollCall", mock.Anything)
+	})
+
+	t.Run("should return not found for unknown class room", func(t *testing.T) {
+		s := getScenario()
+		dto := s.request("2023-03-06")
+		dto.ClassRoomId = uuid.New().String()
+		s.classRoomRepository.On("FindById", dto.ClassRoomId).Return((*classroom.ClassRoom)(nil), nil)
+
+		_, err := s.actions().RollCall(dto)
+		assert.ErrorIs(t, err, attendance.ErrClassRoomNotFound)
+	})
+
+	t.Run("should require teacher assigned to the subject", func(t *testing.T) {
+		s := getScenario()
+		s.teacherRepository = new(mocks.TeacherRepository)
+		s.teacherRepository.On("FindAssignment", s.classRoom.Id(), s.subjectId).Return((*teacher.Assignment)(nil), nil)
+
+		_, err := s.actions().RollCall(s.request("2023-03-06"))
+		assert.EqualError(t, err, "subject has no teacher assigned in the class room")
+	})
+}
+
+func TestAlerts(t *testing.T) {
+	s := getScenario()
+	lesson := attendance.Lesson{ClassRoomId: s.classRoom.Id(), SubjectId: s.subjectId, ScheduleId: s.schedule.Id()}
+
+	var records []attendance.Record
+	for i, status := range []string{attendance.StatusPresent, attendance.StatusAbsent, attendance.StatusAbsent, attendance.StatusPresent} {
+		record, _ := attendance.NewRecord(lesson, uuid.New(), s.students[0], status, "")
+		records = append(records, *record)
+
+		status = attendance.StatusPresent
+		if i == 0 {
+			status = attendance.StatusAbsent
+		}
+
+		record, _ = attendance.NewRecord(lesson, uuid.New(), s.students[1], status, "")
+		records = append(records, *record)
+	}
+
+	s.repository.On("FindBySchoolYear", s.classRoom.SchoolYearId()).Return(records, nil)
+
+	alerts, err := s.actions().Alerts(s.classRoom.SchoolYearId().String(), "")
+	assert.NoError(t, err)
+	assert.Len(t, alerts, 1)
+	assert.Equal(t, s.students[0].StudentId, alerts[0].StudentId)
+	assert.Equal(t, 50.0, alerts[0].Percentage)
+}
+
+func (s *scenario) actions() *AttendanceActions {
+	return New(s.repository, s.classRoomRepository, s.schoolYearRepository, s.scheduleRepository, s.teacherRepository, s.timetableRepository)
+}
+
+func (s *scenario) request(date string, students ...attendance.StudentStatusRequestDto) attendance.RollCallRequestDto {
+	return attendance.RollCallRequestDto{
+		ClassRoomId: s.classRoom.Id().String(),
+		SubjectId:   s.subjectId.String(),
+		ScheduleId:  s.schedule.Id().String(),
+		Date:        date,
+		Students:    students,
+	}
+}
+
+func getScenario() *scenario {
+	schoolYear, _ := schoolyear.New("2023", "2023-02-01", "2023-12-15")
+	yearId := schoolYear.Id().String()
+
+	sch, _ := schedule.New("1a aula", "07:00:00", "07:50:00", yearId)
+	clr, _ := classroom.New(30, "morning", "Fundamental", "TUR-001", yearId, uuid.New().String(), uuid.New().String(), "ANY", "remote")
+	subjectId := uuid.New()
+
+	assignment := teacher.LoadAssignment(uuid.New(), uuid.New(), "Marcos Lima", clr.Id(), "TUR-001", schoolYear.Id(), subjectId, "Matematica")
+
+	s := &scenario{
+		classRoom: clr,
+		schedule:  sch,
+		subjectId: subjectId,
+		students: []attendance.Student{
+			{RegistrationId: uuid.New(), StudentId: uuid.New(), Name: "Ana Souza"},
+			{RegistrationId: uuid.New(), StudentId: uuid.New(), Name: "Bruno Lima"},
+		},
+		repository:           new(mocks.AttendanceRepository),
+		classRoomRepository:  new(mocks.ClassRoomRepository),
+		schoolYearRepository: new(mocks.SchoolYearRepository),
+		scheduleRepository:   new(mocks.ScheduleRepository),
+		teacherRepository:    new(mocks.TeacherRepository),
+		timetableRepository:  new(mocks.TimetableRepository),
+	}
+
+	monday := timetable.NewLesson(
+		schoolYear.Id(),
+		timetable.ClassRoom{Id: clr.Id(), Identification: "TUR-001"},
+		timetable.Subject{Id: subjectId, Name: "Matematica", TeacherId: assignment.TeacherId(), Teacher: "Marcos Lima"},
+		timetable.Room{Id: uuid.New(), Code: "SALA-01"},
+		timetable.Slot{ScheduleId: sch.Id(), Weekday: 1, StartAt: "07:00:00", EndAt: "07:50:00"},
+	)
+
+	s.classRoomRepository.On("FindById", clr.Id().String()).Return(clr, nil)
+	s.schoolYearRepository.On("FindById", yearId).Return(schoolYear, nil)
+	s.scheduleRepository.On("FindById", sch.Id().String()).Return(sch, nil)
+	s.teacherRepository.On("FindAssignment", clr.Id(), subjectId).Return(assignment, nil)
+	s.timetableRepository.On("FindLessons", schoolYear.Id()).Return([]timetable.Lesson{*monday}, nil)
+	s.repository.On("FindStudents", clr.Id(), mock.Anything).Return(s.students, nil)
+
+	return s
+}
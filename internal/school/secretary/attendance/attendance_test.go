@@ -0,0 +1,99 @@
+package attendance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecord(t *testing.T) {
+	lesson := Lesson{ClassRoomId: uuid.New(), SubjectId: uuid.New(), ScheduleId: uuid.New(), Date: date("2023-03-06")}
+	student := Student{RegistrationId: uuid.New(), StudentId: uuid.New(), Name: "Ana Souza"}
+
+	t.Run("should record student presence", func(t *testing.T) {
+		record, err := NewRecord(lesson, uuid.New(), student, StatusPresent, "")
+		assert.NoError(t, err)
+		assert.True(t, record.Attended())
+		assert.Equal(t, student.StudentId, record.StudentId())
+	})
+
+	t.Run("should require justification for justified absence", func(t *testing.T) {
+		_, err := NewRecord(lesson, uuid.New(), student, StatusJustified, "")
+		assert.EqualError(t, err, "justification is required for justified absences")
+
+		record, err := NewRecord(lesson, uuid.New(), student, StatusJustified, "atestado medico")
+		assert.NoError(t, err)
+		assert.True(t, record.Attended())
+	})
+
+	t.Run("should not accept invalid status", func(t *testing.T) {
+		_, err := NewRecord(lesson, uuid.New(), student, "LATE", "")
+		assert.EqualError(t, err, "invalid attendance status provided")
+	})
+}
+
+func TestNewTerms(t *testing.T) {
+	terms, err := NewTerms(date("2023-01-01"), date("2023-12-31"), 4)
+	assert.NoError(t, err)
+	assert.Len(t, terms, 4)
+	assert.Equal(t, date("2023-01-01"), terms[0].StartAt)
+	assert.Equal(t, date("2023-12-31"), terms[3].EndAt)
+
+	for i := 1; i < len(terms); i++ {
+		assert.Equal(t, terms[i-1].EndAt.AddDate(0, 0, 1), terms[i].StartAt)
+	}
+
+	_, err = NewTerms(date("2023-01-01"), date("2023-12-31"), 0)
+	assert.EqualError(t, err, "invalid number of terms provided")
+}
+
+func TestSummarize(t *testing.T) {
+	classRoomId := uuid.New()
+	math := uuid.New()
+	history := uuid.New()
+	ana := Student{RegistrationId: uuid.New(), StudentId: uuid.New(), Name: "Ana Souza"}
+	bruno := Student{RegistrationId: uuid.New(), StudentId: uuid.New(), Name: "Bruno Lima"}
+
+	terms, _ := NewTerms(date("2023-01-01"), date("2023-12-31"), 4)
+
+	var records []Record
+	add := func(subjectId uuid.UUID, subject string, day string, student Student, status string, note string) {
+		lesson := Lesson{ClassRoomId: classRoomId, SubjectId: subjectId, ScheduleId: uuid.New(), Date: date(day)}
+		record, _ := LoadRecord(uuid.New().String(), lesson, "TUR-001", subject, uuid.New(), student, status, note)
+		records = append(records, *record)
+	}
+
+	add(math, "Matematica", "2023-02-06", ana, StatusPresent, "")
+	add(math, "Matematica", "2023-02-07", ana, StatusAbsent, "")
+	add(math, "Matematica", "2023-05-08", ana, StatusJustified, "atestado")
+	add(math, "Matematica", "2023-05-09", ana, StatusAbsent, "")
+	add(history, "Historia", "2023-02-06", ana, StatusPresent, "")
+	add(math, "Matematica", "2023-02-06", bruno, StatusPresent, "")
+
+	frequencies := Summarize(records, terms)
+	assert.Len(t, frequencies, 2)
+	assert.Equal(t, "Ana Souza", frequencies[0].Student)
+	assert.Len(t, frequencies[0].Subjects, 2)
+
+	mathFrequency := frequencies[0].Subjects[1]
+	assert.Equal(t, "Matematica", mathFrequency.Subject)
+	assert.Equal(t, 4, mathFrequency.Lessons)
+	assert.Equal(t, 2, mathFrequency.Absences)
+	assert.Equal(t, 1, mathFrequency.Justified)
+	assert.Equal(t, 50.0, mathFrequency.Percentage)
+	assert.Equal(t, 50.0, mathFrequency.Terms[0].Percentage)
+	assert.Equal(t, 50.0, mathFrequency.Terms[1].Percentage)
+	assert.Equal(t, 0, mathFrequency.Terms[2].Lessons)
+
+	alerts := Alerts(frequencies)
+	assert.Len(t, alerts, 1)
+	assert.Equal(t, ana.StudentId, alerts[0].StudentId)
+	assert.Equal(t, math, alerts[0].SubjectId)
+}
+
+func date(value string) time.Time {
+	d, _ := time.Parse("2006-01-02", value)
+	return d
+}
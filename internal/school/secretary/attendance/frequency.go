@@ -0,0 +1,212 @@
+package attendance
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MinimumFrequency Frequencia minima (percentual) exigida pela LDB para aprovacao
+const MinimumFrequency = 75.0
+
+// Term Etapa do ano letivo (bimestre, trimestre...) usada nos relatorios de frequencia
+type Term struct {
+	Number  int       `json:"number"`
+	StartAt time.Time `json:"start_at"`
+	EndAt   time.Time `json:"end_at"`
+}
+
+// NewTerms Divide o periodo do ano letivo em etapas com a mesma quantidade de dias
+func NewTerms(startAt time.Time, endAt time.Time, count int) ([]Term, error) {
+	if count < 1 || count > 12 {
+		return nil, errors.New("invalid number of terms provided")
+	}
+
+	days := int(endAt.Sub(startAt).Hours()/24) + 1
+	if days < count {
+		return nil, errors.New("school year is shorter than the number of terms")
+	}
+
+	var terms []Term
+
+	for i := 0; i < count; i++ {
+		terms = append(terms, Term{
+			Number:  i + 1,
+			StartAt: startAt.AddDate(0, 0, i*days/count),
+			EndAt:   startAt.AddDate(0, 0, (i+1)*days/count-1),
+		})
+	}
+
+	return terms, nil
+}
+
+func (t Term) Contains(date time.Time) bool {
+	return !date.Before(t.StartAt) && !date.After(t.EndAt)
+}
+
+// Frequency Aulas registradas e percentual de presenca. Faltas justificadas contam como presenca
+type Frequency struct {
+	Lessons    int     `json:"lessons"`
+	Presences  int     `json:"presences"`
+	Absences   int     `json:"absences"`
+	Justified  int     `json:"justified"`
+	Percentage float64 `json:"percentage"`
+	attended   int
+}
+
+func (f *Frequency) add(record Record) {
+	f.Lessons++
+
+	if record.Attended() {
+		f.attended++
+	}
+
+	switch record.Status() {
+	case StatusPresent:
+		f.Presences++
+	case StatusAbsent:
+		f.Absences++
+	case StatusJustified:
+		f.Justified++
+	}
+
+	f.Percentage = math.Round(float64(f.attended)/float64(f.Lessons)*10000) / 100
+}
+
+// BelowMinimum Informa se a frequencia esta abaixo do minimo exigido
+func (f Frequency) BelowMinimum() bool {
+	return f.Lessons > 0 && f.Percentage < MinimumFrequency
+}
+
+type TermFrequency struct {
+	Term int `json:"term"`
+	Frequency
+}
+
+type SubjectFrequency struct {
+	SubjectId uuid.UUID `json:"subject_id"`
+	Subject   string    `json:"subject"`
+	Frequency
+	Terms []TermFrequency `json:"terms"`
+}
+
+// StudentFrequency Frequencia do aluno em cada disciplina da turma, no ano e por etapa
+type StudentFrequency struct {
+	StudentId   uuid.UUID          `json:"student_id"`
+	Student     string             `json:"student"`
+	ClassRoomId uuid.UUID          `json:"class_room_id"`
+	ClassRoom   string             `json:"class_room"`
+	Subjects    []SubjectFrequency `json:"subjects"`
+}
+
+// Alert Aluno com frequencia abaixo do minimo em uma disciplina
+type Alert struct {
+	StudentId   uuid.UUID `json:"student_id"`
+	Student     string    `json:"student"`
+	ClassRoomId uuid.UUID `json:"class_room_id"`
+	ClassRoom   string    `json:"class_room"`
+	SubjectId   uuid.UUID `json:"subject_id"`
+	Subject     string    `json:"subject"`
+	Frequency
+}
+
+// Summarize Agrupa os registros por aluno e disciplina, calculando a frequencia no ano e em cada
+// etapa. Registros fora das etapas contam apenas no ano
+func Summarize(records []Record, terms []Term) []StudentFrequency {
+	type key struct {
+		studentId   uuid.UUID
+		classRoomId uuid.UUID
+	}
+
+	students := map[key]*StudentFrequency{}
+	subjects := map[key]map[uuid.UUID]*SubjectFrequency{}
+
+	for _, record := range records {
+		k := key{studentId: record.StudentId(), classRoomId: record.Lesson().ClassRoomId}
+
+		if _, ok := students[k]; !ok {
+			students[k] = &StudentFrequency{
+				StudentId:   record.StudentId(),
+				Student:     record.Student(),
+				ClassRoomId: record.Lesson().ClassRoomId,
+				ClassRoom:   record.ClassRoom(),
+			}
+			subjects[k] = map[uuid.UUID]*SubjectFrequency{}
+		}
+
+		subject, ok := subjects[k][record.Lesson().SubjectId]
+		if !ok {
+			subject = &SubjectFrequency{
+				SubjectId: record.Lesson().SubjectId,
+				Subject:   record.Subject(),
+				Terms:     make([]TermFrequency, len(terms)),
+			}
+
+			for i, term := range terms {
+				subject.Terms[i].Term = term.Number
+			}
+
+			subjects[k][record.Lesson().SubjectId] = subject
+		}
+
+		subject.add(record)
+
+		for i, term := range terms {
+			if term.Contains(record.Lesson().Date) {
+				subject.Terms[i].add(record)
+			}
+		}
+	}
+
+	var frequencies []StudentFrequency
+
+	for k, student := range students {
+		for _, subject := range subjects[k] {
+			student.Subjects = append(student.Subjects, *subject)
+		}
+
+		sort.Slice(student.Subjects, func(i, j int) bool {
+			return student.Subjects[i].Subject < student.Subjects[j].Subject
+		})
+
+		frequencies = append(frequencies, *student)
+	}
+
+	sort.Slice(frequencies, func(i, j int) bool {
+		if frequencies[i].ClassRoom != frequencies[j].ClassRoom {
+			return frequencies[i].ClassRoom < frequencies[j].ClassRoom
+		}
+
+		return frequencies[i].Student < frequencies[j].Student
+	})
+
+	return frequencies
+}
+
+// Alerts Alunos com frequencia anual abaixo do minimo em alguma disciplina
+func Alerts(frequencies []StudentFrequency) []Alert {
+	var alerts []Alert
+
+	for _, student := range frequencies {
+		for _, subject := range student.Subjects {
+			if !subject.BelowMinimum() {
+				continue
+			}
+
+			alerts = append(alerts, Alert{
+				StudentId:   student.StudentId,
+				Student:     student.Student,
+				ClassRoomId: student.ClassRoomId,
+				ClassRoom:   student.ClassRoom,
+				SubjectId:   subject.SubjectId,
+				Subject:     subject.Subject,
+				Frequency:   subject.Frequency,
+			})
+		}
+	}
+
+	return alerts
+}
@@ -0,0 +1,17 @@
+package attendance
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	SaveRollCall(records []Record) error
+	Update(record Record) error
+	FindById(id string) (*Record, error)
+	FindByLesson(lesson Lesson) ([]Record, error)
+	FindByClassRoom(classRoomId uuid.UUID) ([]Record, error)
+	FindBySchoolYear(schoolYearId uuid.UUID) ([]Record, error)
+	FindStudents(classRoomId uuid.UUID, date time.Time) ([]Student, error)
+}
@@ -0,0 +1,40 @@
+package attendance
+
+import (
+	"github.com/go-playground/validator"
+	requestvalidator "github.com/henriquerocha2004/sistema-escolar/internal/infra/http/request_validator"
+)
+
+// RollCallRequestDto Chamada da turma inteira em uma aula. Os alunos matriculados que nao forem
+// informados recebem a situacao padrao (presente quando nao informada)
+type RollCallRequestDto struct {
+	ClassRoomId   string                    `json:"class_room_id" validate:"required,uuid"`
+	SubjectId     string                    `json:"subject_id" validate:"required,uuid"`
+	ScheduleId    string                    `json:"schedule_id" validate:"required,uuid"`
+	Date          string                    `json:"date" validate:"required,date::format:yyyy-mm-dd"`
+	DefaultStatus string                    `json:"default_status" validate:"omitempty,oneof=PRESENT ABSENT"`
+	Students      []StudentStatusRequestDto `json:"students" validate:"omitempty,dive"`
+}
+
+type StudentStatusRequestDto struct {
+	StudentId string `json:"student_id" validate:"required,uuid"`
+	Status    string `json:"status" validate:"required,oneof=PRESENT ABSENT JUSTIFIED"`
+	Note      string `json:"note" validate:"omitempty,max=255"`
+}
+
+func (r *RollCallRequestDto) Validate() error {
+	v := validator.New()
+	_ = v.RegisterValidation("date::format:yyyy-mm-dd", requestvalidator.ValidateDateUSA)
+	return v.Struct(r)
+}
+
+// RecordRequestDto Correcao da situacao de um aluno em uma aula
+type RecordRequestDto struct {
+	Status string `json:"status" validate:"required,oneof=PRESENT ABSENT JUSTIFIED"`
+	Note   string `json:"note" validate:"omitempty,max=255"`
+}
+
+func (r *RecordRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(r)
+}
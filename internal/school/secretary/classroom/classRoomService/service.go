@@ -3,12 +3,16 @@ package classRoomService
 import (
 	"errors"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/curriculum"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/waitinglist/waitingListService"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
 	"log"
 )
 
 type ServiceClassRoom struct {
-	repository classroom.Repository
+	repository           classroom.Repository
+	waitingList          waitingListService.WaitingListActionsInterface
+	curriculumRepository curriculum.Repository
 }
 
 type ServiceClassRoomInterface interface {
@@ -19,9 +23,15 @@ type ServiceClassRoomInterface interface {
 	FindAll(dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error)
 }
 
-func New(repository classroom.Repository) *ServiceClassRoom {
+func New(
+	repository classroom.Repository,
+	waitingList waitingListService.WaitingListActionsInterface,
+	curriculumRepository curriculum.Repository,
+) *ServiceClassRoom {
 	return &ServiceClassRoom{
-		repository: repository,
+		repository:           repository,
+		waitingList:          waitingList,
+		curriculumRepository: curriculumRepository,
 	}
 }
 
@@ -40,6 +50,11 @@ func (c *ServiceClassRoom) Create(dto classroom.Request) error {
 		return err
 	}
 
+	err = c.checkLevel(*classRoom)
+	if err != nil {
+		return err
+	}
+
 	err = c.repository.Create(*classRoom)
 	if err != nil {
 		log.Println(err)
@@ -50,7 +65,23 @@ func (c *ServiceClassRoom) Create(dto classroom.Request) error {
 }
 
 func (c *ServiceClassRoom) Update(id string, dto classroom.Request) error {
-	classRoom, err := classroom.New(dto.VacancyQuantity,
+	current, err := c.repository.FindById(id)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to retrieve class room")
+	}
+
+	if dto.VacancyQuantity < current.OccupiedVacancies() {
+		return errors.New("vacancy quantity cannot be less than occupied vacancies")
+	}
+
+	classRoom, err := classroom.Load(
+		id,
+		true,
+		current.Status(),
+		current.OccupiedVacancies(),
+		dto.VacancyQuantity,
+		current.OpenDate().Format("2006-01-02"),
 		dto.Shift,
 		dto.Level,
 		dto.Identification,
@@ -64,7 +95,7 @@ func (c *ServiceClassRoom) Update(id string, dto classroom.Request) error {
 		return err
 	}
 
-	err = classRoom.ChangeRoomId(id)
+	err = c.checkLevel(*classRoom)
 	if err != nil {
 		return err
 	}
@@ -75,6 +106,14 @@ func (c *ServiceClassRoom) Update(id string, dto classroom.Request) error {
 		return errors.New("failed to update class room")
 	}
 
+	// Vagas novas sao oferecidas primeiro para quem aguarda na lista de espera
+	if classRoom.VacancyQuantity() > current.VacancyQuantity() {
+		err = c.waitingList.OfferVacancies(id)
+		if err != nil {
+			log.Println(err)
+		}
+	}
+
 	return nil
 }
 
@@ -109,3 +148,18 @@ func (c *ServiceClassRoom) FindAll(dtoRequest paginator.PaginatorRequest) (*pagi
 
 	return classRooms, nil
 }
+
+// checkLevel O nivel da turma precisa ter uma matriz curricular no ano letivo da turma
+func (c *ServiceClassRoom) checkLevel(classRoom classroom.ClassRoom) error {
+	matrix, err := c.curriculumRepository.FindMatrix(classRoom.Level(), classRoom.SchoolYearId())
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to retrieve curriculum matrix")
+	}
+
+	if matrix == nil {
+		return errors.New("class room level has no curriculum matrix in the school year")
+	}
+
+	return nil
+}
@@ -9,6 +9,9 @@ import (
 	"github.com/google/uuid"
 )
 
+// ErrClassRoomFull Retornado quando a turma nao possui mais vagas livres
+var ErrClassRoomFull = errors.New("class room has no free vacancies")
+
 type ClassRoom struct {
 	id              uuid.UUID
 	active          bool
@@ -305,6 +308,10 @@ func (cr *ClassRoom) SetOccupiedVacancies(quantity int) error {
 
 	remainingVacancies := cr.vacancyQuantity - cr.occupiedVacancy
 
+	if remainingVacancies <= 0 {
+		return ErrClassRoomFull
+	}
+
 	if quantity > remainingVacancies {
 		return errors.New("number of available vacancies is less than the number of vacancies requested")
 	}
@@ -318,6 +325,20 @@ func (cr *ClassRoom) SetOccupiedVacancies(quantity int) error {
 	return nil
 }
 
+func (cr *ClassRoom) ReleaseOccupiedVacancies(quantity int) error {
+	if quantity <= 0 {
+		return nil
+	}
+
+	if quantity > cr.occupiedVacancy {
+		return errors.New("number of vacancies to release is greater than the number of occupied vacancies")
+	}
+
+	cr.occupiedVacancy -= quantity
+
+	return nil
+}
+
 func (cr *ClassRoom) OccupiedVacancies() int {
 	return cr.occupiedVacancy
 }
@@ -82,3 +82,43 @@ func TestShouldReturnErrorIfQuantityRequestedIsGreaterThanVacancyQuantity(t *tes
 	err = classRoom.SetOccupiedVacancies(50)
 	assert.Error(t, err)
 }
+
+func TestShouldReleaseOccupiedVacancyWithSuccess(t *testing.T) {
+	classRoom, err := New(
+		20,
+		"morning",
+		"OPEN",
+		"TUR-A123",
+		uuid.New().String(),
+		"",
+		uuid.New().String(),
+		"Terreo",
+		"in_person",
+	)
+
+	assert.NoError(t, err)
+	err = classRoom.SetOccupiedVacancies(5)
+	assert.NoError(t, err)
+	err = classRoom.ReleaseOccupiedVacancies(1)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, classRoom.OccupiedVacancies())
+}
+
+func TestShouldReturnErrorIfReleaseMoreVacanciesThanOccupied(t *testing.T) {
+	classRoom, err := New(
+		20,
+		"morning",
+		"OPEN",
+		"TUR-A123",
+		uuid.New().String(),
+		"",
+		uuid.New().String(),
+		"Terreo",
+		"in_person",
+	)
+
+	assert.NoError(t, err)
+	err = classRoom.ReleaseOccupiedVacancies(1)
+	assert.Error(t, err)
+	assert.Equal(t, 0, classRoom.OccupiedVacancies())
+}
@@ -1,6 +1,9 @@
 package classroom
 
-import "github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+import (
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+)
 
 type Repository interface {
 	Create(classRoom ClassRoom) error
@@ -9,4 +12,7 @@ type Repository interface {
 	FindAll(pagination paginator.Pagination) (*paginator.PaginationResult, error)
 	FindById(id string) (*ClassRoom, error)
 	FindByIdLock(id string) (*ClassRoom, error)
+	FindBySchoolYear(schoolYearId uuid.UUID) ([]ClassRoom, error)
+	OccupyVacancies(classRoom ClassRoom) error
+	ReleaseVacancies(classRoom ClassRoom) error
 }
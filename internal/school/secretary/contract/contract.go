@@ -0,0 +1,136 @@
+package contract
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Contract Contrato emitido para a matricula. O PDF fica no armazenamento de arquivos junto com o
+// resumo do conteudo, garantindo que o contrato entregue nao mude com edicoes posteriores do modelo
+type Contract struct {
+	id             uuid.UUID
+	registrationId uuid.UUID
+	templateId     uuid.NullUUID
+	templateHash   string
+	hash           string
+	storageKey     string
+	issuedAt       time.Time
+}
+
+func New(registrationId uuid.UUID, template Template, content []byte, issuedAt time.Time) (*Contract, error) {
+	if len(content) == 0 {
+		return nil, errors.New("contract content cannot be empty")
+	}
+
+	c := &Contract{
+		id:             uuid.New(),
+		registrationId: registrationId,
+		templateId: uuid.NullUUID{
+			UUID:  template.Id,
+			Valid: template.Id != uuid.Nil,
+		},
+		templateHash: template.Hash,
+		hash:         Hash(content),
+		issuedAt:     issuedAt,
+	}
+
+	c.storageKey = "contracts/" + registrationId.String() + "/" + c.id.String() + ".pdf"
+
+	return c, nil
+}
+
+func Load(
+	id string,
+	registrationId uuid.UUID,
+	templateId uuid.NullUUID,
+	templateHash string,
+	hash string,
+	storageKey string,
+	issuedAt time.Time,
+) (*Contract, error) {
+
+	contractId, err := uuid.Parse(id)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to change contract id")
+	}
+
+	return &Contract{
+		id:             contractId,
+		registrationId: registrationId,
+		templateId:     templateId,
+		templateHash:   templateHash,
+		hash:           hash,
+		storageKey:     storageKey,
+		issuedAt:       issuedAt,
+	}, nil
+}
+
+// Verify Confere se o arquivo armazenado e o mesmo emitido
+func (c *Contract) Verify(content []byte) bool {
+	return Hash(content) == c.hash
+}
+
+// FileName Nome do arquivo do contrato para download
+func (c *Contract) FileName(registrationCode string) string {
+	if registrationCode == "" {
+		return "contrato.pdf"
+	}
+
+	return "contrato_" + registrationCode + ".pdf"
+}
+
+func (c *Contract) Id() uuid.UUID {
+	return c.id
+}
+
+func (c *Contract) RegistrationId() uuid.UUID {
+	return c.registrationId
+}
+
+func (c *Contract) TemplateId() uuid.NullUUID {
+	return c.templateId
+}
+
+func (c *Contract) TemplateHash() string {
+	return c.templateHash
+}
+
+func (c *Contract) Hash() string {
+	return c.hash
+}
+
+func (c *Contract) StorageKey() string {
+	return c.storageKey
+}
+
+func (c *Contract) IssuedAt() time.Time {
+	return c.issuedAt
+}
+
+func (c *Contract) MarshalJSON() ([]byte, error) {
+	templateId := ""
+	if c.TemplateId().Valid {
+		templateId = c.TemplateId().UUID.String()
+	}
+
+	return json.Marshal(struct {
+		Id             string `json:"id"`
+		RegistrationId string `json:"registration_id"`
+		TemplateId     string `json:"template_id,omitempty"`
+		TemplateHash   string `json:"template_hash"`
+		Hash           string `json:"hash"`
+		IssuedAt       string `json:"issued_at"`
+	}{
+		Id:             c.Id().String(),
+		RegistrationId: c.RegistrationId().String(),
+		TemplateId:     templateId,
+		TemplateHash:   c.TemplateHash(),
+		Hash:           c.Hash(),
+		IssuedAt:       c.IssuedAt().Format(time.RFC3339),
+	})
+}
@@ -0,0 +1,159 @@
+package contractService
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/contract"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/document"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
+)
+
+type ContractActionsInterface interface {
+	Download(registrationId string) (*contract.File, error)
+	FindTemplate() (*contract.Template, error)
+	UpdateTemplate(dto contract.TemplateRequestDto) (*contract.Template, error)
+}
+
+type ContractActions struct {
+	repository             contract.Repository
+	registrationRepository registration.Repository
+	schoolYearRepository   schoolyear.Repository
+	storage                document.Storage
+}
+
+func New(
+	repository contract.Repository,
+	registrationRepository registration.Repository,
+	schoolYearRepository schoolyear.Repository,
+	storage document.Storage,
+) *ContractActions {
+	return &ContractActions{
+		repository:             repository,
+		registrationRepository: registrationRepository,
+		schoolYearRepository:   schoolYearRepository,
+		storage:                storage,
+	}
+}
+
+// Download Devolve o contrato emitido para a matricula. Na primeira solicitacao o contrato e gerado
+// com o modelo vigente; as seguintes devolvem o mesmo arquivo, conferido pelo hash gravado
+func (c *ContractActions) Download(registrationId string) (*contract.File, error) {
+	reg, err := c.registrationRepository.FindById(registrationId)
+	if err != nil || reg == nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve registration")
+	}
+
+	issued, err := c.repository.FindByRegistration(registrationId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve contract")
+	}
+
+	if issued == nil {
+		return c.issue(reg)
+	}
+
+	content, err := c.storage.Open(issued.StorageKey())
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to read contract file")
+	}
+
+	if !issued.Verify(content) {
+		return nil, errors.New("contract file does not match the issued contract")
+	}
+
+	return &contract.File{
+		Name:    issued.FileName(reg.Code()),
+		Hash:    issued.Hash(),
+		Content: content,
+	}, nil
+}
+
+func (c *ContractActions) FindTemplate() (*contract.Template, error) {
+	template, err := c.repository.FindCurrentTemplate()
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve contract template")
+	}
+
+	if template == nil {
+		return contract.Default(), nil
+	}
+
+	return template, nil
+}
+
+// UpdateTemplate Cadastra uma nova versao do modelo. Contratos ja emitidos nao sao alterados
+func (c *ContractActions) UpdateTemplate(dto contract.TemplateRequestDto) (*contract.Template, error) {
+	template, err := contract.NewTemplate(dto.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.repository.CreateTemplate(*template)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to save contract template")
+	}
+
+	return template, nil
+}
+
+func (c *ContractActions) issue(reg *registration.Registration) (*contract.File, error) {
+	if reg.FinancialResponsible() == nil {
+		return nil, errors.New("registration has no financial responsible")
+	}
+
+	template, err := c.FindTemplate()
+	if err != nil {
+		return nil, err
+	}
+
+	schoolYear, err := c.schoolYearRepository.FindById(reg.Class().SchoolYearId().String())
+	if err != nil || schoolYear == nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve school year")
+	}
+
+	issuedAt := time.Now()
+
+	text, err := template.Fill(contract.NewData(*reg, *schoolYear, issuedAt))
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := contract.RenderPdf(text)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to generate contract pdf")
+	}
+
+	issued, err := contract.New(reg.Id(), *template, content, issuedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.storage.Save(issued.StorageKey(), content)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to store contract file")
+	}
+
+	err = c.repository.Create(*issued)
+	if err != nil {
+		log.Println(err)
+		_ = c.storage.Delete(issued.StorageKey())
+		return nil, errors.New("failed to save contract")
+	}
+
+	return &contract.File{
+		Name:    issued.FileName(reg.Code()),
+		Hash:    issued.Hash(),
+		Content: content,
+	}, nil
+}
@@ -0,0 +1,101 @@
+package contractService
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/mocks"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/service"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/contract"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestShouldIssueContractOnFirstDownload(t *testing.T) {
+	reg := getRegistration()
+	year, _ := schoolyear.Load(reg.Class().SchoolYearId().String(), "2024", "2024-02-01", "2024-12-15")
+
+	registrationRepository := new(mocks.RegistrationRepository)
+	registrationRepository.On("FindById", reg.Id().String()).Return(reg, nil)
+
+	schoolYearRepository := new(mocks.SchoolYearRepository)
+	schoolYearRepository.On("FindById", reg.Class().SchoolYearId().String()).Return(year, nil)
+
+	repository := new(mocks.ContractRepository)
+	repository.On("FindByRegistration", reg.Id().String()).Return((*contract.Contract)(nil), nil)
+	repository.On("FindCurrentTemplate").Return((*contract.Template)(nil), nil)
+	repository.On("Create", mock.Anything).Return(nil)
+
+	storage := new(mocks.DocumentStorage)
+	storage.On("Save", mock.Anything, mock.Anything).Return(nil)
+
+	file, err := New(repository, registrationRepository, schoolYearRepository, storage).Download(reg.Id().String())
+	assert.NoError(t, err)
+	assert.Equal(t, "contrato_2023000001.pdf", file.Name)
+	assert.True(t, bytes.HasPrefix(file.Content, []byte("%PDF")))
+	assert.Equal(t, contract.Hash(file.Content), file.Hash)
+	repository.AssertCalled(t, "Create", mock.Anything)
+}
+
+func TestShouldReturnIssuedContractUnchanged(t *testing.T) {
+	reg := getRegistration()
+	content := []byte("%PDF-1.3 contrato emitido")
+	issued, _ := contract.New(reg.Id(), *contract.Default(), content, time.Now())
+
+	registrationRepository := new(mocks.RegistrationRepository)
+	registrationRepository.On("FindById", reg.Id().String()).Return(reg, nil)
+
+	repository := new(mocks.ContractRepository)
+	repository.On("FindByRegistration", reg.Id().String()).Return(issued, nil)
+
+	t.Run("should return stored file", func(t *testing.T) {
+		storage := new(mocks.DocumentStorage)
+		storage.On("Open", issued.StorageKey()).Return(content, nil)
+
+		file, err := New(repository, registrationRepository, new(mocks.SchoolYearRepository), storage).Download(reg.Id().String())
+		assert.NoError(t, err)
+		assert.Equal(t, content, file.Content)
+		repository.AssertNotCalled(t, "Create", mock.Anything)
+	})
+
+	t.Run("should refuse stored file changed after issue", func(t *testing.T) {
+		storage := new(mocks.DocumentStorage)
+		storage.On("Open", issued.StorageKey()).Return([]byte("%PDF-1.3 contrato alterado"), nil)
+
+		_, err := New(repository, registrationRepository, new(mocks.SchoolYearRepository), storage).Download(reg.Id().String())
+		assert.EqualError(t, err, "contract file does not match the issued contract")
+	})
+}
+
+func getRegistration() *registration.Registration {
+	svc, _ := service.New("Ensino Fundamental", 5000.00)
+	clr, _ := classroom.New(10, "morning", "Fundamental", "TUR-001", uuid.New().String(), uuid.New().String(), uuid.New().String(), "ANY", "remote")
+	std, _ := student.New("Henrique", "Rocha", "2000-01-01", "123456789", "84731086043", "student@mail.com", true)
+
+	reg, _ := registration.Load(
+		uuid.New().String(),
+		"2023000001",
+		*clr,
+		"morning",
+		*std,
+		*svc,
+		500.00,
+		10,
+		200.00,
+		"2023-12-10",
+		10,
+		registration.StatusWaitEnrollmentFee,
+		"2023-09-01",
+		"10",
+		false,
+	)
+	_ = reg.ChangeFinancialResponsible("")
+
+	return reg
+}
@@ -0,0 +1,53 @@
+package contract
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/service"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContractTemplate(t *testing.T) {
+	t.Run("should refuse template with unknown fields", func(t *testing.T) {
+		_, err := NewTemplate("Aluno: {{.Student.Nome}}")
+		assert.Error(t, err)
+	})
+
+	t.Run("should fill template with registration data", func(t *testing.T) {
+		svc, _ := service.New("Ensino Fundamental", 5000.00)
+		clr, _ := classroom.New(10, "morning", "Fundamental", "TUR-001", uuid.New().String(), uuid.New().String(), uuid.New().String(), "ANY", "remote")
+		std, _ := student.New("Henrique", "Rocha", "2000-01-01", "123456789", "84731086043", "student@mail.com", true)
+		reg, _ := registration.Load(uuid.New().String(), "2023000001", *clr, "morning", *std, *svc, 1250.5, 10, 200.00, "2023-12-10", 10, registration.StatusWaitEnrollmentFee, "2023-09-01", "10", false)
+		_ = reg.ChangeFinancialResponsible("")
+		year, _ := schoolyear.Load(uuid.New().String(), "2024", "2024-02-01", "2024-12-15")
+
+		template, err := NewTemplate("{{.Responsible.Name}} ({{.Responsible.Cpf}}) - {{.Service}} - {{.InstallmentsQuantity}}x {{.MonthlyFee}} dia {{.PaymentDay}} - {{.SchoolYear.StartAt}} a {{.SchoolYear.EndAt}}")
+		assert.NoError(t, err)
+
+		text, err := template.Fill(NewData(*reg, *year, time.Now()))
+		assert.NoError(t, err)
+		assert.Equal(t, "Henrique Rocha (847.310.860-43) - Ensino Fundamental - 10x R$ 1.250,50 dia 10 - 01/02/2024 a 15/12/2024", text)
+	})
+}
+
+func TestContract(t *testing.T) {
+	content, err := RenderPdf(DefaultTemplate)
+	assert.NoError(t, err)
+	assert.True(t, bytes.HasPrefix(content, []byte("%PDF")))
+
+	registrationId := uuid.New()
+	issued, err := New(registrationId, *Default(), content, time.Now())
+	assert.NoError(t, err)
+	assert.False(t, issued.TemplateId().Valid)
+	assert.Equal(t, Hash([]byte(DefaultTemplate)), issued.TemplateHash())
+	assert.Equal(t, "contracts/"+registrationId.String()+"/"+issued.Id().String()+".pdf", issued.StorageKey())
+	assert.True(t, issued.Verify(content))
+	assert.False(t, issued.Verify(append(content, ' ')))
+}
@@ -0,0 +1,127 @@
+package contract
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
+)
+
+type Person struct {
+	Name     string
+	Cpf      string
+	Document string
+	Email    string
+	BirthDay string
+}
+
+type Period struct {
+	Year    string
+	StartAt string
+	EndAt   string
+}
+
+// Data Campos disponiveis para o modelo de contrato. Datas e valores ja vem formatados
+type Data struct {
+	Code                 string
+	Student              Person
+	Responsible          Person
+	Service              string
+	ClassRoom            string
+	Shift                string
+	MonthlyFee           string
+	InstallmentsQuantity int
+	PaymentDay           string
+	SchoolYear           Period
+	IssuedAt             string
+}
+
+func NewData(reg registration.Registration, schoolYear schoolyear.SchoolYear, issuedAt time.Time) Data {
+	sdt := reg.Student()
+
+	data := Data{
+		Code: reg.Code(),
+		Student: Person{
+			Name:     sdt.FirstName() + " " + sdt.LastName(),
+			Cpf:      formatCpf(string(sdt.Cpf())),
+			Document: formatDocument(sdt.Document()),
+			Email:    sdt.Email(),
+			BirthDay: formatDate(sdt.BirthDay()),
+		},
+		Service:              reg.Service().Description(),
+		Shift:                string(reg.Shift()),
+		MonthlyFee:           formatMoney(reg.MonthlyFee()),
+		InstallmentsQuantity: reg.InstallmentsQuantity(),
+		PaymentDay:           reg.PaymentDay(),
+		SchoolYear: Period{
+			Year:    schoolYear.Year(),
+			StartAt: formatDate(schoolYear.StartAt()),
+			EndAt:   formatDate(schoolYear.EndAt()),
+		},
+		IssuedAt: issuedAt.Format("02/01/2006"),
+	}
+
+	if reg.Class() != nil {
+		data.ClassRoom = reg.Class().Identification()
+	}
+
+	if responsible := reg.FinancialResponsible(); responsible != nil {
+		data.Responsible = Person{
+			Name:  responsible.Name,
+			Cpf:   formatCpf(responsible.Cpf),
+			Email: responsible.Email,
+		}
+	}
+
+	return data
+}
+
+// formatDocument Tipo e numero do documento de identificacao (ex: CPF 123.456.789-09)
+func formatDocument(document value_objects.Document) string {
+	switch document.Type {
+	case value_objects.DocumentCpf:
+		return "CPF " + formatCpf(document.Number)
+	case value_objects.DocumentRnm:
+		return "RNM " + document.Number
+	case value_objects.DocumentPassport:
+		return "passaporte " + document.Number
+	case value_objects.DocumentBirthCertificate:
+		return "certidao de nascimento " + document.Number
+	}
+
+	return ""
+}
+
+func formatDate(date *time.Time) string {
+	if date == nil || date.IsZero() {
+		return ""
+	}
+
+	return date.Format("02/01/2006")
+}
+
+func formatCpf(cpf string) string {
+	if len(cpf) != 11 {
+		return cpf
+	}
+
+	return cpf[0:3] + "." + cpf[3:6] + "." + cpf[6:9] + "-" + cpf[9:11]
+}
+
+// formatMoney Valor em reais no formato brasileiro (ex: R$ 1.234,56)
+func formatMoney(value float64) string {
+	parts := strings.Split(strconv.FormatFloat(value, 'f', 2, 64), ".")
+
+	integer := parts[0]
+	var grouped []string
+	for len(integer) > 3 {
+		grouped = append([]string{integer[len(integer)-3:]}, grouped...)
+		integer = integer[:len(integer)-3]
+	}
+	grouped = append([]string{integer}, grouped...)
+
+	return "R$ " + strings.Join(grouped, ".") + "," + parts[1]
+}
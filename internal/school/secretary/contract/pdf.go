@@ -0,0 +1,44 @@
+package contract
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/jung-kurt/gofpdf"
+)
+
+// RenderPdf Gera o PDF do contrato a partir do texto preenchido. A primeira linha do texto e
+// usada como titulo
+func RenderPdf(text string) ([]byte, error) {
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetMargins(20, 20, 20)
+	pdf.SetAutoPageBreak(true, 20)
+	pdf.AddPage()
+
+	translate := pdf.UnicodeTranslatorFromDescriptor("")
+
+	title, body, _ := strings.Cut(text, "\n")
+
+	pdf.SetFont("Helvetica", "B", 13)
+	pdf.MultiCell(0, 7, translate(strings.TrimSpace(title)), "", "C", false)
+	pdf.Ln(4)
+
+	pdf.SetFont("Helvetica", "", 11)
+	pdf.MultiCell(0, 6, translate(strings.TrimSpace(body)), "", "J", false)
+
+	var content bytes.Buffer
+
+	err := pdf.Output(&content)
+	if err != nil {
+		return nil, err
+	}
+
+	return content.Bytes(), nil
+}
+
+// File PDF do contrato para download
+type File struct {
+	Name    string
+	Hash    string
+	Content []byte
+}
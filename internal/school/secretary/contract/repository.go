@@ -0,0 +1,8 @@
+package contract
+
+type Repository interface {
+	Create(contract Contract) error
+	FindByRegistration(registrationId string) (*Contract, error)
+	CreateTemplate(template Template) error
+	FindCurrentTemplate() (*Template, error)
+}
@@ -0,0 +1,12 @@
+package contract
+
+import "github.com/go-playground/validator"
+
+type TemplateRequestDto struct {
+	Content string `json:"content" validate:"required"`
+}
+
+func (t *TemplateRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(t)
+}
@@ -0,0 +1,97 @@
+package contract
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// DefaultTemplate Modelo usado enquanto a escola nao cadastrar o proprio modelo de contrato
+const DefaultTemplate = `CONTRATO DE PRESTACAO DE SERVICOS EDUCACIONAIS
+
+Matricula: {{.Code}}
+
+CONTRATANTE: {{.Responsible.Name}}, CPF {{.Responsible.Cpf}}, e-mail {{.Responsible.Email}}, responsavel financeiro pelo aluno abaixo identificado.
+
+ALUNO: {{.Student.Name}}, {{.Student.Document}}, nascido em {{.Student.BirthDay}}.
+
+CLAUSULA 1 - DO OBJETO
+O presente contrato tem por objeto a prestacao do servico "{{.Service}}" na turma {{.ClassRoom}} ({{.Shift}}) durante o ano letivo de {{.SchoolYear.Year}}, com inicio em {{.SchoolYear.StartAt}} e termino em {{.SchoolYear.EndAt}}.
+
+CLAUSULA 2 - DO VALOR
+Pelo servico contratado o CONTRATANTE pagara {{.InstallmentsQuantity}} parcelas mensais de {{.MonthlyFee}}, com vencimento todo dia {{.PaymentDay}}.
+
+CLAUSULA 3 - DO INADIMPLEMENTO
+O atraso no pagamento das parcelas sujeita o CONTRATANTE a multa e juros previstos nas regras de cobranca do servico.
+
+Emitido em {{.IssuedAt}}.
+
+
+_____________________________________
+{{.Responsible.Name}}
+CONTRATANTE`
+
+// Template Modelo de contrato. Os campos da matricula sao preenchidos com a sintaxe de
+// text/template (ex: {{.Student.Name}}). Modelos nao sao alterados: cada edicao gera uma nova versao
+type Template struct {
+	Id        uuid.UUID `json:"id"`
+	Content   string    `json:"content"`
+	Hash      string    `json:"hash"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func NewTemplate(content string) (*Template, error) {
+	if strings.TrimSpace(content) == "" {
+		return nil, errors.New("contract template cannot be empty")
+	}
+
+	t := &Template{
+		Id:        uuid.New(),
+		Content:   content,
+		Hash:      Hash([]byte(content)),
+		CreatedAt: time.Now(),
+	}
+
+	_, err := t.Fill(Data{})
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// Default Modelo padrao, sem versao cadastrada
+func Default() *Template {
+	return &Template{
+		Content: DefaultTemplate,
+		Hash:    Hash([]byte(DefaultTemplate)),
+	}
+}
+
+// Fill Preenche o modelo com os dados da matricula
+func (t *Template) Fill(data Data) (string, error) {
+	tmpl, err := template.New("contract").Parse(t.Content)
+	if err != nil {
+		return "", errors.New("invalid contract template: " + err.Error())
+	}
+
+	var text strings.Builder
+
+	err = tmpl.Execute(&text, data)
+	if err != nil {
+		return "", errors.New("invalid contract template: " + err.Error())
+	}
+
+	return text.String(), nil
+}
+
+// Hash Resumo SHA-256 em hexadecimal
+func Hash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
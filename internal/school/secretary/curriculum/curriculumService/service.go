@@ -0,0 +1,348 @@
+package curriculumService
+
+import (
+	"errors"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/curriculum"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+)
+
+type CurriculumActionsInterface interface {
+	CreateSubject(dto curriculum.SubjectRequestDto) (*curriculum.Subject, error)
+	UpdateSubject(id string, dto curriculum.SubjectRequestDto) error
+	DeleteSubject(id string) error
+	FindSubject(id string) (*curriculum.Subject, error)
+	FindSubjects(dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error)
+	CreateMatrix(dto curriculum.MatrixRequestDto) (*curriculum.Matrix, error)
+	UpdateMatrix(id string, dto curriculum.MatrixRequestDto) error
+	DeleteMatrix(id string) error
+	FindMatrix(id string) (*curriculum.Matrix, error)
+	FindMatrices(schoolYearId string) ([]curriculum.Matrix, error)
+	Clone(dto curriculum.CloneRequestDto) (*curriculum.CloneResult, error)
+}
+
+type CurriculumActions struct {
+	repository           curriculum.Repository
+	schoolYearRepository schoolyear.Repository
+}
+
+func New(repository curriculum.Repository, schoolYearRepository schoolyear.Repository) *CurriculumActions {
+	return &CurriculumActions{
+		repository:           repository,
+		schoolYearRepository: schoolYearRepository,
+	}
+}
+
+func (c *CurriculumActions) CreateSubject(dto curriculum.SubjectRequestDto) (*curriculum.Subject, error) {
+	subject, err := curriculum.NewSubject(dto.Code, dto.Name, dto.Workload)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.checkSubjectCode(*subject)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.repository.CreateSubject(*subject)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to create subject")
+	}
+
+	return subject, nil
+}
+
+func (c *CurriculumActions) UpdateSubject(id string, dto curriculum.SubjectRequestDto) error {
+	subject, err := curriculum.LoadSubject(id, dto.Code, dto.Name, dto.Workload)
+	if err != nil {
+		return err
+	}
+
+	err = c.checkSubjectCode(*subject)
+	if err != nil {
+		return err
+	}
+
+	err = c.repository.UpdateSubject(*subject)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to update subject")
+	}
+
+	return nil
+}
+
+// DeleteSubject Disciplinas presentes em alguma matriz curricular nao podem ser removidas
+func (c *CurriculumActions) DeleteSubject(id string) error {
+	subjectId, err := uuid.Parse(id)
+	if err != nil {
+		return errors.New("invalid subject id provided")
+	}
+
+	inUse, err := c.repository.SubjectInUse(subjectId)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to verify subject usage")
+	}
+
+	if inUse {
+		return errors.New("subject is part of a curriculum matrix")
+	}
+
+	err = c.repository.DeleteSubject(id)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to delete subject")
+	}
+
+	return nil
+}
+
+func (c *CurriculumActions) FindSubject(id string) (*curriculum.Subject, error) {
+	subject, err := c.repository.FindSubjectById(id)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve subject")
+	}
+
+	if subject == nil {
+		return nil, errors.New("subject not found")
+	}
+
+	return subject, nil
+}
+
+func (c *CurriculumActions) FindSubjects(dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error) {
+	pg := paginator.Pagination{}
+	pg.FillFromDto(dtoRequest)
+
+	subjects, err := c.repository.FindSubjects(pg)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve subjects")
+	}
+
+	return subjects, nil
+}
+
+// CreateMatrix Cria a matriz curricular do nivel no ano letivo. Cada nivel possui uma unica
+// matriz por ano letivo
+func (c *CurriculumActions) CreateMatrix(dto curriculum.MatrixRequestDto) (*curriculum.Matrix, error) {
+	matrix, err := curriculum.NewMatrix(dto.Level, dto.SchoolYearId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.checkSchoolYear(dto.SchoolYearId)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := c.repository.FindMatrix(matrix.Level(), matrix.SchoolYearId())
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to verify curriculum matrix")
+	}
+
+	if current != nil {
+		return nil, errors.New("level already has a curriculum matrix in the school year")
+	}
+
+	err = c.fillItems(matrix, dto.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.repository.CreateMatrices([]curriculum.Matrix{*matrix})
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to create curriculum matrix")
+	}
+
+	return matrix, nil
+}
+
+// UpdateMatrix Refaz as disciplinas da matriz. O nivel e o ano letivo da matriz nao sao alterados
+// pois identificam as turmas que seguem a matriz
+func (c *CurriculumActions) UpdateMatrix(id string, dto curriculum.MatrixRequestDto) error {
+	matrix, err := c.FindMatrix(id)
+	if err != nil {
+		return err
+	}
+
+	matrix.ClearItems()
+
+	err = c.fillItems(matrix, dto.Items)
+	if err != nil {
+		return err
+	}
+
+	err = c.repository.UpdateMatrix(*matrix)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to update curriculum matrix")
+	}
+
+	return nil
+}
+
+// DeleteMatrix Matrizes seguidas por turmas do ano letivo nao podem ser removidas
+func (c *CurriculumActions) DeleteMatrix(id string) error {
+	matrix, err := c.FindMatrix(id)
+	if err != nil {
+		return err
+	}
+
+	inUse, err := c.repository.MatrixInUse(*matrix)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to verify curriculum matrix usage")
+	}
+
+	if inUse {
+		return errors.New("curriculum matrix is used by class rooms of the school year")
+	}
+
+	err = c.repository.DeleteMatrix(id)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to delete curriculum matrix")
+	}
+
+	return nil
+}
+
+func (c *CurriculumActions) FindMatrix(id string) (*curriculum.Matrix, error) {
+	matrix, err := c.repository.FindMatrixById(id)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve curriculum matrix")
+	}
+
+	if matrix == nil {
+		return nil, errors.New("curriculum matrix not found")
+	}
+
+	return matrix, nil
+}
+
+func (c *CurriculumActions) FindMatrices(schoolYearId string) ([]curriculum.Matrix, error) {
+	yearId, err := uuid.Parse(schoolYearId)
+	if err != nil {
+		return nil, errors.New("invalid school year id provided")
+	}
+
+	matrices, err := c.repository.FindMatrices(yearId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve curriculum matrices")
+	}
+
+	return matrices, nil
+}
+
+// Clone Copia as matrizes curriculares de um ano letivo para outro. Niveis que ja possuem matriz
+// no ano letivo de destino sao mantidos e informados no resultado
+func (c *CurriculumActions) Clone(dto curriculum.CloneRequestDto) (*curriculum.CloneResult, error) {
+	err := c.checkSchoolYear(dto.FromSchoolYearId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.checkSchoolYear(dto.ToSchoolYearId)
+	if err != nil {
+		return nil, err
+	}
+
+	matrices, err := c.FindMatrices(dto.FromSchoolYearId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(matrices) == 0 {
+		return nil, errors.New("no curriculum matrix found in the source school year")
+	}
+
+	targetYearId, err := uuid.Parse(dto.ToSchoolYearId)
+	if err != nil {
+		return nil, errors.New("invalid school year id provided")
+	}
+
+	result := &curriculum.CloneResult{}
+
+	for _, matrix := range matrices {
+		current, err := c.repository.FindMatrix(matrix.Level(), targetYearId)
+		if err != nil {
+			log.Println(err)
+			return nil, errors.New("failed to verify curriculum matrix")
+		}
+
+		if current != nil {
+			result.Skipped = append(result.Skipped, matrix.Level())
+			continue
+		}
+
+		result.Cloned = append(result.Cloned, *matrix.Clone(targetYearId))
+	}
+
+	if len(result.Cloned) == 0 {
+		return result, nil
+	}
+
+	err = c.repository.CreateMatrices(result.Cloned)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to clone curriculum matrices")
+	}
+
+	return result, nil
+}
+
+func (c *CurriculumActions) fillItems(matrix *curriculum.Matrix, items []curriculum.MatrixItemRequestDto) error {
+	for _, item := range items {
+		subject, err := c.repository.FindSubjectById(item.SubjectId)
+		if err != nil {
+			log.Println(err)
+			return errors.New("failed to retrieve subject")
+		}
+
+		if subject == nil {
+			return errors.New("subject " + item.SubjectId + " not found")
+		}
+
+		err = matrix.AddItem(*subject, item.WeeklyClasses)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *CurriculumActions) checkSubjectCode(subject curriculum.Subject) error {
+	current, err := c.repository.FindSubjectByCode(subject.Code())
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to verify subject code")
+	}
+
+	if current != nil && current.Id() != subject.Id() {
+		return errors.New("subject code already in use")
+	}
+
+	return nil
+}
+
+func (c *CurriculumActions) checkSchoolYear(id string) error {
+	schoolYear, err := c.schoolYearRepository.FindById(id)
+	if err != nil || schoolYear == nil {
+		log.Println(err)
+		return errors.New("school year not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package curriculumService
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/mocks"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/curriculum"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestShouldNotCreateSubjectWithCodeInUse(t *testing.T) {
+	current, _ := curriculum.NewSubject("MAT", "Matematica", 200)
+
+	repository := new(mocks.CurriculumRepository)
+	repository.On("FindSubjectByCode", "MAT").Return(current, nil)
+
+	_, err := New(repository, new(mocks.SchoolYearRepository)).CreateSubject(curriculum.SubjectRequestDto{
+		Code:     "mat",
+		Name:     "Matematica Basica",
+		Workload: 160,
+	})
+	assert.EqualError(t, err, "subject code already in use")
+	repository.AssertNotCalled(t, "CreateSubject", mock.Anything)
+}
+
+func TestShouldNotDeleteSubjectInUse(t *testing.T) {
+	subjectId := uuid.New()
+
+	repository := new(mocks.CurriculumRepository)
+	repository.On("SubjectInUse", subjectId).Return(true, nil)
+
+	err := New(repository, new(mocks.SchoolYearRepository)).DeleteSubject(subjectId.String())
+	assert.EqualError(t, err, "subject is part of a curriculum matrix")
+	repository.AssertNotCalled(t, "DeleteSubject", mock.Anything)
+}
+
+func TestShouldCreateMatrix(t *testing.T) {
+	schoolYear := getSchoolYear()
+	subject, _ := curriculum.NewSubject("MAT", "Matematica", 200)
+
+	schoolYearRepository := new(mocks.SchoolYearRepository)
+	schoolYearRepository.On("FindById", schoolYear.Id().String()).Return(schoolYear, nil)
+
+	repository := new(mocks.CurriculumRepository)
+	repository.On("FindMatrix", "Fundamental", schoolYear.Id()).Return((*curriculum.Matrix)(nil), nil)
+	repository.On("FindSubjectById", subject.Id().String()).Return(subject, nil)
+	repository.On("CreateMatrices", mock.Anything).Return(nil)
+
+	matrix, err := New(repository, schoolYearRepository).CreateMatrix(curriculum.MatrixRequestDto{
+		Level:        "Fundamental",
+		SchoolYearId: schoolYear.Id().String(),
+		Items: []curriculum.MatrixItemRequestDto{
+			{SubjectId: subject.Id().String(), WeeklyClasses: 5},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 5, matrix.WeeklyClasses())
+	repository.AssertCalled(t, "CreateMatrices", mock.Anything)
+}
+
+func TestShouldNotCreateSecondMatrixForLevel(t *testing.T) {
+	schoolYear := getSchoolYear()
+	current, _ := curriculum.NewMatrix("Fundamental", schoolYear.Id().String())
+
+	schoolYearRepository := new(mocks.SchoolYearRepository)
+	schoolYearRepository.On("FindById", schoolYear.Id().String()).Return(schoolYear, nil)
+
+	repository := new(mocks.CurriculumRepository)
+	repository.On("FindMatrix", "Fundamental", schoolYear.Id()).Return(current, nil)
+
+	_, err := New(repository, schoolYearRepository).CreateMatrix(curriculum.MatrixRequestDto{
+		Level:        "Fundamental",
+		SchoolYearId: schoolYear.Id().String(),
+		Items: []curriculum.MatrixItemRequestDto{
+			{SubjectId: uuid.New().String(), WeeklyClasses: 5},
+		},
+	})
+	assert.EqualError(t, err, "level already has a curriculum matrix in the school year")
+	repository.AssertNotCalled(t, "CreateMatrices", mock.Anything)
+}
+
+func TestShouldCloneMatricesSkippingExistingLevels(t *testing.T) {
+	from := getSchoolYear()
+	to, _ := schoolyear.New("2025", "2025-02-01", "2025-12-15")
+	subject, _ := curriculum.NewSubject("MAT", "Matematica", 200)
+
+	fundamental, _ := curriculum.NewMatrix("Fundamental", from.Id().String())
+	_ = fundamental.AddItem(*subject, 5)
+	medio, _ := curriculum.NewMatrix("Medio", from.Id().String())
+	_ = medio.AddItem(*subject, 4)
+	existing, _ := curriculum.NewMatrix("Medio", to.Id().String())
+
+	schoolYearRepository := new(mocks.SchoolYearRepository)
+	schoolYearRepository.On("FindById", from.Id().String()).Return(from, nil)
+	schoolYearRepository.On("FindById", to.Id().String()).Return(to, nil)
+
+	repository := new(mocks.CurriculumRepository)
+	repository.On("FindMatrices", from.Id()).Return([]curriculum.Matrix{*fundamental, *medio}, nil)
+	repository.On("FindMatrix", "Fundamental", to.Id()).Return((*curriculum.Matrix)(nil), nil)
+	repository.On("FindMatrix", "Medio", to.Id()).Return(existing, nil)
+	repository.On("CreateMatrices", mock.MatchedBy(func(matrices []curriculum.Matrix) bool {
+		return len(matrices) == 1 && matrices[0].Level() == "Fundamental" && matrices[0].SchoolYearId() == to.Id()
+	})).Return(nil)
+
+	result, err := New(repository, schoolYearRepository).Clone(curriculum.CloneRequestDto{
+		FromSchoolYearId: from.Id().String(),
+		ToSchoolYearId:   to.Id().String(),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, result.Cloned, 1)
+	assert.Equal(t, []string{"Medio"}, result.Skipped)
+	repository.AssertExpectations(t)
+}
+
+func getSchoolYear() *schoolyear.SchoolYear {
+	schoolYear, _ := schoolyear.New("2024", "2024-02-01", "2024-12-15")
+	return schoolYear
+}
@@ -0,0 +1,65 @@
+package curriculum
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubject(t *testing.T) {
+	t.Run("should create subject with uppercase code", func(t *testing.T) {
+		subject, err := NewSubject(" mat ", "Matematica", 200)
+		assert.NoError(t, err)
+		assert.Equal(t, "MAT", subject.Code())
+	})
+
+	t.Run("should refuse subject without workload", func(t *testing.T) {
+		_, err := NewSubject("MAT", "Matematica", 0)
+		assert.Error(t, err)
+	})
+}
+
+func TestMatrix(t *testing.T) {
+	subject, _ := NewSubject("MAT", "Matematica", 200)
+
+	t.Run("should add subjects with weekly classes", func(t *testing.T) {
+		matrix, err := NewMatrix("Fundamental", uuid.New().String())
+		assert.NoError(t, err)
+
+		err = matrix.AddItem(*subject, 5)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, matrix.WeeklyClasses())
+
+		item, found := matrix.Item(subject.Id())
+		assert.True(t, found)
+		assert.Equal(t, "Matematica", item.Subject)
+	})
+
+	t.Run("should refuse duplicated subject", func(t *testing.T) {
+		matrix, _ := NewMatrix("Fundamental", uuid.New().String())
+		_ = matrix.AddItem(*subject, 5)
+
+		err := matrix.AddItem(*subject, 2)
+		assert.EqualError(t, err, "subject MAT already in the curriculum matrix")
+	})
+
+	t.Run("should refuse invalid weekly classes", func(t *testing.T) {
+		matrix, _ := NewMatrix("Fundamental", uuid.New().String())
+
+		err := matrix.AddItem(*subject, MaxWeeklyClasses+1)
+		assert.EqualError(t, err, "invalid weekly classes for subject MAT")
+	})
+
+	t.Run("should clone matrix to another school year", func(t *testing.T) {
+		matrix, _ := NewMatrix("Fundamental", uuid.New().String())
+		_ = matrix.AddItem(*subject, 5)
+		target := uuid.New()
+
+		clone := matrix.Clone(target)
+		assert.NotEqual(t, matrix.Id(), clone.Id())
+		assert.Equal(t, target, clone.SchoolYearId())
+		assert.Equal(t, "Fundamental", clone.Level())
+		assert.Equal(t, matrix.Items(), clone.Items())
+	})
+}
@@ -0,0 +1,146 @@
+package curriculum
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// MaxWeeklyClasses Limite de aulas semanais de uma disciplina na matriz
+const MaxWeeklyClasses = 20
+
+// MatrixItem Disciplina da matriz com a quantidade de aulas por semana
+type MatrixItem struct {
+	SubjectId     uuid.UUID `json:"subject_id"`
+	Subject       string    `json:"subject"`
+	WeeklyClasses int       `json:"weekly_classes"`
+}
+
+// Matrix Matriz curricular de um nivel de ensino no ano letivo. Define as disciplinas ministradas
+// nas turmas do nivel e a carga semanal de cada uma
+type Matrix struct {
+	id           uuid.UUID
+	level        string
+	schoolYearId uuid.UUID
+	items        []MatrixItem
+}
+
+func NewMatrix(level string, schoolYearId string) (*Matrix, error) {
+	level = strings.TrimSpace(level)
+	if level == "" {
+		return nil, errors.New("curriculum level cannot be empty")
+	}
+
+	yearId, err := uuid.Parse(schoolYearId)
+	if err != nil {
+		return nil, errors.New("invalid school year id provided")
+	}
+
+	return &Matrix{
+		id:           uuid.New(),
+		level:        level,
+		schoolYearId: yearId,
+	}, nil
+}
+
+func LoadMatrix(id uuid.UUID, level string, schoolYearId uuid.UUID, items []MatrixItem) *Matrix {
+	return &Matrix{
+		id:           id,
+		level:        level,
+		schoolYearId: schoolYearId,
+		items:        items,
+	}
+}
+
+func (m *Matrix) Id() uuid.UUID {
+	return m.id
+}
+
+func (m *Matrix) Level() string {
+	return m.level
+}
+
+func (m *Matrix) SchoolYearId() uuid.UUID {
+	return m.schoolYearId
+}
+
+func (m *Matrix) Items() []MatrixItem {
+	return m.items
+}
+
+// AddItem Inclui a disciplina na matriz. Cada disciplina aparece uma unica vez
+func (m *Matrix) AddItem(subject Subject, weeklyClasses int) error {
+	if weeklyClasses <= 0 || weeklyClasses > MaxWeeklyClasses {
+		return errors.New("invalid weekly classes for subject " + subject.Code())
+	}
+
+	_, found := m.Item(subject.Id())
+	if found {
+		return errors.New("subject " + subject.Code() + " already in the curriculum matrix")
+	}
+
+	m.items = append(m.items, MatrixItem{
+		SubjectId:     subject.Id(),
+		Subject:       subject.Name(),
+		WeeklyClasses: weeklyClasses,
+	})
+
+	return nil
+}
+
+// ClearItems Remove as disciplinas para que a matriz seja refeita
+func (m *Matrix) ClearItems() {
+	m.items = nil
+}
+
+func (m *Matrix) Item(subjectId uuid.UUID) (MatrixItem, bool) {
+	for _, item := range m.items {
+		if item.SubjectId == subjectId {
+			return item, true
+		}
+	}
+
+	return MatrixItem{}, false
+}
+
+// WeeklyClasses Total de aulas por semana das turmas do nivel
+func (m *Matrix) WeeklyClasses() int {
+	total := 0
+
+	for _, item := range m.items {
+		total += item.WeeklyClasses
+	}
+
+	return total
+}
+
+// Clone Copia a matriz, com as mesmas disciplinas e cargas, para outro ano letivo
+func (m *Matrix) Clone(schoolYearId uuid.UUID) *Matrix {
+	items := make([]MatrixItem, len(m.items))
+	copy(items, m.items)
+
+	return &Matrix{
+		id:           uuid.New(),
+		level:        m.level,
+		schoolYearId: schoolYearId,
+		items:        items,
+	}
+}
+
+func (m *Matrix) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Id            string       `json:"id"`
+		Level         string       `json:"level"`
+		SchoolYearId  string       `json:"school_year_id"`
+		Items         []MatrixItem `json:"items"`
+		WeeklyClasses int          `json:"weekly_classes"`
+	}{
+		Id:            m.Id().String(),
+		Level:         m.Level(),
+		SchoolYearId:  m.SchoolYearId().String(),
+		Items:         m.Items(),
+		WeeklyClasses: m.WeeklyClasses(),
+	})
+}
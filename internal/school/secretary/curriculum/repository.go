@@ -0,0 +1,23 @@
+package curriculum
+
+import (
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+)
+
+type Repository interface {
+	CreateSubject(subject Subject) error
+	UpdateSubject(subject Subject) error
+	DeleteSubject(id string) error
+	FindSubjectById(id string) (*Subject, error)
+	FindSubjectByCode(code string) (*Subject, error)
+	FindSubjects(pagination paginator.Pagination) (*paginator.PaginationResult, error)
+	SubjectInUse(subjectId uuid.UUID) (bool, error)
+	CreateMatrices(matrices []Matrix) error
+	UpdateMatrix(matrix Matrix) error
+	DeleteMatrix(id string) error
+	FindMatrixById(id string) (*Matrix, error)
+	FindMatrix(level string, schoolYearId uuid.UUID) (*Matrix, error)
+	FindMatrices(schoolYearId uuid.UUID) ([]Matrix, error)
+	MatrixInUse(matrix Matrix) (bool, error)
+}
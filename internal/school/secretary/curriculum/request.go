@@ -0,0 +1,47 @@
+package curriculum
+
+import "github.com/go-playground/validator"
+
+type SubjectRequestDto struct {
+	Code     string `json:"code" validate:"required"`
+	Name     string `json:"name" validate:"required"`
+	Workload int    `json:"workload" validate:"required,min=1"`
+}
+
+func (s *SubjectRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(s)
+}
+
+type MatrixRequestDto struct {
+	Level        string                 `json:"level" validate:"required"`
+	SchoolYearId string                 `json:"school_year_id" validate:"required,uuid"`
+	Items        []MatrixItemRequestDto `json:"items" validate:"required,min=1,dive"`
+}
+
+func (m *MatrixRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(m)
+}
+
+type MatrixItemRequestDto struct {
+	SubjectId     string `json:"subject_id" validate:"required,uuid"`
+	WeeklyClasses int    `json:"weekly_classes" validate:"required,min=1"`
+}
+
+// CloneRequestDto Copia as matrizes de um ano letivo para o seguinte
+type CloneRequestDto struct {
+	FromSchoolYearId string `json:"from_school_year_id" validate:"required,uuid"`
+	ToSchoolYearId   string `json:"to_school_year_id" validate:"required,uuid,nefield=FromSchoolYearId"`
+}
+
+func (c *CloneRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(c)
+}
+
+// CloneResult Matrizes copiadas e niveis ignorados por ja possuirem matriz no ano letivo de destino
+type CloneResult struct {
+	Cloned  []Matrix `json:"cloned"`
+	Skipped []string `json:"skipped"`
+}
@@ -0,0 +1,130 @@
+package curriculum
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Subject Disciplina ofertada pela escola com sua carga horaria anual em horas
+type Subject struct {
+	id       uuid.UUID
+	code     string
+	name     string
+	workload int
+}
+
+func NewSubject(code string, name string, workload int) (*Subject, error) {
+	s := &Subject{
+		id: uuid.New(),
+	}
+
+	err := s.ChangeCode(code)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.ChangeName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.ChangeWorkload(workload)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func LoadSubject(id string, code string, name string, workload int) (*Subject, error) {
+	s, err := NewSubject(code, name, workload)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.ChangeId(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *Subject) Id() uuid.UUID {
+	return s.id
+}
+
+func (s *Subject) Code() string {
+	return s.code
+}
+
+func (s *Subject) Name() string {
+	return s.name
+}
+
+func (s *Subject) Workload() int {
+	return s.workload
+}
+
+func (s *Subject) ChangeId(id string) error {
+	subjectId, err := uuid.Parse(id)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to change subject id")
+	}
+
+	s.id = subjectId
+
+	return nil
+}
+
+// ChangeCode O codigo da disciplina e armazenado em maiusculas
+func (s *Subject) ChangeCode(code string) error {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" {
+		return errors.New("subject code cannot be empty")
+	}
+
+	s.code = code
+
+	return nil
+}
+
+func (s *Subject) ChangeName(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.New("subject name cannot be empty")
+	}
+
+	s.name = name
+
+	return nil
+}
+
+func (s *Subject) ChangeWorkload(workload int) error {
+	if workload <= 0 {
+		return errors.New("subject workload must be greater than zero")
+	}
+
+	s.workload = workload
+
+	return nil
+}
+
+func (s *Subject) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Id       string `json:"id"`
+		Code     string `json:"code"`
+		Name     string `json:"name"`
+		Workload int    `json:"workload"`
+	}{
+		Id:       s.Id().String(),
+		Code:     s.Code(),
+		Name:     s.Name(),
+		Workload: s.Workload(),
+	})
+}
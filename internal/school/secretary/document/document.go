@@ -0,0 +1,249 @@
+package document
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	StatusPending  = "PENDING"
+	StatusReceived = "RECEIVED"
+	StatusRejected = "REJECTED"
+)
+
+const (
+	KindBirthCertificate = "BIRTH_CERTIFICATE"
+	KindVaccinationCard  = "VACCINATION_CARD"
+	KindProofOfResidence = "PROOF_OF_RESIDENCE"
+	KindTransferDocument = "TRANSFER_DOCUMENT"
+	KindPhoto            = "PHOTO"
+)
+
+// MaxFileSize Tamanho maximo de cada arquivo enviado (10 MB)
+const MaxFileSize = 10 << 20
+
+var allowedContentTypes = map[string]string{
+	"application/pdf": ".pdf",
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+}
+
+var kindPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+// Document Arquivo de um documento do aluno. Todo envio fica pendente ate a conferencia da
+// secretaria, que recebe ou recusa o documento. Documentos enviados na matricula guardam a
+// matricula de origem, mas valem para o aluno nas matriculas seguintes
+type Document struct {
+	id             uuid.UUID
+	studentId      uuid.UUID
+	registrationId uuid.NullUUID
+	kind           string
+	fileName       string
+	contentType    string
+	size           int64
+	storageKey     string
+	status         string
+	reason         string
+	uploadedAt     time.Time
+}
+
+func New(studentId uuid.UUID, registrationId uuid.NullUUID, kind string, fileName string, contentType string, size int64) (*Document, error) {
+	d := &Document{
+		id:             uuid.New(),
+		studentId:      studentId,
+		registrationId: registrationId,
+		status:         StatusPending,
+		uploadedAt:     time.Now(),
+	}
+
+	err := d.ChangeKind(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.ChangeFile(fileName, contentType, size)
+	if err != nil {
+		return nil, err
+	}
+
+	d.storageKey = "students/" + studentId.String() + "/" + d.id.String() + allowedContentTypes[d.contentType]
+
+	return d, nil
+}
+
+func Load(
+	id string,
+	studentId uuid.UUID,
+	registrationId uuid.NullUUID,
+	kind string,
+	fileName string,
+	contentType string,
+	size int64,
+	storageKey string,
+	status string,
+	reason string,
+	uploadedAt time.Time,
+) (*Document, error) {
+
+	documentId, err := uuid.Parse(id)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to change document id")
+	}
+
+	return &Document{
+		id:             documentId,
+		studentId:      studentId,
+		registrationId: registrationId,
+		kind:           kind,
+		fileName:       fileName,
+		contentType:    contentType,
+		size:           size,
+		storageKey:     storageKey,
+		status:         status,
+		reason:         reason,
+		uploadedAt:     uploadedAt,
+	}, nil
+}
+
+func (d *Document) ChangeKind(kind string) error {
+	kind = strings.ToUpper(strings.TrimSpace(kind))
+
+	if !kindPattern.MatchString(kind) {
+		return errors.New("invalid document kind provided")
+	}
+
+	d.kind = kind
+
+	return nil
+}
+
+func (d *Document) ChangeFile(fileName string, contentType string, size int64) error {
+	if size <= 0 {
+		return errors.New("document file is empty")
+	}
+
+	if size > MaxFileSize {
+		return errors.New("document file exceeds the maximum size of 10 MB")
+	}
+
+	if _, ok := allowedContentTypes[contentType]; !ok {
+		return errors.New("document file must be a pdf, jpeg or png")
+	}
+
+	d.fileName = filepath.Base(fileName)
+	d.contentType = contentType
+	d.size = size
+
+	return nil
+}
+
+// Receive Confirma a conferencia do documento pela secretaria
+func (d *Document) Receive() error {
+	if d.status != StatusPending {
+		return errors.New("only pending documents can be received")
+	}
+
+	d.status = StatusReceived
+	d.reason = ""
+
+	return nil
+}
+
+// Reject Recusa o documento informando o motivo. O aluno deve enviar um novo arquivo
+func (d *Document) Reject(reason string) error {
+	if d.status == StatusRejected {
+		return errors.New("document already rejected")
+	}
+
+	if strings.TrimSpace(reason) == "" {
+		return errors.New("rejection reason cannot be empty")
+	}
+
+	d.status = StatusRejected
+	d.reason = reason
+
+	return nil
+}
+
+func (d *Document) Id() uuid.UUID {
+	return d.id
+}
+
+func (d *Document) StudentId() uuid.UUID {
+	return d.studentId
+}
+
+func (d *Document) RegistrationId() uuid.NullUUID {
+	return d.registrationId
+}
+
+func (d *Document) Kind() string {
+	return d.kind
+}
+
+func (d *Document) FileName() string {
+	return d.fileName
+}
+
+func (d *Document) ContentType() string {
+	return d.contentType
+}
+
+func (d *Document) Size() int64 {
+	return d.size
+}
+
+func (d *Document) StorageKey() string {
+	return d.storageKey
+}
+
+func (d *Document) Status() string {
+	return d.status
+}
+
+func (d *Document) Reason() string {
+	return d.reason
+}
+
+func (d *Document) UploadedAt() time.Time {
+	return d.uploadedAt
+}
+
+func (d *Document) MarshalJSON() ([]byte, error) {
+	registrationId := ""
+	if d.RegistrationId().Valid {
+		registrationId = d.RegistrationId().UUID.String()
+	}
+
+	return json.Marshal(struct {
+		Id             string `json:"id"`
+		StudentId      string `json:"student_id"`
+		RegistrationId string `json:"registration_id,omitempty"`
+		Kind           string `json:"kind"`
+		FileName       string `json:"file_name"`
+		ContentType    string `json:"content_type"`
+		Size           int64  `json:"size"`
+		Status         string `json:"status"`
+		Reason         string `json:"reason,omitempty"`
+		UploadedAt     string `json:"uploaded_at"`
+	}{
+		Id:             d.Id().String(),
+		StudentId:      d.StudentId().String(),
+		RegistrationId: registrationId,
+		Kind:           d.Kind(),
+		FileName:       d.FileName(),
+		ContentType:    d.ContentType(),
+		Size:           d.Size(),
+		Status:         d.Status(),
+		Reason:         d.Reason(),
+		UploadedAt:     d.UploadedAt().Format(time.RFC3339),
+	})
+}
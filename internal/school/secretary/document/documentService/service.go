@@ -0,0 +1,227 @@
+package documentService
+
+import (
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/document"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+)
+
+type DocumentActionsInterface interface {
+	Upload(studentId string, dto document.UploadRequestDto) (*document.Document, error)
+	FindByStudent(studentId string) ([]document.Document, error)
+	Download(id string) (*document.File, error)
+	ChangeStatus(id string, dto document.StatusRequestDto) (*document.Document, error)
+	Checklist(registrationId string) (*document.Checklist, error)
+	CreateRequirement(dto document.RequirementRequestDto) (*document.Requirement, error)
+	DeleteRequirement(id string) error
+	FindRequirements() ([]document.Requirement, error)
+}
+
+type DocumentActions struct {
+	repository             document.Repository
+	storage                document.Storage
+	studentRepository      student.Repository
+	registrationRepository registration.Repository
+}
+
+func New(
+	repository document.Repository,
+	storage document.Storage,
+	studentRepository student.Repository,
+	registrationRepository registration.Repository,
+) *DocumentActions {
+	return &DocumentActions{
+		repository:             repository,
+		storage:                storage,
+		studentRepository:      studentRepository,
+		registrationRepository: registrationRepository,
+	}
+}
+
+// Upload Grava o arquivo no armazenamento e registra o documento como pendente de conferencia.
+// Se o registro falhar o arquivo gravado e removido
+func (d *DocumentActions) Upload(studentId string, dto document.UploadRequestDto) (*document.Document, error) {
+	sdt, err := d.studentRepository.FindById(studentId)
+	if err != nil || sdt == nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve student")
+	}
+
+	registrationId := uuid.NullUUID{}
+
+	if dto.RegistrationId != "" {
+		reg, err := d.registrationRepository.FindById(dto.RegistrationId)
+		if err != nil || reg == nil {
+			log.Println(err)
+			return nil, errors.New("failed to retrieve registration")
+		}
+
+		if reg.Student().Id() != sdt.Id() {
+			return nil, errors.New("registration does not belong to the student")
+		}
+
+		registrationId = uuid.NullUUID{UUID: reg.Id(), Valid: true}
+	}
+
+	doc, err := document.New(sdt.Id(), registrationId, dto.Kind, dto.FileName, dto.ContentType, int64(len(dto.Content)))
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.storage.Save(doc.StorageKey(), dto.Content)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to store document file")
+	}
+
+	err = d.repository.Create(*doc)
+	if err != nil {
+		log.Println(err)
+		_ = d.storage.Delete(doc.StorageKey())
+		return nil, errors.New("failed to create document")
+	}
+
+	return doc, nil
+}
+
+func (d *DocumentActions) FindByStudent(studentId string) ([]document.Document, error) {
+	documents, err := d.repository.FindByStudent(studentId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve documents")
+	}
+
+	return documents, nil
+}
+
+func (d *DocumentActions) Download(id string) (*document.File, error) {
+	doc, err := d.repository.FindById(id)
+	if err != nil || doc == nil {
+		log.Println(err)
+		return nil, errors.New("document not found")
+	}
+
+	content, err := d.storage.Open(doc.StorageKey())
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to read document file")
+	}
+
+	return &document.File{
+		Name:        doc.FileName(),
+		ContentType: doc.ContentType(),
+		Content:     content,
+	}, nil
+}
+
+// ChangeStatus Registra a conferencia do documento pela secretaria
+func (d *DocumentActions) ChangeStatus(id string, dto document.StatusRequestDto) (*document.Document, error) {
+	doc, err := d.repository.FindById(id)
+	if err != nil || doc == nil {
+		log.Println(err)
+		return nil, errors.New("document not found")
+	}
+
+	switch dto.Status {
+	case document.StatusReceived:
+		err = doc.Receive()
+	case document.StatusRejected:
+		err = doc.Reject(dto.Reason)
+	default:
+		err = errors.New("invalid document status provided")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.repository.UpdateStatus(*doc)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to update document status")
+	}
+
+	return doc, nil
+}
+
+// Checklist Confere os documentos do aluno com os exigidos para o nivel da turma da matricula
+func (d *DocumentActions) Checklist(registrationId string) (*document.Checklist, error) {
+	reg, err := d.registrationRepository.FindById(registrationId)
+	if err != nil || reg == nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve registration")
+	}
+
+	requirements, err := d.repository.FindRequirements()
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve document requirements")
+	}
+
+	documents, err := d.repository.FindByStudent(reg.Student().Id().String())
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve documents")
+	}
+
+	level := ""
+	if reg.Class() != nil {
+		level = reg.Class().Level()
+	}
+
+	checklist := document.BuildChecklist(reg.Id(), level, requirements, documents)
+
+	return &checklist, nil
+}
+
+func (d *DocumentActions) CreateRequirement(dto document.RequirementRequestDto) (*document.Requirement, error) {
+	requirement, err := document.NewRequirement(dto.Level, dto.Kind, dto.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	requirements, err := d.repository.FindRequirements()
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve document requirements")
+	}
+
+	for _, r := range requirements {
+		if r.Kind == requirement.Kind && strings.EqualFold(r.Level, requirement.Level) {
+			return nil, errors.New("document already required for this level")
+		}
+	}
+
+	err = d.repository.CreateRequirement(*requirement)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to create document requirement")
+	}
+
+	return requirement, nil
+}
+
+func (d *DocumentActions) DeleteRequirement(id string) error {
+	err := d.repository.DeleteRequirement(id)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to delete document requirement")
+	}
+
+	return nil
+}
+
+func (d *DocumentActions) FindRequirements() ([]document.Requirement, error) {
+	requirements, err := d.repository.FindRequirements()
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve document requirements")
+	}
+
+	return requirements, nil
+}
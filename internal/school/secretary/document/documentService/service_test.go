@@ -0,0 +1,73 @@
+package documentService
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/mocks"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/document"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestShouldUploadStudentDocument(t *testing.T) {
+	sdt, _ := student.New("Joana", "Santos", "2015-05-10", "", "823.781.140-28", "joana@gmail.com", false)
+	dto := document.UploadRequestDto{
+		Kind:        document.KindBirthCertificate,
+		FileName:    "certidao.pdf",
+		ContentType: "application/pdf",
+		Content:     []byte("%PDF-1.4"),
+	}
+
+	t.Run("should store file and create pending document", func(t *testing.T) {
+		studentRepository := new(mocks.StudentRepository)
+		studentRepository.On("FindById", sdt.Id().String()).Return(sdt, nil)
+
+		storage := new(mocks.DocumentStorage)
+		storage.On("Save", mock.Anything, dto.Content).Return(nil)
+
+		repository := new(mocks.DocumentRepository)
+		repository.On("Create", mock.Anything).Return(nil)
+
+		doc, err := New(repository, storage, studentRepository, new(mocks.RegistrationRepository)).Upload(sdt.Id().String(), dto)
+		assert.NoError(t, err)
+		assert.Equal(t, document.StatusPending, doc.Status())
+		storage.AssertCalled(t, "Save", doc.StorageKey(), dto.Content)
+	})
+
+	t.Run("should remove stored file when document is not created", func(t *testing.T) {
+		studentRepository := new(mocks.StudentRepository)
+		studentRepository.On("FindById", sdt.Id().String()).Return(sdt, nil)
+
+		storage := new(mocks.DocumentStorage)
+		storage.On("Save", mock.Anything, dto.Content).Return(nil)
+		storage.On("Delete", mock.Anything).Return(nil)
+
+		repository := new(mocks.DocumentRepository)
+		repository.On("Create", mock.Anything).Return(errors.New("connection refused"))
+
+		_, err := New(repository, storage, studentRepository, new(mocks.RegistrationRepository)).Upload(sdt.Id().String(), dto)
+		assert.EqualError(t, err, "failed to create document")
+		storage.AssertCalled(t, "Delete", mock.Anything)
+	})
+}
+
+func TestShouldRejectDocumentWithReason(t *testing.T) {
+	doc, _ := document.New(uuid.New(), uuid.NullUUID{}, document.KindPhoto, "foto.png", "image/png", 1024)
+
+	repository := new(mocks.DocumentRepository)
+	repository.On("FindById", doc.Id().String()).Return(doc, nil)
+	repository.On("UpdateStatus", mock.Anything).Return(nil)
+
+	actions := New(repository, new(mocks.DocumentStorage), new(mocks.StudentRepository), new(mocks.RegistrationRepository))
+
+	_, err := actions.ChangeStatus(doc.Id().String(), document.StatusRequestDto{Status: document.StatusRejected})
+	assert.EqualError(t, err, "rejection reason cannot be empty")
+	repository.AssertNotCalled(t, "UpdateStatus", mock.Anything)
+
+	updated, err := actions.ChangeStatus(doc.Id().String(), document.StatusRequestDto{Status: document.StatusRejected, Reason: "foto ilegivel"})
+	assert.NoError(t, err)
+	assert.Equal(t, document.StatusRejected, updated.Status())
+}
@@ -0,0 +1,79 @@
+package document
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocument(t *testing.T) {
+	studentId := uuid.New()
+
+	t.Run("should create pending document with storage key of the student", func(t *testing.T) {
+		doc, err := New(studentId, uuid.NullUUID{}, "birth_certificate", "../certidao.pdf", "application/pdf", 1024)
+		assert.NoError(t, err)
+		assert.Equal(t, KindBirthCertificate, doc.Kind())
+		assert.Equal(t, StatusPending, doc.Status())
+		assert.Equal(t, "certidao.pdf", doc.FileName())
+		assert.Equal(t, "students/"+studentId.String()+"/"+doc.Id().String()+".pdf", doc.StorageKey())
+	})
+
+	t.Run("should refuse files that are not pdf or images", func(t *testing.T) {
+		_, err := New(studentId, uuid.NullUUID{}, KindPhoto, "foto.gif", "image/gif", 1024)
+		assert.EqualError(t, err, "document file must be a pdf, jpeg or png")
+	})
+
+	t.Run("should refuse files larger than the maximum size", func(t *testing.T) {
+		_, err := New(studentId, uuid.NullUUID{}, KindPhoto, "foto.png", "image/png", MaxFileSize+1)
+		assert.EqualError(t, err, "document file exceeds the maximum size of 10 MB")
+	})
+
+	t.Run("should require reason to reject document", func(t *testing.T) {
+		doc, _ := New(studentId, uuid.NullUUID{}, KindPhoto, "foto.png", "image/png", 1024)
+		assert.EqualError(t, doc.Reject(""), "rejection reason cannot be empty")
+		assert.NoError(t, doc.Reject("foto ilegivel"))
+		assert.Equal(t, StatusRejected, doc.Status())
+		assert.EqualError(t, doc.Receive(), "only pending documents can be received")
+	})
+}
+
+func TestChecklist(t *testing.T) {
+	studentId := uuid.New()
+	registrationId := uuid.New()
+
+	requirements := []Requirement{
+		{Kind: KindBirthCertificate, Description: "Certidao de nascimento"},
+		{Kind: KindPhoto, Description: "Foto 3x4"},
+		{Kind: KindTransferDocument, Description: "Declaracao de transferencia", Level: "medio"},
+	}
+
+	rejected, _ := Load(uuid.New().String(), studentId, uuid.NullUUID{}, KindPhoto, "foto.png", "image/png", 10, "key", StatusRejected, "foto ilegivel", time.Now().AddDate(0, 0, -2))
+	received, _ := Load(uuid.New().String(), studentId, uuid.NullUUID{}, KindBirthCertificate, "certidao.pdf", "application/pdf", 10, "key", StatusReceived, "", time.Now().AddDate(0, 0, -1))
+
+	t.Run("should report missing and rejected documents", func(t *testing.T) {
+		checklist := BuildChecklist(registrationId, "fundamental", requirements, []Document{*rejected, *received})
+		assert.False(t, checklist.Complete)
+		assert.Len(t, checklist.Items, 2)
+		assert.Equal(t, []string{KindPhoto}, checklist.Missing)
+	})
+
+	t.Run("should require level specific documents", func(t *testing.T) {
+		checklist := BuildChecklist(registrationId, "Medio", requirements, []Document{*rejected, *received})
+		assert.Len(t, checklist.Items, 3)
+		assert.Equal(t, []string{KindPhoto, KindTransferDocument}, checklist.Missing)
+	})
+
+	t.Run("should use the latest upload of each kind", func(t *testing.T) {
+		newPhoto, _ := Load(uuid.New().String(), studentId, uuid.NullUUID{}, KindPhoto, "foto.png", "image/png", 10, "key", StatusPending, "", time.Now())
+
+		checklist := BuildChecklist(registrationId, "fundamental", requirements, []Document{*rejected, *received, *newPhoto})
+		assert.False(t, checklist.Complete)
+		assert.Empty(t, checklist.Missing)
+
+		_ = newPhoto.Receive()
+		checklist = BuildChecklist(registrationId, "fundamental", requirements, []Document{*rejected, *received, *newPhoto})
+		assert.True(t, checklist.Complete)
+	})
+}
@@ -0,0 +1,11 @@
+package document
+
+type Repository interface {
+	Create(document Document) error
+	UpdateStatus(document Document) error
+	FindById(id string) (*Document, error)
+	FindByStudent(studentId string) ([]Document, error)
+	CreateRequirement(requirement Requirement) error
+	DeleteRequirement(id string) error
+	FindRequirements() ([]Requirement, error)
+}
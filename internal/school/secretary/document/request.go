@@ -0,0 +1,39 @@
+package document
+
+import "github.com/go-playground/validator"
+
+// UploadRequestDto Arquivo enviado no formulario multipart com o tipo do documento e, opcionalmente,
+// a matricula em que o documento foi entregue
+type UploadRequestDto struct {
+	Kind           string `validate:"required"`
+	RegistrationId string `validate:"omitempty,uuid"`
+	FileName       string `validate:"required"`
+	ContentType    string `validate:"required"`
+	Content        []byte `validate:"required"`
+}
+
+func (u *UploadRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(u)
+}
+
+type StatusRequestDto struct {
+	Status string `json:"status" validate:"required,oneof=RECEIVED REJECTED"`
+	Reason string `json:"reason"`
+}
+
+func (s *StatusRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(s)
+}
+
+type RequirementRequestDto struct {
+	Level       string `json:"level"`
+	Kind        string `json:"kind" validate:"required"`
+	Description string `json:"description" validate:"required"`
+}
+
+func (r *RequirementRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(r)
+}
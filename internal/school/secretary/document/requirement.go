@@ -0,0 +1,113 @@
+package document
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// ChecklistStatusMissing Documento exigido que ainda nao foi enviado
+const ChecklistStatusMissing = "MISSING"
+
+// Requirement Documento exigido na matricula. Sem nivel informado o documento e exigido em todos
+// os niveis de ensino; os demais valem apenas para as turmas do nivel
+type Requirement struct {
+	Id          uuid.UUID `json:"id"`
+	Level       string    `json:"level"`
+	Kind        string    `json:"kind"`
+	Description string    `json:"description"`
+}
+
+func NewRequirement(level string, kind string, description string) (*Requirement, error) {
+	kind = strings.ToUpper(strings.TrimSpace(kind))
+	if !kindPattern.MatchString(kind) {
+		return nil, errors.New("invalid document kind provided")
+	}
+
+	if strings.TrimSpace(description) == "" {
+		return nil, errors.New("requirement description cannot be empty")
+	}
+
+	return &Requirement{
+		Id:          uuid.New(),
+		Level:       strings.TrimSpace(level),
+		Kind:        kind,
+		Description: strings.TrimSpace(description),
+	}, nil
+}
+
+// AppliesTo Informa se o documento e exigido nas turmas do nivel informado
+func (r Requirement) AppliesTo(level string) bool {
+	return r.Level == "" || strings.EqualFold(r.Level, level)
+}
+
+type ChecklistItem struct {
+	Kind        string     `json:"kind"`
+	Description string     `json:"description"`
+	Status      string     `json:"status"`
+	DocumentId  *uuid.UUID `json:"document_id"`
+	Reason      string     `json:"reason,omitempty"`
+}
+
+// Checklist Situacao dos documentos exigidos na matricula. Documentos ausentes ou recusados sao
+// listados em Missing; a matricula so esta completa com todos os documentos recebidos
+type Checklist struct {
+	RegistrationId uuid.UUID       `json:"registration_id"`
+	Complete       bool            `json:"complete"`
+	Items          []ChecklistItem `json:"items"`
+	Missing        []string        `json:"missing"`
+}
+
+// BuildChecklist Confere os documentos do aluno com os exigidos para o nivel da turma. Para cada
+// tipo vale o ultimo arquivo enviado
+func BuildChecklist(registrationId uuid.UUID, level string, requirements []Requirement, documents []Document) Checklist {
+	latest := map[string]Document{}
+	for _, d := range documents {
+		current, ok := latest[d.Kind()]
+		if !ok || !d.UploadedAt().Before(current.UploadedAt()) {
+			latest[d.Kind()] = d
+		}
+	}
+
+	checklist := Checklist{
+		RegistrationId: registrationId,
+		Complete:       true,
+		Items:          []ChecklistItem{},
+		Missing:        []string{},
+	}
+
+	seen := map[string]bool{}
+
+	for _, requirement := range requirements {
+		if !requirement.AppliesTo(level) || seen[requirement.Kind] {
+			continue
+		}
+		seen[requirement.Kind] = true
+
+		item := ChecklistItem{
+			Kind:        requirement.Kind,
+			Description: requirement.Description,
+			Status:      ChecklistStatusMissing,
+		}
+
+		if d, ok := latest[requirement.Kind]; ok {
+			id := d.Id()
+			item.Status = d.Status()
+			item.DocumentId = &id
+			item.Reason = d.Reason()
+		}
+
+		if item.Status != StatusReceived {
+			checklist.Complete = false
+		}
+
+		if item.Status == ChecklistStatusMissing || item.Status == StatusRejected {
+			checklist.Missing = append(checklist.Missing, requirement.Kind)
+		}
+
+		checklist.Items = append(checklist.Items, item)
+	}
+
+	return checklist
+}
@@ -0,0 +1,16 @@
+package document
+
+// Storage Armazenamento dos arquivos enviados. A chave identifica o arquivo no armazenamento
+// e e gravada junto com o documento
+type Storage interface {
+	Save(key string, content []byte) error
+	Open(key string) ([]byte, error)
+	Delete(key string) error
+}
+
+// File Conteudo de um documento para download
+type File struct {
+	Name        string
+	ContentType string
+	Content     []byte
+}
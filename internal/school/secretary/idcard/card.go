@@ -0,0 +1,37 @@
+package idcard
+
+import "github.com/google/uuid"
+
+var shiftDescriptions = map[string]string{
+	"morning":   "Matutino",
+	"afternoon": "Vespertino",
+	"nocturnal": "Noturno",
+	"full-time": "Integral",
+}
+
+// Card Dados impressos na carteirinha a partir da matricula aprovada do aluno
+type Card struct {
+	StudentId    uuid.UUID
+	Name         string
+	Code         string
+	ClassRoom    string
+	Shift        string
+	SchoolYear   string
+	Photo        *Photo
+	PhotoContent []byte
+}
+
+// ShiftDescription Turno por extenso para impressao
+func (c Card) ShiftDescription() string {
+	if description, ok := shiftDescriptions[c.Shift]; ok {
+		return description
+	}
+
+	return c.Shift
+}
+
+// File PDF das carteirinhas para download
+type File struct {
+	Name    string
+	Content []byte
+}
@@ -0,0 +1,160 @@
+package idcardService
+
+import (
+	"errors"
+	"log"
+	"path/filepath"
+	"sort"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/document"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/idcard"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+)
+
+type IdCardActionsInterface interface {
+	UploadPhoto(studentId string, dto idcard.PhotoRequestDto) error
+	DownloadPhoto(studentId string) (*document.File, error)
+	Cards(dto idcard.CardRequestDto) (*idcard.File, error)
+}
+
+type IdCardActions struct {
+	repository        idcard.Repository
+	storage           document.Storage
+	studentRepository student.Repository
+}
+
+func New(repository idcard.Repository, storage document.Storage, studentRepository student.Repository) *IdCardActions {
+	return &IdCardActions{
+		repository:        repository,
+		storage:           storage,
+		studentRepository: studentRepository,
+	}
+}
+
+// UploadPhoto Grava a nova foto do aluno e remove a anterior do armazenamento. Se o registro falhar
+// a foto gravada e removida
+func (i *IdCardActions) UploadPhoto(studentId string, dto idcard.PhotoRequestDto) error {
+	sdt, err := i.studentRepository.FindById(studentId)
+	if err != nil || sdt == nil {
+		log.Println(err)
+		return errors.New("failed to retrieve student")
+	}
+
+	photo, err := idcard.NewPhoto(sdt.Id(), dto.ContentType, int64(len(dto.Content)))
+	if err != nil {
+		return err
+	}
+
+	current, err := i.repository.FindPhoto(studentId)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to retrieve student photo")
+	}
+
+	err = i.storage.Save(photo.StorageKey(), dto.Content)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to store student photo")
+	}
+
+	err = i.repository.UpdatePhoto(*photo)
+	if err != nil {
+		_ = i.storage.Delete(photo.StorageKey())
+		log.Println(err)
+		return errors.New("failed to save student photo")
+	}
+
+	if current != nil {
+		err = i.storage.Delete(current.StorageKey())
+		if err != nil {
+			log.Println(err)
+		}
+	}
+
+	return nil
+}
+
+func (i *IdCardActions) DownloadPhoto(studentId string) (*document.File, error) {
+	photo, err := i.repository.FindPhoto(studentId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve student photo")
+	}
+
+	if photo == nil {
+		return nil, errors.New("student has no photo")
+	}
+
+	content, err := i.storage.Open(photo.StorageKey())
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to read student photo")
+	}
+
+	return &document.File{
+		Name:        filepath.Base(photo.StorageKey()),
+		ContentType: photo.ContentType(),
+		Content:     content,
+	}, nil
+}
+
+// Cards Gera as carteirinhas dos alunos com matricula aprovada na turma ou dos alunos informados.
+// Alunos sem foto, ou com foto indisponivel, recebem o quadro para colagem da foto
+func (i *IdCardActions) Cards(dto idcard.CardRequestDto) (*idcard.File, error) {
+	if dto.ClassRoomId == "" && len(dto.StudentIds) == 0 {
+		return nil, errors.New("class room or students must be provided")
+	}
+
+	var cards []idcard.Card
+	var err error
+	name := "carteirinhas.pdf"
+
+	if dto.ClassRoomId != "" {
+		cards, err = i.repository.FindByClassRoom(dto.ClassRoomId)
+		name = "carteirinhas_" + dto.ClassRoomId + ".pdf"
+	} else {
+		cards, err = i.repository.FindByStudents(dto.StudentIds)
+	}
+
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve students registrations")
+	}
+
+	if len(cards) == 0 {
+		return nil, errors.New("no students with approved registration found")
+	}
+
+	sort.SliceStable(cards, func(a, b int) bool {
+		if cards[a].ClassRoom != cards[b].ClassRoom {
+			return cards[a].ClassRoom < cards[b].ClassRoom
+		}
+
+		return cards[a].Name < cards[b].Name
+	})
+
+	for c := range cards {
+		if cards[c].Photo == nil {
+			continue
+		}
+
+		content, err := i.storage.Open(cards[c].Photo.StorageKey())
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+
+		cards[c].PhotoContent = content
+	}
+
+	content, err := idcard.RenderPdf(cards)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to generate id cards")
+	}
+
+	return &idcard.File{
+		Name:    name,
+		Content: content,
+	}, nil
+}
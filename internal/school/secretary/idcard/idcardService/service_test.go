@@ -0,0 +1,99 @@
+package idcardService
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/mocks"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/idcard"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestUploadPhoto(t *testing.T) {
+	sdt, _ := student.New("Joana", "Santos", "2010-05-10", "", "823.781.140-28", "joana@gmail.com", true)
+	studentId := sdt.Id().String()
+	content := []byte("photo")
+
+	t.Run("should replace the previous photo", func(t *testing.T) {
+		previous := idcard.LoadPhoto(sdt.Id(), "students/"+studentId+"/photo-old.jpg", "image/jpeg")
+
+		var saved idcard.Photo
+
+		studentRepository := new(mocks.StudentRepository)
+		studentRepository.On("FindById", studentId).Return(sdt, nil)
+
+		repository := new(mocks.IdCardRepository)
+		repository.On("FindPhoto", studentId).Return(previous, nil)
+		repository.On("UpdatePhoto", mock.Anything).Run(func(args mock.Arguments) {
+			saved = args.Get(0).(idcard.Photo)
+		}).Return(nil)
+
+		storage := new(mocks.DocumentStorage)
+		storage.On("Save", mock.Anything, content).Return(nil)
+		storage.On("Delete", previous.StorageKey()).Return(nil)
+
+		err := New(repository, storage, studentRepository).UploadPhoto(studentId, idcard.PhotoRequestDto{ContentType: "image/jpeg", Content: content})
+		assert.NoError(t, err)
+		assert.Equal(t, "image/jpeg", saved.ContentType())
+		storage.AssertCalled(t, "Save", saved.StorageKey(), content)
+		storage.AssertCalled(t, "Delete", previous.StorageKey())
+	})
+
+	t.Run("should remove stored photo when registration fails", func(t *testing.T) {
+		studentRepository := new(mocks.StudentRepository)
+		studentRepository.On("FindById", studentId).Return(sdt, nil)
+
+		repository := new(mocks.IdCardRepository)
+		repository.On("FindPhoto", studentId).Return((*idcard.Photo)(nil), nil)
+		repository.On("UpdatePhoto", mock.Anything).Return(errors.New("connection lost"))
+
+		storage := new(mocks.DocumentStorage)
+		storage.On("Save", mock.Anything, content).Return(nil)
+		storage.On("Delete", mock.Anything).Return(nil)
+
+		err := New(repository, storage, studentRepository).UploadPhoto(studentId, idcard.PhotoRequestDto{ContentType: "image/png", Content: content})
+		assert.EqualError(t, err, "failed to save student photo")
+		storage.AssertNumberOfCalls(t, "Delete", 1)
+	})
+}
+
+func TestCards(t *testing.T) {
+	t.Run("should require class room or students", func(t *testing.T) {
+		_, err := New(new(mocks.IdCardRepository), new(mocks.DocumentStorage), new(mocks.StudentRepository)).Cards(idcard.CardRequestDto{})
+		assert.EqualError(t, err, "class room or students must be provided")
+	})
+
+	t.Run("should print cards of the class room", func(t *testing.T) {
+		classRoomId := uuid.New().String()
+		studentId := uuid.New()
+		photo := idcard.LoadPhoto(studentId, "students/photo.jpg", "image/jpeg")
+
+		repository := new(mocks.IdCardRepository)
+		repository.On("FindByClassRoom", classRoomId).Return([]idcard.Card{
+			{StudentId: studentId, Name: "Joana Santos", Code: "2023000001", ClassRoom: "5A", Shift: "morning", SchoolYear: "2023", Photo: photo},
+			{StudentId: uuid.New(), Name: "Ana Reis", Code: "2023000002", ClassRoom: "5A", Shift: "morning", SchoolYear: "2023"},
+		}, nil)
+
+		storage := new(mocks.DocumentStorage)
+		storage.On("Open", photo.StorageKey()).Return([]byte(nil), errors.New("file not found"))
+
+		file, err := New(repository, storage, new(mocks.StudentRepository)).Cards(idcard.CardRequestDto{ClassRoomId: classRoomId})
+		assert.NoError(t, err)
+		assert.Equal(t, "carteirinhas_"+classRoomId+".pdf", file.Name)
+		assert.True(t, bytes.HasPrefix(file.Content, []byte("%PDF")))
+	})
+
+	t.Run("should refuse students without approved registration", func(t *testing.T) {
+		studentIds := []string{uuid.New().String()}
+
+		repository := new(mocks.IdCardRepository)
+		repository.On("FindByStudents", studentIds).Return([]idcard.Card(nil), nil)
+
+		_, err := New(repository, new(mocks.DocumentStorage), new(mocks.StudentRepository)).Cards(idcard.CardRequestDto{StudentIds: studentIds})
+		assert.EqualError(t, err, "no students with approved registration found")
+	})
+}
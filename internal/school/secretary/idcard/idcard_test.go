@@ -0,0 +1,75 @@
+package idcard
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPhoto(t *testing.T) {
+	studentId := uuid.New()
+
+	t.Run("should accept jpeg and png photos", func(t *testing.T) {
+		photo, err := NewPhoto(studentId, "image/png", 1024)
+		assert.NoError(t, err)
+		assert.Regexp(t, "^students/"+studentId.String()+"/photo-.+\\.png$", photo.StorageKey())
+		assert.Equal(t, "PNG", photo.imageType())
+	})
+
+	t.Run("should refuse other file types", func(t *testing.T) {
+		_, err := NewPhoto(studentId, "application/pdf", 1024)
+		assert.EqualError(t, err, "photo file must be a jpeg or png image")
+	})
+
+	t.Run("should refuse photos bigger than 2 MB", func(t *testing.T) {
+		_, err := NewPhoto(studentId, "image/jpeg", MaxPhotoSize+1)
+		assert.EqualError(t, err, "photo file exceeds the maximum size of 2 MB")
+	})
+}
+
+func TestRenderPdf(t *testing.T) {
+	t.Run("should print ten cards per page", func(t *testing.T) {
+		var cards []Card
+		for i := 0; i < CardsPerPage+1; i++ {
+			cards = append(cards, Card{
+				StudentId:  uuid.New(),
+				Name:       "Joana Santos",
+				Code:       fmt.Sprintf("2023%06d", i+1),
+				ClassRoom:  "5A",
+				Shift:      "morning",
+				SchoolYear: "2023",
+			})
+		}
+
+		content, err := RenderPdf(cards)
+		assert.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(content, []byte("%PDF")))
+		assert.Equal(t, 2, bytes.Count(content, []byte("/Type /Page\n")))
+	})
+
+	t.Run("should print photo frame when photo cannot be read", func(t *testing.T) {
+		studentId := uuid.New()
+		cards := []Card{{
+			StudentId:    studentId,
+			Name:         "Joana Santos",
+			Code:         "2023000001",
+			Photo:        LoadPhoto(studentId, "students/photo.png", "image/png"),
+			PhotoContent: []byte("not an image"),
+		}}
+
+		_, err := RenderPdf(cards)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not print empty list", func(t *testing.T) {
+		_, err := RenderPdf(nil)
+		assert.EqualError(t, err, "no cards to print")
+	})
+
+	t.Run("should describe shift", func(t *testing.T) {
+		assert.Equal(t, "Vespertino", Card{Shift: "afternoon"}.ShiftDescription())
+	})
+}
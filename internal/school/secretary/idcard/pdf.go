@@ -0,0 +1,148 @@
+package idcard
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/jung-kurt/gofpdf"
+	"github.com/skip2/go-qrcode"
+)
+
+// Dimensoes em milimetros. As carteirinhas tem o tamanho de um cartao de credito (CR80) e sao
+// impressas em duas colunas e cinco linhas por folha A4
+const (
+	cardWidth    = 85.6
+	cardHeight   = 54.0
+	cardColumns  = 2
+	cardRows     = 5
+	columnGap    = 8.0
+	rowGap       = 3.0
+	CardsPerPage = cardColumns * cardRows
+)
+
+const qrCodeSize = 256
+
+// RenderPdf Gera a folha de carteirinhas com nome, matricula, turma, turno, ano letivo, foto e o
+// QR code do codigo da matricula
+func RenderPdf(cards []Card) ([]byte, error) {
+	if len(cards) == 0 {
+		return nil, errors.New("no cards to print")
+	}
+
+	pdf := gofpdf.New("P", "mm", "A4", "")
+	pdf.SetAutoPageBreak(false, 0)
+
+	pageWidth, pageHeight := pdf.GetPageSize()
+	marginLeft := (pageWidth - (cardColumns*cardWidth + (cardColumns-1)*columnGap)) / 2
+	marginTop := (pageHeight - (cardRows*cardHeight + (cardRows-1)*rowGap)) / 2
+
+	translate := pdf.UnicodeTranslatorFromDescriptor("")
+
+	for i, card := range cards {
+		position := i % CardsPerPage
+		if position == 0 {
+			pdf.AddPage()
+		}
+
+		x := marginLeft + float64(position%cardColumns)*(cardWidth+columnGap)
+		y := marginTop + float64(position/cardColumns)*(cardHeight+rowGap)
+
+		err := drawCard(pdf, translate, card, x, y)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var content bytes.Buffer
+
+	err := pdf.Output(&content)
+	if err != nil {
+		return nil, err
+	}
+
+	return content.Bytes(), nil
+}
+
+func drawCard(pdf *gofpdf.Fpdf, translate func(string) string, card Card, x float64, y float64) error {
+	pdf.SetDrawColor(80, 80, 80)
+	pdf.SetLineWidth(0.3)
+	pdf.RoundedRect(x, y, cardWidth, cardHeight, 3, "1234", "D")
+
+	pdf.SetFillColor(30, 70, 140)
+	pdf.RoundedRect(x, y, cardWidth, 8, 3, "12", "F")
+	pdf.SetTextColor(255, 255, 255)
+	pdf.SetFont("Helvetica", "B", 8)
+	pdf.SetXY(x, y+1)
+	pdf.CellFormat(cardWidth, 6, translate("CARTEIRA DE IDENTIFICACAO ESTUDANTIL"), "", 0, "C", false, 0, "")
+
+	drawPhoto(pdf, card, x+4, y+11)
+
+	pdf.SetTextColor(0, 0, 0)
+	textX := x + 30
+	textWidth := cardWidth - 34
+
+	pdf.SetFont("Helvetica", "B", 9)
+	lines := pdf.SplitLines([]byte(translate(card.Name)), textWidth)
+	if len(lines) > 2 {
+		lines = lines[:2]
+	}
+
+	lineY := y + 11
+	for _, line := range lines {
+		pdf.SetXY(textX, lineY)
+		pdf.CellFormat(textWidth, 4.5, string(line), "", 0, "L", false, 0, "")
+		lineY += 4.5
+	}
+
+	pdf.SetFont("Helvetica", "", 7.5)
+	details := []string{
+		"Matricula: " + card.Code,
+		"Turma: " + card.ClassRoom,
+		"Turno: " + card.ShiftDescription(),
+		"Ano letivo: " + card.SchoolYear,
+	}
+
+	lineY += 1
+	for _, detail := range details {
+		pdf.SetXY(textX, lineY)
+		pdf.CellFormat(textWidth-20, 4, translate(detail), "", 0, "L", false, 0, "")
+		lineY += 4
+	}
+
+	qrCode, err := qrcode.Encode(card.Code, qrcode.Medium, qrCodeSize)
+	if err != nil {
+		return err
+	}
+
+	qrName := "qr-" + card.Code
+	pdf.RegisterImageOptionsReader(qrName, gofpdf.ImageOptions{ImageType: "PNG"}, bytes.NewReader(qrCode))
+	pdf.ImageOptions(qrName, x+cardWidth-22, y+cardHeight-22, 19, 19, false, gofpdf.ImageOptions{ImageType: "PNG"}, 0, "")
+
+	return pdf.Error()
+}
+
+// drawPhoto Imprime a foto do aluno. Sem foto, ou com um arquivo que nao pode ser lido, e impresso
+// apenas o quadro para colagem
+func drawPhoto(pdf *gofpdf.Fpdf, card Card, x float64, y float64) {
+	width, height := 22.0, 28.0
+
+	if card.Photo != nil && len(card.PhotoContent) > 0 {
+		name := "photo-" + card.StudentId.String()
+		options := gofpdf.ImageOptions{ImageType: card.Photo.imageType()}
+
+		pdf.RegisterImageOptionsReader(name, options, bytes.NewReader(card.PhotoContent))
+		if pdf.Ok() {
+			pdf.ImageOptions(name, x, y, width, height, false, options, 0, "")
+			return
+		}
+
+		pdf.ClearError()
+	}
+
+	pdf.SetDrawColor(160, 160, 160)
+	pdf.Rect(x, y, width, height, "D")
+	pdf.SetTextColor(160, 160, 160)
+	pdf.SetFont("Helvetica", "", 6)
+	pdf.SetXY(x, y+height/2-2)
+	pdf.CellFormat(width, 4, "FOTO", "", 0, "C", false, 0, "")
+}
@@ -0,0 +1,73 @@
+package idcard
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// MaxPhotoSize Tamanho maximo da foto do aluno (2 MB)
+const MaxPhotoSize = 2 << 20
+
+var photoExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+}
+
+// Photo Foto do aluno usada na carteirinha. Cada envio gera uma nova chave no armazenamento para
+// que a foto anterior so seja removida depois que a nova for registrada
+type Photo struct {
+	studentId   uuid.UUID
+	storageKey  string
+	contentType string
+}
+
+func NewPhoto(studentId uuid.UUID, contentType string, size int64) (*Photo, error) {
+	if size == 0 {
+		return nil, errors.New("photo file is empty")
+	}
+
+	if size > MaxPhotoSize {
+		return nil, errors.New("photo file exceeds the maximum size of 2 MB")
+	}
+
+	extension, ok := photoExtensions[contentType]
+	if !ok {
+		return nil, errors.New("photo file must be a jpeg or png image")
+	}
+
+	return &Photo{
+		studentId:   studentId,
+		storageKey:  "students/" + studentId.String() + "/photo-" + uuid.New().String() + extension,
+		contentType: contentType,
+	}, nil
+}
+
+func LoadPhoto(studentId uuid.UUID, storageKey string, contentType string) *Photo {
+	return &Photo{
+		studentId:   studentId,
+		storageKey:  storageKey,
+		contentType: contentType,
+	}
+}
+
+func (p *Photo) StudentId() uuid.UUID {
+	return p.studentId
+}
+
+func (p *Photo) StorageKey() string {
+	return p.storageKey
+}
+
+func (p *Photo) ContentType() string {
+	return p.contentType
+}
+
+// imageType Tipo da imagem no formato esperado pelo gofpdf
+func (p *Photo) imageType() string {
+	if p.contentType == "image/png" {
+		return "PNG"
+	}
+
+	return "JPG"
+}
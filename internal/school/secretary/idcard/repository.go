@@ -0,0 +1,8 @@
+package idcard
+
+type Repository interface {
+	UpdatePhoto(photo Photo) error
+	FindPhoto(studentId string) (*Photo, error)
+	FindByClassRoom(classRoomId string) ([]Card, error)
+	FindByStudents(studentIds []string) ([]Card, error)
+}
@@ -0,0 +1,25 @@
+package idcard
+
+import "github.com/go-playground/validator"
+
+// PhotoRequestDto Foto enviada no formulario multipart
+type PhotoRequestDto struct {
+	ContentType string `validate:"required"`
+	Content     []byte `validate:"required"`
+}
+
+func (p *PhotoRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(p)
+}
+
+// CardRequestDto Carteirinhas de todos os alunos da turma ou dos alunos informados
+type CardRequestDto struct {
+	ClassRoomId string   `json:"class_room_id" validate:"omitempty,uuid"`
+	StudentIds  []string `json:"student_ids" validate:"omitempty,dive,uuid"`
+}
+
+func (c *CardRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(c)
+}
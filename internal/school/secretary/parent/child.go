@@ -0,0 +1,12 @@
+package parent
+
+import "github.com/google/uuid"
+
+// Child Aluno vinculado ao responsavel e o tipo de vinculo entre eles
+type Child struct {
+	StudentId    uuid.UUID `json:"student_id"`
+	FirstName    string    `json:"first_name"`
+	LastName     string    `json:"last_name"`
+	CpfDocument  string    `json:"cpf_document"`
+	Relationship string    `json:"relationship"`
+}
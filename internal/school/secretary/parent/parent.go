@@ -12,20 +12,29 @@ import (
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
 )
 
+const (
+	RelationshipMother               = "MOTHER"
+	RelationshipFather               = "FATHER"
+	RelationshipLegalGuardian        = "LEGAL_GUARDIAN"
+	RelationshipFinancialResponsible = "FINANCIAL_RESPONSIBLE"
+)
+
+// Parent Responsavel cadastrado uma unica vez por CPF e vinculado a um ou mais alunos. O tipo de
+// vinculo pertence a relacao com o aluno a partir do qual o responsavel foi carregado
 type Parent struct {
-	id          uuid.UUID
-	firstName   string
-	lastName    string
-	birthDay    *time.Time
-	addresses   []value_objects.Address
-	phones      []value_objects.Phone
-	rgDocument  string
-	cpfDocument value_objects.CPF
-	studentId   uuid.UUID
-	email       string
+	id           uuid.UUID
+	firstName    string
+	lastName     string
+	birthDay     *time.Time
+	addresses    []value_objects.Address
+	phones       []value_objects.Phone
+	rgDocument   string
+	cpfDocument  value_objects.CPF
+	email        string
+	relationship string
 }
 
-func New(firstName string, lastName string, birthDay string, rg string, cpf string, studentId string, email string) (*Parent, error) {
+func New(firstName string, lastName string, birthDay string, rg string, cpf string, email string) (*Parent, error) {
 	p := &Parent{
 		id: uuid.New(),
 	}
@@ -47,12 +56,21 @@ func New(firstName string, lastName string, birthDay string, rg string, cpf stri
 		return nil, err
 	}
 
-	err = p.ChangeStudentId(studentId)
+	err = p.ChangeEmail(email)
 	if err != nil {
 		return nil, err
 	}
 
-	err = p.ChangeEmail(email)
+	return p, nil
+}
+
+func Load(id string, firstName string, lastName string, birthDay string, rg string, cpf string, email string) (*Parent, error) {
+	p, err := New(firstName, lastName, birthDay, rg, cpf, email)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.ChangeId(id)
 	if err != nil {
 		return nil, err
 	}
@@ -80,8 +98,8 @@ func (p *Parent) Cpf() value_objects.CPF {
 	return p.cpfDocument
 }
 
-func (p *Parent) StudentId() uuid.UUID {
-	return p.studentId
+func (p *Parent) Relationship() string {
+	return p.relationship
 }
 
 func (p *Parent) Email() string {
@@ -102,20 +120,15 @@ func (p *Parent) ChangeEmail(email string) error {
 	return nil
 }
 
-func (p *Parent) ChangeStudentId(studentId string) error {
-	if studentId == "" {
-		return errors.New("student id cannot be empty")
+// ChangeRelationship Altera o tipo de vinculo do responsavel com o aluno
+func (p *Parent) ChangeRelationship(relationship string) error {
+	switch relationship {
+	case RelationshipMother, RelationshipFather, RelationshipLegalGuardian, RelationshipFinancialResponsible:
+		p.relationship = relationship
+		return nil
 	}
 
-	student, err := uuid.Parse(studentId)
-	if err != nil {
-		log.Println(err)
-		return errors.New("failed to change student id in parent")
-	}
-
-	p.studentId = student
-
-	return nil
+	return errors.New("invalid parent relationship provided")
 }
 
 func (p *Parent) ChangeCPF(cpf string) error {
@@ -209,6 +222,11 @@ func (p *Parent) AddAddress(addressDto []address.RequestDto) {
 	p.addresses = addresses
 }
 
+// ChangeAddresses Substitui os enderecos do responsavel pelos enderecos ja cadastrados
+func (p *Parent) ChangeAddresses(addresses []value_objects.Address) {
+	p.addresses = addresses
+}
+
 func (p *Parent) Phones() []value_objects.Phone {
 	return p.phones
 }
@@ -231,28 +249,33 @@ func (p *Parent) AddPhones(phonesDto []phone.RequestDto) {
 	p.phones = phones
 }
 
+// ChangePhones Substitui os telefones do responsavel pelos telefones ja cadastrados
+func (p *Parent) ChangePhones(phones []value_objects.Phone) {
+	p.phones = phones
+}
+
 func (p *Parent) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
-		Id          string                  `json:"id"`
-		FirstName   string                  `json:"first_name"`
-		LastName    string                  `json:"last_name"`
-		BirthDay    string                  `json:"birth_day"`
-		Addresses   []value_objects.Address `json:"addresses"`
-		Phones      []value_objects.Phone   `json:"phones"`
-		RgDocument  string                  `json:"rg_document"`
-		CpfDocument value_objects.CPF       `json:"cpf_document"`
-		StudentId   string                  `json:"student_id"`
-		Email       string                  `json:"email"`
+		Id           string                  `json:"id"`
+		FirstName    string                  `json:"first_name"`
+		LastName     string                  `json:"last_name"`
+		BirthDay     string                  `json:"birth_day"`
+		Addresses    []value_objects.Address `json:"addresses"`
+		Phones       []value_objects.Phone   `json:"phones"`
+		RgDocument   string                  `json:"rg_document"`
+		CpfDocument  value_objects.CPF       `json:"cpf_document"`
+		Email        string                  `json:"email"`
+		Relationship string                  `json:"relationship,omitempty"`
 	}{
-		Id:          p.Id().String(),
-		FirstName:   p.FirstName(),
-		LastName:    p.LastName(),
-		BirthDay:    p.BirthDay().Format("2006-01-02"),
-		Addresses:   p.Addresses(),
-		Phones:      p.Phones(),
-		RgDocument:  p.Rg(),
-		CpfDocument: p.Cpf(),
-		StudentId:   p.StudentId().String(),
-		Email:       p.Email(),
+		Id:           p.Id().String(),
+		FirstName:    p.FirstName(),
+		LastName:     p.LastName(),
+		BirthDay:     p.BirthDay().Format("2006-01-02"),
+		Addresses:    p.Addresses(),
+		Phones:       p.Phones(),
+		RgDocument:   p.Rg(),
+		CpfDocument:  p.Cpf(),
+		Email:        p.Email(),
+		Relationship: p.Relationship(),
 	})
 }
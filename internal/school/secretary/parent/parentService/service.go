@@ -0,0 +1,61 @@
+package parentService
+
+import (
+	"errors"
+	"log"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/parent"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+)
+
+type ParentActionsInterface interface {
+	FindAll(dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error)
+	Find(id string) (*parent.Parent, error)
+	FindChildren(id string) ([]parent.Child, error)
+}
+
+type ParentActions struct {
+	repository parent.Repository
+}
+
+func New(repository parent.Repository) *ParentActions {
+	return &ParentActions{
+		repository: repository,
+	}
+}
+
+// FindAll Busca paginada de responsaveis por nome, CPF ou email, usada para localizar um
+// responsavel ja cadastrado antes de vincula-lo a uma nova matricula
+func (p *ParentActions) FindAll(dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error) {
+	pg := paginator.Pagination{}
+	pg.FillFromDto(dtoRequest)
+
+	parents, err := p.repository.FindAll(pg)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve parents")
+	}
+
+	return parents, nil
+}
+
+func (p *ParentActions) Find(id string) (*parent.Parent, error) {
+	prt, err := p.repository.FindById(id)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve parent")
+	}
+
+	return prt, nil
+}
+
+// FindChildren Alunos vinculados ao responsavel
+func (p *ParentActions) FindChildren(id string) ([]parent.Child, error) {
+	children, err := p.repository.FindChildren(id)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve parent children")
+	}
+
+	return children, nil
+}
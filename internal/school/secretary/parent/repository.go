@@ -1,5 +1,13 @@
 package parent
 
+import (
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
+)
+
 type Repository interface {
-	Create(parent Parent) error
+	FindById(id string) (*Parent, error)
+	FindByCpf(cpf value_objects.CPF) (*Parent, error)
+	FindAll(pagination paginator.Pagination) (*paginator.PaginationResult, error)
+	FindChildren(parentId string) ([]Child, error)
 }
@@ -5,13 +5,17 @@ import (
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/phone"
 )
 
+// RequestDto Responsavel do aluno. Informando o Id, o responsavel ja cadastrado e vinculado ao
+// aluno e apenas o Relationship e considerado
 type RequestDto struct {
-	FirstName   string               `json:"first_name"`
-	LastName    string               `json:"last_name"`
-	BirthDay    string               `json:"birth_day"`
-	Addresses   []address.RequestDto `json:"addresses"`
-	Phones      []phone.RequestDto   `json:"phones"`
-	RgDocument  string               `json:"rg_document"`
-	CpfDocument string               `json:"cpf_document"`
-	Email       string               `json:"email"`
+	Id           string               `json:"id"`
+	FirstName    string               `json:"first_name"`
+	LastName     string               `json:"last_name"`
+	BirthDay     string               `json:"birth_day"`
+	Addresses    []address.RequestDto `json:"addresses"`
+	Phones       []phone.RequestDto   `json:"phones"`
+	RgDocument   string               `json:"rg_document"`
+	CpfDocument  string               `json:"cpf_document"`
+	Email        string               `json:"email"`
+	Relationship string               `json:"relationship"`
 }
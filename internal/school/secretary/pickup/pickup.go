@@ -0,0 +1,263 @@
+package pickup
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/parent"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
+)
+
+// Authorization Pessoa autorizada a buscar o aluno na escola: um responsavel vinculado ao aluno
+// ou uma pessoa externa identificada por nome, CPF e foto. Sem data final a autorizacao vale
+// ate ser revogada
+type Authorization struct {
+	id         uuid.UUID
+	studentId  uuid.UUID
+	parentId   uuid.NullUUID
+	name       string
+	cpf        value_objects.CPF
+	photo      string
+	validFrom  time.Time
+	validUntil time.Time
+}
+
+func New(studentId uuid.UUID, name string, cpf string, photo string, validFrom string, validUntil string) (*Authorization, error) {
+	a := &Authorization{
+		id:        uuid.New(),
+		studentId: studentId,
+	}
+
+	err := a.ChangeName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.ChangeCPF(cpf)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.ChangePhoto(photo)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.ChangeValidity(validFrom, validUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// NewFromParent Autoriza um responsavel ja vinculado ao aluno. Nome e CPF vem do cadastro do
+// responsavel e a foto e opcional
+func NewFromParent(studentId uuid.UUID, p parent.Parent, photo string, validFrom string, validUntil string) (*Authorization, error) {
+	a := &Authorization{
+		id:        uuid.New(),
+		studentId: studentId,
+		parentId:  uuid.NullUUID{UUID: p.Id(), Valid: true},
+		photo:     photo,
+	}
+
+	err := a.ChangeName(strings.TrimSpace(p.FirstName() + " " + p.LastName()))
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.ChangeCPF(string(p.Cpf()))
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.ChangeValidity(validFrom, validUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func Load(
+	id string,
+	studentId uuid.UUID,
+	parentId uuid.NullUUID,
+	name string,
+	cpf string,
+	photo string,
+	validFrom time.Time,
+	validUntil time.Time,
+) (*Authorization, error) {
+
+	authorizationId, err := uuid.Parse(id)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to change pickup authorization id")
+	}
+
+	return &Authorization{
+		id:         authorizationId,
+		studentId:  studentId,
+		parentId:   parentId,
+		name:       name,
+		cpf:        value_objects.CPF(cpf),
+		photo:      photo,
+		validFrom:  validFrom,
+		validUntil: validUntil,
+	}, nil
+}
+
+func (a *Authorization) ChangeName(name string) error {
+	if strings.TrimSpace(name) == "" {
+		return errors.New("authorized person name cannot be empty")
+	}
+
+	a.name = strings.TrimSpace(name)
+
+	return nil
+}
+
+func (a *Authorization) ChangeCPF(cpf string) error {
+	document := value_objects.CPF(cpf)
+
+	err := document.Validate()
+	if err != nil {
+		return err
+	}
+
+	a.cpf = document
+
+	return nil
+}
+
+// ChangePhoto Referencia da foto usada pela portaria para conferir a identidade de pessoas externas
+func (a *Authorization) ChangePhoto(photo string) error {
+	if strings.TrimSpace(photo) == "" {
+		return errors.New("photo is required for external authorized person")
+	}
+
+	a.photo = strings.TrimSpace(photo)
+
+	return nil
+}
+
+func (a *Authorization) ChangeValidity(validFrom string, validUntil string) error {
+	a.validFrom = today()
+	a.validUntil = time.Time{}
+
+	if validFrom != "" {
+		from, err := time.Parse("2006-01-02", validFrom)
+		if err != nil {
+			log.Println(err)
+			return errors.New("invalid valid from date provided")
+		}
+
+		a.validFrom = from
+	}
+
+	if validUntil != "" {
+		until, err := time.Parse("2006-01-02", validUntil)
+		if err != nil {
+			log.Println(err)
+			return errors.New("invalid valid until date provided")
+		}
+
+		if until.Before(a.validFrom) {
+			return errors.New("valid until cannot be before valid from")
+		}
+
+		a.validUntil = until
+	}
+
+	return nil
+}
+
+// AllowedAt Informa se a autorizacao vale na data informada
+func (a *Authorization) AllowedAt(date time.Time) bool {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	if day.Before(a.validFrom) {
+		return false
+	}
+
+	return a.validUntil.IsZero() || !day.After(a.validUntil)
+}
+
+// Expired Autorizacoes vencidas continuam no historico, mas podem ser cadastradas novamente
+func (a *Authorization) Expired(date time.Time) bool {
+	return !a.validUntil.IsZero() && a.validUntil.Before(time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC))
+}
+
+func (a *Authorization) Id() uuid.UUID {
+	return a.id
+}
+
+func (a *Authorization) StudentId() uuid.UUID {
+	return a.studentId
+}
+
+func (a *Authorization) ParentId() uuid.NullUUID {
+	return a.parentId
+}
+
+func (a *Authorization) Name() string {
+	return a.name
+}
+
+func (a *Authorization) Cpf() value_objects.CPF {
+	return a.cpf
+}
+
+func (a *Authorization) Photo() string {
+	return a.photo
+}
+
+func (a *Authorization) ValidFrom() time.Time {
+	return a.validFrom
+}
+
+func (a *Authorization) ValidUntil() time.Time {
+	return a.validUntil
+}
+
+func (a *Authorization) MarshalJSON() ([]byte, error) {
+	parentId := ""
+	if a.ParentId().Valid {
+		parentId = a.ParentId().UUID.String()
+	}
+
+	validUntil := ""
+	if !a.ValidUntil().IsZero() {
+		validUntil = a.ValidUntil().Format("2006-01-02")
+	}
+
+	return json.Marshal(struct {
+		Id         string `json:"id"`
+		StudentId  string `json:"student_id"`
+		ParentId   string `json:"parent_id,omitempty"`
+		Name       string `json:"name"`
+		Cpf        string `json:"cpf"`
+		Photo      string `json:"photo"`
+		ValidFrom  string `json:"valid_from"`
+		ValidUntil string `json:"valid_until,omitempty"`
+	}{
+		Id:         a.Id().String(),
+		StudentId:  a.StudentId().String(),
+		ParentId:   parentId,
+		Name:       a.Name(),
+		Cpf:        string(a.Cpf()),
+		Photo:      a.Photo(),
+		ValidFrom:  a.ValidFrom().Format("2006-01-02"),
+		ValidUntil: validUntil,
+	})
+}
+
+func today() time.Time {
+	now := time.Now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
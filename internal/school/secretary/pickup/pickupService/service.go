@@ -0,0 +1,143 @@
+package pickupService
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/pickup"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
+)
+
+type PickupActionsInterface interface {
+	FindByStudent(studentId string) ([]pickup.Authorization, error)
+	Authorize(studentId string, dto pickup.RequestDto) (*pickup.Authorization, error)
+	Revoke(studentId string, id string) error
+	Check(studentId string, cpf string) (*pickup.CheckResponse, error)
+}
+
+type PickupActions struct {
+	repository        pickup.Repository
+	studentRepository student.Repository
+}
+
+func New(repository pickup.Repository, studentRepository student.Repository) *PickupActions {
+	return &PickupActions{
+		repository:        repository,
+		studentRepository: studentRepository,
+	}
+}
+
+func (p *PickupActions) FindByStudent(studentId string) ([]pickup.Authorization, error) {
+	authorizations, err := p.repository.FindByStudent(studentId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve pickup authorizations")
+	}
+
+	return authorizations, nil
+}
+
+// Authorize Inclui uma pessoa na lista de retirada do aluno. Responsaveis so podem ser autorizados
+// se estiverem vinculados ao aluno, e a mesma pessoa nao pode ter duas autorizacoes vigentes
+func (p *PickupActions) Authorize(studentId string, dto pickup.RequestDto) (*pickup.Authorization, error) {
+	sdt, err := p.studentRepository.FindById(studentId)
+	if err != nil || sdt == nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve student")
+	}
+
+	var authorization *pickup.Authorization
+
+	if dto.ParentId != "" {
+		authorization, err = p.fromParent(sdt, dto)
+	} else {
+		authorization, err = pickup.New(sdt.Id(), dto.Name, dto.CpfDocument, dto.Photo, dto.ValidFrom, dto.ValidUntil)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := p.repository.FindByCpf(studentId, authorization.Cpf())
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to verify pickup authorizations")
+	}
+
+	for _, c := range current {
+		if !c.Expired(time.Now()) {
+			return nil, errors.New("person already authorized to pick up the student")
+		}
+	}
+
+	err = p.repository.Create(*authorization)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to create pickup authorization")
+	}
+
+	return authorization, nil
+}
+
+func (p *PickupActions) fromParent(sdt *student.Student, dto pickup.RequestDto) (*pickup.Authorization, error) {
+	parentId, err := uuid.Parse(dto.ParentId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("invalid parent id provided")
+	}
+
+	for _, prt := range sdt.Parents() {
+		if prt.Id() == parentId {
+			return pickup.NewFromParent(sdt.Id(), prt, dto.Photo, dto.ValidFrom, dto.ValidUntil)
+		}
+	}
+
+	return nil, errors.New("parent is not linked to the student")
+}
+
+func (p *PickupActions) Revoke(studentId string, id string) error {
+	authorization, err := p.repository.FindById(id)
+	if err != nil || authorization == nil {
+		log.Println(err)
+		return errors.New("pickup authorization not found")
+	}
+
+	if authorization.StudentId().String() != studentId {
+		return errors.New("pickup authorization not found")
+	}
+
+	err = p.repository.Delete(id)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to revoke pickup authorization")
+	}
+
+	return nil
+}
+
+// Check Informa se o CPF pode retirar o aluno hoje, retornando a autorizacao vigente
+func (p *PickupActions) Check(studentId string, cpf string) (*pickup.CheckResponse, error) {
+	document := value_objects.CPF(cpf)
+	err := document.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	authorizations, err := p.repository.FindByCpf(studentId, document)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to verify pickup authorizations")
+	}
+
+	for _, authorization := range authorizations {
+		if authorization.AllowedAt(time.Now()) {
+			found := authorization
+			return &pickup.CheckResponse{Allowed: true, Authorization: &found}, nil
+		}
+	}
+
+	return &pickup.CheckResponse{Allowed: false}, nil
+}
@@ -0,0 +1,110 @@
+package pickupService
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/mocks"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/parent"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/pickup"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestShouldAuthorizeParentLinkedToStudent(t *testing.T) {
+	sdt := getStudent()
+	parentId := sdt.Parents()[0].Id().String()
+
+	studentRepository := new(mocks.StudentRepository)
+	studentRepository.On("FindById", sdt.Id().String()).Return(sdt, nil)
+
+	repository := new(mocks.PickupRepository)
+	repository.On("FindByCpf", sdt.Id().String(), value_objects.CPF("62449972048")).Return([]pickup.Authorization{}, nil)
+	repository.On("Create", mock.Anything).Return(nil)
+
+	authorization, err := New(repository, studentRepository).Authorize(sdt.Id().String(), pickup.RequestDto{ParentId: parentId})
+	assert.NoError(t, err)
+	assert.Equal(t, parentId, authorization.ParentId().UUID.String())
+	repository.AssertCalled(t, "Create", mock.Anything)
+}
+
+func TestShouldNotAuthorizeParentNotLinkedToStudent(t *testing.T) {
+	sdt := getStudent()
+
+	studentRepository := new(mocks.StudentRepository)
+	studentRepository.On("FindById", sdt.Id().String()).Return(sdt, nil)
+
+	repository := new(mocks.PickupRepository)
+
+	_, err := New(repository, studentRepository).Authorize(sdt.Id().String(), pickup.RequestDto{ParentId: uuid.New().String()})
+	assert.EqualError(t, err, "parent is not linked to the student")
+	repository.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestShouldNotAuthorizeSamePersonTwice(t *testing.T) {
+	sdt := getStudent()
+	current, _ := pickup.New(sdt.Id(), "Carlos Souza", "529.982.247-25", "photos/carlos.jpg", "", "")
+
+	studentRepository := new(mocks.StudentRepository)
+	studentRepository.On("FindById", sdt.Id().String()).Return(sdt, nil)
+
+	repository := new(mocks.PickupRepository)
+	repository.On("FindByCpf", sdt.Id().String(), current.Cpf()).Return([]pickup.Authorization{*current}, nil)
+
+	_, err := New(repository, studentRepository).Authorize(sdt.Id().String(), pickup.RequestDto{
+		Name:        "Carlos Souza",
+		CpfDocument: "529.982.247-25",
+		Photo:       "photos/carlos.jpg",
+	})
+	assert.EqualError(t, err, "person already authorized to pick up the student")
+	repository.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestShouldCheckIfPersonCanPickUpStudentToday(t *testing.T) {
+	studentId := uuid.New()
+	active, _ := pickup.New(studentId, "Carlos Souza", "529.982.247-25", "photos/carlos.jpg", "", "")
+	future, _ := pickup.New(studentId, "Carlos Souza", "529.982.247-25", "photos/carlos.jpg", time.Now().AddDate(0, 0, 5).Format("2006-01-02"), "")
+
+	t.Run("should allow person with authorization valid today", func(t *testing.T) {
+		repository := new(mocks.PickupRepository)
+		repository.On("FindByCpf", studentId.String(), value_objects.CPF("52998224725")).Return([]pickup.Authorization{*future, *active}, nil)
+
+		response, err := New(repository, new(mocks.StudentRepository)).Check(studentId.String(), "529.982.247-25")
+		assert.NoError(t, err)
+		assert.True(t, response.Allowed)
+		assert.Equal(t, active.Id(), response.Authorization.Id())
+	})
+
+	t.Run("should deny person without authorization valid today", func(t *testing.T) {
+		repository := new(mocks.PickupRepository)
+		repository.On("FindByCpf", studentId.String(), value_objects.CPF("52998224725")).Return([]pickup.Authorization{*future}, nil)
+
+		response, err := New(repository, new(mocks.StudentRepository)).Check(studentId.String(), "529.982.247-25")
+		assert.NoError(t, err)
+		assert.False(t, response.Allowed)
+		assert.Nil(t, response.Authorization)
+	})
+}
+
+func TestShouldNotRevokeAuthorizationOfAnotherStudent(t *testing.T) {
+	authorization, _ := pickup.New(uuid.New(), "Carlos Souza", "529.982.247-25", "photos/carlos.jpg", "", "")
+
+	repository := new(mocks.PickupRepository)
+	repository.On("FindById", authorization.Id().String()).Return(authorization, nil)
+
+	err := New(repository, new(mocks.StudentRepository)).Revoke(uuid.New().String(), authorization.Id().String())
+	assert.EqualError(t, err, "pickup authorization not found")
+	repository.AssertNotCalled(t, "Delete", mock.Anything)
+}
+
+func getStudent() *student.Student {
+	sdt, _ := student.New("Joana", "Santos", "2015-05-10", "", "823.781.140-28", "joana@gmail.com", false)
+	_ = sdt.AddParents([]parent.RequestDto{
+		{FirstName: "Ana", LastName: "Santos", BirthDay: "1980-01-10", CpfDocument: "624.499.720-48", Email: "ana@gmail.com", Relationship: parent.RelationshipMother},
+	})
+
+	return sdt
+}
@@ -0,0 +1,59 @@
+package pickup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/parent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPickupAuthorization(t *testing.T) {
+	studentId := uuid.New()
+
+	t.Run("should authorize external person with name, cpf and photo", func(t *testing.T) {
+		authorization, err := New(studentId, "Carlos Souza", "529.982.247-25", "photos/carlos.jpg", "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, "52998224725", string(authorization.Cpf()))
+		assert.False(t, authorization.ParentId().Valid)
+		assert.True(t, authorization.AllowedAt(time.Now()))
+	})
+
+	t.Run("should require photo for external person", func(t *testing.T) {
+		_, err := New(studentId, "Carlos Souza", "529.982.247-25", "", "", "")
+		assert.EqualError(t, err, "photo is required for external authorized person")
+	})
+
+	t.Run("should refuse invalid cpf", func(t *testing.T) {
+		_, err := New(studentId, "Carlos Souza", "111.111.111-11", "photos/carlos.jpg", "", "")
+		assert.Error(t, err)
+	})
+
+	t.Run("should authorize parent without photo", func(t *testing.T) {
+		p, _ := parent.New("Ana", "Santos", "1980-01-10", "", "624.499.720-48", "ana@gmail.com")
+
+		authorization, err := NewFromParent(studentId, *p, "", "", "")
+		assert.NoError(t, err)
+		assert.Equal(t, p.Id(), authorization.ParentId().UUID)
+		assert.Equal(t, "Ana Santos", authorization.Name())
+	})
+
+	t.Run("should only allow pickup inside the validity", func(t *testing.T) {
+		from := time.Now().AddDate(0, 0, 1).Format("2006-01-02")
+		until := time.Now().AddDate(0, 0, 10).Format("2006-01-02")
+
+		authorization, err := New(studentId, "Carlos Souza", "529.982.247-25", "photos/carlos.jpg", from, until)
+		assert.NoError(t, err)
+		assert.False(t, authorization.AllowedAt(time.Now()))
+		assert.True(t, authorization.AllowedAt(time.Now().AddDate(0, 0, 1)))
+		assert.True(t, authorization.AllowedAt(time.Now().AddDate(0, 0, 10)))
+		assert.False(t, authorization.AllowedAt(time.Now().AddDate(0, 0, 11)))
+		assert.True(t, authorization.Expired(time.Now().AddDate(0, 0, 11)))
+	})
+
+	t.Run("should refuse validity ending before it starts", func(t *testing.T) {
+		_, err := New(studentId, "Carlos Souza", "529.982.247-25", "photos/carlos.jpg", "2023-10-10", "2023-10-01")
+		assert.EqualError(t, err, "valid until cannot be before valid from")
+	})
+}
@@ -0,0 +1,11 @@
+package pickup
+
+import "github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
+
+type Repository interface {
+	Create(authorization Authorization) error
+	Delete(id string) error
+	FindById(id string) (*Authorization, error)
+	FindByStudent(studentId string) ([]Authorization, error)
+	FindByCpf(studentId string, cpf value_objects.CPF) ([]Authorization, error)
+}
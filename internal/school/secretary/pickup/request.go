@@ -0,0 +1,25 @@
+package pickup
+
+import "github.com/go-playground/validator"
+
+// RequestDto Autorizacao de retirada. Com ParentId o responsavel vinculado ao aluno e autorizado;
+// sem ele nome, CPF e foto da pessoa externa sao obrigatorios
+type RequestDto struct {
+	ParentId    string `json:"parent_id" validate:"omitempty,uuid"`
+	Name        string `json:"name" validate:"required_without=ParentId"`
+	CpfDocument string `json:"cpf_document" validate:"required_without=ParentId"`
+	Photo       string `json:"photo" validate:"required_without=ParentId"`
+	ValidFrom   string `json:"valid_from"`
+	ValidUntil  string `json:"valid_until"`
+}
+
+func (r *RequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(r)
+}
+
+// CheckResponse Resultado da conferencia na portaria: se a pessoa pode retirar o aluno hoje
+type CheckResponse struct {
+	Allowed       bool           `json:"allowed"`
+	Authorization *Authorization `json:"authorization"`
+}
@@ -0,0 +1,110 @@
+package reenrollment
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Campaign Rematricula dos alunos de um ano letivo para o ano letivo seguinte. As familias
+// confirmam ou recusam a proposta de rematricula ate o prazo de confirmacao
+type Campaign struct {
+	id               uuid.UUID
+	fromSchoolYearId uuid.UUID
+	toSchoolYearId   uuid.UUID
+	deadline         time.Time
+	createdAt        time.Time
+}
+
+func NewCampaign(fromSchoolYearId uuid.UUID, toSchoolYearId uuid.UUID, deadline string) (*Campaign, error) {
+	c := &Campaign{
+		id:               uuid.New(),
+		fromSchoolYearId: fromSchoolYearId,
+		toSchoolYearId:   toSchoolYearId,
+		createdAt:        time.Now(),
+	}
+
+	err := c.ChangeDeadline(deadline)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func LoadCampaign(id string, fromSchoolYearId uuid.UUID, toSchoolYearId uuid.UUID, deadline time.Time, createdAt time.Time) (*Campaign, error) {
+	campaignId, err := uuid.Parse(id)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to change campaign id")
+	}
+
+	return &Campaign{
+		id:               campaignId,
+		fromSchoolYearId: fromSchoolYearId,
+		toSchoolYearId:   toSchoolYearId,
+		deadline:         deadline,
+		createdAt:        createdAt,
+	}, nil
+}
+
+func (c *Campaign) ChangeDeadline(deadline string) error {
+	date, err := time.Parse("2006-01-02", deadline)
+	if err != nil {
+		log.Println(err)
+		return errors.New("invalid confirmation deadline provided")
+	}
+
+	now := time.Now()
+	if date.Before(time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)) {
+		return errors.New("confirmation deadline cannot be before today")
+	}
+
+	c.deadline = date
+
+	return nil
+}
+
+// Open Informa se as familias ainda podem responder a proposta. O prazo vale ate o fim do dia
+func (c *Campaign) Open(at time.Time) bool {
+	return !time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, time.UTC).After(c.deadline)
+}
+
+func (c *Campaign) Id() uuid.UUID {
+	return c.id
+}
+
+func (c *Campaign) FromSchoolYearId() uuid.UUID {
+	return c.fromSchoolYearId
+}
+
+func (c *Campaign) ToSchoolYearId() uuid.UUID {
+	return c.toSchoolYearId
+}
+
+func (c *Campaign) Deadline() time.Time {
+	return c.deadline
+}
+
+func (c *Campaign) CreatedAt() time.Time {
+	return c.createdAt
+}
+
+func (c *Campaign) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Id               string `json:"id"`
+		FromSchoolYearId string `json:"from_school_year_id"`
+		ToSchoolYearId   string `json:"to_school_year_id"`
+		Deadline         string `json:"deadline"`
+		CreatedAt        string `json:"created_at"`
+	}{
+		Id:               c.Id().String(),
+		FromSchoolYearId: c.FromSchoolYearId().String(),
+		ToSchoolYearId:   c.ToSchoolYearId().String(),
+		Deadline:         c.Deadline().Format("2006-01-02"),
+		CreatedAt:        c.CreatedAt().Format(time.RFC3339),
+	})
+}
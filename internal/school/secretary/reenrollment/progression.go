@@ -0,0 +1,29 @@
+package reenrollment
+
+import "strings"
+
+// Progression Nivel de ensino seguinte de cada nivel. Niveis sem sequencia encerram o ciclo
+// escolar e nao recebem proposta de rematricula
+type Progression map[string]string
+
+// ParseProgression Le a progressao no formato "nivel:proximo nivel", separada por virgulas
+// (ex: "Infantil I:Infantil II,Infantil II:1 Ano")
+func ParseProgression(value string) Progression {
+	progression := Progression{}
+
+	for _, pair := range strings.Split(value, ",") {
+		from, to, found := strings.Cut(pair, ":")
+		if !found || strings.TrimSpace(from) == "" || strings.TrimSpace(to) == "" {
+			continue
+		}
+
+		progression[strings.ToLower(strings.TrimSpace(from))] = strings.TrimSpace(to)
+	}
+
+	return progression
+}
+
+func (p Progression) Next(level string) (string, bool) {
+	next, ok := p[strings.ToLower(strings.TrimSpace(level))]
+	return next, ok
+}
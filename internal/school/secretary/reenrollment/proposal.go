@@ -0,0 +1,299 @@
+package reenrollment
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+)
+
+const (
+	StatusProposed  = "PROPOSED"
+	StatusConfirmed = "CONFIRMED"
+	StatusDeclined  = "DECLINED"
+	StatusUnplaced  = "UNPLACED"
+	StatusExpired   = "EXPIRED"
+)
+
+// EnrollmentDueDays Prazo em dias para pagamento da taxa de matricula apos a confirmacao
+const EnrollmentDueDays = 5
+
+// Proposal Proposta de rematricula de uma matricula aprovada. Alunos sem nivel seguinte ou sem
+// turma do nivel no proximo ano ficam sem proposta (UNPLACED) e aparecem no relatorio da campanha
+type Proposal struct {
+	id                uuid.UUID
+	campaignId        uuid.UUID
+	registrationId    uuid.UUID
+	studentId         uuid.UUID
+	studentName       string
+	classRoomId       uuid.UUID
+	classRoom         string
+	targetLevel       string
+	targetClassRoomId uuid.NullUUID
+	status            string
+	reason            string
+	newRegistrationId uuid.NullUUID
+	respondedAt       time.Time
+}
+
+func NewProposal(
+	campaignId uuid.UUID,
+	registrationId uuid.UUID,
+	studentId uuid.UUID,
+	studentName string,
+	classRoomId uuid.UUID,
+	classRoom string,
+	targetLevel string,
+	targetClassRoomId uuid.UUID,
+) *Proposal {
+	return &Proposal{
+		id:                uuid.New(),
+		campaignId:        campaignId,
+		registrationId:    registrationId,
+		studentId:         studentId,
+		studentName:       studentName,
+		classRoomId:       classRoomId,
+		classRoom:         classRoom,
+		targetLevel:       targetLevel,
+		targetClassRoomId: uuid.NullUUID{UUID: targetClassRoomId, Valid: true},
+		status:            StatusProposed,
+	}
+}
+
+// NewUnplaced Registra a matricula que nao pode receber proposta, com o motivo
+func NewUnplaced(
+	campaignId uuid.UUID,
+	registrationId uuid.UUID,
+	studentId uuid.UUID,
+	studentName string,
+	classRoomId uuid.UUID,
+	classRoom string,
+	targetLevel string,
+	reason string,
+) *Proposal {
+	return &Proposal{
+		id:             uuid.New(),
+		campaignId:     campaignId,
+		registrationId: registrationId,
+		studentId:      studentId,
+		studentName:    studentName,
+		classRoomId:    classRoomId,
+		classRoom:      classRoom,
+		targetLevel:    targetLevel,
+		status:         StatusUnplaced,
+		reason:         reason,
+	}
+}
+
+func LoadProposal(
+	id string,
+	campaignId uuid.UUID,
+	registrationId uuid.UUID,
+	studentId uuid.UUID,
+	studentName string,
+	classRoomId uuid.UUID,
+	classRoom string,
+	targetLevel string,
+	targetClassRoomId uuid.NullUUID,
+	status string,
+	reason string,
+	newRegistrationId uuid.NullUUID,
+	respondedAt time.Time,
+) (*Proposal, error) {
+
+	proposalId, err := uuid.Parse(id)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to change proposal id")
+	}
+
+	return &Proposal{
+		id:                proposalId,
+		campaignId:        campaignId,
+		registrationId:    registrationId,
+		studentId:         studentId,
+		studentName:       studentName,
+		classRoomId:       classRoomId,
+		classRoom:         classRoom,
+		targetLevel:       targetLevel,
+		targetClassRoomId: targetClassRoomId,
+		status:            status,
+		reason:            reason,
+		newRegistrationId: newRegistrationId,
+		respondedAt:       respondedAt,
+	}, nil
+}
+
+// Confirm Registra a confirmacao da familia com a matricula gerada na turma de destino
+func (p *Proposal) Confirm(campaign Campaign, targetClassRoomId uuid.UUID, newRegistrationId uuid.UUID, at time.Time) error {
+	err := p.CheckAnswer(campaign, at)
+	if err != nil {
+		return err
+	}
+
+	p.status = StatusConfirmed
+	p.targetClassRoomId = uuid.NullUUID{UUID: targetClassRoomId, Valid: true}
+	p.newRegistrationId = uuid.NullUUID{UUID: newRegistrationId, Valid: true}
+	p.respondedAt = at
+
+	return nil
+}
+
+// Decline Registra a recusa da familia com o motivo informado
+func (p *Proposal) Decline(campaign Campaign, reason string, at time.Time) error {
+	if strings.TrimSpace(reason) == "" {
+		return errors.New("reason for declining cannot be empty")
+	}
+
+	err := p.CheckAnswer(campaign, at)
+	if err != nil {
+		return err
+	}
+
+	p.status = StatusDeclined
+	p.reason = reason
+	p.respondedAt = at
+
+	return nil
+}
+
+// CheckAnswer Informa se a familia ainda pode responder a proposta na data informada
+func (p *Proposal) CheckAnswer(campaign Campaign, at time.Time) error {
+	if p.status != StatusProposed {
+		return errors.New("re-enrollment proposal already answered or unavailable")
+	}
+
+	if !campaign.Open(at) {
+		return errors.New("re-enrollment confirmation deadline expired")
+	}
+
+	return nil
+}
+
+// Situation Status da proposta na data informada. Propostas sem resposta apos o prazo estao expiradas
+func (p *Proposal) Situation(campaign Campaign, at time.Time) string {
+	if p.status == StatusProposed && !campaign.Open(at) {
+		return StatusExpired
+	}
+
+	return p.status
+}
+
+func (p *Proposal) Id() uuid.UUID {
+	return p.id
+}
+
+func (p *Proposal) CampaignId() uuid.UUID {
+	return p.campaignId
+}
+
+func (p *Proposal) RegistrationId() uuid.UUID {
+	return p.registrationId
+}
+
+func (p *Proposal) StudentId() uuid.UUID {
+	return p.studentId
+}
+
+func (p *Proposal) StudentName() string {
+	return p.studentName
+}
+
+func (p *Proposal) ClassRoomId() uuid.UUID {
+	return p.classRoomId
+}
+
+func (p *Proposal) ClassRoom() string {
+	return p.classRoom
+}
+
+func (p *Proposal) TargetLevel() string {
+	return p.targetLevel
+}
+
+func (p *Proposal) TargetClassRoomId() uuid.NullUUID {
+	return p.targetClassRoomId
+}
+
+func (p *Proposal) Status() string {
+	return p.status
+}
+
+func (p *Proposal) Reason() string {
+	return p.reason
+}
+
+func (p *Proposal) NewRegistrationId() uuid.NullUUID {
+	return p.newRegistrationId
+}
+
+func (p *Proposal) RespondedAt() time.Time {
+	return p.respondedAt
+}
+
+func (p *Proposal) MarshalJSON() ([]byte, error) {
+	targetClassRoomId := ""
+	if p.TargetClassRoomId().Valid {
+		targetClassRoomId = p.TargetClassRoomId().UUID.String()
+	}
+
+	newRegistrationId := ""
+	if p.NewRegistrationId().Valid {
+		newRegistrationId = p.NewRegistrationId().UUID.String()
+	}
+
+	respondedAt := ""
+	if !p.RespondedAt().IsZero() {
+		respondedAt = p.RespondedAt().Format(time.RFC3339)
+	}
+
+	return json.Marshal(struct {
+		Id                string `json:"id"`
+		CampaignId        string `json:"campaign_id"`
+		RegistrationId    string `json:"registration_id"`
+		StudentId         string `json:"student_id"`
+		StudentName       string `json:"student_name"`
+		ClassRoomId       string `json:"class_room_id"`
+		ClassRoom         string `json:"class_room"`
+		TargetLevel       string `json:"target_level"`
+		TargetClassRoomId string `json:"target_class_room_id,omitempty"`
+		Status            string `json:"status"`
+		Reason            string `json:"reason,omitempty"`
+		NewRegistrationId string `json:"new_registration_id,omitempty"`
+		RespondedAt       string `json:"responded_at,omitempty"`
+	}{
+		Id:                p.Id().String(),
+		CampaignId:        p.CampaignId().String(),
+		RegistrationId:    p.RegistrationId().String(),
+		StudentId:         p.StudentId().String(),
+		StudentName:       p.StudentName(),
+		ClassRoomId:       p.ClassRoomId().String(),
+		ClassRoom:         p.ClassRoom(),
+		TargetLevel:       p.TargetLevel(),
+		TargetClassRoomId: targetClassRoomId,
+		Status:            p.Status(),
+		Reason:            p.Reason(),
+		NewRegistrationId: newRegistrationId,
+		RespondedAt:       respondedAt,
+	})
+}
+
+// TargetClassRoom Escolhe a turma de destino entre as turmas do proximo nivel, dando preferencia
+// ao mesmo turno da turma atual. As vagas sao ocupadas somente na confirmacao da familia
+func TargetClassRoom(classRooms []classroom.ClassRoom, shift string) *classroom.ClassRoom {
+	if len(classRooms) == 0 {
+		return nil
+	}
+
+	for i := range classRooms {
+		if classRooms[i].Shift() == shift {
+			return &classRooms[i]
+		}
+	}
+
+	return &classRooms[0]
+}
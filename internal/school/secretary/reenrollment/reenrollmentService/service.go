@@ -0,0 +1,474 @@
+package reenrollmentService
+
+import (
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/delinquency"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/reenrollment"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
+)
+
+type ReenrollmentActionsInterface interface {
+	CreateCampaign(dto reenrollment.CampaignRequestDto) (*reenrollment.Campaign, error)
+	FindProposals(campaignId string) ([]reenrollment.Proposal, error)
+	Confirm(proposalId string, dto reenrollment.ConfirmRequestDto) (*ReenrollmentResponse, error)
+	Decline(proposalId string, dto reenrollment.DeclineRequestDto) error
+	Report(campaignId string) (*reenrollment.Report, error)
+}
+
+type ReenrollmentResponse struct {
+	RegistrationCode string `json:"registration_code"`
+}
+
+type ReenrollmentActions struct {
+	repository             reenrollment.Repository
+	registrationRepository registration.Repository
+	schoolYearRepository   schoolyear.Repository
+	newUow                 reenrollment.ReenrollmentUowFactory
+	progression            reenrollment.Progression
+	debtPolicy             delinquency.Policy
+}
+
+func New(
+	repository reenrollment.Repository,
+	registrationRepository registration.Repository,
+	schoolYearRepository schoolyear.Repository,
+	uowFactory reenrollment.ReenrollmentUowFactory,
+	progression reenrollment.Progression,
+	debtPolicy delinquency.Policy,
+) *ReenrollmentActions {
+	return &ReenrollmentActions{
+		repository:             repository,
+		registrationRepository: registrationRepository,
+		schoolYearRepository:   schoolYearRepository,
+		newUow:                 uowFactory,
+		progression:            progression,
+		debtPolicy:             debtPolicy,
+	}
+}
+
+// CreateCampaign Abre a rematricula do ano letivo informado, gerando para cada matricula aprovada
+// uma proposta na turma do proximo nivel no ano letivo seguinte
+func (r *ReenrollmentActions) CreateCampaign(dto reenrollment.CampaignRequestDto) (*reenrollment.Campaign, error) {
+	year, err := strconv.Atoi(dto.SchoolYear)
+	if err != nil {
+		return nil, errors.New("invalid school year provided")
+	}
+
+	fromSchoolYear, err := r.schoolYearRepository.FindByYear(dto.SchoolYear)
+	if err != nil || fromSchoolYear == nil {
+		log.Println(err)
+		return nil, errors.New("failed to get school year information")
+	}
+
+	toSchoolYear, err := r.schoolYearRepository.FindByYear(strconv.Itoa(year + 1))
+	if err != nil || toSchoolYear == nil {
+		log.Println(err)
+		return nil, errors.New("school year " + strconv.Itoa(year+1) + " is not registered")
+	}
+
+	current, err := r.repository.FindCampaignBySchoolYear(fromSchoolYear.Id())
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to verify re-enrollment campaign")
+	}
+
+	if current != nil {
+		return nil, errors.New("re-enrollment campaign already created for this school year")
+	}
+
+	campaign, err := reenrollment.NewCampaign(fromSchoolYear.Id(), toSchoolYear.Id(), dto.Deadline)
+	if err != nil {
+		return nil, err
+	}
+
+	registrationIds, err := r.repository.FindApprovedRegistrations(fromSchoolYear.Id())
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to get approved registrations")
+	}
+
+	classRooms := map[string][]classroom.ClassRoom{}
+	var proposals []reenrollment.Proposal
+
+	for _, registrationId := range registrationIds {
+		reg, err := r.registrationRepository.FindById(registrationId.String())
+		if err != nil || reg == nil {
+			log.Println(err)
+			return nil, errors.New("failed to get registration information")
+		}
+
+		proposal, err := r.propose(*campaign, reg, classRooms)
+		if err != nil {
+			return nil, err
+		}
+
+		proposals = append(proposals, *proposal)
+	}
+
+	uow := r.newUow()
+
+	err = uow.BeginTransaction()
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to create re-enrollment campaign")
+	}
+
+	err = uow.CreateCampaign(*campaign)
+	if err != nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return nil, errors.New("failed to create re-enrollment campaign")
+	}
+
+	err = uow.CreateProposals(proposals)
+	if err != nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return nil, errors.New("failed to create re-enrollment proposals")
+	}
+
+	err = uow.Commit()
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to create re-enrollment campaign")
+	}
+
+	return campaign, nil
+}
+
+// propose Monta a proposta da matricula. As turmas do proximo ano sao consultadas uma vez por nivel
+func (r *ReenrollmentActions) propose(
+	campaign reenrollment.Campaign,
+	reg *registration.Registration,
+	classRooms map[string][]classroom.ClassRoom,
+) (*reenrollment.Proposal, error) {
+	std := reg.Student()
+	studentName := std.FirstName() + " " + std.LastName()
+	class := reg.Class()
+
+	nextLevel, ok := r.progression.Next(class.Level())
+	if !ok {
+		return reenrollment.NewUnplaced(
+			campaign.Id(),
+			reg.Id(),
+			std.Id(),
+			studentName,
+			class.Id(),
+			class.Identification(),
+			"",
+			"no next level configured for level "+class.Level(),
+		), nil
+	}
+
+	levelClassRooms, found := classRooms[nextLevel]
+	if !found {
+		var err error
+		levelClassRooms, err = r.repository.FindClassRooms(campaign.ToSchoolYearId(), nextLevel)
+		if err != nil {
+			log.Println(err)
+			return nil, errors.New("failed to get class rooms of level " + nextLevel)
+		}
+
+		classRooms[nextLevel] = levelClassRooms
+	}
+
+	target := reenrollment.TargetClassRoom(levelClassRooms, class.Shift())
+	if target == nil {
+		return reenrollment.NewUnplaced(
+			campaign.Id(),
+			reg.Id(),
+			std.Id(),
+			studentName,
+			class.Id(),
+			class.Identification(),
+			nextLevel,
+			"no open class room for level "+nextLevel,
+		), nil
+	}
+
+	return reenrollment.NewProposal(
+		campaign.Id(),
+		reg.Id(),
+		std.Id(),
+		studentName,
+		class.Id(),
+		class.Identification(),
+		nextLevel,
+		target.Id(),
+	), nil
+}
+
+func (r *ReenrollmentActions) FindProposals(campaignId string) ([]reenrollment.Proposal, error) {
+	proposals, err := r.repository.FindProposals(campaignId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve re-enrollment proposals")
+	}
+
+	return proposals, nil
+}
+
+// Confirm Registra a confirmacao da familia gerando a matricula no proximo ano letivo, com a
+// ocupacao da vaga e as cobrancas do novo ano. A familia pode escolher outra turma do mesmo nivel.
+// Alunos com debito em atraso seguem a mesma politica de bloqueio da matricula
+func (r *ReenrollmentActions) Confirm(proposalId string, dto reenrollment.ConfirmRequestDto) (*ReenrollmentResponse, error) {
+	uow := r.newUow()
+
+	err := uow.BeginTransaction()
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to confirm re-enrollment")
+	}
+
+	proposal, campaign, err := r.findProposal(uow, proposalId)
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
+
+	now := time.Now()
+
+	err = proposal.CheckAnswer(*campaign, now)
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
+
+	classRoomId := dto.ClassRoomId
+	if classRoomId == "" {
+		classRoomId = proposal.TargetClassRoomId().UUID.String()
+	}
+
+	classRoom, err := uow.FindClassRoomLock(classRoomId)
+	if err != nil || classRoom == nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return nil, errors.New("failed to get class room information")
+	}
+
+	if classRoom.SchoolYearId() != campaign.ToSchoolYearId() || classRoom.Level() != proposal.TargetLevel() {
+		_ = uow.Rollback()
+		return nil, errors.New("class room does not belong to the re-enrollment level and school year")
+	}
+
+	reg, err := uow.FindRegisterLock(proposal.RegistrationId().String())
+	if err != nil || reg == nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return nil, errors.New("failed to get registration information")
+	}
+
+	studentRegistered, err := uow.StudentAlreadyRegisterInClass(reg.Student().Id(), classRoom.Id())
+	if err != nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return nil, errors.New("failed to verify if student already registered")
+	}
+
+	if studentRegistered {
+		_ = uow.Rollback()
+		return nil, errors.New("student already registered")
+	}
+
+	debtOverride, err := r.checkOverdueDebt(uow, reg.Student().Id(), dto.DebtOverride)
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
+
+	enrollmentDueDate := now.AddDate(0, 0, reenrollment.EnrollmentDueDays).Format("2006-01-02")
+
+	renewed, err := reg.Reenroll(*classRoom, enrollmentDueDate, "re-enrollment confirmed")
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
+
+	if debtOverride != "" {
+		renewed.RecordDebtOverride(dto.DebtOverride.ApprovedBy, debtOverride)
+	}
+
+	err = proposal.Confirm(*campaign, classRoom.Id(), renewed.Id(), now)
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
+
+	err = classRoom.SetOccupiedVacancies(1)
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
+
+	err = uow.OccupyVacancies(*classRoom)
+	if err != nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		if errors.Is(err, classroom.ErrClassRoomFull) {
+			return nil, err
+		}
+		return nil, errors.New("failed to update occupied vacancies")
+	}
+
+	err = uow.CreateRegister(*renewed)
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
+
+	err = r.createInvoices(uow, renewed)
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
+
+	err = uow.UpdateProposal(*proposal)
+	if err != nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return nil, errors.New("failed to update re-enrollment proposal")
+	}
+
+	err = uow.Commit()
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to confirm re-enrollment")
+	}
+
+	return &ReenrollmentResponse{
+		RegistrationCode: renewed.Code(),
+	}, nil
+}
+
+func (r *ReenrollmentActions) Decline(proposalId string, dto reenrollment.DeclineRequestDto) error {
+	uow := r.newUow()
+
+	err := uow.BeginTransaction()
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to decline re-enrollment")
+	}
+
+	proposal, campaign, err := r.findProposal(uow, proposalId)
+	if err != nil {
+		_ = uow.Rollback()
+		return err
+	}
+
+	err = proposal.Decline(*campaign, dto.Reason, time.Now())
+	if err != nil {
+		_ = uow.Rollback()
+		return err
+	}
+
+	err = uow.UpdateProposal(*proposal)
+	if err != nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return errors.New("failed to update re-enrollment proposal")
+	}
+
+	err = uow.Commit()
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to decline re-enrollment")
+	}
+
+	return nil
+}
+
+// Report Resultado da rematricula por turma de origem
+func (r *ReenrollmentActions) Report(campaignId string) (*reenrollment.Report, error) {
+	campaign, err := r.repository.FindCampaignById(campaignId)
+	if err != nil || campaign == nil {
+		log.Println(err)
+		return nil, errors.New("failed to get re-enrollment campaign")
+	}
+
+	proposals, err := r.repository.FindProposals(campaignId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve re-enrollment proposals")
+	}
+
+	report := reenrollment.BuildReport(*campaign, proposals, time.Now())
+
+	return &report, nil
+}
+
+func (r *ReenrollmentActions) findProposal(
+	uow reenrollment.ReenrollmentUow,
+	proposalId string,
+) (*reenrollment.Proposal, *reenrollment.Campaign, error) {
+	proposal, err := uow.FindProposalLock(proposalId)
+	if err != nil || proposal == nil {
+		log.Println(err)
+		return nil, nil, errors.New("failed to get re-enrollment proposal")
+	}
+
+	campaign, err := r.repository.FindCampaignById(proposal.CampaignId().String())
+	if err != nil || campaign == nil {
+		log.Println(err)
+		return nil, nil, errors.New("failed to get re-enrollment campaign")
+	}
+
+	return proposal, campaign, nil
+}
+
+// checkOverdueDebt Aplica a politica de inadimplencia na confirmacao da rematricula. Com debito em
+// atraso, a rematricula so prossegue com uma liberacao justificada, retornada para o historico
+func (r *ReenrollmentActions) checkOverdueDebt(
+	uow reenrollment.ReenrollmentUow,
+	studentId uuid.UUID,
+	override *registration.DebtOverrideRequestDto,
+) (string, error) {
+	if !r.debtPolicy.Enabled {
+		return "", nil
+	}
+
+	debt, err := uow.StudentOverdueDebt(studentId, r.debtPolicy.OverdueBefore(time.Now()))
+	if err != nil {
+		log.Println(err)
+		return "", errors.New("failed to verify student overdue debt")
+	}
+
+	if !r.debtPolicy.Blocks(debt) {
+		return "", nil
+	}
+
+	if override == nil {
+		return "", delinquency.ErrOverdueDebt
+	}
+
+	return r.debtPolicy.Override(debt, override.ApprovedBy, override.ApprovalKey, override.Reason)
+}
+
+// createInvoices Gera a taxa de matricula e as mensalidades no periodo do novo ano letivo
+func (r *ReenrollmentActions) createInvoices(uow reenrollment.ReenrollmentUow, reg *registration.Registration) error {
+	schoolYear, err := r.schoolYearRepository.FindById(reg.Class().SchoolYearId().String())
+	if err != nil || schoolYear == nil {
+		log.Println(err)
+		return errors.New("failed to get school year information")
+	}
+
+	invoices, err := invoice.Generate(reg.Billing(), *schoolYear)
+	if err != nil {
+		return err
+	}
+
+	err = uow.CreateInvoices(invoices)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to create invoices")
+	}
+
+	return nil
+}
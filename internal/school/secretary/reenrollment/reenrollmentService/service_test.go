@@ -0,0 +1,262 @@
+package reenrollmentService
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/mocks"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/delinquency"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/service"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/reenrollment"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var progression = reenrollment.ParseProgression("1 Ano:2 Ano,2 Ano:3 Ano")
+
+var debtPolicy = delinquency.Policy{Enabled: true, ToleranceDays: 5, MinimumDebt: 50, Approvers: map[string]string{"coordenacao": "secret"}}
+
+func TestShouldCreateCampaignWithProposals(t *testing.T) {
+	currentYear, nextYear := getSchoolYears()
+	placed := getRegistration(getClassRoom(currentYear.Id(), "1 Ano", "afternoon"))
+	withoutClass := getRegistration(getClassRoom(currentYear.Id(), "2 Ano", "morning"))
+	lastLevel := getRegistration(getClassRoom(currentYear.Id(), "5 Ano", "morning"))
+	nextClassRooms := []classroom.ClassRoom{
+		getClassRoom(nextYear.Id(), "2 Ano", "morning"),
+		getClassRoom(nextYear.Id(), "2 Ano", "afternoon"),
+	}
+
+	schoolYearRepository := new(mocks.SchoolYearRepository)
+	schoolYearRepository.On("FindByYear", "2024").Return(currentYear, nil)
+	schoolYearRepository.On("FindByYear", "2025").Return(nextYear, nil)
+
+	registrationRepository := new(mocks.RegistrationRepository)
+	for _, reg := range []*registration.Registration{placed, withoutClass, lastLevel} {
+		registrationRepository.On("FindById", reg.Id().String()).Return(reg, nil)
+	}
+
+	repository := new(mocks.ReenrollmentRepository)
+	repository.On("FindCampaignBySchoolYear", currentYear.Id()).Return((*reenrollment.Campaign)(nil), nil)
+	repository.On("FindApprovedRegistrations", currentYear.Id()).Return([]uuid.UUID{placed.Id(), withoutClass.Id(), lastLevel.Id()}, nil)
+	repository.On("FindClassRooms", nextYear.Id(), "2 Ano").Return(nextClassRooms, nil)
+	repository.On("FindClassRooms", nextYear.Id(), "3 Ano").Return([]classroom.ClassRoom{}, nil)
+
+	var proposals []reenrollment.Proposal
+
+	uow := new(mocks.ReenrollmentUowMock)
+	uow.On("BeginTransaction").Return(nil)
+	uow.On("CreateCampaign", mock.Anything).Return(nil)
+	uow.On("CreateProposals", mock.Anything).Run(func(args mock.Arguments) {
+		proposals = args.Get(0).([]reenrollment.Proposal)
+	}).Return(nil)
+	uow.On("Commit").Return(nil)
+
+	actions := New(repository, registrationRepository, schoolYearRepository, func() reenrollment.ReenrollmentUow { return uow }, progression, debtPolicy)
+
+	campaign, err := actions.CreateCampaign(reenrollment.CampaignRequestDto{
+		SchoolYear: "2024",
+		Deadline:   time.Now().AddDate(0, 0, 15).Format("2006-01-02"),
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, nextYear.Id(), campaign.ToSchoolYearId())
+	assert.Len(t, proposals, 3)
+
+	assert.Equal(t, reenrollment.StatusProposed, proposals[0].Status())
+	assert.Equal(t, "2 Ano", proposals[0].TargetLevel())
+	assert.Equal(t, nextClassRooms[1].Id(), proposals[0].TargetClassRoomId().UUID)
+
+	assert.Equal(t, reenrollment.StatusUnplaced, proposals[1].Status())
+	assert.Equal(t, "no open class room for level 3 Ano", proposals[1].Reason())
+
+	assert.Equal(t, reenrollment.StatusUnplaced, proposals[2].Status())
+	assert.Equal(t, "no next level configured for level 5 Ano", proposals[2].Reason())
+
+	repository.AssertNumberOfCalls(t, "FindClassRooms", 2)
+}
+
+func TestShouldNotCreateCampaignTwiceForTheSameSchoolYear(t *testing.T) {
+	currentYear, nextYear := getSchoolYears()
+	current, _ := reenrollment.NewCampaign(currentYear.Id(), nextYear.Id(), time.Now().Format("2006-01-02"))
+
+	schoolYearRepository := new(mocks.SchoolYearRepository)
+	schoolYearRepository.On("FindByYear", "2024").Return(currentYear, nil)
+	schoolYearRepository.On("FindByYear", "2025").Return(nextYear, nil)
+
+	repository := new(mocks.ReenrollmentRepository)
+	repository.On("FindCampaignBySchoolYear", currentYear.Id()).Return(current, nil)
+
+	actions := New(repository, new(mocks.RegistrationRepository), schoolYearRepository, nil, progression, debtPolicy)
+
+	_, err := actions.CreateCampaign(reenrollment.CampaignRequestDto{
+		SchoolYear: "2024",
+		Deadline:   time.Now().AddDate(0, 0, 15).Format("2006-01-02"),
+	})
+
+	assert.EqualError(t, err, "re-enrollment campaign already created for this school year")
+}
+
+func TestConfirmReenrollment(t *testing.T) {
+	currentYear, nextYear := getSchoolYears()
+	campaign, _ := reenrollment.NewCampaign(currentYear.Id(), nextYear.Id(), time.Now().AddDate(0, 0, 15).Format("2006-01-02"))
+
+	setup := func(campaign *reenrollment.Campaign, debt float64) (*ReenrollmentActions, *mocks.ReenrollmentUowMock, *reenrollment.Proposal) {
+		reg := getRegistration(getClassRoom(currentYear.Id(), "1 Ano", "morning"))
+		nextClassRoom := getClassRoom(nextYear.Id(), "2 Ano", "morning")
+		proposal := reenrollment.NewProposal(
+			campaign.Id(),
+			reg.Id(),
+			reg.Student().Id(),
+			"Henrique Rocha",
+			reg.Class().Id(),
+			reg.Class().Identification(),
+			"2 Ano",
+			nextClassRoom.Id(),
+		)
+
+		repository := new(mocks.ReenrollmentRepository)
+		repository.On("FindCampaignById", campaign.Id().String()).Return(campaign, nil)
+
+		schoolYearRepository := new(mocks.SchoolYearRepository)
+		schoolYearRepository.On("FindById", nextYear.Id().String()).Return(nextYear, nil)
+
+		uow := new(mocks.ReenrollmentUowMock)
+		uow.On("BeginTransaction").Return(nil)
+		uow.On("Rollback").Return(nil)
+		uow.On("FindProposalLock", proposal.Id().String()).Return(proposal, nil)
+		uow.On("FindClassRoomLock", nextClassRoom.Id().String()).Return(&nextClassRoom, nil)
+		uow.On("FindRegisterLock", reg.Id().String()).Return(reg, nil)
+		uow.On("StudentAlreadyRegisterInClass", reg.Student().Id(), nextClassRoom.Id()).Return(false, nil)
+		uow.On("StudentOverdueDebt", reg.Student().Id(), mock.Anything).Return(debt, nil)
+		uow.On("OccupyVacancies", mock.Anything).Return(nil)
+		uow.On("CreateRegister", mock.Anything).Return(nil)
+		uow.On("CreateInvoices", mock.Anything).Return(nil)
+		uow.On("UpdateProposal", mock.Anything).Return(nil)
+		uow.On("Commit").Return(nil)
+
+		actions := New(repository, new(mocks.RegistrationRepository), schoolYearRepository, func() reenrollment.ReenrollmentUow { return uow }, progression, debtPolicy)
+
+		return actions, uow, proposal
+	}
+
+	t.Run("should create registration in the next school year", func(t *testing.T) {
+		actions, uow, proposal := setup(campaign, 0)
+
+		response, err := actions.Confirm(proposal.Id().String(), reenrollment.ConfirmRequestDto{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, response.RegistrationCode)
+		assert.Equal(t, reenrollment.StatusConfirmed, proposal.Status())
+		uow.AssertCalled(t, "CreateRegister", mock.Anything)
+		uow.AssertCalled(t, "CreateInvoices", mock.Anything)
+		uow.AssertCalled(t, "Commit")
+	})
+
+	t.Run("should not confirm after the deadline", func(t *testing.T) {
+		expired, _ := reenrollment.LoadCampaign(
+			uuid.New().String(),
+			currentYear.Id(),
+			nextYear.Id(),
+			time.Now().AddDate(0, 0, -1),
+			time.Now().AddDate(0, 0, -30),
+		)
+		actions, uow, proposal := setup(expired, 0)
+
+		_, err := actions.Confirm(proposal.Id().String(), reenrollment.ConfirmRequestDto{})
+		assert.EqualError(t, err, "re-enrollment confirmation deadline expired")
+		uow.AssertNotCalled(t, "CreateRegister", mock.Anything)
+		uow.AssertCalled(t, "Rollback")
+	})
+
+	t.Run("should not confirm student with overdue debt without override", func(t *testing.T) {
+		actions, uow, proposal := setup(campaign, 850.00)
+
+		_, err := actions.Confirm(proposal.Id().String(), reenrollment.ConfirmRequestDto{})
+		assert.ErrorIs(t, err, delinquency.ErrOverdueDebt)
+		assert.Equal(t, reenrollment.StatusProposed, proposal.Status())
+		uow.AssertNotCalled(t, "CreateRegister", mock.Anything)
+		uow.AssertCalled(t, "Rollback")
+	})
+
+	t.Run("should not confirm student with overdue debt when override has no authorized approver", func(t *testing.T) {
+		actions, uow, proposal := setup(campaign, 850.00)
+
+		_, err := actions.Confirm(proposal.Id().String(), reenrollment.ConfirmRequestDto{
+			DebtOverride: &registration.DebtOverrideRequestDto{Reason: "payment agreement", ApprovedBy: "secretaria", ApprovalKey: "secret"},
+		})
+		assert.ErrorIs(t, err, delinquency.ErrUnauthorizedApprover)
+		assert.Equal(t, reenrollment.StatusProposed, proposal.Status())
+		uow.AssertNotCalled(t, "CreateRegister", mock.Anything)
+		uow.AssertCalled(t, "Rollback")
+	})
+
+	t.Run("should confirm student with overdue debt when an authorized approver overrides with a reason", func(t *testing.T) {
+		actions, uow, proposal := setup(campaign, 850.00)
+
+		_, err := actions.Confirm(proposal.Id().String(), reenrollment.ConfirmRequestDto{
+			DebtOverride: &registration.DebtOverrideRequestDto{Reason: "payment agreement", ApprovedBy: "coordenacao", ApprovalKey: "secret"},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, reenrollment.StatusConfirmed, proposal.Status())
+		uow.AssertCalled(t, "CreateRegister", mock.MatchedBy(func(renewed registration.Registration) bool {
+			changes := renewed.StatusChanges()
+			if len(changes) == 0 {
+				return false
+			}
+
+			last := changes[len(changes)-1]
+			return last.Reason == "overdue debt of 850.00 overridden: payment agreement" && last.ApprovedBy == "coordenacao"
+		}))
+	})
+
+	t.Run("should decline proposal", func(t *testing.T) {
+		actions, uow, proposal := setup(campaign, 0)
+
+		err := actions.Decline(proposal.Id().String(), reenrollment.DeclineRequestDto{Reason: "moving to another city"})
+		assert.NoError(t, err)
+		assert.Equal(t, reenrollment.StatusDeclined, proposal.Status())
+		uow.AssertCalled(t, "UpdateProposal", mock.Anything)
+	})
+}
+
+func getSchoolYears() (*schoolyear.SchoolYear, *schoolyear.SchoolYear) {
+	currentYear, _ := schoolyear.Load(uuid.New().String(), "2024", "2024-02-01", "2024-12-15")
+	nextYear, _ := schoolyear.Load(uuid.New().String(), "2025", "2025-02-01", "2025-12-15")
+
+	return currentYear, nextYear
+}
+
+func getClassRoom(schoolYearId uuid.UUID, level string, shift string) classroom.ClassRoom {
+	clr, _ := classroom.New(10, shift, level, "TUR-"+level, schoolYearId.String(), uuid.New().String(), uuid.New().String(), "ANY", "remote")
+	return *clr
+}
+
+func getRegistration(class classroom.ClassRoom) *registration.Registration {
+	svc, _ := service.New("Ensino Fundamental", 5000.00)
+	std, _ := student.New("Henrique", "Rocha", "2000-01-01", "123456789", "84731086043", "student@mail.com", true)
+
+	reg, _ := registration.Load(
+		uuid.New().String(),
+		"2024000001",
+		class,
+		class.Shift(),
+		*std,
+		*svc,
+		500.00,
+		10,
+		200.00,
+		"2024-01-10",
+		10,
+		registration.StatusApproved,
+		"2024-01-05",
+		"10",
+		true,
+	)
+	_ = reg.ChangeFinancialResponsible("")
+
+	return reg
+}
@@ -0,0 +1,132 @@
+package reenrollment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCampaign(t *testing.T) {
+	t.Run("should not create campaign with deadline before today", func(t *testing.T) {
+		_, err := NewCampaign(uuid.New(), uuid.New(), time.Now().AddDate(0, 0, -1).Format("2006-01-02"))
+		assert.EqualError(t, err, "confirmation deadline cannot be before today")
+	})
+
+	t.Run("should accept answers until the end of the deadline day", func(t *testing.T) {
+		deadline := time.Now().AddDate(0, 0, 10)
+		campaign, err := NewCampaign(uuid.New(), uuid.New(), deadline.Format("2006-01-02"))
+		assert.NoError(t, err)
+
+		lastMinute := time.Date(deadline.Year(), deadline.Month(), deadline.Day(), 23, 59, 0, 0, time.Local)
+		assert.True(t, campaign.Open(lastMinute))
+		assert.False(t, campaign.Open(lastMinute.AddDate(0, 0, 1)))
+	})
+}
+
+func TestProposal(t *testing.T) {
+	campaign, _ := NewCampaign(uuid.New(), uuid.New(), time.Now().AddDate(0, 0, 10).Format("2006-01-02"))
+	afterDeadline := time.Now().AddDate(0, 0, 11)
+
+	newProposal := func() *Proposal {
+		return NewProposal(campaign.Id(), uuid.New(), uuid.New(), "Henrique Rocha", uuid.New(), "TUR-001", "2 Ano", uuid.New())
+	}
+
+	t.Run("should confirm proposal before the deadline", func(t *testing.T) {
+		proposal := newProposal()
+		classRoomId := uuid.New()
+		registrationId := uuid.New()
+
+		err := proposal.Confirm(*campaign, classRoomId, registrationId, time.Now())
+		assert.NoError(t, err)
+		assert.Equal(t, StatusConfirmed, proposal.Status())
+		assert.Equal(t, classRoomId, proposal.TargetClassRoomId().UUID)
+		assert.Equal(t, registrationId, proposal.NewRegistrationId().UUID)
+	})
+
+	t.Run("should not confirm proposal after the deadline", func(t *testing.T) {
+		proposal := newProposal()
+
+		err := proposal.Confirm(*campaign, uuid.New(), uuid.New(), afterDeadline)
+		assert.EqualError(t, err, "re-enrollment confirmation deadline expired")
+		assert.Equal(t, StatusExpired, proposal.Situation(*campaign, afterDeadline))
+	})
+
+	t.Run("should decline proposal with reason", func(t *testing.T) {
+		proposal := newProposal()
+
+		assert.EqualError(t, proposal.Decline(*campaign, "", time.Now()), "reason for declining cannot be empty")
+		assert.NoError(t, proposal.Decline(*campaign, "moving to another city", time.Now()))
+		assert.Equal(t, StatusDeclined, proposal.Status())
+		assert.Equal(t, StatusDeclined, proposal.Situation(*campaign, afterDeadline))
+	})
+
+	t.Run("should not answer proposal twice", func(t *testing.T) {
+		proposal := newProposal()
+		_ = proposal.Decline(*campaign, "moving to another city", time.Now())
+
+		err := proposal.Confirm(*campaign, uuid.New(), uuid.New(), time.Now())
+		assert.EqualError(t, err, "re-enrollment proposal already answered or unavailable")
+	})
+
+	t.Run("should not answer unplaced proposal", func(t *testing.T) {
+		proposal := NewUnplaced(campaign.Id(), uuid.New(), uuid.New(), "Henrique Rocha", uuid.New(), "TUR-001", "", "no next level configured for level 5 Ano")
+
+		err := proposal.Confirm(*campaign, uuid.New(), uuid.New(), time.Now())
+		assert.Error(t, err)
+	})
+}
+
+func TestProgression(t *testing.T) {
+	progression := ParseProgression("1 Ano:2 Ano, 2 Ano : 3 Ano,invalid,4 Ano:")
+
+	next, ok := progression.Next("1 ano")
+	assert.True(t, ok)
+	assert.Equal(t, "2 Ano", next)
+
+	next, ok = progression.Next("2 Ano")
+	assert.True(t, ok)
+	assert.Equal(t, "3 Ano", next)
+
+	_, ok = progression.Next("4 Ano")
+	assert.False(t, ok)
+}
+
+func TestTargetClassRoom(t *testing.T) {
+	newClassRoom := func(shift string, identification string) classroom.ClassRoom {
+		clr, _ := classroom.New(10, shift, "2 Ano", identification, uuid.New().String(), uuid.New().String(), uuid.New().String(), "ANY", "remote")
+		return *clr
+	}
+
+	classRooms := []classroom.ClassRoom{newClassRoom("morning", "TUR-A"), newClassRoom("afternoon", "TUR-B")}
+
+	assert.Equal(t, "TUR-B", TargetClassRoom(classRooms, "afternoon").Identification())
+	assert.Equal(t, "TUR-A", TargetClassRoom(classRooms, "night").Identification())
+	assert.Nil(t, TargetClassRoom(nil, "morning"))
+}
+
+func TestBuildReport(t *testing.T) {
+	campaign, _ := NewCampaign(uuid.New(), uuid.New(), time.Now().AddDate(0, 0, 10).Format("2006-01-02"))
+	classA := uuid.New()
+	classB := uuid.New()
+
+	confirmed := NewProposal(campaign.Id(), uuid.New(), uuid.New(), "Aluno A", classA, "TUR-A", "2 Ano", uuid.New())
+	_ = confirmed.Confirm(*campaign, uuid.New(), uuid.New(), time.Now())
+	declined := NewProposal(campaign.Id(), uuid.New(), uuid.New(), "Aluno B", classA, "TUR-A", "2 Ano", uuid.New())
+	_ = declined.Decline(*campaign, "moving", time.Now())
+	pending := NewProposal(campaign.Id(), uuid.New(), uuid.New(), "Aluno C", classB, "TUR-B", "2 Ano", uuid.New())
+	unplaced := NewUnplaced(campaign.Id(), uuid.New(), uuid.New(), "Aluno D", classB, "TUR-B", "", "no next level")
+
+	proposals := []Proposal{*pending, *confirmed, *declined, *unplaced}
+
+	report := BuildReport(*campaign, proposals, time.Now())
+	assert.Len(t, report.Classes, 2)
+	assert.Equal(t, ClassReport{ClassRoomId: classA, ClassRoom: "TUR-A", Total: 2, Confirmed: 1, Declined: 1}, report.Classes[0])
+	assert.Equal(t, ClassReport{ClassRoomId: classB, ClassRoom: "TUR-B", Total: 2, Proposed: 1, Unplaced: 1}, report.Classes[1])
+
+	expired := BuildReport(*campaign, proposals, time.Now().AddDate(0, 0, 11))
+	assert.Equal(t, 1, expired.Classes[1].Expired)
+	assert.Equal(t, 0, expired.Classes[1].Proposed)
+}
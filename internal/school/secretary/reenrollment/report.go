@@ -0,0 +1,71 @@
+package reenrollment
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ClassReport Resultado da rematricula dos alunos de uma turma
+type ClassReport struct {
+	ClassRoomId uuid.UUID `json:"class_room_id"`
+	ClassRoom   string    `json:"class_room"`
+	Total       int       `json:"total"`
+	Proposed    int       `json:"proposed"`
+	Confirmed   int       `json:"confirmed"`
+	Declined    int       `json:"declined"`
+	Expired     int       `json:"expired"`
+	Unplaced    int       `json:"unplaced"`
+}
+
+type Report struct {
+	Campaign Campaign      `json:"campaign"`
+	Classes  []ClassReport `json:"classes"`
+}
+
+// BuildReport Agrupa as propostas pela turma de origem considerando o prazo na data informada
+func BuildReport(campaign Campaign, proposals []Proposal, at time.Time) Report {
+	classes := map[uuid.UUID]*ClassReport{}
+
+	for _, proposal := range proposals {
+		class, ok := classes[proposal.ClassRoomId()]
+		if !ok {
+			class = &ClassReport{
+				ClassRoomId: proposal.ClassRoomId(),
+				ClassRoom:   proposal.ClassRoom(),
+			}
+			classes[proposal.ClassRoomId()] = class
+		}
+
+		class.Total++
+
+		switch proposal.Situation(campaign, at) {
+		case StatusProposed:
+			class.Proposed++
+		case StatusConfirmed:
+			class.Confirmed++
+		case StatusDeclined:
+			class.Declined++
+		case StatusExpired:
+			class.Expired++
+		case StatusUnplaced:
+			class.Unplaced++
+		}
+	}
+
+	report := Report{
+		Campaign: campaign,
+		Classes:  []ClassReport{},
+	}
+
+	for _, class := range classes {
+		report.Classes = append(report.Classes, *class)
+	}
+
+	sort.Slice(report.Classes, func(i, j int) bool {
+		return report.Classes[i].ClassRoom < report.Classes[j].ClassRoom
+	})
+
+	return report
+}
@@ -0,0 +1,19 @@
+package reenrollment
+
+import (
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+)
+
+type Repository interface {
+	CreateCampaign(campaign Campaign) error
+	FindCampaignById(id string) (*Campaign, error)
+	FindCampaignBySchoolYear(fromSchoolYearId uuid.UUID) (*Campaign, error)
+	CreateProposals(proposals []Proposal) error
+	UpdateProposal(proposal Proposal) error
+	FindProposals(campaignId string) ([]Proposal, error)
+	FindProposalById(id string) (*Proposal, error)
+	FindProposalByIdLock(id string) (*Proposal, error)
+	FindApprovedRegistrations(schoolYearId uuid.UUID) ([]uuid.UUID, error)
+	FindClassRooms(schoolYearId uuid.UUID, level string) ([]classroom.ClassRoom, error)
+}
@@ -0,0 +1,37 @@
+package reenrollment
+
+import (
+	"github.com/go-playground/validator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+)
+
+type CampaignRequestDto struct {
+	SchoolYear string `json:"school_year" validate:"required"`
+	Deadline   string `json:"deadline" validate:"required"`
+}
+
+func (c *CampaignRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(c)
+}
+
+// ConfirmRequestDto Permite escolher outra turma do mesmo nivel no proximo ano letivo e liberar a
+// rematricula de aluno com debito em atraso
+type ConfirmRequestDto struct {
+	ClassRoomId  string                               `json:"class_room_id" validate:"omitempty,uuid"`
+	DebtOverride *registration.DebtOverrideRequestDto `json:"debt_override"`
+}
+
+func (c *ConfirmRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(c)
+}
+
+type DeclineRequestDto struct {
+	Reason string `json:"reason" validate:"required"`
+}
+
+func (d *DeclineRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(d)
+}
@@ -0,0 +1,30 @@
+package reenrollment
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+)
+
+type ReenrollmentUow interface {
+	BeginTransaction() error
+	Commit() error
+	Rollback() error
+	CreateCampaign(campaign Campaign) error
+	CreateProposals(proposals []Proposal) error
+	FindProposalLock(id string) (*Proposal, error)
+	UpdateProposal(proposal Proposal) error
+	FindRegisterLock(id string) (*registration.Registration, error)
+	FindClassRoomLock(id string) (*classroom.ClassRoom, error)
+	OccupyVacancies(classRoom classroom.ClassRoom) error
+	StudentAlreadyRegisterInClass(studentId uuid.UUID, classRoomId uuid.UUID) (bool, error)
+	StudentOverdueDebt(studentId uuid.UUID, dueBefore time.Time) (float64, error)
+	CreateRegister(register registration.Registration) error
+	CreateInvoices(invoices []invoice.Invoice) error
+}
+
+// ReenrollmentUowFactory Cria uma nova unidade de trabalho para cada operacao da rematricula
+type ReenrollmentUowFactory func() ReenrollmentUow
@@ -0,0 +1,455 @@
+package registration
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
+)
+
+const (
+	DiscountKindScholarship = "SCHOLARSHIP"
+	DiscountKindSibling     = "SIBLING"
+)
+
+const (
+	DiscountTypePercentage = "PERCENTAGE"
+	DiscountTypeFixed      = "FIXED"
+)
+
+// SiblingDiscountPercentage Desconto concedido automaticamente quando outro aluno com o mesmo
+// responsavel (CPF) possui matricula ativa
+const SiblingDiscountPercentage = 10.0
+
+// SiblingDiscountApprover Aprovador registrado nos descontos concedidos automaticamente
+const SiblingDiscountApprover = "AUTOMATIC"
+
+// Discount Desconto aplicado sobre o preco do servico da matricula. Descontos fixos sao
+// concedidos por parcela, percentuais sobre o preco total
+type Discount struct {
+	id           uuid.UUID
+	kind         string
+	discountType string
+	value        float64
+	reason       string
+	validFrom    time.Time
+	validUntil   time.Time
+	approvedBy   string
+}
+
+func NewDiscount(
+	kind string,
+	discountType string,
+	value float64,
+	reason string,
+	validFrom string,
+	validUntil string,
+	approvedBy string,
+) (*Discount, error) {
+
+	d := &Discount{
+		id: uuid.New(),
+	}
+
+	err := d.ChangeKind(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.ChangeValue(discountType, value)
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.ChangeReason(reason)
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.ChangeValidity(validFrom, validUntil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.ChangeApprovedBy(approvedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+func LoadDiscount(
+	id string,
+	kind string,
+	discountType string,
+	value float64,
+	reason string,
+	validFrom string,
+	validUntil string,
+	approvedBy string,
+) (*Discount, error) {
+
+	d, err := NewDiscount(kind, discountType, value, reason, validFrom, validUntil, approvedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	discountId, err := uuid.Parse(id)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to change discount id")
+	}
+
+	d.id = discountId
+
+	return d, nil
+}
+
+func (d *Discount) ChangeKind(kind string) error {
+	if kind != DiscountKindScholarship && kind != DiscountKindSibling {
+		return errors.New("invalid discount kind provided")
+	}
+
+	d.kind = kind
+
+	return nil
+}
+
+func (d *Discount) ChangeValue(discountType string, value float64) error {
+	if discountType != DiscountTypePercentage && discountType != DiscountTypeFixed {
+		return errors.New("invalid discount type provided")
+	}
+
+	if value <= 0 {
+		return errors.New("discount value must be greater than zero")
+	}
+
+	if discountType == DiscountTypePercentage && value > 100 {
+		return errors.New("discount percentage cannot be greater than 100")
+	}
+
+	d.discountType = discountType
+	d.value = value
+
+	return nil
+}
+
+func (d *Discount) ChangeReason(reason string) error {
+	if reason == "" {
+		return errors.New("discount reason cannot be empty")
+	}
+
+	d.reason = reason
+
+	return nil
+}
+
+// ChangeValidity Periodo de vigencia do desconto. Sem data final o desconto vale por toda a matricula
+func (d *Discount) ChangeValidity(validFrom string, validUntil string) error {
+	from, err := time.Parse("2006-01-02", validFrom)
+	if err != nil {
+		log.Println(err)
+		return errors.New("invalid discount start date provided")
+	}
+
+	d.validFrom = from
+	d.validUntil = time.Time{}
+
+	if validUntil == "" {
+		return nil
+	}
+
+	until, err := time.Parse("2006-01-02", validUntil)
+	if err != nil {
+		log.Println(err)
+		return errors.New("invalid discount end date provided")
+	}
+
+	if until.Before(from) {
+		return errors.New("discount end date cannot be before start date")
+	}
+
+	d.validUntil = until
+
+	return nil
+}
+
+func (d *Discount) ChangeApprovedBy(approvedBy string) error {
+	if approvedBy == "" {
+		return errors.New("discount approver cannot be empty")
+	}
+
+	d.approvedBy = approvedBy
+
+	return nil
+}
+
+// ValidAt Informa se o desconto esta vigente na data
+func (d *Discount) ValidAt(date time.Time) bool {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	if day.Before(d.validFrom) {
+		return false
+	}
+
+	return d.validUntil.IsZero() || !day.After(d.validUntil)
+}
+
+// Amount Valor do desconto sobre o preco do servico parcelado na quantidade informada, proporcional
+// as parcelas cobertas pela vigencia do desconto
+func (d *Discount) Amount(price float64, installments int, covered int) float64 {
+	amount := d.value * float64(covered)
+
+	if d.discountType == DiscountTypePercentage {
+		amount = price * d.value / 100 * float64(covered) / float64(installments)
+	}
+
+	return math.Round(math.Min(amount, price)*100) / 100
+}
+
+// InstallmentAmount Valor do desconto em cada mensalidade: percentual sobre a mensalidade ou o valor fixo
+func (d *Discount) InstallmentAmount(monthlyFee float64) float64 {
+	amount := d.value
+
+	if d.discountType == DiscountTypePercentage {
+		amount = monthlyFee * d.value / 100
+	}
+
+	return math.Round(math.Min(amount, monthlyFee)*100) / 100
+}
+
+func (d *Discount) Id() uuid.UUID {
+	return d.id
+}
+
+func (d *Discount) Kind() string {
+	return d.kind
+}
+
+func (d *Discount) DiscountType() string {
+	return d.discountType
+}
+
+func (d *Discount) Value() float64 {
+	return d.value
+}
+
+func (d *Discount) Reason() string {
+	return d.reason
+}
+
+func (d *Discount) ValidFrom() time.Time {
+	return d.validFrom
+}
+
+func (d *Discount) ValidUntil() time.Time {
+	return d.validUntil
+}
+
+func (d *Discount) ApprovedBy() string {
+	return d.approvedBy
+}
+
+func (d *Discount) MarshalJSON() ([]byte, error) {
+	validUntil := ""
+	if !d.validUntil.IsZero() {
+		validUntil = d.validUntil.Format("2006-01-02")
+	}
+
+	return json.Marshal(struct {
+		Id           string  `json:"id"`
+		Kind         string  `json:"kind"`
+		DiscountType string  `json:"type"`
+		Value        float64 `json:"value"`
+		Reason       string  `json:"reason"`
+		ValidFrom    string  `json:"valid_from"`
+		ValidUntil   string  `json:"valid_until"`
+		ApprovedBy   string  `json:"approved_by"`
+	}{
+		Id:           d.Id().String(),
+		Kind:         d.Kind(),
+		DiscountType: d.DiscountType(),
+		Value:        d.Value(),
+		Reason:       d.Reason(),
+		ValidFrom:    d.ValidFrom().Format("2006-01-02"),
+		ValidUntil:   validUntil,
+		ApprovedBy:   d.ApprovedBy(),
+	})
+}
+
+// ChangeSchoolYear Ano letivo da turma, que define os vencimentos das mensalidades usados na vigencia dos descontos
+func (r *Registration) ChangeSchoolYear(schoolYear schoolyear.SchoolYear) {
+	r.schoolYear = &schoolYear
+}
+
+// installmentDueDates Vencimentos das mensalidades da matricula no ano letivo. Um desconto vale para
+// as mensalidades que vencem dentro da sua vigencia, tanto na validacao quanto na geracao das cobrancas
+func (r *Registration) installmentDueDates() ([]time.Time, error) {
+	if r.schoolYear == nil {
+		return nil, errors.New("school year is required to apply discounts")
+	}
+
+	return invoice.InstallmentDueDates(r.Billing(), *r.schoolYear)
+}
+
+// coveredInstallments Quantidade de mensalidades que vencem dentro da vigencia do desconto
+func (r *Registration) coveredInstallments(discount Discount) int {
+	dueDates, err := r.installmentDueDates()
+	if err != nil {
+		return r.installmentsQuantity
+	}
+
+	covered := 0
+	for _, dueDate := range dueDates {
+		if discount.ValidAt(dueDate) {
+			covered++
+		}
+	}
+
+	return covered
+}
+
+// AddDiscount Aplica um desconto a matricula. O desconto precisa estar vigente no vencimento de ao menos
+// uma mensalidade, podendo comecar depois da matricula
+func (r *Registration) AddDiscount(discount Discount) error {
+	dueDates, err := r.installmentDueDates()
+	if err != nil {
+		return err
+	}
+
+	valid := false
+	for _, dueDate := range dueDates {
+		if discount.ValidAt(dueDate) {
+			valid = true
+			break
+		}
+	}
+
+	if !valid {
+		return errors.New("discount is not valid for any installment")
+	}
+
+	for _, current := range r.discounts {
+		if current.kind == DiscountKindSibling && discount.kind == DiscountKindSibling {
+			return errors.New("sibling discount already applied")
+		}
+	}
+
+	r.discounts = append(r.discounts, discount)
+
+	return nil
+}
+
+// ApplySiblingDiscount Concede o desconto de irmao do vencimento da primeira mensalidade ate o fim do ano letivo
+func (r *Registration) ApplySiblingDiscount() error {
+	dueDates, err := r.installmentDueDates()
+	if err != nil {
+		return err
+	}
+
+	validFrom := dueDates[0].Format("2006-01-02")
+	validUntil := r.schoolYear.EndAt().Format("2006-01-02")
+
+	discount, err := NewDiscount(
+		DiscountKindSibling,
+		DiscountTypePercentage,
+		SiblingDiscountPercentage,
+		"sibling with active registration",
+		validFrom,
+		validUntil,
+		SiblingDiscountApprover,
+	)
+
+	if err != nil {
+		return err
+	}
+
+	return r.AddDiscount(*discount)
+}
+
+func (r *Registration) Discounts() []Discount {
+	return r.discounts
+}
+
+// LoadDiscounts Restaura os descontos ja persistidos da matricula, sem revalidar a vigencia
+func (r *Registration) LoadDiscounts(discounts []Discount) {
+	r.discounts = discounts
+}
+
+// DiscountTotal Soma dos descontos sobre o preco do servico, limitada ao proprio preco
+func (r *Registration) DiscountTotal() float64 {
+	total := 0.0
+
+	for _, discount := range r.discounts {
+		total += discount.Amount(r.service.Price(), r.installmentsQuantity, r.coveredInstallments(discount))
+	}
+
+	return math.Round(math.Min(total, r.service.Price())*100) / 100
+}
+
+// InstallmentDiscounts Descontos abatidos das mensalidades dentro da vigencia de cada desconto
+func (r *Registration) InstallmentDiscounts() []invoice.InstallmentDiscount {
+	var discounts []invoice.InstallmentDiscount
+
+	for _, discount := range r.discounts {
+		discounts = append(discounts, invoice.InstallmentDiscount{
+			Amount:     discount.InstallmentAmount(r.monthlyFee),
+			ValidFrom:  discount.validFrom,
+			ValidUntil: discount.validUntil,
+		})
+	}
+
+	return discounts
+}
+
+// ApplyDiscounts Aplica as bolsas informadas na matricula e o desconto de irmao quando outro aluno
+// com um mesmo responsavel (CPF) possui matricula ativa. Deve ser chamada antes de Check
+func ApplyDiscounts(uow RegisterUow, reg *Registration, discounts []DiscountRequestDto) error {
+	for _, discountDto := range discounts {
+		discount, err := NewDiscount(
+			DiscountKindScholarship,
+			discountDto.Type,
+			discountDto.Value,
+			discountDto.Reason,
+			discountDto.ValidFrom,
+			discountDto.ValidUntil,
+			discountDto.ApprovedBy,
+		)
+
+		if err != nil {
+			return err
+		}
+
+		err = reg.AddDiscount(*discount)
+		if err != nil {
+			return err
+		}
+	}
+
+	var parentCpfs []string
+	for _, p := range reg.student.Parents() {
+		parentCpfs = append(parentCpfs, string(p.Cpf()))
+	}
+
+	if len(parentCpfs) == 0 {
+		return nil
+	}
+
+	hasSibling, err := uow.HasActiveSiblingRegistration(reg.student.Id(), parentCpfs)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to verify sibling registrations")
+	}
+
+	if !hasSibling {
+		return nil
+	}
+
+	return reg.ApplySiblingDiscount()
+}
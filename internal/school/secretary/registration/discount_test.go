@@ -0,0 +1,182 @@
+package registration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegistrationDiscounts(t *testing.T) {
+	inputData := createInputData()
+	classRoom := getClassRoom()
+	student := getStudent(inputData)
+	today := time.Now().Format("2006-01-02")
+	enrollmentDueDate := time.Now().AddDate(0, 0, 2).Format("2006-01-02")
+
+	schoolYear, _ := schoolyear.New("2023", today, time.Now().AddDate(1, 0, 0).Format("2006-01-02"))
+
+	newRegistration := func(price float64, monthlyFee float64) *Registration {
+		service := getService()
+		_ = service.ChangePrice(price)
+
+		reg, _ := New(
+			classRoom,
+			inputData.Shift,
+			student,
+			service,
+			monthlyFee,
+			inputData.InstallmentsQuantity,
+			inputData.EnrollmentFee,
+			enrollmentDueDate,
+			inputData.MonthDuration,
+			inputData.PaymentDay,
+		)
+
+		reg.ChangeSchoolYear(*schoolYear)
+
+		return reg
+	}
+
+	t.Run("should validate payment against price with scholarship discount", func(t *testing.T) {
+		reg := newRegistration(4800.00, 400.00)
+
+		discount, err := NewDiscount(DiscountKindScholarship, DiscountTypePercentage, 50, "merit scholarship", today, "", "coordinator")
+		assert.NoError(t, err)
+
+		err = reg.AddDiscount(*discount)
+		assert.NoError(t, err)
+		assert.Equal(t, 2400.00, reg.DiscountTotal())
+		assert.NoError(t, reg.Check())
+	})
+
+	t.Run("should bill installments with discounts valid at the due date", func(t *testing.T) {
+		reg := newRegistration(4800.00, 400.00)
+		validUntil := time.Now().AddDate(0, 3, 0)
+
+		discount, _ := NewDiscount(DiscountKindScholarship, DiscountTypePercentage, 50, "merit scholarship", today, validUntil.Format("2006-01-02"), "coordinator")
+		_ = reg.AddDiscount(*discount)
+
+		billing := reg.Billing()
+		assert.Len(t, billing.Discounts, 1)
+		assert.Equal(t, 200.00, billing.Discounts[0].Amount)
+		assert.Equal(t, validUntil.Format("2006-01-02"), billing.Discounts[0].ValidUntil.Format("2006-01-02"))
+	})
+
+	t.Run("should apply fixed discount per installment", func(t *testing.T) {
+		reg := newRegistration(5000.00, 400.00)
+
+		discount, err := NewDiscount(DiscountKindScholarship, DiscountTypeFixed, 16.67, "partner company", today, "", "coordinator")
+		assert.NoError(t, err)
+
+		err = reg.AddDiscount(*discount)
+		assert.NoError(t, err)
+		assert.Equal(t, 200.04, reg.DiscountTotal())
+	})
+
+	t.Run("should reject payment that does not cover discounted price", func(t *testing.T) {
+		reg := newRegistration(5000.00, 200.00)
+
+		discount, _ := NewDiscount(DiscountKindScholarship, DiscountTypePercentage, 40, "merit scholarship", today, "", "coordinator")
+		_ = reg.AddDiscount(*discount)
+
+		err := reg.Check()
+		assert.Error(t, err)
+		assert.Equal(t, "total paid not match with service price", err.Error())
+	})
+
+	t.Run("should reject discount that does not cover any installment", func(t *testing.T) {
+		reg := newRegistration(5000.00, 400.00)
+		validFrom := time.Now().AddDate(2, 0, 0).Format("2006-01-02")
+
+		discount, _ := NewDiscount(DiscountKindScholarship, DiscountTypePercentage, 50, "merit scholarship", validFrom, "", "coordinator")
+
+		err := reg.AddDiscount(*discount)
+		assert.Error(t, err)
+		assert.Equal(t, "discount is not valid for any installment", err.Error())
+	})
+
+	t.Run("should accept scholarship starting later in the school year", func(t *testing.T) {
+		reg := newRegistration(4800.00, 400.00)
+		validFrom := time.Now().AddDate(0, 6, 0).Format("2006-01-02")
+
+		discount, _ := NewDiscount(DiscountKindScholarship, DiscountTypePercentage, 50, "merit scholarship", validFrom, "", "coordinator")
+
+		err := reg.AddDiscount(*discount)
+		assert.NoError(t, err)
+		assert.Less(t, reg.DiscountTotal(), 2400.00)
+		assert.NoError(t, reg.Check())
+	})
+
+	t.Run("should require the school year to apply discounts", func(t *testing.T) {
+		reg := newRegistration(5000.00, 400.00)
+		reg.schoolYear = nil
+
+		discount, _ := NewDiscount(DiscountKindScholarship, DiscountTypePercentage, 50, "merit scholarship", today, "", "coordinator")
+
+		err := reg.AddDiscount(*discount)
+		assert.Error(t, err)
+		assert.Equal(t, "school year is required to apply discounts", err.Error())
+	})
+
+	t.Run("should return error with invalid discount data", func(t *testing.T) {
+		_, err := NewDiscount(DiscountKindScholarship, DiscountTypePercentage, 150, "merit scholarship", today, "", "coordinator")
+		assert.Equal(t, "discount percentage cannot be greater than 100", err.Error())
+
+		_, err = NewDiscount(DiscountKindScholarship, DiscountTypeFixed, 100, "merit scholarship", today, "", "")
+		assert.Equal(t, "discount approver cannot be empty", err.Error())
+
+		yesterday := time.Now().AddDate(0, 0, -1).Format("2006-01-02")
+		_, err = NewDiscount(DiscountKindScholarship, DiscountTypeFixed, 100, "merit scholarship", today, yesterday, "coordinator")
+		assert.Equal(t, "discount end date cannot be before start date", err.Error())
+	})
+
+	t.Run("should apply sibling discount only once", func(t *testing.T) {
+		reg := newRegistration(5000.00, 420.00)
+
+		err := reg.ApplySiblingDiscount()
+		assert.NoError(t, err)
+		assert.Equal(t, 500.00, reg.DiscountTotal())
+		assert.Equal(t, SiblingDiscountApprover, reg.Discounts()[0].ApprovedBy())
+		assert.NoError(t, reg.Check())
+
+		err = reg.ApplySiblingDiscount()
+		assert.Error(t, err)
+		assert.Equal(t, "sibling discount already applied", err.Error())
+		assert.Len(t, reg.Discounts(), 1)
+	})
+
+	t.Run("should keep discounts valid for every installment when enrolling before the school year starts", func(t *testing.T) {
+		start := time.Now().AddDate(0, 3, 0)
+		futureSchoolYear, _ := schoolyear.New("2024", start.Format("2006-01-02"), start.AddDate(0, 11, 0).Format("2006-01-02"))
+
+		reg := newRegistration(5000.00, 420.00)
+		reg.ChangeSchoolYear(*futureSchoolYear)
+
+		err := reg.ApplySiblingDiscount()
+		assert.NoError(t, err)
+
+		scholarship, _ := NewDiscount(DiscountKindScholarship, DiscountTypeFixed, 20, "partner company", start.Format("2006-01-02"), "", "coordinator")
+		err = reg.AddDiscount(*scholarship)
+		assert.NoError(t, err)
+		assert.NoError(t, reg.Check())
+
+		invoices, err := invoice.Generate(reg.Billing(), *futureSchoolYear)
+		assert.NoError(t, err)
+
+		installments := 0
+		for _, inv := range invoices {
+			if inv.Kind() != invoice.KindInstallment {
+				continue
+			}
+
+			installments++
+			assert.Equal(t, 358.00, inv.Value())
+		}
+
+		assert.Equal(t, inputData.InstallmentsQuantity, installments)
+		assert.Equal(t, 740.00, reg.DiscountTotal())
+	})
+}
@@ -0,0 +1,107 @@
+package registration
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
+)
+
+const (
+	ResponsibleTypeStudent = "STUDENT"
+	ResponsibleTypeParent  = "PARENT"
+)
+
+// MinimumResponsibleAge Idade minima para assinar o contrato e responder pelas cobrancas
+const MinimumResponsibleAge = 18
+
+// FinancialResponsible Pessoa a quem o contrato e as cobrancas da matricula sao enderecados: o
+// proprio aluno maior de idade ou um dos seus responsaveis. Nome, CPF e email sao gravados na
+// matricula para que os documentos ja emitidos nao mudem com alteracoes no cadastro
+type FinancialResponsible struct {
+	Id    uuid.UUID `json:"id"`
+	Type  string    `json:"type"`
+	Name  string    `json:"name"`
+	Cpf   string    `json:"cpf"`
+	Email string    `json:"email"`
+}
+
+// ChangeFinancialResponsible Define o responsavel financeiro pelo CPF entre o aluno e seus
+// responsaveis. Sem CPF informado, o aluno que responde por si mesmo assume a responsabilidade
+func (r *Registration) ChangeFinancialResponsible(cpf string) error {
+	if cpf == "" {
+		if !r.student.HimSelfResponsible() {
+			return errors.New("financial responsible is required")
+		}
+
+		if r.student.Cpf() == "" {
+			return errors.New("student without cpf cannot be the financial responsible")
+		}
+
+		cpf = string(r.student.Cpf())
+	}
+
+	document := value_objects.CPF(cpf)
+	err := document.Validate()
+	if err != nil {
+		return errors.New("invalid financial responsible cpf: " + err.Error())
+	}
+
+	if r.student.Cpf() == document {
+		if !ofLegalAge(r.student.BirthDay(), r.enrollmentDate) {
+			return errors.New("student must be of legal age to be the financial responsible")
+		}
+
+		r.financialResponsible = &FinancialResponsible{
+			Id:    r.student.Id(),
+			Type:  ResponsibleTypeStudent,
+			Name:  strings.TrimSpace(r.student.FirstName() + " " + r.student.LastName()),
+			Cpf:   string(document),
+			Email: r.student.Email(),
+		}
+
+		return nil
+	}
+
+	for _, p := range r.student.Parents() {
+		if p.Cpf() != document {
+			continue
+		}
+
+		if !ofLegalAge(p.BirthDay(), r.enrollmentDate) {
+			return errors.New("parent must be of legal age to be the financial responsible")
+		}
+
+		r.financialResponsible = &FinancialResponsible{
+			Id:    p.Id(),
+			Type:  ResponsibleTypeParent,
+			Name:  strings.TrimSpace(p.FirstName() + " " + p.LastName()),
+			Cpf:   string(document),
+			Email: p.Email(),
+		}
+
+		return nil
+	}
+
+	return errors.New("financial responsible must be the student or one of its parents")
+}
+
+// LoadFinancialResponsible Restaura o responsavel financeiro ja gravado na matricula
+func (r *Registration) LoadFinancialResponsible(responsible FinancialResponsible) {
+	r.financialResponsible = &responsible
+}
+
+// FinancialResponsible Matriculas anteriores ao cadastro do responsavel financeiro retornam nil
+func (r *Registration) FinancialResponsible() *FinancialResponsible {
+	return r.financialResponsible
+}
+
+func ofLegalAge(birthDay *time.Time, at time.Time) bool {
+	if birthDay == nil {
+		return false
+	}
+
+	return !birthDay.AddDate(MinimumResponsibleAge, 0, 0).After(at)
+}
@@ -0,0 +1,106 @@
+package registration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/parent"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFinancialResponsible(t *testing.T) {
+	newRegistration := func(data RequestDto) *Registration {
+		reg, _ := New(
+			getClassRoom(),
+			data.Shift,
+			getStudent(data),
+			getService(),
+			data.MonthlyFee,
+			data.InstallmentsQuantity,
+			data.EnrollmentFee,
+			data.EnrollmentDueDate,
+			data.MonthDuration,
+			data.PaymentDay,
+		)
+		return reg
+	}
+
+	t.Run("should define adult student as financial responsible", func(t *testing.T) {
+		reg := newRegistration(createInputData())
+
+		err := reg.ChangeFinancialResponsible("823.781.140-28")
+		assert.NoError(t, err)
+		assert.Equal(t, ResponsibleTypeStudent, reg.FinancialResponsible().Type)
+		assert.Equal(t, reg.Student().Id(), reg.FinancialResponsible().Id)
+		assert.Equal(t, "82378114028", reg.FinancialResponsible().Cpf)
+		assert.Equal(t, "Henrique Rocha", reg.FinancialResponsible().Name)
+	})
+
+	t.Run("should use student responsible for himself when cpf is not provided", func(t *testing.T) {
+		reg := newRegistration(createInputData())
+
+		err := reg.ChangeFinancialResponsible("")
+		assert.NoError(t, err)
+		assert.Equal(t, ResponsibleTypeStudent, reg.FinancialResponsible().Type)
+	})
+
+	t.Run("should define parent as financial responsible", func(t *testing.T) {
+		data := createInputData()
+		data.Student.HimSelfResponsible = false
+		reg := newRegistration(data)
+
+		err := reg.ChangeFinancialResponsible("62449972048")
+		assert.NoError(t, err)
+		assert.Equal(t, ResponsibleTypeParent, reg.FinancialResponsible().Type)
+		assert.Equal(t, reg.Student().Parents()[0].Id(), reg.FinancialResponsible().Id)
+		assert.Equal(t, "parent@mail.com", reg.FinancialResponsible().Email)
+	})
+
+	t.Run("should require financial responsible when student is not responsible for himself", func(t *testing.T) {
+		data := createInputData()
+		data.Student.HimSelfResponsible = false
+		reg := newRegistration(data)
+
+		err := reg.ChangeFinancialResponsible("")
+		assert.Error(t, err)
+		assert.Equal(t, "financial responsible is required", err.Error())
+		assert.Nil(t, reg.FinancialResponsible())
+	})
+
+	t.Run("should refuse minor student as financial responsible", func(t *testing.T) {
+		data := createInputData()
+		data.Student.Birthday = time.Now().AddDate(-17, 0, 0).Format("2006-01-02")
+		reg := newRegistration(data)
+
+		err := reg.ChangeFinancialResponsible("823.781.140-28")
+		assert.Error(t, err)
+		assert.Equal(t, "student must be of legal age to be the financial responsible", err.Error())
+	})
+
+	t.Run("should refuse minor parent as financial responsible", func(t *testing.T) {
+		data := createInputData()
+		data.Student.Parents = []parent.RequestDto{data.Student.Parents[0]}
+		data.Student.Parents[0].BirthDay = time.Now().AddDate(-16, 0, 0).Format("2006-01-02")
+		reg := newRegistration(data)
+
+		err := reg.ChangeFinancialResponsible("624.499.720-48")
+		assert.Error(t, err)
+		assert.Equal(t, "parent must be of legal age to be the financial responsible", err.Error())
+	})
+
+	t.Run("should refuse invalid cpf", func(t *testing.T) {
+		reg := newRegistration(createInputData())
+
+		err := reg.ChangeFinancialResponsible("123.456.789-00")
+		assert.Error(t, err)
+		assert.Equal(t, "invalid financial responsible cpf: invalid cpf", err.Error())
+	})
+
+	t.Run("should refuse person who is not the student or one of its parents", func(t *testing.T) {
+		reg := newRegistration(createInputData())
+
+		err := reg.ChangeFinancialResponsible("529.982.247-25")
+		assert.Error(t, err)
+		assert.Equal(t, "financial responsible must be the student or one of its parents", err.Error())
+	})
+}
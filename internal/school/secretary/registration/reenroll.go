@@ -0,0 +1,64 @@
+package registration
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+)
+
+// Reenroll Gera a matricula do aluno na turma do proximo ano letivo com as mesmas condicoes
+// financeiras, descontos e responsavel financeiro da matricula atual. Os dados do aluno ja foram
+// validados na matricula original
+func (r *Registration) Reenroll(class classroom.ClassRoom, enrollmentDueDate string, reason string) (*Registration, error) {
+	if r.status != StatusApproved {
+		return nil, errors.New("only approved registrations can be renewed")
+	}
+
+	if class.SchoolYearId() == r.class.SchoolYearId() {
+		return nil, errors.New("registration must be renewed in another school year")
+	}
+
+	renewed, err := New(
+		class,
+		string(r.shift),
+		r.student,
+		r.service,
+		r.monthlyFee,
+		r.installmentsQuantity,
+		r.enrollmentFee,
+		enrollmentDueDate,
+		r.monthDuration,
+		r.paymentDay,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, discount := range r.discounts {
+		discount.id = uuid.New()
+		renewed.discounts = append(renewed.discounts, discount)
+	}
+
+	if r.financialResponsible != nil {
+		responsible := *r.financialResponsible
+		renewed.financialResponsible = &responsible
+	}
+
+	err = renewed.checkEnrollment()
+	if err != nil {
+		return nil, err
+	}
+
+	err = renewed.checkPayment()
+	if err != nil {
+		return nil, err
+	}
+
+	renewed.ChangeStatus()
+
+	renewed.recordStatusChange("", renewed.status, reason)
+
+	return renewed, nil
+}
@@ -0,0 +1,67 @@
+package registration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReenroll(t *testing.T) {
+	inputData := createInputData()
+	inputData.EnrollmentDueDate = time.Now().AddDate(0, 0, 2).Format("2006-01-02")
+	srvce, _ := service.New("Ensino Fundamental", inputData.MonthlyFee*float64(inputData.InstallmentsQuantity))
+
+	newRegistration := func() *Registration {
+		reg, _ := New(
+			getClassRoom(),
+			inputData.Shift,
+			getStudent(inputData),
+			*srvce,
+			inputData.MonthlyFee,
+			inputData.InstallmentsQuantity,
+			inputData.EnrollmentFee,
+			inputData.EnrollmentDueDate,
+			inputData.MonthDuration,
+			inputData.PaymentDay,
+		)
+		_ = reg.ChangeFinancialResponsible("823.781.140-28")
+		reg.status = StatusApproved
+		return reg
+	}
+
+	dueDate := time.Now().AddDate(0, 0, 5).Format("2006-01-02")
+
+	t.Run("should create registration in the next school year with the same conditions", func(t *testing.T) {
+		reg := newRegistration()
+		nextClassRoom := getClassRoom()
+
+		renewed, err := reg.Reenroll(nextClassRoom, dueDate, "re-enrollment confirmed")
+		assert.NoError(t, err)
+		assert.NotEqual(t, reg.Id(), renewed.Id())
+		assert.Equal(t, nextClassRoom.Id(), renewed.Class().Id())
+		assert.Equal(t, reg.Student().Id(), renewed.Student().Id())
+		assert.Equal(t, reg.MonthlyFee(), renewed.MonthlyFee())
+		assert.Equal(t, reg.FinancialResponsible().Cpf, renewed.FinancialResponsible().Cpf)
+		assert.Equal(t, StatusWaitEnrollmentFee, renewed.Status())
+		assert.Equal(t, StatusApproved, reg.Status())
+		assert.Len(t, renewed.StatusChanges(), 1)
+		assert.Equal(t, "re-enrollment confirmed", renewed.StatusChanges()[0].Reason)
+	})
+
+	t.Run("should not renew registration that is not approved", func(t *testing.T) {
+		reg := newRegistration()
+		reg.status = StatusWaitEnrollmentFee
+
+		_, err := reg.Reenroll(getClassRoom(), dueDate, "re-enrollment confirmed")
+		assert.EqualError(t, err, "only approved registrations can be renewed")
+	})
+
+	t.Run("should not renew registration in the same school year", func(t *testing.T) {
+		reg := newRegistration()
+
+		_, err := reg.Reenroll(*reg.Class(), dueDate, "re-enrollment confirmed")
+		assert.EqualError(t, err, "registration must be renewed in another school year")
+	})
+}
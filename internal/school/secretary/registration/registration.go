@@ -3,10 +3,13 @@ package registration
 import (
 	"encoding/json"
 	"errors"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/service"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
 	"log"
+	"math"
 	"math/rand"
 	"strconv"
 	"time"
@@ -31,6 +34,10 @@ type Registration struct {
 	enrollmentDate       time.Time
 	paymentDay           string
 	paid                 bool
+	statusChanges        []StatusHistory
+	discounts            []Discount
+	financialResponsible *FinancialResponsible
+	schoolYear           *schoolyear.SchoolYear
 }
 
 func New(class classroom.ClassRoom,
@@ -88,6 +95,58 @@ func New(class classroom.ClassRoom,
 	return r, nil
 }
 
+// Load Reconstroi a matricula persistida sem reaplicar as validacoes de criacao, que nao valem
+// para matriculas anteriores a inclusao de novos campos obrigatorios
+func Load(
+	id string,
+	code string,
+	class classroom.ClassRoom,
+	shift string,
+	student student.Student,
+	service service.Service,
+	monthlyFee float64,
+	installmentsQuantity int,
+	enrollmentFee float64,
+	enrollmentDueDate string,
+	monthDuration int,
+	status string,
+	enrollmentDate string,
+	paymentDay string,
+	paid bool) (*Registration, error) {
+
+	r := &Registration{
+		code:                 code,
+		class:                class,
+		shift:                value_objects.Shift(shift),
+		student:              student,
+		service:              service,
+		monthlyFee:           monthlyFee,
+		installmentsQuantity: installmentsQuantity,
+		enrollmentFee:        enrollmentFee,
+		monthDuration:        monthDuration,
+		status:               status,
+		paymentDay:           paymentDay,
+		paid:                 paid,
+	}
+
+	err := r.ChangeId(id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.ChangeEnrollmentDueDate(enrollmentDueDate)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.ChangeEnrollmentDate(enrollmentDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
 func (r *Registration) Id() uuid.UUID {
 	return r.id
 }
@@ -148,6 +207,23 @@ func (r *Registration) Paid() bool {
 	return r.paid
 }
 
+// Billing Condicoes financeiras da matricula para geracao das cobrancas
+func (r *Registration) Billing() invoice.Billing {
+	return invoice.Billing{
+		RegistrationId:       r.id,
+		StudentId:            r.student.Id(),
+		MonthlyFee:           r.monthlyFee,
+		InstallmentsQuantity: r.installmentsQuantity,
+		EnrollmentFee:        r.enrollmentFee,
+		EnrollmentFeePaid:    r.paid,
+		EnrollmentDueDate:    r.enrollmentDueDate,
+		EnrollmentDate:       r.enrollmentDate,
+		PaymentDay:           r.paymentDay,
+		ChargeRules:          r.service.ChargeRules(),
+		Discounts:            r.InstallmentDiscounts(),
+	}
+}
+
 func (r *Registration) ChangeId(id string) error {
 	if id == "" {
 		return errors.New("registration id cannot be empty")
@@ -301,11 +377,35 @@ func (r *Registration) checkEnrollment() error {
 	return nil
 }
 
+// checkPayment Valida que as parcelas, abatidas das bolsas e descontos vigentes no vencimento de cada uma,
+// cobrem o preco do servico com os mesmos descontos
 func (r *Registration) checkPayment() error {
 
 	total := r.monthlyFee * float64(r.installmentsQuantity)
 
-	if total < r.service.Price() {
+	if len(r.discounts) > 0 {
+		dueDates, err := r.installmentDueDates()
+		if err != nil {
+			return err
+		}
+
+		total = 0
+		for _, dueDate := range dueDates {
+			installment := r.monthlyFee
+			for _, discount := range r.discounts {
+				if discount.ValidAt(dueDate) {
+					installment -= discount.InstallmentAmount(r.monthlyFee)
+				}
+			}
+
+			total += math.Max(installment, 0)
+		}
+	}
+
+	total = math.Round(total*100) / 100
+	discountedPrice := math.Round((r.service.Price()-r.DiscountTotal())*100) / 100
+
+	if total < discountedPrice {
 		return errors.New("total paid not match with service price")
 	}
 
@@ -315,29 +415,32 @@ func (r *Registration) checkPayment() error {
 func (r *Registration) ChangeStatus() {
 
 	if r.enrollmentFee > 0 && !r.paid {
-		r.status = "WAIT_ENROLLMENT_FEE"
+		r.status = StatusWaitEnrollmentFee
+		return
 	}
 
-	r.status = "APPROVED"
+	r.status = StatusApproved
 }
 
 func (r *Registration) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
-		Id                   string              `json:"id"`
-		Code                 string              `json:"code"`
-		Class                classroom.ClassRoom `json:"class"`
-		Shift                string              `json:"shift"`
-		Student              student.Student     `json:"student"`
-		Service              service.Service     `json:"service"`
-		MonthlyFee           float64             `json:"monthly_fee"`
-		InstallmentsQuantity int                 `json:"installments_quantity"`
-		EnrollmentFee        float64             `json:"enrollment_fee"`
-		EnrollmentDueDate    string              `json:"enrollment_due_date"`
-		MonthDuration        int                 `json:"month_duration"`
-		Status               string              `json:"status"`
-		EnrollmentDate       string              `json:"enrollment_date"`
-		PaymentDay           string              `json:"payment_day"`
-		Paid                 bool                `json:"paid"`
+		Id                   string                `json:"id"`
+		Code                 string                `json:"code"`
+		Class                classroom.ClassRoom   `json:"class"`
+		Shift                string                `json:"shift"`
+		Student              student.Student       `json:"student"`
+		Service              service.Service       `json:"service"`
+		MonthlyFee           float64               `json:"monthly_fee"`
+		InstallmentsQuantity int                   `json:"installments_quantity"`
+		EnrollmentFee        float64               `json:"enrollment_fee"`
+		EnrollmentDueDate    string                `json:"enrollment_due_date"`
+		MonthDuration        int                   `json:"month_duration"`
+		Status               string                `json:"status"`
+		EnrollmentDate       string                `json:"enrollment_date"`
+		PaymentDay           string                `json:"payment_day"`
+		Paid                 bool                  `json:"paid"`
+		Discounts            []Discount            `json:"discounts"`
+		FinancialResponsible *FinancialResponsible `json:"financial_responsible"`
 	}{
 		Id:                   r.Id().String(),
 		Code:                 r.Code(),
@@ -354,5 +457,7 @@ func (r *Registration) MarshalJSON() ([]byte, error) {
 		EnrollmentDate:       r.EnrollmentDate().Format("2006-01-02"),
 		PaymentDay:           r.PaymentDay(),
 		Paid:                 r.Paid(),
+		Discounts:            r.Discounts(),
+		FinancialResponsible: r.FinancialResponsible(),
 	})
 }
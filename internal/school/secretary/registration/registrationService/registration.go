@@ -2,16 +2,30 @@ package registrationService
 
 import (
 	"errors"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/delinquency"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/service"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/parent"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/waitinglist/waitingListService"
 
+	"github.com/google/uuid"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
 	"log"
+	"time"
 )
 
 type RegistrationActionsInterface interface {
 	Create(dto registration.RequestDto) (*RegistrationResponse, error)
+	Cancel(id string, dto registration.StatusRequestDto) error
+	Lock(id string, dto registration.StatusRequestDto) error
+	Reactivate(id string, dto registration.StatusRequestDto) error
+	Conclude(id string, dto registration.StatusRequestDto) error
+	Transfer(id string, dto registration.TransferRequestDto) (*RegistrationResponse, error)
+	History(id string) ([]registration.StatusHistory, error)
+	Discounts(id string) ([]registration.Discount, error)
 }
 
 type RegistrationResponse struct {
@@ -19,20 +33,29 @@ type RegistrationResponse struct {
 }
 
 type RegistrationActions struct {
-	serviceRepo     service.Repository
-	classRoomRepo   classroom.Repository
-	registrationUow registration.RegisterUow
+	serviceRepo      service.Repository
+	registrationRepo registration.Repository
+	schoolYearRepo   schoolyear.Repository
+	newUow           registration.RegisterUowFactory
+	waitingList      waitingListService.WaitingListActionsInterface
+	debtPolicy       delinquency.Policy
 }
 
 func NewRegistrationActions(
 	serviceRepo service.Repository,
-	classRoomRepo classroom.Repository,
-	registerUow registration.RegisterUow,
+	registrationRepo registration.Repository,
+	schoolYearRepo schoolyear.Repository,
+	registerUowFactory registration.RegisterUowFactory,
+	waitingList waitingListService.WaitingListActionsInterface,
+	debtPolicy delinquency.Policy,
 ) *RegistrationActions {
 	return &RegistrationActions{
-		serviceRepo:     serviceRepo,
-		classRoomRepo:   classRoomRepo,
-		registrationUow: registerUow,
+		serviceRepo:      serviceRepo,
+		registrationRepo: registrationRepo,
+		schoolYearRepo:   schoolYearRepo,
+		newUow:           registerUowFactory,
+		waitingList:      waitingList,
+		debtPolicy:       debtPolicy,
 	}
 }
 
@@ -44,12 +67,6 @@ func (r *RegistrationActions) Create(dto registration.RequestDto) (*Registration
 		return nil, errors.New("failed to get service information")
 	}
 
-	classRoom, err := r.classRoomRepo.FindById(dto.ClassRoomId)
-	if err != nil || classRoom == nil {
-		log.Println(err)
-		return nil, errors.New("failed to get class room information")
-	}
-
 	student, err := student.New(
 		dto.Student.FirstName,
 		dto.Student.LastName,
@@ -64,6 +81,11 @@ func (r *RegistrationActions) Create(dto registration.RequestDto) (*Registration
 		return nil, err
 	}
 
+	err = student.ChangeDocument(dto.Student.DocumentType, dto.Student.DocumentNumber)
+	if err != nil {
+		return nil, err
+	}
+
 	student.AddAddress(dto.Student.Addresses)
 	student.AddPhones(dto.Student.Phones)
 
@@ -72,42 +94,76 @@ func (r *RegistrationActions) Create(dto registration.RequestDto) (*Registration
 		return nil, err
 	}
 
-	err = r.registrationUow.BeginTransaction()
+	uow := r.newUow()
+
+	err = uow.BeginTransaction()
 	if err != nil {
 		log.Println(err)
 		return nil, errors.New("failed to create registration")
 	}
 
-	studentId, err := r.registrationUow.StudentAlreadyExists(string(student.Cpf()))
+	classRoom, err := uow.FindClassRoomLock(dto.ClassRoomId)
+	if err != nil || classRoom == nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return nil, errors.New("failed to get class room information")
+	}
+
+	err = classRoom.SetOccupiedVacancies(1)
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
 
+	err = r.attachParents(uow, student, dto.Student.Parents)
 	if err != nil {
-		_ = r.registrationUow.Rollback()
+		_ = uow.Rollback()
+		return nil, err
+	}
+
+	var debtOverride string
+
+	studentId, err := uow.StudentAlreadyExists(student.Document())
+
+	if err != nil {
+		_ = uow.Rollback()
 		log.Println(err)
 		return nil, errors.New("failed to verify if student already exists")
 	}
 
 	if studentId == nil {
-		err = r.registrationUow.CreateStudent(*student)
+		err = uow.CreateStudent(*student)
 		if err != nil {
-			_ = r.registrationUow.Rollback()
+			_ = uow.Rollback()
 			log.Println(err)
 			return nil, errors.New("failed to save student")
 		}
 
 	} else {
 
-		studentRegistered, err := r.registrationUow.StudentAlreadyRegisterInClass(*studentId, classRoom.Id())
+		studentRegistered, err := uow.StudentAlreadyRegisterInClass(*studentId, classRoom.Id())
 		if err != nil {
-			_ = r.registrationUow.Rollback()
+			_ = uow.Rollback()
 			log.Println(err)
 			return nil, errors.New("failed to verify if student already registered")
 		}
 
 		if studentRegistered {
-			_ = r.registrationUow.Rollback()
-			log.Println(err)
+			_ = uow.Rollback()
 			return nil, errors.New("student already registered")
 		}
+
+		debtOverride, err = r.checkOverdueDebt(uow, *studentId, dto.DebtOverride)
+		if err != nil {
+			_ = uow.Rollback()
+			return nil, err
+		}
+
+		err = student.ChangeId(studentId.String())
+		if err != nil {
+			_ = uow.Rollback()
+			return nil, err
+		}
 	}
 
 	reg, err := registration.New(
@@ -123,19 +179,70 @@ func (r *RegistrationActions) Create(dto registration.RequestDto) (*Registration
 		dto.PaymentDay,
 	)
 
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
+
+	err = reg.ChangeFinancialResponsible(dto.FinancialResponsibleCpf)
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
+
+	schoolYear, err := r.schoolYearRepo.FindById(classRoom.SchoolYearId().String())
+	if err != nil || schoolYear == nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return nil, errors.New("failed to get school year information")
+	}
+
+	reg.ChangeSchoolYear(*schoolYear)
+
+	err = registration.ApplyDiscounts(uow, reg, dto.Discounts)
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
+
 	err = reg.Check()
 	if err != nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return nil, err
+	}
+
+	if debtOverride != "" {
+		reg.RecordDebtOverride(dto.DebtOverride.ApprovedBy, debtOverride)
+	}
+
+	err = uow.OccupyVacancies(*classRoom)
+	if err != nil {
+		_ = uow.Rollback()
 		log.Println(err)
+		if errors.Is(err, classroom.ErrClassRoomFull) {
+			return nil, err
+		}
+		return nil, errors.New("failed to update occupied vacancies")
+	}
+
+	err = uow.CreateRegister(*reg)
+	if err != nil {
+		_ = uow.Rollback()
 		return nil, err
 	}
 
-	err = r.registrationUow.CreateRegister(*reg)
+	err = r.createInvoices(uow, reg, *schoolYear)
 	if err != nil {
-		_ = r.registrationUow.Rollback()
+		_ = uow.Rollback()
 		return nil, err
 	}
 
-	_ = r.registrationUow.Commit()
+	err = uow.Commit()
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to create registration")
+	}
 
 	registrationResponse := RegistrationResponse{
 		RegistrationCode: reg.Code(),
@@ -143,3 +250,414 @@ func (r *RegistrationActions) Create(dto registration.RequestDto) (*Registration
 
 	return &registrationResponse, nil
 }
+
+// attachParents Vincula ao aluno os responsaveis ja cadastrados: os informados pelo id e os novos
+// cujo CPF ja pertence a um responsavel, evitando duplicar o mesmo responsavel entre irmaos
+func (r *RegistrationActions) attachParents(uow registration.RegisterUow, std *student.Student, parentsDto []parent.RequestDto) error {
+	for _, p := range std.Parents() {
+		existing, err := uow.FindParentByCpf(string(p.Cpf()))
+		if err != nil {
+			log.Println(err)
+			return errors.New("failed to verify if parent already exists")
+		}
+
+		if existing == nil {
+			continue
+		}
+
+		err = std.AttachParent(*existing, p.Relationship())
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, parentDto := range parentsDto {
+		if parentDto.Id == "" {
+			continue
+		}
+
+		existing, err := uow.FindParent(parentDto.Id)
+		if err != nil || existing == nil {
+			log.Println(err)
+			return errors.New("parent " + parentDto.Id + " not found")
+		}
+
+		err = std.AttachParent(*existing, parentDto.Relationship)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkOverdueDebt Aplica a politica de inadimplencia na rematricula de um aluno ja cadastrado. Com
+// debito em atraso, a matricula so prossegue com uma liberacao justificada, cuja justificativa e
+// retornada para o historico da matricula
+func (r *RegistrationActions) checkOverdueDebt(
+	uow registration.RegisterUow,
+	studentId uuid.UUID,
+	override *registration.DebtOverrideRequestDto,
+) (string, error) {
+	if !r.debtPolicy.Enabled {
+		return "", nil
+	}
+
+	debt, err := uow.StudentOverdueDebt(studentId, r.debtPolicy.OverdueBefore(time.Now()))
+	if err != nil {
+		log.Println(err)
+		return "", errors.New("failed to verify student overdue debt")
+	}
+
+	if !r.debtPolicy.Blocks(debt) {
+		return "", nil
+	}
+
+	if override == nil {
+		return "", delinquency.ErrOverdueDebt
+	}
+
+	return r.debtPolicy.Override(debt, override.ApprovedBy, override.ApprovalKey, override.Reason)
+}
+
+func (r *RegistrationActions) Cancel(id string, dto registration.StatusRequestDto) error {
+	return r.changeStatus(id, func(reg *registration.Registration) error {
+		return reg.Cancel(dto.Reason)
+	})
+}
+
+func (r *RegistrationActions) Lock(id string, dto registration.StatusRequestDto) error {
+	return r.changeStatus(id, func(reg *registration.Registration) error {
+		return reg.Lock(dto.Reason)
+	})
+}
+
+func (r *RegistrationActions) Reactivate(id string, dto registration.StatusRequestDto) error {
+	return r.changeStatus(id, func(reg *registration.Registration) error {
+		return reg.Reactivate(dto.Reason)
+	})
+}
+
+func (r *RegistrationActions) Conclude(id string, dto registration.StatusRequestDto) error {
+	return r.changeStatus(id, func(reg *registration.Registration) error {
+		return reg.Conclude(dto.Reason)
+	})
+}
+
+func (r *RegistrationActions) Transfer(id string, dto registration.TransferRequestDto) (*RegistrationResponse, error) {
+	uow := r.newUow()
+
+	err := uow.BeginTransaction()
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to transfer registration")
+	}
+
+	reg, err := uow.FindRegisterLock(id)
+	if err != nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return nil, errors.New("failed to get registration information")
+	}
+
+	targetClassRoomId, err := uuid.Parse(dto.ClassRoomId)
+	if err != nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return nil, errors.New("invalid class room id provided")
+	}
+
+	sourceClassRoom, targetClassRoom, err := r.lockClassRooms(uow, reg.Class().Id(), targetClassRoomId)
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
+
+	studentRegistered, err := uow.StudentAlreadyRegisterInClass(reg.Student().Id(), targetClassRoom.Id())
+	if err != nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return nil, errors.New("failed to verify if student already registered")
+	}
+
+	if studentRegistered {
+		_ = uow.Rollback()
+		return nil, errors.New("student already registered")
+	}
+
+	transferred, err := reg.Transfer(*targetClassRoom, dto.Reason)
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
+
+	err = targetClassRoom.SetOccupiedVacancies(1)
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
+
+	err = uow.OccupyVacancies(*targetClassRoom)
+	if err != nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		if errors.Is(err, classroom.ErrClassRoomFull) {
+			return nil, err
+		}
+		return nil, errors.New("failed to update occupied vacancies")
+	}
+
+	err = r.releaseClassRoomVacancy(uow, sourceClassRoom)
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
+
+	err = uow.UpdateRegisterStatus(*reg)
+	if err != nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return nil, errors.New("failed to update registration status")
+	}
+
+	err = uow.CreateRegister(*transferred)
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
+
+	err = r.moveOpenInvoices(uow, reg, transferred)
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
+
+	err = uow.Commit()
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to transfer registration")
+	}
+
+	r.offerReleasedVacancy(reg.Class().Id())
+
+	return &RegistrationResponse{
+		RegistrationCode: transferred.Code(),
+	}, nil
+}
+
+func (r *RegistrationActions) History(id string) ([]registration.StatusHistory, error) {
+	history, err := r.registrationRepo.FindStatusHistory(id)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to get registration history")
+	}
+
+	return history, nil
+}
+
+func (r *RegistrationActions) Discounts(id string) ([]registration.Discount, error) {
+	discounts, err := r.registrationRepo.FindDiscounts(id)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to get registration discounts")
+	}
+
+	return discounts, nil
+}
+
+// changeStatus Aplica a mudanca de status na matricula dentro de uma transacao, liberando a vaga
+// da turma quando a matricula deixa de ocupa-la. A matricula cancelada tem todas as cobrancas em aberto
+// canceladas e a concluida apenas as que vencem depois da conclusao, as vencidas seguem como debito.
+// A trancada mantem as cobrancas e a transferida as repassa para a nova matricula
+func (r *RegistrationActions) changeStatus(id string, change func(reg *registration.Registration) error) error {
+	uow := r.newUow()
+
+	err := uow.BeginTransaction()
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to change registration status")
+	}
+
+	reg, err := uow.FindRegisterLock(id)
+	if err != nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return errors.New("failed to get registration information")
+	}
+
+	occupiedVacancy := reg.OccupiesVacancy()
+
+	err = change(reg)
+	if err != nil {
+		_ = uow.Rollback()
+		return err
+	}
+
+	if occupiedVacancy && !reg.OccupiesVacancy() {
+		err = r.releaseVacancy(uow, reg)
+		if err != nil {
+			_ = uow.Rollback()
+			return err
+		}
+	}
+
+	switch reg.Status() {
+	case registration.StatusCancelled:
+		err = r.cancelOpenInvoices(uow, reg, time.Time{})
+	case registration.StatusConcluded:
+		year, month, day := time.Now().Date()
+		err = r.cancelOpenInvoices(uow, reg, time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+	}
+
+	if err != nil {
+		_ = uow.Rollback()
+		return err
+	}
+
+	err = uow.UpdateRegisterStatus(*reg)
+	if err != nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return errors.New("failed to update registration status")
+	}
+
+	err = uow.Commit()
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to change registration status")
+	}
+
+	if occupiedVacancy && !reg.OccupiesVacancy() {
+		r.offerReleasedVacancy(reg.Class().Id())
+	}
+
+	return nil
+}
+
+// cancelOpenInvoices Cancela o saldo das cobrancas em aberto ou parcialmente pagas da matricula que
+// vencem depois de dueAfter, retirando-as da inadimplencia, das remessas de boletos e do consumo de creditos
+func (r *RegistrationActions) cancelOpenInvoices(uow registration.RegisterUow, reg *registration.Registration, dueAfter time.Time) error {
+	invoices, err := uow.FindOpenInvoicesLock(reg.Id())
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to get registration invoices")
+	}
+
+	for _, inv := range invoices {
+		if !inv.DueDate().After(dueAfter) {
+			continue
+		}
+
+		err = inv.Cancel()
+		if err != nil {
+			return err
+		}
+
+		err = uow.UpdateInvoiceStatus(inv)
+		if err != nil {
+			log.Println(err)
+			return errors.New("failed to cancel registration invoices")
+		}
+	}
+
+	return nil
+}
+
+// moveOpenInvoices Passa as cobrancas em aberto da matricula transferida para a nova matricula,
+// que passa a responder por elas no cancelamento e na inadimplencia
+func (r *RegistrationActions) moveOpenInvoices(uow registration.RegisterUow, from *registration.Registration, to *registration.Registration) error {
+	invoices, err := uow.FindOpenInvoicesLock(from.Id())
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to get registration invoices")
+	}
+
+	for _, inv := range invoices {
+		inv.ChangeRegistration(to.Id())
+
+		err = uow.UpdateInvoiceRegistration(inv)
+		if err != nil {
+			log.Println(err)
+			return errors.New("failed to move registration invoices")
+		}
+	}
+
+	return nil
+}
+
+// lockClassRooms Bloqueia as turmas de origem e destino da transferencia sempre na ordem dos ids,
+// evitando deadlock entre transferencias simultaneas em sentidos opostos
+func (r *RegistrationActions) lockClassRooms(
+	uow registration.RegisterUow,
+	sourceId uuid.UUID,
+	targetId uuid.UUID,
+) (*classroom.ClassRoom, *classroom.ClassRoom, error) {
+	ids := []uuid.UUID{sourceId, targetId}
+	if targetId.String() < sourceId.String() {
+		ids = []uuid.UUID{targetId, sourceId}
+	}
+
+	locked := make(map[uuid.UUID]*classroom.ClassRoom)
+
+	for _, id := range ids {
+		classRoom, err := uow.FindClassRoomLock(id.String())
+		if err != nil || classRoom == nil {
+			log.Println(err)
+			return nil, nil, errors.New("failed to get class room information")
+		}
+
+		locked[id] = classRoom
+	}
+
+	return locked[sourceId], locked[targetId], nil
+}
+
+func (r *RegistrationActions) releaseVacancy(uow registration.RegisterUow, reg *registration.Registration) error {
+	classRoom, err := uow.FindClassRoomLock(reg.Class().Id().String())
+	if err != nil || classRoom == nil {
+		log.Println(err)
+		return errors.New("failed to get class room information")
+	}
+
+	return r.releaseClassRoomVacancy(uow, classRoom)
+}
+
+func (r *RegistrationActions) releaseClassRoomVacancy(uow registration.RegisterUow, classRoom *classroom.ClassRoom) error {
+	err := classRoom.ReleaseOccupiedVacancies(1)
+	if err != nil {
+		return err
+	}
+
+	err = uow.ReleaseVacancies(*classRoom)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to release class room vacancy")
+	}
+
+	return nil
+}
+
+// createInvoices Gera a taxa de matricula e as mensalidades dentro do periodo do ano letivo da turma
+func (r *RegistrationActions) createInvoices(uow registration.RegisterUow, reg *registration.Registration, schoolYear schoolyear.SchoolYear) error {
+	invoices, err := invoice.Generate(reg.Billing(), schoolYear)
+	if err != nil {
+		return err
+	}
+
+	err = uow.CreateInvoices(invoices)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to create invoices")
+	}
+
+	return nil
+}
+
+// offerReleasedVacancy Oferece a vaga liberada ao proximo da lista de espera. Falhas nao desfazem
+// a operacao que liberou a vaga, a oferta sera refeita na proxima liberacao
+func (r *RegistrationActions) offerReleasedVacancy(classRoomId uuid.UUID) {
+	err := r.waitingList.OfferVacancies(classRoomId.String())
+	if err != nil {
+		log.Println(err)
+	}
+}
@@ -1,21 +1,785 @@
 package registrationService
 
 import (
-	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+	"errors"
 	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/mocks"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/delinquency"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/service"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/parent"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/address"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/phone"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestShouldRegisterStudent(t *testing.T) {
-	// dataInput := createInputData()
-	// serviceRepo := new(mocks)
+	dataInput := createInputData()
+	chargeRules := service.ChargeRules{FinePercentage: 2, DailyInterestPercentage: 0.033, DiscountPercentage: 5, DiscountDaysBefore: 5}
+	srvce, _ := service.Load(dataInput.ServiceId, "Ensino Fundamental", dataInput.MonthlyFee*float64(dataInput.InstallmentsQuantity), chargeRules)
+	classRoom := getClassRoom(dataInput.ClassRoomId, 10, 0)
+
+	serviceRepo := new(mocks.ServiceRepository)
+	serviceRepo.On("FindById", dataInput.ServiceId).Return(srvce, nil)
+
+	schoolYear, _ := schoolyear.New("2023", time.Now().Format("2006-01-02"), time.Now().AddDate(1, 0, 0).Format("2006-01-02"))
+	schoolYearRepo := new(mocks.SchoolYearRepository)
+	schoolYearRepo.On("FindById", classRoom.SchoolYearId().String()).Return(schoolYear, nil)
+
+	var invoices []invoice.Invoice
+
+	uow := new(mocks.RegistrationUowMock)
+	uow.On("BeginTransaction").Return(nil)
+	uow.On("FindClassRoomLock", dataInput.ClassRoomId).Return(classRoom, nil)
+	uow.On("FindParentByCpf", mock.Anything).Return((*parent.Parent)(nil), nil)
+	uow.On("StudentAlreadyExists", mock.Anything).Return((*uuid.UUID)(nil), nil)
+	uow.On("CreateStudent", mock.Anything).Return(nil)
+	uow.On("HasActiveSiblingRegistration", mock.Anything, mock.Anything).Return(false, nil)
+	uow.On("OccupyVacancies", mock.Anything).Return(nil)
+	uow.On("CreateRegister", mock.Anything).Return(nil)
+	uow.On("CreateInvoices", mock.Anything).Run(func(args mock.Arguments) {
+		invoices = args.Get(0).([]invoice.Invoice)
+	}).Return(nil)
+	uow.On("Commit").Return(nil)
+
+	registrationActions := NewRegistrationActions(serviceRepo, new(mocks.RegistrationRepository), schoolYearRepo, func() registration.RegisterUow {
+		return uow
+	}, new(mocks.WaitingListActionsMock), delinquency.Policy{})
+
+	response, err := registrationActions.Create(dataInput)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, response.RegistrationCode)
+	assert.Equal(t, 1, classRoom.OccupiedVacancies())
+	assert.Len(t, invoices, dataInput.InstallmentsQuantity+1)
+	assert.Equal(t, invoice.KindEnrollmentFee, invoices[0].Kind())
+	assert.Equal(t, chargeRules, invoices[1].ChargeRules())
+	uow.AssertCalled(t, "OccupyVacancies", mock.Anything)
+	uow.AssertNotCalled(t, "Rollback")
+}
+
+func TestShouldRegisterStudentWithoutCpf(t *testing.T) {
+	dataInput := createInputData()
+	dataInput.Student.Birthday = time.Now().AddDate(-6, 0, 0).Format("2006-01-02")
+	dataInput.Student.CpfDocument = ""
+	dataInput.Student.DocumentType = value_objects.DocumentBirthCertificate
+	dataInput.Student.DocumentNumber = "104539 01 55 2017 1 00012 021 0012345-99"
+	dataInput.Student.HimSelfResponsible = false
+	dataInput.FinancialResponsibleCpf = "624.499.720-48"
+
+	srvce, _ := service.Load(dataInput.ServiceId, "Ensino Fundamental", dataInput.MonthlyFee*float64(dataInput.InstallmentsQuantity), service.ChargeRules{})
+	classRoom := getClassRoom(dataInput.ClassRoomId, 10, 0)
+
+	serviceRepo := new(mocks.ServiceRepository)
+	serviceRepo.On("FindById", dataInput.ServiceId).Return(srvce, nil)
+
+	schoolYear, _ := schoolyear.New("2023", time.Now().Format("2006-01-02"), time.Now().AddDate(1, 0, 0).Format("2006-01-02"))
+	schoolYearRepo := new(mocks.SchoolYearRepository)
+	schoolYearRepo.On("FindById", classRoom.SchoolYearId().String()).Return(schoolYear, nil)
+
+	var created student.Student
+
+	uow := new(mocks.RegistrationUowMock)
+	uow.On("BeginTransaction").Return(nil)
+	uow.On("FindClassRoomLock", dataInput.ClassRoomId).Return(classRoom, nil)
+	uow.On("FindParentByCpf", mock.Anything).Return((*parent.Parent)(nil), nil)
+	uow.On("StudentAlreadyExists", value_objects.Document{
+		Type:   value_objects.DocumentBirthCertificate,
+		Number: "10453901552017100012021001234599",
+	}).Return((*uuid.UUID)(nil), nil)
+	uow.On("CreateStudent", mock.Anything).Run(func(args mock.Arguments) {
+		created = args.Get(0).(student.Student)
+	}).Return(nil)
+	uow.On("HasActiveSiblingRegistration", mock.Anything, mock.Anything).Return(false, nil)
+	uow.On("OccupyVacancies", mock.Anything).Return(nil)
+	uow.On("CreateRegister", mock.Anything).Return(nil)
+	uow.On("CreateInvoices", mock.Anything).Return(nil)
+	uow.On("Commit").Return(nil)
+
+	registrationActions := NewRegistrationActions(serviceRepo, new(mocks.RegistrationRepository), schoolYearRepo, func() registration.RegisterUow {
+		return uow
+	}, new(mocks.WaitingListActionsMock), delinquency.Policy{})
+
+	response, err := registrationActions.Create(dataInput)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, response.RegistrationCode)
+	assert.Empty(t, created.Cpf())
+	assert.Equal(t, value_objects.DocumentBirthCertificate, created.Document().Type)
+	uow.AssertNotCalled(t, "Rollback")
+}
+
+func TestShouldApplySiblingDiscountOnRegistration(t *testing.T) {
+	dataInput := createInputData()
+	srvce, _ := service.Load(dataInput.ServiceId, "Ensino Fundamental", dataInput.MonthlyFee*float64(dataInput.InstallmentsQuantity), service.ChargeRules{})
+	classRoom := getClassRoom(dataInput.ClassRoomId, 10, 0)
+
+	serviceRepo := new(mocks.ServiceRepository)
+	serviceRepo.On("FindById", dataInput.ServiceId).Return(srvce, nil)
+
+	schoolYear, _ := schoolyear.New("2023", time.Now().Format("2006-01-02"), time.Now().AddDate(1, 0, 0).Format("2006-01-02"))
+	schoolYearRepo := new(mocks.SchoolYearRepository)
+	schoolYearRepo.On("FindById", classRoom.SchoolYearId().String()).Return(schoolYear, nil)
+
+	var reg registration.Registration
+
+	uow := new(mocks.RegistrationUowMock)
+	uow.On("BeginTransaction").Return(nil)
+	uow.On("FindClassRoomLock", dataInput.ClassRoomId).Return(classRoom, nil)
+	uow.On("FindParentByCpf", mock.Anything).Return((*parent.Parent)(nil), nil)
+	uow.On("StudentAlreadyExists", mock.Anything).Return((*uuid.UUID)(nil), nil)
+	uow.On("CreateStudent", mock.Anything).Return(nil)
+	uow.On("HasActiveSiblingRegistration", mock.Anything, []string{"62449972048"}).Return(true, nil)
+	uow.On("OccupyVacancies", mock.Anything).Return(nil)
+	uow.On("CreateRegister", mock.Anything).Run(func(args mock.Arguments) {
+		reg = args.Get(0).(registration.Registration)
+	}).Return(nil)
+	var invoices []invoice.Invoice
+	uow.On("CreateInvoices", mock.Anything).Run(func(args mock.Arguments) {
+		invoices = args.Get(0).([]invoice.Invoice)
+	}).Return(nil)
+	uow.On("Commit").Return(nil)
+
+	registrationActions := NewRegistrationActions(serviceRepo, new(mocks.RegistrationRepository), schoolYearRepo, func() registration.RegisterUow {
+		return uow
+	}, new(mocks.WaitingListActionsMock), delinquency.Policy{})
+
+	_, err := registrationActions.Create(dataInput)
+	assert.NoError(t, err)
+	assert.Len(t, reg.Discounts(), 1)
+	assert.Equal(t, registration.DiscountKindSibling, reg.Discounts()[0].Kind())
+	assert.Equal(t, 480.00, reg.DiscountTotal())
+	assert.Equal(t, invoice.KindEnrollmentFee, invoices[0].Kind())
+	assert.Equal(t, dataInput.EnrollmentFee, invoices[0].Value())
+	assert.Equal(t, 360.00, invoices[1].Value())
+	uow.AssertNotCalled(t, "Rollback")
+}
+
+func TestShouldNotRegisterStudentInFullClassRoom(t *testing.T) {
+	dataInput := createInputData()
+	srvce, _ := service.Load(dataInput.ServiceId, "Ensino Fundamental", dataInput.MonthlyFee*float64(dataInput.InstallmentsQuantity), service.ChargeRules{})
+
+	serviceRepo := new(mocks.ServiceRepository)
+	serviceRepo.On("FindById", dataInput.ServiceId).Return(srvce, nil)
+	schoolYear, _ := schoolyear.New("2023", time.Now().Format("2006-01-02"), time.Now().AddDate(1, 0, 0).Format("2006-01-02"))
+	schoolYearRepo := new(mocks.SchoolYearRepository)
+	schoolYearRepo.On("FindById", mock.Anything).Return(schoolYear, nil)
+
+	t.Run("should reject when class room has no free vacancies", func(t *testing.T) {
+		uow := new(mocks.RegistrationUowMock)
+		uow.On("BeginTransaction").Return(nil)
+		uow.On("FindClassRoomLock", dataInput.ClassRoomId).Return(getClassRoom(dataInput.ClassRoomId, 1, 1), nil)
+		uow.On("Rollback").Return(nil)
+
+		registrationActions := NewRegistrationActions(serviceRepo, new(mocks.RegistrationRepository), schoolYearRepo, func() registration.RegisterUow {
+			return uow
+		}, new(mocks.WaitingListActionsMock), delinquency.Policy{})
+
+		_, err := registrationActions.Create(dataInput)
+		assert.True(t, errors.Is(err, classroom.ErrClassRoomFull))
+		uow.AssertCalled(t, "Rollback")
+		uow.AssertNotCalled(t, "CreateStudent", mock.Anything)
+		uow.AssertNotCalled(t, "CreateRegister", mock.Anything)
+	})
+
+	t.Run("should reject when last vacancy was taken by a concurrent registration", func(t *testing.T) {
+		uow := new(mocks.RegistrationUowMock)
+		uow.On("BeginTransaction").Return(nil)
+		uow.On("FindClassRoomLock", dataInput.ClassRoomId).Return(getClassRoom(dataInput.ClassRoomId, 1, 0), nil)
+		uow.On("FindParentByCpf", mock.Anything).Return((*parent.Parent)(nil), nil)
+		uow.On("StudentAlreadyExists", mock.Anything).Return((*uuid.UUID)(nil), nil)
+		uow.On("CreateStudent", mock.Anything).Return(nil)
+		uow.On("HasActiveSiblingRegistration", mock.Anything, mock.Anything).Return(false, nil)
+		uow.On("OccupyVacancies", mock.Anything).Return(classroom.ErrClassRoomFull)
+		uow.On("Rollback").Return(nil)
+
+		registrationActions := NewRegistrationActions(serviceRepo, new(mocks.RegistrationRepository), schoolYearRepo, func() registration.RegisterUow {
+			return uow
+		}, new(mocks.WaitingListActionsMock), delinquency.Policy{})
+
+		_, err := registrationActions.Create(dataInput)
+		assert.True(t, errors.Is(err, classroom.ErrClassRoomFull))
+		uow.AssertNotCalled(t, "CreateRegister", mock.Anything)
+		uow.AssertNotCalled(t, "Commit")
+	})
+}
+
+func TestShouldBlockRegistrationOfStudentWithOverdueDebt(t *testing.T) {
+	dataInput := createInputData()
+	srvce, _ := service.Load(dataInput.ServiceId, "Ensino Fundamental", dataInput.MonthlyFee*float64(dataInput.InstallmentsQuantity), service.ChargeRules{})
+	studentId := uuid.New()
+	policy := delinquency.Policy{Enabled: true, ToleranceDays: 5, MinimumDebt: 50, Approvers: map[string]string{"coordenacao": "secret"}}
+
+	serviceRepo := new(mocks.ServiceRepository)
+	serviceRepo.On("FindById", dataInput.ServiceId).Return(srvce, nil)
+
+	schoolYear, _ := schoolyear.New("2023", time.Now().Format("2006-01-02"), time.Now().AddDate(1, 0, 0).Format("2006-01-02"))
+	schoolYearRepo := new(mocks.SchoolYearRepository)
+	schoolYearRepo.On("FindById", mock.Anything).Return(schoolYear, nil)
+
+	var reg registration.Registration
+
+	newUow := func(debt float64) *mocks.RegistrationUowMock {
+		uow := new(mocks.RegistrationUowMock)
+		uow.On("BeginTransaction").Return(nil)
+		uow.On("FindClassRoomLock", dataInput.ClassRoomId).Return(getClassRoom(dataInput.ClassRoomId, 10, 0), nil)
+		uow.On("FindParentByCpf", mock.Anything).Return((*parent.Parent)(nil), nil)
+		uow.On("StudentAlreadyExists", mock.Anything).Return(&studentId, nil)
+		uow.On("StudentAlreadyRegisterInClass", studentId, mock.Anything).Return(false, nil)
+		uow.On("StudentOverdueDebt", studentId, mock.Anything).Return(debt, nil)
+		uow.On("HasActiveSiblingRegistration", mock.Anything, mock.Anything).Return(false, nil)
+		uow.On("OccupyVacancies", mock.Anything).Return(nil)
+		uow.On("CreateRegister", mock.Anything).Run(func(args mock.Arguments) {
+			reg = args.Get(0).(registration.Registration)
+		}).Return(nil)
+		uow.On("CreateInvoices", mock.Anything).Return(nil)
+		uow.On("Rollback").Return(nil)
+		uow.On("Commit").Return(nil)
+		return uow
+	}
+
+	t.Run("should refuse registration when student has overdue debt", func(t *testing.T) {
+		uow := newUow(850.00)
+		registrationActions := NewRegistrationActions(serviceRepo, new(mocks.RegistrationRepository), schoolYearRepo, func() registration.RegisterUow {
+			return uow
+		}, new(mocks.WaitingListActionsMock), policy)
+
+		_, err := registrationActions.Create(dataInput)
+		assert.True(t, errors.Is(err, delinquency.ErrOverdueDebt))
+		uow.AssertCalled(t, "Rollback")
+		uow.AssertNotCalled(t, "CreateRegister", mock.Anything)
+	})
+
+	t.Run("should register student with debt below the policy minimum", func(t *testing.T) {
+		uow := newUow(30.00)
+		registrationActions := NewRegistrationActions(serviceRepo, new(mocks.RegistrationRepository), schoolYearRepo, func() registration.RegisterUow {
+			return uow
+		}, new(mocks.WaitingListActionsMock), policy)
+
+		_, err := registrationActions.Create(dataInput)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should refuse override without reason", func(t *testing.T) {
+		uow := newUow(850.00)
+		registrationActions := NewRegistrationActions(serviceRepo, new(mocks.RegistrationRepository), schoolYearRepo, func() registration.RegisterUow {
+			return uow
+		}, new(mocks.WaitingListActionsMock), policy)
+
+		input := createInputData()
+		input.DebtOverride = &registration.DebtOverrideRequestDto{Reason: " ", ApprovedBy: "coordenacao", ApprovalKey: "secret"}
+
+		_, err := registrationActions.Create(input)
+		assert.Error(t, err)
+		assert.Equal(t, "reason is required to override overdue debt block", err.Error())
+		uow.AssertNotCalled(t, "CreateRegister", mock.Anything)
+	})
+
+	t.Run("should refuse override without an authorized approver", func(t *testing.T) {
+		overrides := []*registration.DebtOverrideRequestDto{
+			{Reason: "payment agreement"},
+			{Reason: "payment agreement", ApprovedBy: "secretaria", ApprovalKey: "secret"},
+			{Reason: "payment agreement", ApprovedBy: "coordenacao", ApprovalKey: "wrong"},
+		}
+
+		for _, override := range overrides {
+			uow := newUow(850.00)
+			registrationActions := NewRegistrationActions(serviceRepo, new(mocks.RegistrationRepository), schoolYearRepo, func() registration.RegisterUow {
+				return uow
+			}, new(mocks.WaitingListActionsMock), policy)
+
+			input := createInputData()
+			input.DebtOverride = override
+
+			_, err := registrationActions.Create(input)
+			assert.ErrorIs(t, err, delinquency.ErrUnauthorizedApprover)
+			uow.AssertCalled(t, "Rollback")
+			uow.AssertNotCalled(t, "CreateRegister", mock.Anything)
+		}
+	})
+
+	t.Run("should register student when an authorized approver overrides with a reason", func(t *testing.T) {
+		uow := newUow(850.00)
+		registrationActions := NewRegistrationActions(serviceRepo, new(mocks.RegistrationRepository), schoolYearRepo, func() registration.RegisterUow {
+			return uow
+		}, new(mocks.WaitingListActionsMock), policy)
+
+		input := createInputData()
+		input.DebtOverride = &registration.DebtOverrideRequestDto{Reason: "payment agreement", ApprovedBy: "coordenacao", ApprovalKey: "secret"}
+
+		_, err := registrationActions.Create(input)
+		assert.NoError(t, err)
+		uow.AssertNotCalled(t, "Rollback")
+		assert.Len(t, reg.StatusChanges(), 1)
+		assert.Equal(t, "overdue debt of 850.00 overridden: payment agreement", reg.StatusChanges()[0].Reason)
+		assert.Equal(t, "coordenacao", reg.StatusChanges()[0].ApprovedBy)
+	})
+}
+
+func TestShouldLinkExistingParentsOnRegistration(t *testing.T) {
+	dataInput := createInputData()
+	srvce, _ := service.Load(dataInput.ServiceId, "Ensino Fundamental", dataInput.MonthlyFee*float64(dataInput.InstallmentsQuantity), service.ChargeRules{})
+
+	serviceRepo := new(mocks.ServiceRepository)
+	serviceRepo.On("FindById", dataInput.ServiceId).Return(srvce, nil)
+
+	schoolYear, _ := schoolyear.New("2023", time.Now().Format("2006-01-02"), time.Now().AddDate(1, 0, 0).Format("2006-01-02"))
+	schoolYearRepo := new(mocks.SchoolYearRepository)
+	schoolYearRepo.On("FindById", mock.Anything).Return(schoolYear, nil)
+
+	existing, _ := parent.Load(uuid.New().String(), "Marcha", "Marbule", "1970-05-05", "745698885", "624.499.720-48", "parent@mail.com")
+	father, _ := parent.Load(uuid.New().String(), "Jose", "Marbule", "1968-02-10", "845698885", "529.982.247-25", "father@mail.com")
+
+	var created student.Student
+
+	newUow := func(byCpf *parent.Parent, byId *parent.Parent) *mocks.RegistrationUowMock {
+		uow := new(mocks.RegistrationUowMock)
+		uow.On("BeginTransaction").Return(nil)
+		uow.On("FindClassRoomLock", dataInput.ClassRoomId).Return(getClassRoom(dataInput.ClassRoomId, 10, 0), nil)
+		uow.On("FindParentByCpf", mock.Anything).Return(byCpf, nil)
+		uow.On("FindParent", mock.Anything).Return(byId, nil)
+		uow.On("StudentAlreadyExists", mock.Anything).Return((*uuid.UUID)(nil), nil)
+		uow.On("CreateStudent", mock.Anything).Run(func(args mock.Arguments) {
+			created = args.Get(0).(student.Student)
+		}).Return(nil)
+		uow.On("HasActiveSiblingRegistration", mock.Anything, mock.Anything).Return(false, nil)
+		uow.On("OccupyVacancies", mock.Anything).Return(nil)
+		uow.On("CreateRegister", mock.Anything).Return(nil)
+		uow.On("CreateInvoices", mock.Anything).Return(nil)
+		uow.On("Rollback").Return(nil)
+		uow.On("Commit").Return(nil)
+		return uow
+	}
+
+	t.Run("should reuse parent already registered with the same cpf", func(t *testing.T) {
+		uow := newUow(existing, nil)
+		registrationActions := NewRegistrationActions(serviceRepo, new(mocks.RegistrationRepository), schoolYearRepo, func() registration.RegisterUow {
+			return uow
+		}, new(mocks.WaitingListActionsMock), delinquency.Policy{})
+
+		_, err := registrationActions.Create(dataInput)
+		assert.NoError(t, err)
+		assert.Len(t, created.Parents(), 1)
+		assert.Equal(t, existing.Id(), created.Parents()[0].Id())
+		assert.Equal(t, parent.RelationshipMother, created.Parents()[0].Relationship())
+	})
+
+	t.Run("should attach existing parent informed by id", func(t *testing.T) {
+		uow := newUow(nil, father)
+		registrationActions := NewRegistrationActions(serviceRepo, new(mocks.RegistrationRepository), schoolYearRepo, func() registration.RegisterUow {
+			return uow
+		}, new(mocks.WaitingListActionsMock), delinquency.Policy{})
+
+		input := createInputData()
+		input.Student.Parents = append(input.Student.Parents, parent.RequestDto{
+			Id:           father.Id().String(),
+			Relationship: parent.RelationshipFather,
+		})
+
+		_, err := registrationActions.Create(input)
+		assert.NoError(t, err)
+		assert.Len(t, created.Parents(), 2)
+		assert.Equal(t, father.Id(), created.Parents()[1].Id())
+		assert.Equal(t, parent.RelationshipFather, created.Parents()[1].Relationship())
+	})
+
+	t.Run("should refuse registration with unknown parent id", func(t *testing.T) {
+		uow := newUow(nil, nil)
+		registrationActions := NewRegistrationActions(serviceRepo, new(mocks.RegistrationRepository), schoolYearRepo, func() registration.RegisterUow {
+			return uow
+		}, new(mocks.WaitingListActionsMock), delinquency.Policy{})
+
+		parentId := uuid.New().String()
+		input := createInputData()
+		input.Student.Parents = append(input.Student.Parents, parent.RequestDto{
+			Id:           parentId,
+			Relationship: parent.RelationshipFather,
+		})
+
+		_, err := registrationActions.Create(input)
+		assert.Error(t, err)
+		assert.Equal(t, "parent "+parentId+" not found", err.Error())
+		uow.AssertCalled(t, "Rollback")
+	})
+}
+
+func TestShouldOfferReleasedVacancyToWaitingListOnCancel(t *testing.T) {
+	dataInput := createInputData()
+	srvce, _ := service.Load(dataInput.ServiceId, "Ensino Fundamental", dataInput.MonthlyFee*float64(dataInput.InstallmentsQuantity), service.ChargeRules{})
+	classRoom := getClassRoom(dataInput.ClassRoomId, 1, 1)
+	std, _ := student.New(
+		dataInput.Student.FirstName,
+		dataInput.Student.LastName,
+		dataInput.Student.Birthday,
+		dataInput.Student.RgDocument,
+		dataInput.Student.CpfDocument,
+		dataInput.Student.Email,
+		dataInput.Student.HimSelfResponsible,
+	)
+
+	reg, _ := registration.Load(
+		uuid.New().String(),
+		"2023090112345",
+		*classRoom,
+		dataInput.Shift,
+		*std,
+		*srvce,
+		dataInput.MonthlyFee,
+		dataInput.InstallmentsQuantity,
+		dataInput.EnrollmentFee,
+		dataInput.EnrollmentDueDate,
+		dataInput.MonthDuration,
+		registration.StatusApproved,
+		"2023-09-01",
+		dataInput.PaymentDay,
+		true,
+	)
+
+	uow := new(mocks.RegistrationUowMock)
+	uow.On("BeginTransaction").Return(nil)
+	uow.On("FindRegisterLock", reg.Id().String()).Return(reg, nil)
+	uow.On("FindClassRoomLock", dataInput.ClassRoomId).Return(classRoom, nil)
+	uow.On("ReleaseVacancies", mock.Anything).Return(nil)
+	uow.On("FindOpenInvoicesLock", reg.Id()).Return([]invoice.Invoice{}, nil)
+	uow.On("UpdateRegisterStatus", mock.Anything).Return(nil)
+	uow.On("Commit").Return(nil)
+
+	waitingList := new(mocks.WaitingListActionsMock)
+	waitingList.On("OfferVacancies", dataInput.ClassRoomId).Return(nil)
+
+	registrationActions := NewRegistrationActions(new(mocks.ServiceRepository), new(mocks.RegistrationRepository), new(mocks.SchoolYearRepository), func() registration.RegisterUow {
+		return uow
+	}, waitingList, delinquency.Policy{})
+
+	err := registrationActions.Cancel(reg.Id().String(), registration.StatusRequestDto{Reason: "family moved"})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, classRoom.OccupiedVacancies())
+	waitingList.AssertCalled(t, "OfferVacancies", dataInput.ClassRoomId)
+}
+
+func TestShouldCancelOpenInvoicesOnCancel(t *testing.T) {
+	dataInput := createInputData()
+	srvce, _ := service.Load(dataInput.ServiceId, "Ensino Fundamental", dataInput.MonthlyFee*float64(dataInput.InstallmentsQuantity), service.ChargeRules{})
+	std, _ := student.New(
+		dataInput.Student.FirstName,
+		dataInput.Student.LastName,
+		dataInput.Student.Birthday,
+		dataInput.Student.RgDocument,
+		dataInput.Student.CpfDocument,
+		dataInput.Student.Email,
+		dataInput.Student.HimSelfResponsible,
+	)
+
+	setup := func(updateErr error) (*mocks.RegistrationUowMock, *registration.Registration, *[]invoice.Invoice) {
+		classRoom := getClassRoom(dataInput.ClassRoomId, 10, 1)
+		reg, _ := registration.Load(uuid.New().String(), "2023090112345", *classRoom, dataInput.Shift, *std, *srvce, dataInput.MonthlyFee, dataInput.InstallmentsQuantity, 0, "", dataInput.MonthDuration, registration.StatusApproved, "2023-09-01", dataInput.PaymentDay, true)
+
+		first, _ := invoice.New(reg.Id(), std.Id(), "Mensalidade 11/12", invoice.KindInstallment, 11, 400.00, time.Now().AddDate(0, 1, 0))
+		second, _ := invoice.New(reg.Id(), std.Id(), "Mensalidade 12/12", invoice.KindInstallment, 12, 400.00, time.Now().AddDate(0, 2, 0))
+
+		var updated []invoice.Invoice
+
+		uow := new(mocks.RegistrationUowMock)
+		uow.On("BeginTransaction").Return(nil)
+		uow.On("FindRegisterLock", reg.Id().String()).Return(reg, nil)
+		uow.On("FindClassRoomLock", dataInput.ClassRoomId).Return(classRoom, nil)
+		uow.On("ReleaseVacancies", mock.Anything).Return(nil)
+		uow.On("FindOpenInvoicesLock", reg.Id()).Return([]invoice.Invoice{*first, *second}, nil)
+		uow.On("UpdateInvoiceStatus", mock.Anything).Run(func(args mock.Arguments) {
+			updated = append(updated, args.Get(0).(invoice.Invoice))
+		}).Return(updateErr)
+		uow.On("UpdateRegisterStatus", mock.Anything).Return(nil)
+		uow.On("Rollback").Return(nil)
+		uow.On("Commit").Return(nil)
+
+		return uow, reg, &updated
+	}
+
+	newActions := func(uow *mocks.RegistrationUowMock) *RegistrationActions {
+		waitingList := new(mocks.WaitingListActionsMock)
+		waitingList.On("OfferVacancies", mock.Anything).Return(nil)
+
+		return NewRegistrationActions(new(mocks.ServiceRepository), new(mocks.RegistrationRepository), new(mocks.SchoolYearRepository), func() registration.RegisterUow {
+			return uow
+		}, waitingList, delinquency.Policy{})
+	}
+
+	t.Run("should cancel open invoices with the registration", func(t *testing.T) {
+		uow, reg, updated := setup(nil)
+
+		err := newActions(uow).Cancel(reg.Id().String(), registration.StatusRequestDto{Reason: "family moved"})
+		assert.NoError(t, err)
+		assert.Len(t, *updated, 2)
+		for _, inv := range *updated {
+			assert.Equal(t, invoice.StatusCancelled, inv.Status())
+		}
+		uow.AssertCalled(t, "Commit")
+		uow.AssertNotCalled(t, "Rollback")
+	})
+
+	t.Run("should keep registration active when invoices cannot be cancelled", func(t *testing.T) {
+		uow, reg, _ := setup(errors.New("connection lost"))
+
+		err := newActions(uow).Cancel(reg.Id().String(), registration.StatusRequestDto{Reason: "family moved"})
+		assert.Error(t, err)
+		assert.Equal(t, "failed to cancel registration invoices", err.Error())
+		uow.AssertCalled(t, "Rollback")
+		uow.AssertNotCalled(t, "UpdateRegisterStatus", mock.Anything)
+		uow.AssertNotCalled(t, "Commit")
+	})
+
+	t.Run("should not touch invoices when registration is locked", func(t *testing.T) {
+		uow, reg, _ := setup(nil)
+
+		err := newActions(uow).Lock(reg.Id().String(), registration.StatusRequestDto{Reason: "medical leave"})
+		assert.NoError(t, err)
+		uow.AssertNotCalled(t, "FindOpenInvoicesLock", mock.Anything)
+	})
+}
+
+func TestShouldCancelInvoicesAccordingToRegistrationStatus(t *testing.T) {
+	dataInput := createInputData()
+	srvce, _ := service.Load(dataInput.ServiceId, "Ensino Fundamental", dataInput.MonthlyFee*float64(dataInput.InstallmentsQuantity), service.ChargeRules{})
+	std, _ := student.New(
+		dataInput.Student.FirstName,
+		dataInput.Student.LastName,
+		dataInput.Student.Birthday,
+		dataInput.Student.RgDocument,
+		dataInput.Student.CpfDocument,
+		dataInput.Student.Email,
+		dataInput.Student.HimSelfResponsible,
+	)
+
+	setup := func() (*RegistrationActions, *registration.Registration, map[string]invoice.Invoice) {
+		classRoom := getClassRoom(dataInput.ClassRoomId, 10, 1)
+		reg, _ := registration.Load(uuid.New().String(), "2023090112345", *classRoom, dataInput.Shift, *std, *srvce, dataInput.MonthlyFee, dataInput.InstallmentsQuantity, 0, "", dataInput.MonthDuration, registration.StatusApproved, "2023-09-01", dataInput.PaymentDay, true)
+
+		overdue, _ := invoice.New(reg.Id(), std.Id(), "Mensalidade 10/12", invoice.KindInstallment, 10, 400.00, time.Now().AddDate(0, -1, 0))
+		partiallyPaid, _ := invoice.New(reg.Id(), std.Id(), "Mensalidade 11/12", invoice.KindInstallment, 11, 400.00, time.Now().AddDate(0, 1, 0))
+		_, _, _ = partiallyPaid.Pay(150.00, time.Now())
+		future, _ := invoice.New(reg.Id(), std.Id(), "Mensalidade 12/12", invoice.KindInstallment, 12, 400.00, time.Now().AddDate(0, 2, 0))
+
+		updated := map[string]invoice.Invoice{}
+
+		uow := new(mocks.RegistrationUowMock)
+		uow.On("BeginTransaction").Return(nil)
+		uow.On("FindRegisterLock", reg.Id().String()).Return(reg, nil)
+		uow.On("FindClassRoomLock", dataInput.ClassRoomId).Return(classRoom, nil)
+		uow.On("ReleaseVacancies", mock.Anything).Return(nil)
+		uow.On("FindOpenInvoicesLock", reg.Id()).Return([]invoice.Invoice{*overdue, *partiallyPaid, *future}, nil)
+		uow.On("UpdateInvoiceStatus", mock.Anything).Run(func(args mock.Arguments) {
+			inv := args.Get(0).(invoice.Invoice)
+			updated[inv.Description()] = inv
+		}).Return(nil)
+		uow.On("UpdateRegisterStatus", mock.Anything).Return(nil)
+		uow.On("Commit").Return(nil)
+
+		waitingList := new(mocks.WaitingListActionsMock)
+		waitingList.On("OfferVacancies", mock.Anything).Return(nil)
+
+		return NewRegistrationActions(new(mocks.ServiceRepository), new(mocks.RegistrationRepository), new(mocks.SchoolYearRepository), func() registration.RegisterUow {
+			return uow
+		}, waitingList, delinquency.Policy{}), reg, updated
+	}
+
+	t.Run("should cancel every payable invoice keeping the paid value when registration is cancelled", func(t *testing.T) {
+		actions, reg, updated := setup()
+
+		err := actions.Cancel(reg.Id().String(), registration.StatusRequestDto{Reason: "family moved"})
+		assert.NoError(t, err)
+		assert.Len(t, updated, 3)
+		for _, inv := range updated {
+			assert.Equal(t, invoice.StatusCancelled, inv.Status())
+		}
+		partiallyPaid := updated["Mensalidade 11/12"]
+		assert.Equal(t, 150.00, partiallyPaid.PaidValue())
+	})
+
+	t.Run("should cancel only invoices due after conclusion when registration is concluded", func(t *testing.T) {
+		actions, reg, updated := setup()
+
+		err := actions.Conclude(reg.Id().String(), registration.StatusRequestDto{Reason: "school year finished"})
+		assert.NoError(t, err)
+		assert.Len(t, updated, 2)
+		assert.NotContains(t, updated, "Mensalidade 10/12")
+		for _, inv := range updated {
+			assert.Equal(t, invoice.StatusCancelled, inv.Status())
+		}
+	})
+}
+
+func TestShouldLockClassRoomsInIdOrderOnTransfer(t *testing.T) {
+	dataInput := createInputData()
+	srvce, _ := service.Load(dataInput.ServiceId, "Ensino Fundamental", dataInput.MonthlyFee*float64(dataInput.InstallmentsQuantity), service.ChargeRules{})
+	std, _ := student.New(
+		dataInput.Student.FirstName,
+		dataInput.Student.LastName,
+		dataInput.Student.Birthday,
+		dataInput.Student.RgDocument,
+		dataInput.Student.CpfDocument,
+		dataInput.Student.Email,
+		dataInput.Student.HimSelfResponsible,
+	)
+
+	lowerId := "11111111-1111-4111-8111-111111111111"
+	upperId := "99999999-9999-4999-8999-999999999999"
+
+	transfer := func(sourceId string, targetId string) []string {
+		sourceClassRoom := getClassRoom(sourceId, 10, 1)
+		targetClassRoom := getClassRoom(targetId, 10, 0)
+
+		reg, _ := registration.Load(uuid.New().String(), "2023090112345", *sourceClassRoom, dataInput.Shift, *std, *srvce, dataInput.MonthlyFee, dataInput.InstallmentsQuantity, 0, "", dataInput.MonthDuration, registration.StatusApproved, "2023-09-01", dataInput.PaymentDay, true)
+
+		var locked []string
+
+		uow := new(mocks.RegistrationUowMock)
+		uow.On("BeginTransaction").Return(nil)
+		uow.On("FindRegisterLock", reg.Id().String()).Return(reg, nil)
+		uow.On("FindClassRoomLock", sourceId).Run(func(args mock.Arguments) {
+			locked = append(locked, args.String(0))
+		}).Return(sourceClassRoom, nil)
+		uow.On("FindClassRoomLock", targetId).Run(func(args mock.Arguments) {
+			locked = append(locked, args.String(0))
+		}).Return(targetClassRoom, nil)
+		uow.On("StudentAlreadyRegisterInClass", mock.Anything, mock.Anything).Return(false, nil)
+		uow.On("OccupyVacancies", mock.Anything).Return(nil)
+		uow.On("ReleaseVacancies", mock.Anything).Return(nil)
+		uow.On("UpdateRegisterStatus", mock.Anything).Return(nil)
+		uow.On("CreateRegister", mock.Anything).Return(nil)
+		uow.On("FindOpenInvoicesLock", reg.Id()).Return([]invoice.Invoice{}, nil)
+		uow.On("Commit").Return(nil)
+
+		waitingList := new(mocks.WaitingListActionsMock)
+		waitingList.On("OfferVacancies", sourceId).Return(nil)
+
+		registrationActions := NewRegistrationActions(new(mocks.ServiceRepository), new(mocks.RegistrationRepository), new(mocks.SchoolYearRepository), func() registration.RegisterUow {
+			return uow
+		}, waitingList, delinquency.Policy{})
+
+		response, err := registrationActions.Transfer(reg.Id().String(), registration.TransferRequestDto{ClassRoomId: targetId, Reason: "shift change"})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, response.RegistrationCode)
+		assert.Equal(t, 0, sourceClassRoom.OccupiedVacancies())
+		assert.Equal(t, 1, targetClassRoom.OccupiedVacancies())
+
+		return locked
+	}
+
+	assert.Equal(t, []string{lowerId, upperId}, transfer(lowerId, upperId))
+	assert.Equal(t, []string{lowerId, upperId}, transfer(upperId, lowerId))
+}
+
+func TestShouldCancelTransferredInvoicesWithTheNewRegistration(t *testing.T) {
+	dataInput := createInputData()
+	srvce, _ := service.Load(dataInput.ServiceId, "Ensino Fundamental", dataInput.MonthlyFee*float64(dataInput.InstallmentsQuantity), service.ChargeRules{})
+	std, _ := student.New(
+		dataInput.Student.FirstName,
+		dataInput.Student.LastName,
+		dataInput.Student.Birthday,
+		dataInput.Student.RgDocument,
+		dataInput.Student.CpfDocument,
+		dataInput.Student.Email,
+		dataInput.Student.HimSelfResponsible,
+	)
+
+	sourceId := uuid.New().String()
+	targetId := uuid.New().String()
+	sourceClassRoom := getClassRoom(sourceId, 10, 1)
+	targetClassRoom := getClassRoom(targetId, 10, 0)
+
+	reg, _ := registration.Load(uuid.New().String(), "2023090112345", *sourceClassRoom, dataInput.Shift, *std, *srvce, dataInput.MonthlyFee, dataInput.InstallmentsQuantity, 0, "", dataInput.MonthDuration, registration.StatusApproved, "2023-09-01", dataInput.PaymentDay, true)
+
+	first, _ := invoice.New(reg.Id(), std.Id(), "Mensalidade 11/12", invoice.KindInstallment, 11, 400.00, time.Now().AddDate(0, 1, 0))
+	second, _ := invoice.New(reg.Id(), std.Id(), "Mensalidade 12/12", invoice.KindInstallment, 12, 400.00, time.Now().AddDate(0, 2, 0))
+
+	waitingList := new(mocks.WaitingListActionsMock)
+	waitingList.On("OfferVacancies", mock.Anything).Return(nil)
+
+	var transferred registration.Registration
+	var moved []invoice.Invoice
+
+	transferUow := new(mocks.RegistrationUowMock)
+	transferUow.On("BeginTransaction").Return(nil)
+	transferUow.On("FindRegisterLock", reg.Id().String()).Return(reg, nil)
+	transferUow.On("FindClassRoomLock", sourceId).Return(sourceClassRoom, nil)
+	transferUow.On("FindClassRoomLock", targetId).Return(targetClassRoom, nil)
+	transferUow.On("StudentAlreadyRegisterInClass", mock.Anything, mock.Anything).Return(false, nil)
+	transferUow.On("OccupyVacancies", mock.Anything).Return(nil)
+	transferUow.On("ReleaseVacancies", mock.Anything).Return(nil)
+	transferUow.On("UpdateRegisterStatus", mock.Anything).Return(nil)
+	transferUow.On("CreateRegister", mock.Anything).Run(func(args mock.Arguments) {
+		transferred = args.Get(0).(registration.Registration)
+	}).Return(nil)
+	transferUow.On("FindOpenInvoicesLock", reg.Id()).Return([]invoice.Invoice{*first, *second}, nil)
+	transferUow.On("UpdateInvoiceRegistration", mock.Anything).Run(func(args mock.Arguments) {
+		moved = append(moved, args.Get(0).(invoice.Invoice))
+	}).Return(nil)
+	transferUow.On("Commit").Return(nil)
+
+	_, err := NewRegistrationActions(new(mocks.ServiceRepository), new(mocks.RegistrationRepository), new(mocks.SchoolYearRepository), func() registration.RegisterUow {
+		return transferUow
+	}, waitingList, delinquency.Policy{}).Transfer(reg.Id().String(), registration.TransferRequestDto{ClassRoomId: targetId, Reason: "shift change"})
+	assert.NoError(t, err)
+	assert.Len(t, moved, 2)
+	for _, inv := range moved {
+		assert.Equal(t, transferred.Id(), inv.RegistrationId())
+	}
+
+	var cancelled []invoice.Invoice
+
+	cancelUow := new(mocks.RegistrationUowMock)
+	cancelUow.On("BeginTransaction").Return(nil)
+	cancelUow.On("FindRegisterLock", transferred.Id().String()).Return(&transferred, nil)
+	cancelUow.On("FindClassRoomLock", targetId).Return(targetClassRoom, nil)
+	cancelUow.On("ReleaseVacancies", mock.Anything).Return(nil)
+	cancelUow.On("FindOpenInvoicesLock", transferred.Id()).Return(moved, nil)
+	cancelUow.On("UpdateInvoiceStatus", mock.Anything).Run(func(args mock.Arguments) {
+		cancelled = append(cancelled, args.Get(0).(invoice.Invoice))
+	}).Return(nil)
+	cancelUow.On("UpdateRegisterStatus", mock.Anything).Return(nil)
+	cancelUow.On("Commit").Return(nil)
+
+	err = NewRegistrationActions(new(mocks.ServiceRepository), new(mocks.RegistrationRepository), new(mocks.SchoolYearRepository), func() registration.RegisterUow {
+		return cancelUow
+	}, waitingList, delinquency.Policy{}).Cancel(transferred.Id().String(), registration.StatusRequestDto{Reason: "family moved"})
+	assert.NoError(t, err)
+	assert.Len(t, cancelled, 2)
+	for _, inv := range cancelled {
+		assert.Equal(t, invoice.StatusCancelled, inv.Status())
+	}
+}
 
-	// err := registrationActions.Create(dataInput)
+func getClassRoom(id string, vacancies int, occupied int) *classroom.ClassRoom {
+	clr, _ := classroom.Load(
+		id,
+		true,
+		"OPEN",
+		occupied,
+		vacancies,
+		"2023-01-01",
+		"morning",
+		"Jardim",
+		"TUR-001",
+		uuid.New().String(),
+		uuid.New().String(),
+		uuid.New().String(),
+		"ANY",
+		"remote",
+	)
 
+	return clr
 }
 
-func createInputData() registration.RegistrationDto {
+func createInputData() registration.RequestDto {
 
-	addresses := []registration.AddressDto{
+	addresses := []address.RequestDto{
 		{
 			Street:   "Rua dos Bobos",
 			City:     "Marbule",
@@ -25,26 +789,28 @@ func createInputData() registration.RegistrationDto {
 		},
 	}
 
-	phones := []registration.PhoneDto{
+	phones := []phone.RequestDto{
 		{
 			Description: "Pessoal",
 			Phone:       "7199542-3264",
 		},
 	}
 
-	parents := []registration.ParentDto{
+	parents := []parent.RequestDto{
 		{
-			FirstName:   "Marcha",
-			LastName:    "Marbule",
-			BirthDay:    "1970-05-05",
-			Addresses:   addresses,
-			Phones:      phones,
-			RgDocument:  "745698885",
-			CpfDocument: "624.499.720-48",
+			FirstName:    "Marcha",
+			LastName:     "Marbule",
+			BirthDay:     "1970-05-05",
+			Addresses:    addresses,
+			Phones:       phones,
+			RgDocument:   "745698885",
+			CpfDocument:  "624.499.720-48",
+			Email:        "parent@mail.com",
+			Relationship: parent.RelationshipMother,
 		},
 	}
 
-	student := registration.StudentDto{
+	student := student.RequestDto{
 		FirstName:          "Henrique",
 		LastName:           "Rocha",
 		Birthday:           "1987-09-21",
@@ -57,7 +823,7 @@ func createInputData() registration.RegistrationDto {
 		Parents:            parents,
 	}
 
-	registration := registration.RegistrationDto{
+	registration := registration.RequestDto{
 		ClassRoomId:          "76d0f9b7-3d7d-4cfc-892a-94c0704b4deb",
 		Shift:                "morning",
 		Student:              student,
@@ -65,7 +831,7 @@ func createInputData() registration.RegistrationDto {
 		MonthlyFee:           400.00,
 		InstallmentsQuantity: 12,
 		EnrollmentFee:        60.00,
-		EnrollmentDueDate:    "2023-08-30",
+		EnrollmentDueDate:    time.Now().AddDate(0, 0, 2).Format("2006-01-02"),
 		MonthDuration:        12,
 		PaymentDay:           "16",
 	}
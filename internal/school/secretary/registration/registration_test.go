@@ -206,6 +206,167 @@ func TestRegistrationValidation(t *testing.T) {
 	})
 }
 
+func TestLoadRegistration(t *testing.T) {
+	inputData := createInputData()
+	srvce, _ := service.New("Ensino Fundamental", inputData.MonthlyFee*float64(inputData.InstallmentsQuantity))
+
+	t.Run("should load registration created before payment day was required", func(t *testing.T) {
+		id := uuid.New().String()
+		reg, err := Load(id, "2023000001", getClassRoom(), inputData.Shift, getStudent(inputData), *srvce, inputData.MonthlyFee, inputData.InstallmentsQuantity, 0, "", 0, StatusApproved, "2023-02-01", "", true)
+		assert.NoError(t, err)
+		assert.Equal(t, id, reg.Id().String())
+		assert.Equal(t, "2023000001", reg.Code())
+		assert.Equal(t, "", reg.PaymentDay())
+		assert.Equal(t, StatusApproved, reg.Status())
+		assert.True(t, reg.EnrollmentDueDate().IsZero())
+	})
+
+	t.Run("should return error when id is invalid", func(t *testing.T) {
+		reg, err := Load("invalid", "2023000001", getClassRoom(), inputData.Shift, getStudent(inputData), *srvce, inputData.MonthlyFee, inputData.InstallmentsQuantity, 0, "", 0, StatusApproved, "2023-02-01", "10", true)
+		assert.Nil(t, reg)
+		assert.Error(t, err)
+	})
+}
+
+func TestRegistrationLifecycle(t *testing.T) {
+	inputData := createInputData()
+	inputData.EnrollmentDueDate = time.Now().AddDate(0, 0, 2).Format("2006-01-02")
+	srvce, _ := service.New("Ensino Fundamental", inputData.MonthlyFee*float64(inputData.InstallmentsQuantity))
+
+	newRegistration := func() *Registration {
+		reg, err := New(
+			getClassRoom(),
+			inputData.Shift,
+			getStudent(inputData),
+			*srvce,
+			inputData.MonthlyFee,
+			inputData.InstallmentsQuantity,
+			inputData.EnrollmentFee,
+			inputData.EnrollmentDueDate,
+			inputData.MonthDuration,
+			inputData.PaymentDay,
+		)
+		assert.NoError(t, err)
+		assert.NoError(t, reg.Check())
+		return reg
+	}
+
+	t.Run("should wait enrollment fee when fee is not paid", func(t *testing.T) {
+		reg := newRegistration()
+		assert.Equal(t, StatusWaitEnrollmentFee, reg.Status())
+	})
+
+	t.Run("should approve registration without enrollment fee", func(t *testing.T) {
+		data := inputData
+		data.EnrollmentFee = 0
+		reg, err := New(
+			getClassRoom(),
+			data.Shift,
+			getStudent(data),
+			*srvce,
+			data.MonthlyFee,
+			data.InstallmentsQuantity,
+			data.EnrollmentFee,
+			data.EnrollmentDueDate,
+			data.MonthDuration,
+			data.PaymentDay,
+		)
+		assert.NoError(t, err)
+		assert.NoError(t, reg.Check())
+		assert.Equal(t, StatusApproved, reg.Status())
+	})
+
+	t.Run("should cancel registration and record the transition", func(t *testing.T) {
+		reg := newRegistration()
+		err := reg.Cancel("family moved")
+		assert.NoError(t, err)
+		assert.Equal(t, StatusCancelled, reg.Status())
+		assert.False(t, reg.OccupiesVacancy())
+		assert.Len(t, reg.StatusChanges(), 1)
+		assert.Equal(t, StatusWaitEnrollmentFee, reg.StatusChanges()[0].PreviousStatus)
+		assert.Equal(t, StatusCancelled, reg.StatusChanges()[0].Status)
+		assert.Equal(t, "family moved", reg.StatusChanges()[0].Reason)
+	})
+
+	t.Run("should return error when reason is not provided", func(t *testing.T) {
+		reg := newRegistration()
+		err := reg.Cancel("")
+		assert.Error(t, err)
+		assert.Equal(t, "reason for status change cannot be empty", err.Error())
+	})
+
+	t.Run("should lock and reactivate approved registration", func(t *testing.T) {
+		reg := newRegistration()
+		reg.status = StatusApproved
+		assert.NoError(t, reg.Lock("medical leave"))
+		assert.Equal(t, StatusLocked, reg.Status())
+		assert.True(t, reg.OccupiesVacancy())
+		assert.NoError(t, reg.Reactivate("back to school"))
+		assert.Equal(t, StatusApproved, reg.Status())
+		assert.Len(t, reg.StatusChanges(), 2)
+	})
+
+	t.Run("should not lock registration waiting enrollment fee", func(t *testing.T) {
+		reg := newRegistration()
+		err := reg.Lock("any reason")
+		assert.Error(t, err)
+		assert.Equal(t, "registration cannot change from WAIT_ENROLLMENT_FEE to LOCKED", err.Error())
+	})
+
+	t.Run("should not change status of cancelled registration", func(t *testing.T) {
+		reg := newRegistration()
+		assert.NoError(t, reg.Cancel("any reason"))
+		assert.Error(t, reg.Conclude("any reason"))
+		assert.Error(t, reg.Reactivate("any reason"))
+	})
+
+	t.Run("should transfer registration to another class room", func(t *testing.T) {
+		reg := newRegistration()
+		reg.status = StatusApproved
+		responsible := FinancialResponsible{Id: uuid.New(), Type: ResponsibleTypeParent, Name: "Ana Santos", Cpf: "62449972048"}
+		reg.LoadFinancialResponsible(responsible)
+		targetClassRoom := getClassRoom()
+
+		transferred, err := reg.Transfer(targetClassRoom, "shift change")
+		assert.NoError(t, err)
+		assert.Equal(t, StatusTransferred, reg.Status())
+		assert.Equal(t, StatusApproved, transferred.Status())
+		assert.Equal(t, targetClassRoom.Id(), transferred.Class().Id())
+		assert.NotEqual(t, reg.Id(), transferred.Id())
+		assert.Equal(t, reg.MonthlyFee(), transferred.MonthlyFee())
+		assert.Equal(t, &responsible, transferred.FinancialResponsible())
+		assert.Len(t, transferred.StatusChanges(), 1)
+	})
+
+	t.Run("should not transfer registration to the same class room", func(t *testing.T) {
+		reg := newRegistration()
+		reg.status = StatusApproved
+
+		transferred, err := reg.Transfer(*reg.Class(), "shift change")
+		assert.Nil(t, transferred)
+		assert.Error(t, err)
+		assert.Equal(t, StatusApproved, reg.Status())
+	})
+
+	t.Run("should approve registration when enrollment fee is paid", func(t *testing.T) {
+		reg := newRegistration()
+		assert.NoError(t, reg.PayEnrollmentFee())
+		assert.True(t, reg.Paid())
+		assert.Equal(t, StatusApproved, reg.Status())
+		assert.Len(t, reg.StatusChanges(), 1)
+		assert.Equal(t, StatusWaitEnrollmentFee, reg.StatusChanges()[0].PreviousStatus)
+		assert.Equal(t, "enrollment fee paid", reg.StatusChanges()[0].Reason)
+	})
+
+	t.Run("should not pay enrollment fee twice", func(t *testing.T) {
+		reg := newRegistration()
+		assert.NoError(t, reg.PayEnrollmentFee())
+		err := reg.PayEnrollmentFee()
+		assert.Error(t, err)
+		assert.Equal(t, "enrollment fee already paid", err.Error())
+	})
+}
+
 func getService() service.Service {
 	svc, _ := service.New("Ensino Fundamental", 5000.00)
 	return *svc
@@ -267,14 +428,15 @@ func createInputData() RequestDto {
 
 	parents := []parent.RequestDto{
 		{
-			FirstName:   "Marcha",
-			LastName:    "Marbule",
-			BirthDay:    "1970-05-05",
-			Addresses:   addresses,
-			Phones:      phones,
-			RgDocument:  "745698885",
-			CpfDocument: "624.499.720-48",
-			Email:       "parent@mail.com",
+			FirstName:    "Marcha",
+			LastName:     "Marbule",
+			BirthDay:     "1970-05-05",
+			Addresses:    addresses,
+			Phones:       phones,
+			RgDocument:   "745698885",
+			CpfDocument:  "624.499.720-48",
+			Email:        "parent@mail.com",
+			Relationship: parent.RelationshipMother,
 		},
 	}
 
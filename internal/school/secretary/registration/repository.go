@@ -5,4 +5,9 @@ import "github.com/google/uuid"
 type Repository interface {
 	Create(registration Registration) error
 	SearchStudentAlreadyRegistered(studentId uuid.UUID, classRoomId uuid.UUID) (string, error)
+	FindById(id string) (*Registration, error)
+	UpdateStatus(registration Registration) error
+	CreateStatusHistory(history StatusHistory) error
+	FindStatusHistory(registrationId string) ([]StatusHistory, error)
+	FindDiscounts(registrationId string) ([]Discount, error)
 }
@@ -1,16 +1,59 @@
 package registration
 
-import "github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+import (
+	"github.com/go-playground/validator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+)
 
 type RequestDto struct {
-	ClassRoomId          string             `json:"class_room_id"`
-	Shift                string             `json:"shift"`
-	Student              student.RequestDto `json:"student"`
-	ServiceId            string             `json:"service_id"`
-	MonthlyFee           float64            `json:"monthly_fee"`
-	InstallmentsQuantity int                `json:"installments_quantity"`
-	EnrollmentFee        float64            `json:"enrollment_due_date"`
-	EnrollmentDueDate    string             `json:"due_date"`
-	MonthDuration        int                `json:"month_duration"`
-	PaymentDay           string             `json:"payment_day"`
+	ClassRoomId             string                  `json:"class_room_id"`
+	Shift                   string                  `json:"shift"`
+	Student                 student.RequestDto      `json:"student"`
+	ServiceId               string                  `json:"service_id"`
+	MonthlyFee              float64                 `json:"monthly_fee"`
+	InstallmentsQuantity    int                     `json:"installments_quantity"`
+	EnrollmentFee           float64                 `json:"enrollment_due_date"`
+	EnrollmentDueDate       string                  `json:"due_date"`
+	MonthDuration           int                     `json:"month_duration"`
+	PaymentDay              string                  `json:"payment_day"`
+	Discounts               []DiscountRequestDto    `json:"discounts"`
+	DebtOverride            *DebtOverrideRequestDto `json:"debt_override"`
+	FinancialResponsibleCpf string                  `json:"financial_responsible_cpf"`
+}
+
+// DiscountRequestDto Bolsa ou desconto concedido na matricula. Type: PERCENTAGE ou FIXED (valor por parcela)
+type DiscountRequestDto struct {
+	Type       string  `json:"type" validate:"required,oneof=PERCENTAGE FIXED"`
+	Value      float64 `json:"value" validate:"required,gt=0"`
+	Reason     string  `json:"reason" validate:"required"`
+	ValidFrom  string  `json:"valid_from" validate:"required"`
+	ValidUntil string  `json:"valid_until"`
+	ApprovedBy string  `json:"approved_by" validate:"required"`
+}
+
+// DebtOverrideRequestDto Liberacao justificada da matricula de aluno com debito em atraso. O aprovador
+// se identifica com a chave configurada para ele em DELINQUENCY_OVERRIDE_APPROVERS
+type DebtOverrideRequestDto struct {
+	Reason      string `json:"reason" validate:"required"`
+	ApprovedBy  string `json:"approved_by" validate:"required"`
+	ApprovalKey string `json:"approval_key" validate:"required"`
+}
+
+type StatusRequestDto struct {
+	Reason string `json:"reason" validate:"required"`
+}
+
+func (s *StatusRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(s)
+}
+
+type TransferRequestDto struct {
+	ClassRoomId string `json:"class_room_id" validate:"required,uuid"`
+	Reason      string `json:"reason" validate:"required"`
+}
+
+func (t *TransferRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(t)
 }
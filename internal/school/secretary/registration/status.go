@@ -0,0 +1,183 @@
+package registration
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+)
+
+const (
+	StatusWaitEnrollmentFee = "WAIT_ENROLLMENT_FEE"
+	StatusApproved          = "APPROVED"
+	StatusLocked            = "LOCKED"
+	StatusTransferred       = "TRANSFERRED"
+	StatusCancelled         = "CANCELLED"
+	StatusConcluded         = "CONCLUDED"
+)
+
+// allowedTransitions Mapa com os status que uma matricula pode assumir a partir do status atual
+var allowedTransitions = map[string][]string{
+	StatusWaitEnrollmentFee: {StatusApproved, StatusCancelled},
+	StatusApproved:          {StatusLocked, StatusTransferred, StatusCancelled, StatusConcluded},
+	StatusLocked:            {StatusApproved, StatusCancelled},
+}
+
+type StatusHistory struct {
+	Id             uuid.UUID `json:"id"`
+	RegistrationId uuid.UUID `json:"registration_id"`
+	PreviousStatus string    `json:"previous_status"`
+	Status         string    `json:"status"`
+	Reason         string    `json:"reason"`
+	ApprovedBy     string    `json:"approved_by"`
+	ClassRoomId    uuid.UUID `json:"class_room_id"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+func (r *Registration) StatusChanges() []StatusHistory {
+	return r.statusChanges
+}
+
+// OccupiesVacancy Informa se a matricula ainda ocupa uma vaga na turma
+func (r *Registration) OccupiesVacancy() bool {
+	return r.status == StatusWaitEnrollmentFee ||
+		r.status == StatusApproved ||
+		r.status == StatusLocked
+}
+
+func (r *Registration) Cancel(reason string) error {
+	return r.changeStatusTo(StatusCancelled, reason)
+}
+
+func (r *Registration) Lock(reason string) error {
+	return r.changeStatusTo(StatusLocked, reason)
+}
+
+func (r *Registration) Reactivate(reason string) error {
+	if r.status != StatusLocked {
+		return errors.New("only locked registrations can be reactivated")
+	}
+
+	return r.changeStatusTo(StatusApproved, reason)
+}
+
+func (r *Registration) Conclude(reason string) error {
+	return r.changeStatusTo(StatusConcluded, reason)
+}
+
+// Transfer Encerra a matricula atual como transferida e retorna uma nova matricula
+// com as mesmas condicoes financeiras na turma de destino
+func (r *Registration) Transfer(class classroom.ClassRoom, reason string) (*Registration, error) {
+	if class.Id() == r.class.Id() {
+		return nil, errors.New("registration already belongs to this class room")
+	}
+
+	activeStatus := r.status
+
+	err := r.changeStatusTo(StatusTransferred, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	transferred := &Registration{
+		id:                   uuid.New(),
+		class:                class,
+		shift:                r.shift,
+		student:              r.student,
+		service:              r.service,
+		monthlyFee:           r.monthlyFee,
+		installmentsQuantity: r.installmentsQuantity,
+		enrollmentFee:        r.enrollmentFee,
+		enrollmentDueDate:    r.enrollmentDueDate,
+		monthDuration:        r.monthDuration,
+		enrollmentDate:       time.Now(),
+		paymentDay:           r.paymentDay,
+		paid:                 r.paid,
+	}
+
+	for _, discount := range r.discounts {
+		discount.id = uuid.New()
+		transferred.discounts = append(transferred.discounts, discount)
+	}
+
+	if r.financialResponsible != nil {
+		responsible := *r.financialResponsible
+		transferred.financialResponsible = &responsible
+	}
+
+	transferred.GenerateCode()
+	transferred.recordStatusChange("", activeStatus, reason)
+	transferred.status = activeStatus
+
+	return transferred, nil
+}
+
+func (r *Registration) changeStatusTo(status string, reason string) error {
+	if reason == "" {
+		return errors.New("reason for status change cannot be empty")
+	}
+
+	if !r.canChangeTo(status) {
+		return errors.New("registration cannot change from " + r.status + " to " + status)
+	}
+
+	r.recordStatusChange(r.status, status, reason)
+	r.status = status
+
+	return nil
+}
+
+func (r *Registration) canChangeTo(status string) bool {
+	for _, allowed := range allowedTransitions[r.status] {
+		if allowed == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Registration) recordStatusChange(previousStatus string, status string, reason string) {
+	r.statusChanges = append(r.statusChanges, StatusHistory{
+		Id:             uuid.New(),
+		RegistrationId: r.id,
+		PreviousStatus: previousStatus,
+		Status:         status,
+		Reason:         reason,
+		ClassRoomId:    r.class.Id(),
+		CreatedAt:      time.Now(),
+	})
+}
+
+// OfferFromWaitingList Define o status inicial da matricula gerada para um candidato da lista de espera
+func (r *Registration) OfferFromWaitingList() {
+	r.ChangeStatus()
+	r.recordStatusChange("", r.status, "vacancy offered from waiting list")
+}
+
+// RecordDebtOverride Registra no historico a liberacao da matricula de um aluno com debito em atraso
+// junto com quem a aprovou
+func (r *Registration) RecordDebtOverride(approvedBy string, reason string) {
+	r.recordStatusChange("", r.status, reason)
+	r.statusChanges[len(r.statusChanges)-1].ApprovedBy = approvedBy
+}
+
+// PayEnrollmentFee Marca a taxa de matricula como paga, aprovando a matricula que aguardava o pagamento
+func (r *Registration) PayEnrollmentFee() error {
+	if r.paid {
+		return errors.New("enrollment fee already paid")
+	}
+
+	r.paid = true
+
+	if r.status != StatusWaitEnrollmentFee {
+		return nil
+	}
+
+	previousStatus := r.status
+	r.ChangeStatus()
+	r.recordStatusChange(previousStatus, r.status, "enrollment fee paid")
+
+	return nil
+}
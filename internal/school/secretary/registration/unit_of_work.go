@@ -1,8 +1,15 @@
 package registration
 
 import (
+	"time"
+
 	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/parent"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/waitinglist"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
 )
 
 type RegisterUow interface {
@@ -11,6 +18,26 @@ type RegisterUow interface {
 	Rollback() error
 	CreateStudent(student student.Student) error
 	CreateRegister(register Registration) error
-	StudentAlreadyExists(cpf string) (*uuid.UUID, error)
+	StudentAlreadyExists(document value_objects.Document) (*uuid.UUID, error)
+	FindParent(id string) (*parent.Parent, error)
+	FindParentByCpf(cpf string) (*parent.Parent, error)
 	StudentAlreadyRegisterInClass(studentId uuid.UUID, classRoomId uuid.UUID) (bool, error)
+	FindRegisterLock(id string) (*Registration, error)
+	UpdateRegisterStatus(register Registration) error
+	FindClassRoomLock(id string) (*classroom.ClassRoom, error)
+	OccupyVacancies(classRoom classroom.ClassRoom) error
+	ReleaseVacancies(classRoom classroom.ClassRoom) error
+	CreateWaitingCandidate(candidate waitinglist.Candidate) error
+	FindNextWaitingCandidateLock(classRoomId uuid.UUID) (*waitinglist.Candidate, error)
+	UpdateWaitingCandidate(candidate waitinglist.Candidate) error
+	CreateInvoices(invoices []invoice.Invoice) error
+	FindOpenInvoicesLock(registrationId uuid.UUID) ([]invoice.Invoice, error)
+	UpdateInvoiceStatus(inv invoice.Invoice) error
+	UpdateInvoiceRegistration(inv invoice.Invoice) error
+	HasActiveSiblingRegistration(studentId uuid.UUID, parentCpfs []string) (bool, error)
+	StudentOverdueDebt(studentId uuid.UUID, dueBefore time.Time) (float64, error)
 }
+
+// RegisterUowFactory Cria uma nova unidade de trabalho para cada operacao,
+// evitando que requisicoes concorrentes compartilhem a mesma transacao
+type RegisterUowFactory func() RegisterUow
@@ -0,0 +1,99 @@
+package schedule
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// AgendaEntry Ocupacao de um horario na agenda semanal. Horarios sem aula trazem apenas a descricao
+type AgendaEntry struct {
+	ScheduleId  uuid.UUID `json:"schedule_id"`
+	Description string    `json:"description"`
+	ClassRoom   string    `json:"class_room,omitempty"`
+	Subject     string    `json:"subject,omitempty"`
+	Teacher     string    `json:"teacher,omitempty"`
+	Room        string    `json:"room,omitempty"`
+}
+
+// AgendaRow Intervalo de horario da agenda com as ocupacoes de cada dia da semana. Days e indexado
+// pelo dia da semana (0 domingo a 6 sabado)
+type AgendaRow struct {
+	StartAt string          `json:"start_at"`
+	EndAt   string          `json:"end_at"`
+	Days    [][]AgendaEntry `json:"days"`
+}
+
+// Agenda Grade semanal (dia x horario) de uma sala ou turma
+type Agenda struct {
+	Weekdays []int       `json:"weekdays"`
+	Rows     []AgendaRow `json:"rows"`
+}
+
+func NewAgenda() *Agenda {
+	return &Agenda{
+		Weekdays: []int{},
+		Rows:     []AgendaRow{},
+	}
+}
+
+// AddSchedule Inclui o horario em todos os seus dias da semana
+func (a *Agenda) AddSchedule(schedule ScheduleClass) {
+	for _, weekday := range schedule.Weekdays() {
+		a.Add(weekday, schedule.StartAt(), schedule.EndAt(), AgendaEntry{
+			ScheduleId:  schedule.Id(),
+			Description: schedule.Description(),
+		})
+	}
+}
+
+// Add Inclui a ocupacao no dia e horario. Uma aula substitui o mesmo horario ja incluido sem aula
+func (a *Agenda) Add(weekday int, startAt string, endAt string, entry AgendaEntry) {
+	row := a.row(startAt, endAt)
+	a.addWeekday(weekday)
+
+	for i, current := range row.Days[weekday] {
+		if current.ScheduleId == entry.ScheduleId && current.ClassRoom == "" {
+			row.Days[weekday][i] = entry
+			return
+		}
+	}
+
+	row.Days[weekday] = append(row.Days[weekday], entry)
+}
+
+func (a *Agenda) row(startAt string, endAt string) *AgendaRow {
+	for i := range a.Rows {
+		if a.Rows[i].StartAt == startAt && a.Rows[i].EndAt == endAt {
+			return &a.Rows[i]
+		}
+	}
+
+	days := make([][]AgendaEntry, 7)
+	for i := range days {
+		days[i] = []AgendaEntry{}
+	}
+
+	a.Rows = append(a.Rows, AgendaRow{StartAt: startAt, EndAt: endAt, Days: days})
+
+	sort.SliceStable(a.Rows, func(i, j int) bool {
+		if a.Rows[i].StartAt != a.Rows[j].StartAt {
+			return a.Rows[i].StartAt < a.Rows[j].StartAt
+		}
+
+		return a.Rows[i].EndAt < a.Rows[j].EndAt
+	})
+
+	return a.row(startAt, endAt)
+}
+
+func (a *Agenda) addWeekday(weekday int) {
+	for _, day := range a.Weekdays {
+		if day == weekday {
+			return
+		}
+	}
+
+	a.Weekdays = append(a.Weekdays, weekday)
+	sort.Ints(a.Weekdays)
+}
@@ -1,6 +1,9 @@
 package schedule
 
-import "github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+import (
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+)
 
 type Repository interface {
 	Create(schedule ScheduleClass) error
@@ -8,5 +11,8 @@ type Repository interface {
 	Update(schedule ScheduleClass) error
 	FindById(id string) (*ScheduleClass, error)
 	FindAll(paginator paginator.Pagination) (*paginator.PaginationResult, error)
+	FindBySchoolYear(schoolYearId uuid.UUID) ([]ScheduleClass, error)
+	FindByRoom(roomId uuid.UUID, schoolYearId uuid.UUID) ([]ScheduleClass, error)
+	FindRoomIds(scheduleId uuid.UUID, schoolYearId uuid.UUID) ([]uuid.UUID, error)
 	SyncSchedule(scheduleDto RoomScheduleDto) error
 }
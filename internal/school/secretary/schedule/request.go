@@ -9,6 +9,7 @@ type Request struct {
 	Description string `json:"description" validate:"omitempty"`
 	InitialTime string `json:"initial_time" validate:"required,time"`
 	FinalTime   string `json:"final_time" validate:"required,time"`
+	Weekdays    []int  `json:"weekdays" validate:"omitempty,dive,min=0,max=6"`
 	SchoolYear  string `json:"school_year" validate:"required"`
 }
 
@@ -5,22 +5,34 @@ import (
 	"errors"
 	"log"
 	"regexp"
+	"sort"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// DefaultWeekdays Dias do horario quando nao informados: segunda a sexta
+var DefaultWeekdays = []int{
+	int(time.Monday),
+	int(time.Tuesday),
+	int(time.Wednesday),
+	int(time.Thursday),
+	int(time.Friday),
+}
+
 type ScheduleClass struct {
 	id          uuid.UUID
 	description string
 	startAt     string
 	endAt       string
+	weekdays    []int
 	schoolYear  uuid.UUID
 }
 
 func New(description string, initialTime string, finalTime string, schoolYearId string) (*ScheduleClass, error) {
 	s := &ScheduleClass{
-		id: uuid.New(),
+		id:       uuid.New(),
+		weekdays: DefaultWeekdays,
 	}
 
 	err := s.ChangeDescription(description)
@@ -41,7 +53,7 @@ func New(description string, initialTime string, finalTime string, schoolYearId
 	return s, nil
 }
 
-func Load(id string, description string, initialTime string, finalTime string, schoolYearId string) (*ScheduleClass, error) {
+func Load(id string, description string, initialTime string, finalTime string, weekdays []int, schoolYearId string) (*ScheduleClass, error) {
 	schedule, err := New(description, initialTime, finalTime, schoolYearId)
 	if err != nil {
 		return nil, err
@@ -49,6 +61,11 @@ func Load(id string, description string, initialTime string, finalTime string, s
 
 	_ = schedule.ChangeId(id)
 
+	err = schedule.ChangeWeekdays(weekdays)
+	if err != nil {
+		return nil, err
+	}
+
 	return schedule, nil
 }
 
@@ -64,6 +81,36 @@ func (s *ScheduleClass) EndAt() string {
 	return s.endAt
 }
 
+func (s *ScheduleClass) Weekdays() []int {
+	return s.weekdays
+}
+
+// HasWeekday Informa se o horario acontece no dia da semana
+func (s *ScheduleClass) HasWeekday(weekday int) bool {
+	for _, day := range s.weekdays {
+		if day == weekday {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Overlaps Informa se os horarios acontecem em um mesmo dia da semana com intervalos em comum
+func (s *ScheduleClass) Overlaps(other ScheduleClass) bool {
+	if s.startAt >= other.endAt || other.startAt >= s.endAt {
+		return false
+	}
+
+	for _, day := range other.weekdays {
+		if s.HasWeekday(day) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (s *ScheduleClass) SchoolYearId() uuid.UUID {
 	return s.schoolYear
 }
@@ -126,11 +173,11 @@ func (s *ScheduleClass) ChangePeriod(initialTime string, finalTime string) error
 		return err
 	}
 
-	t1, err := time.Parse("15:04:05", initialTime)
+	t1, err := s.parseTime(initialTime)
 	if err != nil {
 		return err
 	}
-	t2, err := time.Parse("15:04:05", finalTime)
+	t2, err := s.parseTime(finalTime)
 	if err != nil {
 		return err
 	}
@@ -146,8 +193,37 @@ func (s *ScheduleClass) ChangePeriod(initialTime string, finalTime string) error
 	return nil
 }
 
+// ChangeWeekdays Altera os dias da semana do horario. Os dias seguem time.Weekday (0 domingo a
+// 6 sabado); repetidos sao ignorados
+func (s *ScheduleClass) ChangeWeekdays(weekdays []int) error {
+	if len(weekdays) == 0 {
+		return errors.New("schedule must have at least one weekday")
+	}
+
+	var days []int
+	seen := map[int]bool{}
+
+	for _, day := range weekdays {
+		if day < int(time.Sunday) || day > int(time.Saturday) {
+			return errors.New("invalid weekday provided")
+		}
+
+		if seen[day] {
+			continue
+		}
+
+		seen[day] = true
+		days = append(days, day)
+	}
+
+	sort.Ints(days)
+	s.weekdays = days
+
+	return nil
+}
+
 func (s *ScheduleClass) validateTime(hour string) error {
-	regex, _ := regexp.Compile("^(0[0-9]|1[0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9]$")
+	regex, _ := regexp.Compile("^(0[0-9]|1[0-9]|2[0-3]):[0-5][0-9](:[0-5][0-9])?$")
 	if !regex.MatchString(hour) {
 		return errors.New("invalid time schedule provided")
 	}
@@ -155,18 +231,29 @@ func (s *ScheduleClass) validateTime(hour string) error {
 	return nil
 }
 
+// parseTime Aceita horarios com ou sem segundos, como enviados pela requisicao (15:04)
+func (s *ScheduleClass) parseTime(hour string) (time.Time, error) {
+	if len(hour) == len("15:04") {
+		return time.Parse("15:04", hour)
+	}
+
+	return time.Parse("15:04:05", hour)
+}
+
 func (s *ScheduleClass) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
 		Id          string `json:"id"`
 		Description string `json:"description"`
 		StartAt     string `json:"start_at"`
 		EndAt       string `json:"end_at"`
+		Weekdays    []int  `json:"weekdays"`
 		SchoolYear  string `json:"school_year_id"`
 	}{
 		Id:          s.Id().String(),
 		Description: s.Description(),
 		StartAt:     s.StartAt(),
 		EndAt:       s.EndAt(),
+		Weekdays:    s.Weekdays(),
 		SchoolYear:  s.SchoolYearId().String(),
 	})
 }
@@ -2,8 +2,11 @@ package scheduleService
 
 import (
 	"errors"
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schedule"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/timetable"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
 	"log"
 )
@@ -15,19 +18,27 @@ type ServiceScheduleInterface interface {
 	FindOne(id string) (*schedule.ScheduleClass, error)
 	FindAll(dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error)
 	SyncSchedule(scheduleRoomDto schedule.RoomScheduleDto) error
+	RoomAgenda(roomId string, schoolYearId string) (*schedule.Agenda, error)
+	ClassRoomAgenda(classRoomId string) (*schedule.Agenda, error)
 }
 
 type ServiceScheduleClass struct {
 	repository           schedule.Repository
 	schoolYearRepository schoolyear.Repository
+	classRoomRepository  classroom.Repository
+	timetableRepository  timetable.Repository
 }
 
 func New(
 	repository schedule.Repository,
-	schoolYearRepo schoolyear.Repository) *ServiceScheduleClass {
+	schoolYearRepo schoolyear.Repository,
+	classRoomRepo classroom.Repository,
+	timetableRepo timetable.Repository) *ServiceScheduleClass {
 	return &ServiceScheduleClass{
 		repository:           repository,
 		schoolYearRepository: schoolYearRepo,
+		classRoomRepository:  classRoomRepo,
+		timetableRepository:  timetableRepo,
 	}
 }
 
@@ -38,6 +49,13 @@ func (s *ServiceScheduleClass) Create(dto schedule.Request) error {
 		return err
 	}
 
+	if len(dto.Weekdays) > 0 {
+		err = scheduleClass.ChangeWeekdays(dto.Weekdays)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = s.repository.Create(*scheduleClass)
 	if err != nil {
 		log.Println(err)
@@ -59,11 +77,23 @@ func (s *ServiceScheduleClass) Update(id string, dto schedule.Request) error {
 		return err
 	}
 
+	if len(dto.Weekdays) > 0 {
+		err = scheduleClass.ChangeWeekdays(dto.Weekdays)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = scheduleClass.ChangeId(id)
 	if err != nil {
 		return err
 	}
 
+	err = s.checkRoomOverlaps(*scheduleClass)
+	if err != nil {
+		return err
+	}
+
 	err = s.repository.Update(*scheduleClass)
 	if err != nil {
 		log.Println(err)
@@ -73,6 +103,38 @@ func (s *ServiceScheduleClass) Update(id string, dto schedule.Request) error {
 	return nil
 }
 
+// checkRoomOverlaps Confere o horario alterado com os demais horarios das salas as quais ele ja
+// esta vinculado, como feito em SyncSchedule
+func (s *ServiceScheduleClass) checkRoomOverlaps(scheduleClass schedule.ScheduleClass) error {
+	current, err := s.repository.FindById(scheduleClass.Id().String())
+	if err != nil || current == nil {
+		log.Println(err)
+		return errors.New("schedule " + scheduleClass.Id().String() + " not found")
+	}
+
+	roomIds, err := s.repository.FindRoomIds(current.Id(), current.SchoolYearId())
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to retrieve schedule rooms")
+	}
+
+	for _, roomId := range roomIds {
+		schedules, err := s.repository.FindByRoom(roomId, current.SchoolYearId())
+		if err != nil {
+			log.Println(err)
+			return errors.New("failed to retrieve room schedules")
+		}
+
+		for _, other := range schedules {
+			if scheduleClass.Id() != other.Id() && scheduleClass.Overlaps(other) {
+				return errors.New("schedules " + other.Description() + " and " + scheduleClass.Description() + " overlap in the room")
+			}
+		}
+	}
+
+	return nil
+}
+
 func (s *ServiceScheduleClass) FindOne(id string) (*schedule.ScheduleClass, error) {
 	scheduleClass, err := s.repository.FindById(id)
 	if err != nil {
@@ -96,7 +158,31 @@ func (s *ServiceScheduleClass) FindAll(dtoRequest paginator.PaginatorRequest) (*
 	return schedules, nil
 }
 
+// SyncSchedule Vincula os horarios a sala no ano letivo. Horarios que acontecem no mesmo dia da
+// semana com intervalos em comum nao podem ocupar a mesma sala
 func (r *ServiceScheduleClass) SyncSchedule(scheduleRoomDto schedule.RoomScheduleDto) error {
+	var schedules []schedule.ScheduleClass
+
+	for _, scheduleId := range scheduleRoomDto.ScheduleIds {
+		scheduleClass, err := r.repository.FindById(scheduleId)
+		if err != nil || scheduleClass == nil {
+			log.Println(err)
+			return errors.New("schedule " + scheduleId + " not found")
+		}
+
+		if scheduleClass.SchoolYearId().String() != scheduleRoomDto.SchoolYear {
+			return errors.New("schedule " + scheduleClass.Description() + " does not belong to the school year provided")
+		}
+
+		for _, other := range schedules {
+			if scheduleClass.Id() != other.Id() && scheduleClass.Overlaps(other) {
+				return errors.New("schedules " + other.Description() + " and " + scheduleClass.Description() + " overlap in the room")
+			}
+		}
+
+		schedules = append(schedules, *scheduleClass)
+	}
+
 	err := r.repository.SyncSchedule(scheduleRoomDto)
 	if err != nil {
 		log.Println(err)
@@ -105,3 +191,104 @@ func (r *ServiceScheduleClass) SyncSchedule(scheduleRoomDto schedule.RoomSchedul
 
 	return nil
 }
+
+// RoomAgenda Agenda semanal da sala: horarios vinculados a sala e aulas da grade nela
+func (r *ServiceScheduleClass) RoomAgenda(roomId string, schoolYearId string) (*schedule.Agenda, error) {
+	room, err := uuid.Parse(roomId)
+	if err != nil {
+		return nil, errors.New("invalid room id provided")
+	}
+
+	schoolYear, err := uuid.Parse(schoolYearId)
+	if err != nil {
+		return nil, errors.New("invalid school year id provided")
+	}
+
+	schedules, err := r.repository.FindByRoom(room, schoolYear)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve room schedules")
+	}
+
+	agenda := schedule.NewAgenda()
+
+	for _, scheduleClass := range schedules {
+		agenda.AddSchedule(scheduleClass)
+	}
+
+	err = r.addLessons(agenda, schoolYear, func(lesson timetable.Lesson) bool {
+		return lesson.RoomId() == room
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return agenda, nil
+}
+
+// ClassRoomAgenda Agenda semanal da turma: periodo da turma e suas aulas da grade
+func (r *ServiceScheduleClass) ClassRoomAgenda(classRoomId string) (*schedule.Agenda, error) {
+	classRoom, err := r.classRoomRepository.FindById(classRoomId)
+	if err != nil || classRoom == nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve class room")
+	}
+
+	agenda := schedule.NewAgenda()
+
+	period, err := r.repository.FindById(classRoom.ScheduleId().String())
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to get schedule")
+	}
+
+	if period != nil {
+		agenda.AddSchedule(*period)
+	}
+
+	err = r.addLessons(agenda, classRoom.SchoolYearId(), func(lesson timetable.Lesson) bool {
+		return lesson.ClassRoomId() == classRoom.Id()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return agenda, nil
+}
+
+func (r *ServiceScheduleClass) addLessons(agenda *schedule.Agenda, schoolYearId uuid.UUID, filter func(lesson timetable.Lesson) bool) error {
+	schedules, err := r.repository.FindBySchoolYear(schoolYearId)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to get schedules")
+	}
+
+	descriptions := map[uuid.UUID]string{}
+	for _, scheduleClass := range schedules {
+		descriptions[scheduleClass.Id()] = scheduleClass.Description()
+	}
+
+	lessons, err := r.timetableRepository.FindLessons(schoolYearId)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to retrieve timetable")
+	}
+
+	for _, lesson := range lessons {
+		if !filter(lesson) {
+			continue
+		}
+
+		slot := lesson.Slot()
+		agenda.Add(slot.Weekday, slot.StartAt, slot.EndAt, schedule.AgendaEntry{
+			ScheduleId:  slot.ScheduleId,
+			Description: descriptions[slot.ScheduleId],
+			ClassRoom:   lesson.ClassRoom(),
+			Subject:     lesson.Subject(),
+			Teacher:     lesson.Teacher(),
+			Room:        lesson.Room(),
+		})
+	}
+
+	return nil
+}
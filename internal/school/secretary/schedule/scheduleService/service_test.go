@@ -0,0 +1,162 @@
+package scheduleService
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/mocks"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schedule"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/timetable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSyncSchedule(t *testing.T) {
+	schoolYearId := uuid.New().String()
+	roomId := uuid.New().String()
+
+	first, _ := schedule.New("1a aula", "07:00:00", "07:50:00", schoolYearId)
+	second, _ := schedule.New("2a aula", "07:50:00", "08:40:00", schoolYearId)
+	extended, _ := schedule.New("Reforco", "07:30:00", "08:30:00", schoolYearId)
+
+	t.Run("should bind schedules without overlap to the room", func(t *testing.T) {
+		repository := new(mocks.ScheduleRepository)
+		repository.On("FindById", first.Id().String()).Return(first, nil)
+		repository.On("FindById", second.Id().String()).Return(second, nil)
+		repository.On("SyncSchedule", mock.Anything).Return(nil)
+
+		service := New(repository, new(mocks.SchoolYearRepository), new(mocks.ClassRoomRepository), new(mocks.TimetableRepository))
+		err := service.SyncSchedule(schedule.RoomScheduleDto{
+			SchoolYear:  schoolYearId,
+			RoomId:      roomId,
+			ScheduleIds: []string{first.Id().String(), second.Id().String()},
+		})
+		assert.NoError(t, err)
+		repository.AssertCalled(t, "SyncSchedule", mock.Anything)
+	})
+
+	t.Run("should reject overlapping schedules in the same room", func(t *testing.T) {
+		repository := new(mocks.ScheduleRepository)
+		repository.On("FindById", first.Id().String()).Return(first, nil)
+		repository.On("FindById", extended.Id().String()).Return(extended, nil)
+
+		service := New(repository, new(mocks.SchoolYearRepository), new(mocks.ClassRoomRepository), new(mocks.TimetableRepository))
+		err := service.SyncSchedule(schedule.RoomScheduleDto{
+			SchoolYear:  schoolYearId,
+			RoomId:      roomId,
+			ScheduleIds: []string{first.Id().String(), extended.Id().String()},
+		})
+		assert.EqualError(t, err, "schedules 1a aula and Reforco overlap in the room")
+		repository.AssertNotCalled(t, "SyncSchedule", mock.Anything)
+	})
+
+	t.Run("should accept overlapping times on different weekdays", func(t *testing.T) {
+		saturday, _ := schedule.New("Reforco sabado", "07:30:00", "08:30:00", schoolYearId)
+		_ = saturday.ChangeWeekdays([]int{6})
+
+		repository := new(mocks.ScheduleRepository)
+		repository.On("FindById", first.Id().String()).Return(first, nil)
+		repository.On("FindById", saturday.Id().String()).Return(saturday, nil)
+		repository.On("SyncSchedule", mock.Anything).Return(nil)
+
+		service := New(repository, new(mocks.SchoolYearRepository), new(mocks.ClassRoomRepository), new(mocks.TimetableRepository))
+		err := service.SyncSchedule(schedule.RoomScheduleDto{
+			SchoolYear:  schoolYearId,
+			RoomId:      roomId,
+			ScheduleIds: []string{first.Id().String(), saturday.Id().String()},
+		})
+		assert.NoError(t, err)
+	})
+}
+
+func TestUpdateScheduleBoundToRoom(t *testing.T) {
+	schoolYearId := uuid.New().String()
+	roomId := uuid.New()
+
+	first, _ := schedule.New("1a aula", "07:00:00", "07:50:00", schoolYearId)
+	second, _ := schedule.New("2a aula", "07:50:00", "08:40:00", schoolYearId)
+
+	setup := func() *mocks.ScheduleRepository {
+		repository := new(mocks.ScheduleRepository)
+		repository.On("FindById", second.Id().String()).Return(second, nil)
+		repository.On("FindRoomIds", second.Id(), second.SchoolYearId()).Return([]uuid.UUID{roomId}, nil)
+		repository.On("FindByRoom", roomId, second.SchoolYearId()).Return([]schedule.ScheduleClass{*first, *second}, nil)
+		repository.On("Update", mock.Anything).Return(nil)
+		return repository
+	}
+
+	t.Run("should update the schedule when it keeps clear of the room schedules", func(t *testing.T) {
+		repository := setup()
+
+		service := New(repository, new(mocks.SchoolYearRepository), new(mocks.ClassRoomRepository), new(mocks.TimetableRepository))
+		err := service.Update(second.Id().String(), schedule.Request{
+			Description: "2a aula",
+			InitialTime: "08:00:00",
+			FinalTime:   "08:50:00",
+			SchoolYear:  schoolYearId,
+		})
+		assert.NoError(t, err)
+		repository.AssertCalled(t, "Update", mock.Anything)
+	})
+
+	t.Run("should reject an update that overlaps another schedule of the room", func(t *testing.T) {
+		repository := setup()
+
+		service := New(repository, new(mocks.SchoolYearRepository), new(mocks.ClassRoomRepository), new(mocks.TimetableRepository))
+		err := service.Update(second.Id().String(), schedule.Request{
+			Description: "2a aula",
+			InitialTime: "07:30:00",
+			FinalTime:   "08:20:00",
+			SchoolYear:  schoolYearId,
+		})
+		assert.EqualError(t, err, "schedules 1a aula and 2a aula overlap in the room")
+		repository.AssertNotCalled(t, "Update", mock.Anything)
+	})
+}
+
+func TestShouldBuildRoomAgenda(t *testing.T) {
+	schoolYearId := uuid.New()
+	room := timetable.Room{Id: uuid.New(), Code: "S01", Capacity: 35}
+
+	first, _ := schedule.New("1a aula", "07:00:00", "07:50:00", schoolYearId.String())
+	second, _ := schedule.New("2a aula", "07:50:00", "08:40:00", schoolYearId.String())
+
+	classRoom := timetable.ClassRoom{Id: uuid.New(), Identification: "TUR-001"}
+	subject := timetable.Subject{Id: uuid.New(), Name: "Matematica", TeacherId: uuid.New(), Teacher: "Marcos Lima"}
+	lesson := timetable.NewLesson(schoolYearId, classRoom, subject, room, timetable.Slot{
+		ScheduleId: first.Id(),
+		Weekday:    1,
+		StartAt:    first.StartAt(),
+		EndAt:      first.EndAt(),
+	})
+	otherRoom := timetable.NewLesson(schoolYearId, classRoom, subject, timetable.Room{Id: uuid.New(), Code: "S02"}, timetable.Slot{
+		ScheduleId: second.Id(),
+		Weekday:    2,
+		StartAt:    second.StartAt(),
+		EndAt:      second.EndAt(),
+	})
+
+	repository := new(mocks.ScheduleRepository)
+	repository.On("FindByRoom", room.Id, schoolYearId).Return([]schedule.ScheduleClass{*first, *second}, nil)
+	repository.On("FindBySchoolYear", schoolYearId).Return([]schedule.ScheduleClass{*first, *second}, nil)
+	timetableRepository := new(mocks.TimetableRepository)
+	timetableRepository.On("FindLessons", schoolYearId).Return([]timetable.Lesson{*lesson, *otherRoom}, nil)
+
+	service := New(repository, new(mocks.SchoolYearRepository), new(mocks.ClassRoomRepository), timetableRepository)
+	agenda, err := service.RoomAgenda(room.Id.String(), schoolYearId.String())
+	assert.NoError(t, err)
+	assert.Equal(t, schedule.DefaultWeekdays, agenda.Weekdays)
+	assert.Len(t, agenda.Rows, 2)
+
+	monday := agenda.Rows[0].Days[1]
+	assert.Len(t, monday, 1)
+	assert.Equal(t, "TUR-001", monday[0].ClassRoom)
+	assert.Equal(t, "Matematica", monday[0].Subject)
+	assert.Equal(t, "1a aula", monday[0].Description)
+
+	tuesday := agenda.Rows[1].Days[2]
+	assert.Len(t, tuesday, 1)
+	assert.Equal(t, "2a aula", tuesday[0].Description)
+	assert.Empty(t, tuesday[0].ClassRoom)
+	assert.Empty(t, agenda.Rows[0].Days[0])
+}
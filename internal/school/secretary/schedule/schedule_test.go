@@ -24,3 +24,32 @@ func TestShouldCreateScheduleEntityWithSuccess(t *testing.T) {
 	assert.Equal(t, "08:00:00", sch.StartAt())
 	assert.Equal(t, "09:00:00", sch.EndAt())
 }
+
+func TestShouldChangeScheduleWeekdays(t *testing.T) {
+	sch, _ := New("1a aula", "07:00:00", "07:50:00", uuid.New().String())
+	assert.Equal(t, DefaultWeekdays, sch.Weekdays())
+
+	err := sch.ChangeWeekdays([]int{3, 1, 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 3}, sch.Weekdays())
+	assert.True(t, sch.HasWeekday(3))
+	assert.False(t, sch.HasWeekday(2))
+
+	assert.EqualError(t, sch.ChangeWeekdays([]int{7}), "invalid weekday provided")
+	assert.EqualError(t, sch.ChangeWeekdays(nil), "schedule must have at least one weekday")
+}
+
+func TestShouldCheckScheduleOverlap(t *testing.T) {
+	schoolYearId := uuid.New().String()
+
+	first, _ := New("1a aula", "07:00:00", "07:50:00", schoolYearId)
+	second, _ := New("2a aula", "07:50:00", "08:40:00", schoolYearId)
+	extended, _ := New("Reforco", "07:30:00", "08:30:00", schoolYearId)
+
+	assert.False(t, first.Overlaps(*second))
+	assert.True(t, first.Overlaps(*extended))
+	assert.True(t, extended.Overlaps(*second))
+
+	_ = extended.ChangeWeekdays([]int{6})
+	assert.False(t, first.Overlaps(*extended))
+}
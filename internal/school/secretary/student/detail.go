@@ -0,0 +1,26 @@
+package student
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RegistrationSummary Resumo de uma matricula do aluno com a turma e o ano letivo
+type RegistrationSummary struct {
+	Id             uuid.UUID `json:"id"`
+	Code           string    `json:"code"`
+	Status         string    `json:"status"`
+	Shift          string    `json:"shift"`
+	EnrollmentDate time.Time `json:"enrollment_date"`
+	ClassRoomId    uuid.UUID `json:"class_room_id"`
+	ClassRoom      string    `json:"class_room"`
+	SchoolYearId   uuid.UUID `json:"school_year_id"`
+	SchoolYear     string    `json:"school_year"`
+}
+
+// Detail Ficha do aluno: dados pessoais, enderecos, telefones, responsaveis e matriculas
+type Detail struct {
+	Student       *Student              `json:"student"`
+	Registrations []RegistrationSummary `json:"registrations"`
+}
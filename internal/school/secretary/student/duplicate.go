@@ -0,0 +1,211 @@
+package student
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/text"
+)
+
+// DuplicateThreshold Pontuacao minima para que dois cadastros sejam considerados duplicados
+const DuplicateThreshold = 60
+
+const (
+	MatchCpf       = "CPF"
+	MatchName      = "NAME"
+	MatchBirthDay  = "BIRTHDAY"
+	MatchParentCpf = "PARENT_CPF"
+	MatchEmail     = "EMAIL"
+)
+
+// DuplicateCandidate Dados do aluno usados na comparacao entre cadastros
+type DuplicateCandidate struct {
+	Id         uuid.UUID `json:"id"`
+	FirstName  string    `json:"first_name"`
+	LastName   string    `json:"last_name"`
+	BirthDay   time.Time `json:"birthday"`
+	Cpf        string    `json:"cpf_document"`
+	Email      string    `json:"email"`
+	ParentCpfs []string  `json:"parent_cpfs"`
+}
+
+// Duplicate Par de cadastros suspeitos de pertencerem ao mesmo aluno
+type Duplicate struct {
+	Student   DuplicateCandidate `json:"student"`
+	Duplicate DuplicateCandidate `json:"duplicate"`
+	Score     int                `json:"score"`
+	Reasons   []string           `json:"reasons"`
+}
+
+// NormalizeName Nome em minusculas, sem acentos e com espacos simples
+func NormalizeName(name string) string {
+	return strings.Join(strings.Fields(text.RemoveAccents(strings.ToLower(name))), " ")
+}
+
+func (c DuplicateCandidate) name() string {
+	return NormalizeName(c.FirstName + " " + c.LastName)
+}
+
+// Score Pontua a semelhanca entre dois cadastros. CPF igual identifica o mesmo aluno; nome e email
+// somam pontos isoladamente. Nascimento e CPF de responsavel em comum so pontuam junto com o CPF ou
+// o primeiro nome, ja que irmaos gemeos compartilham ambos
+func Score(a DuplicateCandidate, b DuplicateCandidate) (int, []string) {
+	score := 0
+	var reasons []string
+
+	cpfMatch := a.Cpf != "" && a.Cpf == b.Cpf
+	if cpfMatch {
+		score += 100
+		reasons = append(reasons, MatchCpf)
+	}
+
+	nameA := strings.Fields(a.name())
+	nameB := strings.Fields(b.name())
+
+	switch {
+	case len(nameA) == 0 || len(nameB) == 0:
+	case strings.Join(nameA, " ") == strings.Join(nameB, " "):
+		score += 40
+		reasons = append(reasons, MatchName)
+	case nameA[0] == nameB[0] && nameA[len(nameA)-1] == nameB[len(nameB)-1]:
+		score += 25
+		reasons = append(reasons, MatchName)
+	}
+
+	sameStudent := cpfMatch || (len(nameA) > 0 && len(nameB) > 0 && nameA[0] == nameB[0])
+
+	if sameStudent && !a.BirthDay.IsZero() && a.BirthDay.Format("2006-01-02") == b.BirthDay.Format("2006-01-02") {
+		score += 30
+		reasons = append(reasons, MatchBirthDay)
+	}
+
+	if sameStudent && shareParent(a.ParentCpfs, b.ParentCpfs) {
+		score += 30
+		reasons = append(reasons, MatchParentCpf)
+	}
+
+	if a.Email != "" && strings.EqualFold(strings.TrimSpace(a.Email), strings.TrimSpace(b.Email)) {
+		score += 20
+		reasons = append(reasons, MatchEmail)
+	}
+
+	return score, reasons
+}
+
+func shareParent(a []string, b []string) bool {
+	for _, cpfA := range a {
+		for _, cpfB := range b {
+			if cpfA != "" && cpfA == cpfB {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// FindDuplicates Compara os cadastros que compartilham nome, nascimento, email, CPF ou CPF de
+// responsavel e devolve os pares com pontuacao a partir de DuplicateThreshold, dos mais provaveis
+// para os menos provaveis
+func FindDuplicates(candidates []DuplicateCandidate) []Duplicate {
+	groups := map[string][]int{}
+
+	for i, candidate := range candidates {
+		keys := []string{
+			"name:" + candidate.name(),
+			"birthday:" + candidate.BirthDay.Format("2006-01-02"),
+		}
+
+		if candidate.Cpf != "" {
+			keys = append(keys, "cpf:"+candidate.Cpf)
+		}
+
+		if candidate.Email != "" {
+			keys = append(keys, "email:"+strings.ToLower(strings.TrimSpace(candidate.Email)))
+		}
+
+		for _, cpf := range candidate.ParentCpfs {
+			keys = append(keys, "parent:"+cpf)
+		}
+
+		for _, key := range keys {
+			groups[key] = append(groups[key], i)
+		}
+	}
+
+	compared := map[[2]int]bool{}
+	duplicates := []Duplicate{}
+
+	for _, group := range groups {
+		for i := 0; i < len(group); i++ {
+			for j := i + 1; j < len(group); j++ {
+				pair := [2]int{group[i], group[j]}
+				if compared[pair] {
+					continue
+				}
+				compared[pair] = true
+
+				score, reasons := Score(candidates[pair[0]], candidates[pair[1]])
+				if score < DuplicateThreshold {
+					continue
+				}
+
+				duplicates = append(duplicates, Duplicate{
+					Student:   candidates[pair[0]],
+					Duplicate: candidates[pair[1]],
+					Score:     score,
+					Reasons:   reasons,
+				})
+			}
+		}
+	}
+
+	sort.Slice(duplicates, func(i, j int) bool {
+		if duplicates[i].Score != duplicates[j].Score {
+			return duplicates[i].Score > duplicates[j].Score
+		}
+
+		return duplicates[i].Student.name() < duplicates[j].Student.name()
+	})
+
+	return duplicates
+}
+
+// Merge Incorpora ao aluno os responsaveis do cadastro duplicado e os dados pessoais que ainda nao
+// foram preenchidos. Enderecos, telefones e matriculas sao transferidos pelo repositorio
+func (s *Student) Merge(duplicate Student) error {
+	if duplicate.Id() == s.id {
+		return errors.New("student cannot be merged with itself")
+	}
+
+	for _, p := range duplicate.Parents() {
+		linked := false
+		for _, current := range s.parents {
+			if current.Id() == p.Id() {
+				linked = true
+				break
+			}
+		}
+
+		if !linked {
+			s.parents = append(s.parents, p)
+		}
+	}
+
+	if s.email == "" {
+		s.email = duplicate.Email()
+	}
+
+	if s.rg == "" {
+		s.rg = duplicate.Rg()
+	}
+
+	if s.academicResponsible == uuid.Nil {
+		s.academicResponsible = duplicate.AcademicResponsible()
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package student
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindDuplicates(t *testing.T) {
+	birthDay := time.Date(2015, 3, 20, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should normalize accents, case and spaces of the name", func(t *testing.T) {
+		assert.Equal(t, "joao da conceicao", NormalizeName("  João  da CONCEIÇÃO "))
+	})
+
+	t.Run("should match minors without cpf by name, birthday and parent", func(t *testing.T) {
+		a := DuplicateCandidate{Id: uuid.New(), FirstName: "João", LastName: "Silva", BirthDay: birthDay, ParentCpfs: []string{"62449972048"}}
+		b := DuplicateCandidate{Id: uuid.New(), FirstName: "joao", LastName: "silva", BirthDay: birthDay, ParentCpfs: []string{"62449972048"}}
+
+		duplicates := FindDuplicates([]DuplicateCandidate{a, b})
+		assert.Len(t, duplicates, 1)
+		assert.Equal(t, 100, duplicates[0].Score)
+		assert.Equal(t, []string{MatchName, MatchBirthDay, MatchParentCpf}, duplicates[0].Reasons)
+	})
+
+	t.Run("should match typo in the middle name with same birthday", func(t *testing.T) {
+		a := DuplicateCandidate{Id: uuid.New(), FirstName: "Maria Clara", LastName: "Souza", BirthDay: birthDay}
+		b := DuplicateCandidate{Id: uuid.New(), FirstName: "Maria Klara", LastName: "Souza", BirthDay: birthDay}
+
+		score, reasons := Score(a, b)
+		assert.Equal(t, 55, score)
+		assert.Equal(t, []string{MatchName, MatchBirthDay}, reasons)
+
+		b.Email = "maria@gmail.com"
+		a.Email = "MARIA@gmail.com"
+		duplicates := FindDuplicates([]DuplicateCandidate{a, b})
+		assert.Len(t, duplicates, 1)
+		assert.Equal(t, 75, duplicates[0].Score)
+	})
+
+	t.Run("should not match different students sharing only the birthday", func(t *testing.T) {
+		a := DuplicateCandidate{Id: uuid.New(), FirstName: "Pedro", LastName: "Alves", BirthDay: birthDay}
+		b := DuplicateCandidate{Id: uuid.New(), FirstName: "Lucas", LastName: "Lima", BirthDay: birthDay}
+
+		assert.Empty(t, FindDuplicates([]DuplicateCandidate{a, b}))
+	})
+
+	t.Run("should not match twins sharing last name, birthday and parent", func(t *testing.T) {
+		a := DuplicateCandidate{Id: uuid.New(), FirstName: "João", LastName: "Silva", BirthDay: birthDay, ParentCpfs: []string{"62449972048"}}
+		b := DuplicateCandidate{Id: uuid.New(), FirstName: "Pedro", LastName: "Silva", BirthDay: birthDay, ParentCpfs: []string{"62449972048"}}
+
+		score, reasons := Score(a, b)
+		assert.Equal(t, 0, score)
+		assert.Empty(t, reasons)
+		assert.Empty(t, FindDuplicates([]DuplicateCandidate{a, b}))
+	})
+
+	t.Run("should report each pair once ordered by score", func(t *testing.T) {
+		a := DuplicateCandidate{Id: uuid.New(), FirstName: "Ana", LastName: "Reis", BirthDay: birthDay, Cpf: "82378114028"}
+		b := DuplicateCandidate{Id: uuid.New(), FirstName: "Ana", LastName: "Reis", BirthDay: birthDay, Cpf: "82378114028"}
+		c := DuplicateCandidate{Id: uuid.New(), FirstName: "Ana", LastName: "Reis", BirthDay: birthDay.AddDate(1, 0, 0), Email: "ana@gmail.com"}
+		d := DuplicateCandidate{Id: uuid.New(), FirstName: "Ana Paula", LastName: "Reis", BirthDay: birthDay.AddDate(1, 0, 0), Email: "ana@gmail.com"}
+
+		duplicates := FindDuplicates([]DuplicateCandidate{a, b, c, d})
+		assert.Len(t, duplicates, 2)
+		assert.Equal(t, 170, duplicates[0].Score)
+		assert.Equal(t, c.Id, duplicates[1].Student.Id)
+		assert.Equal(t, d.Id, duplicates[1].Duplicate.Id)
+		assert.Equal(t, 75, duplicates[1].Score)
+	})
+}
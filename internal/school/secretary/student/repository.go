@@ -1,5 +1,20 @@
 package student
 
+import (
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
+)
+
 type Repository interface {
 	Create(student Student) error
+	Update(student Student) error
+	UpdateAcademicResponsible(student Student) error
+	Delete(id string) error
+	FindById(id string) (*Student, error)
+	DocumentAlreadyInUse(document value_objects.Document, exceptId uuid.UUID) (bool, error)
+	FindAll(pagination paginator.Pagination) (*paginator.PaginationResult, error)
+	FindRegistrations(studentId string) ([]RegistrationSummary, error)
+	FindDuplicateCandidates() ([]DuplicateCandidate, error)
+	Merge(survivor Student, duplicateId uuid.UUID) error
 }
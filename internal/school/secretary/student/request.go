@@ -1,6 +1,7 @@
 package student
 
 import (
+	"github.com/go-playground/validator"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/parent"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/address"
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/phone"
@@ -12,9 +13,49 @@ type RequestDto struct {
 	Birthday           string               `json:"birthday"`
 	RgDocument         string               `json:"rg_document"`
 	CpfDocument        string               `json:"cpf_document"`
+	DocumentType       string               `json:"document_type"`
+	DocumentNumber     string               `json:"document_number"`
 	Email              string               `json:"email"`
 	HimSelfResponsible bool                 `json:"him_self_responsible"`
 	Addresses          []address.RequestDto `json:"addresses"`
 	Phones             []phone.RequestDto   `json:"phones"`
 	Parents            []parent.RequestDto  `json:"parents"`
 }
+
+// UpdateRequestDto Dados pessoais do aluno que podem ser alterados apos o cadastro
+type UpdateRequestDto struct {
+	FirstName          string `json:"first_name" validate:"required"`
+	LastName           string `json:"last_name" validate:"required"`
+	Birthday           string `json:"birthday" validate:"required"`
+	RgDocument         string `json:"rg_document" validate:"omitempty"`
+	CpfDocument        string `json:"cpf_document" validate:"required_without=DocumentNumber"`
+	DocumentType       string `json:"document_type" validate:"omitempty,oneof=RNM PASSPORT BIRTH_CERTIFICATE"`
+	DocumentNumber     string `json:"document_number" validate:"required_with=DocumentType"`
+	Email              string `json:"email" validate:"required,email"`
+	HimSelfResponsible bool   `json:"him_self_responsible"`
+}
+
+func (u *UpdateRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(u)
+}
+
+// AcademicResponsibleRequestDto Responsavel do aluno que sera o contato pedagogico da escola
+type AcademicResponsibleRequestDto struct {
+	ParentId string `json:"parent_id" validate:"omitempty,uuid"`
+}
+
+func (a *AcademicResponsibleRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(a)
+}
+
+// MergeRequestDto Cadastro duplicado que sera incorporado ao aluno
+type MergeRequestDto struct {
+	DuplicateId string `json:"duplicate_id" validate:"required,uuid"`
+}
+
+func (m *MergeRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(m)
+}
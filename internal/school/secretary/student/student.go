@@ -14,18 +14,23 @@ import (
 	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
 )
 
+// ErrStudentNotFound Retornado quando o aluno nao existe ou foi removido
+var ErrStudentNotFound = errors.New("student not found")
+
 type Student struct {
-	id                 uuid.UUID
-	firstName          string
-	lastName           string
-	birthDay           *time.Time
-	rg                 string
-	cpf                value_objects.CPF
-	email              string
-	himSelfResponsible bool
-	addresses          []value_objects.Address
-	phones             []value_objects.Phone
-	parents            []parent.Parent
+	id                  uuid.UUID
+	firstName           string
+	lastName            string
+	birthDay            *time.Time
+	rg                  string
+	cpf                 value_objects.CPF
+	document            value_objects.Document
+	email               string
+	himSelfResponsible  bool
+	addresses           []value_objects.Address
+	phones              []value_objects.Phone
+	parents             []parent.Parent
+	academicResponsible uuid.UUID
 }
 
 func New(firstName string, lastName string, birthDay string, rg string, cpf string, email string, himselfResponsible bool) (*Student, error) {
@@ -129,6 +134,15 @@ func (s *Student) Cpf() value_objects.CPF {
 	return s.cpf
 }
 
+// Document Documento de identificacao do aluno. Alunos com CPF sao identificados pelo CPF
+func (s *Student) Document() value_objects.Document {
+	if s.cpf != "" {
+		return value_objects.Document{Type: value_objects.DocumentCpf, Number: string(s.cpf)}
+	}
+
+	return s.document
+}
+
 func (s *Student) HimSelfResponsible() bool { return s.himSelfResponsible }
 
 func (s *Student) ChangeHimSelfResponsible(responsible bool) {
@@ -145,7 +159,14 @@ func (s *Student) ChangeEmail(email string) error {
 	return nil
 }
 
+// ChangeCPF Altera o CPF do aluno. Alunos sem CPF devem ser identificados por outro documento
+// informado em ChangeDocument
 func (s *Student) ChangeCPF(cpf string) error {
+	if cpf == "" {
+		s.cpf = ""
+		return nil
+	}
+
 	docCpf := value_objects.CPF(cpf)
 
 	err := docCpf.Validate()
@@ -158,6 +179,31 @@ func (s *Student) ChangeCPF(cpf string) error {
 	return nil
 }
 
+// ChangeDocument Identifica o aluno sem CPF por RNE/RNM, passaporte ou certidao de nascimento. Um
+// documento do tipo CPF altera o CPF do aluno
+func (s *Student) ChangeDocument(documentType string, number string) error {
+	if documentType == "" && number == "" {
+		s.document = value_objects.Document{}
+		return nil
+	}
+
+	document := value_objects.Document{Type: documentType, Number: number}
+
+	err := document.Validate()
+	if err != nil {
+		return err
+	}
+
+	if document.Type == value_objects.DocumentCpf {
+		s.document = value_objects.Document{}
+		return s.ChangeCPF(document.Number)
+	}
+
+	s.document = document
+
+	return nil
+}
+
 func (s *Student) ChangeRg(rg string) {
 	if rg == "" {
 		return
@@ -216,6 +262,11 @@ func (s *Student) AddAddress(addressDto []address.RequestDto) {
 	s.addresses = addresses
 }
 
+// ChangeAddresses Substitui os enderecos do aluno pelos enderecos ja cadastrados
+func (s *Student) ChangeAddresses(addresses []value_objects.Address) {
+	s.addresses = addresses
+}
+
 func (s *Student) AddPhones(phonesDto []phone.RequestDto) {
 
 	var phones []value_objects.Phone
@@ -234,24 +285,38 @@ func (s *Student) AddPhones(phonesDto []phone.RequestDto) {
 	s.phones = phones
 }
 
+// ChangePhones Substitui os telefones do aluno pelos telefones ja cadastrados
+func (s *Student) ChangePhones(phones []value_objects.Phone) {
+	s.phones = phones
+}
+
+// AddParents Cria os novos responsaveis do aluno. Responsaveis ja cadastrados, informados pelo id,
+// sao vinculados com AttachParent
 func (s *Student) AddParents(parentsDto []parent.RequestDto) error {
 
 	var parents []parent.Parent
 
 	for _, parentDto := range parentsDto {
+		if parentDto.Id != "" {
+			continue
+		}
 
 		p, err := parent.New(parentDto.FirstName,
 			parentDto.LastName,
 			parentDto.BirthDay,
 			parentDto.RgDocument,
 			parentDto.CpfDocument,
-			s.Id().String(),
 			parentDto.Email)
 
 		if err != nil {
 			return err
 		}
 
+		err = p.ChangeRelationship(parentDto.Relationship)
+		if err != nil {
+			return err
+		}
+
 		p.AddAddress(parentDto.Addresses)
 		p.AddPhones(parentDto.Phones)
 
@@ -263,13 +328,67 @@ func (s *Student) AddParents(parentsDto []parent.RequestDto) error {
 	return nil
 }
 
+// AttachParent Vincula ao aluno um responsavel ja cadastrado, substituindo o responsavel com o
+// mesmo CPF caso ele tenha sido informado como novo
+func (s *Student) AttachParent(p parent.Parent, relationship string) error {
+	err := p.ChangeRelationship(relationship)
+	if err != nil {
+		return err
+	}
+
+	for i, current := range s.parents {
+		if current.Id() == p.Id() || current.Cpf() == p.Cpf() {
+			s.parents[i] = p
+			return nil
+		}
+	}
+
+	s.parents = append(s.parents, p)
+
+	return nil
+}
+
+// ChangeAcademicResponsible Define qual dos responsaveis do aluno e o contato pedagogico da escola.
+// Sem id informado o aluno fica sem responsavel academico
+func (s *Student) ChangeAcademicResponsible(parentId string) error {
+	if parentId == "" {
+		s.academicResponsible = uuid.Nil
+		return nil
+	}
+
+	id, err := uuid.Parse(parentId)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to change academic responsible")
+	}
+
+	for _, p := range s.parents {
+		if p.Id() == id {
+			s.academicResponsible = id
+			return nil
+		}
+	}
+
+	return errors.New("academic responsible must be one of the student parents")
+}
+
+// AcademicResponsible Id do responsavel academico ou uuid.Nil quando nao definido
+func (s *Student) AcademicResponsible() uuid.UUID {
+	return s.academicResponsible
+}
+
+// ChangeParents Substitui os responsaveis do aluno pelos responsaveis ja cadastrados
+func (s *Student) ChangeParents(parents []parent.Parent) {
+	s.parents = parents
+}
+
 func (s *Student) Validate() error {
 	err := s.ValidateResponsible()
 	if err != nil {
 		return err
 	}
 
-	err = s.cpf.Validate()
+	err = s.ValidateDocument()
 	if err != nil {
 		return err
 	}
@@ -300,6 +419,17 @@ func (s *Student) ValidateResponsible() error {
 	return nil
 }
 
+// ValidateDocument O aluno deve ter CPF ou outro documento de identificacao valido
+func (s *Student) ValidateDocument() error {
+	if s.cpf == "" && s.document.Type == "" {
+		return errors.New("student identification document not found")
+	}
+
+	document := s.Document()
+
+	return document.Validate()
+}
+
 func (s *Student) ValidateContactInformation() error {
 	if !s.himSelfResponsible {
 		return nil
@@ -335,28 +465,41 @@ func (s *Student) ValidateParentsInformation() error {
 }
 
 func (s *Student) MarshalJSON() ([]byte, error) {
+	academicResponsible := ""
+	if s.AcademicResponsible() != uuid.Nil {
+		academicResponsible = s.AcademicResponsible().String()
+	}
+
 	return json.Marshal(struct {
-		Id        string                  `json:"id"`
-		FirstName string                  `json:"first_name"`
-		LastName  string                  `json:"last_name"`
-		BirthDay  string                  `json:"birth_day"`
-		Addresses []value_objects.Address `json:"addresses"`
-		Phones    []value_objects.Phone   `json:"phones"`
-		Rg        string                  `json:"rg"`
-		Cpf       string                  `json:"cpf"`
-		StudentId string                  `json:"student_id"`
-		Email     string                  `json:"email"`
-		Parents   []parent.Parent         `json:"parents"`
+		Id                  string                  `json:"id"`
+		FirstName           string                  `json:"first_name"`
+		LastName            string                  `json:"last_name"`
+		BirthDay            string                  `json:"birth_day"`
+		Addresses           []value_objects.Address `json:"addresses"`
+		Phones              []value_objects.Phone   `json:"phones"`
+		Rg                  string                  `json:"rg"`
+		Cpf                 string                  `json:"cpf"`
+		DocumentType        string                  `json:"document_type"`
+		DocumentNumber      string                  `json:"document_number"`
+		StudentId           string                  `json:"student_id"`
+		Email               string                  `json:"email"`
+		HimSelfResponsible  bool                    `json:"him_self_responsible"`
+		Parents             []parent.Parent         `json:"parents"`
+		AcademicResponsible string                  `json:"academic_responsible_id,omitempty"`
 	}{
-		Id:        s.Id().String(),
-		FirstName: s.FirstName(),
-		LastName:  s.LastName(),
-		BirthDay:  s.BirthDay().Format("2006-01-02"),
-		Addresses: s.Addresses(),
-		Phones:    s.Phones(),
-		Rg:        s.Rg(),
-		Cpf:       string(s.Cpf()),
-		Email:     s.Email(),
-		Parents:   s.Parents(),
+		Id:                  s.Id().String(),
+		FirstName:           s.FirstName(),
+		LastName:            s.LastName(),
+		BirthDay:            s.BirthDay().Format("2006-01-02"),
+		Addresses:           s.Addresses(),
+		Phones:              s.Phones(),
+		Rg:                  s.Rg(),
+		Cpf:                 string(s.Cpf()),
+		DocumentType:        s.Document().Type,
+		DocumentNumber:      s.Document().Number,
+		Email:               s.Email(),
+		HimSelfResponsible:  s.HimSelfResponsible(),
+		Parents:             s.Parents(),
+		AcademicResponsible: academicResponsible,
 	})
 }
@@ -0,0 +1,259 @@
+package studentService
+
+import (
+	"errors"
+	"log"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+)
+
+type StudentActionsInterface interface {
+	FindAll(dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error)
+	Find(id string) (*student.Detail, error)
+	Update(id string, dto student.UpdateRequestDto) error
+	Delete(id string) error
+	ChangeAcademicResponsible(id string, dto student.AcademicResponsibleRequestDto) error
+	FindDuplicates() ([]student.Duplicate, error)
+	Merge(id string, dto student.MergeRequestDto) error
+}
+
+type StudentActions struct {
+	repository student.Repository
+}
+
+func New(repository student.Repository) *StudentActions {
+	return &StudentActions{
+		repository: repository,
+	}
+}
+
+// FindAll Busca paginada de alunos por nome, CPF ou email. Aceita os filtros class_room_id e
+// school_year_id para listar os alunos matriculados em uma turma ou ano letivo
+func (s *StudentActions) FindAll(dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error) {
+	pg := paginator.Pagination{}
+	pg.FillFromDto(dtoRequest)
+
+	students, err := s.repository.FindAll(pg)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve students")
+	}
+
+	return students, nil
+}
+
+func (s *StudentActions) Find(id string) (*student.Detail, error) {
+	sdt, err := s.find(id)
+	if err != nil {
+		return nil, err
+	}
+
+	registrations, err := s.repository.FindRegistrations(id)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve student registrations")
+	}
+
+	return &student.Detail{
+		Student:       sdt,
+		Registrations: registrations,
+	}, nil
+}
+
+// Update Altera os dados pessoais do aluno. Enderecos, telefones e responsaveis sao mantidos
+func (s *StudentActions) Update(id string, dto student.UpdateRequestDto) error {
+	sdt, err := s.find(id)
+	if err != nil {
+		return err
+	}
+
+	err = sdt.ChangeName(dto.FirstName, dto.LastName)
+	if err != nil {
+		return err
+	}
+
+	err = sdt.ChangeBirthDay(dto.Birthday)
+	if err != nil {
+		return err
+	}
+
+	err = sdt.ChangeCPF(dto.CpfDocument)
+	if err != nil {
+		return err
+	}
+
+	err = sdt.ChangeDocument(dto.DocumentType, dto.DocumentNumber)
+	if err != nil {
+		return err
+	}
+
+	err = sdt.ChangeEmail(dto.Email)
+	if err != nil {
+		return err
+	}
+
+	sdt.ChangeRg(dto.RgDocument)
+	sdt.ChangeHimSelfResponsible(dto.HimSelfResponsible)
+
+	err = sdt.Validate()
+	if err != nil {
+		return err
+	}
+
+	documentInUse, err := s.repository.DocumentAlreadyInUse(sdt.Document(), sdt.Id())
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to verify student document")
+	}
+
+	if documentInUse && sdt.Cpf() != "" {
+		return errors.New("cpf already registered to another student")
+	}
+
+	if documentInUse {
+		return errors.New("document already registered to another student")
+	}
+
+	err = s.repository.Update(*sdt)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to update student")
+	}
+
+	return nil
+}
+
+// ChangeAcademicResponsible Define o responsavel que sera o contato pedagogico do aluno
+func (s *StudentActions) ChangeAcademicResponsible(id string, dto student.AcademicResponsibleRequestDto) error {
+	sdt, err := s.find(id)
+	if err != nil {
+		return err
+	}
+
+	err = sdt.ChangeAcademicResponsible(dto.ParentId)
+	if err != nil {
+		return err
+	}
+
+	err = s.repository.UpdateAcademicResponsible(*sdt)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to update academic responsible")
+	}
+
+	return nil
+}
+
+// Delete Remove o aluno junto com seus enderecos, telefones e responsaveis. Alunos com matricula
+// ativa nao podem ser removidos
+func (s *StudentActions) Delete(id string) error {
+	_, err := s.find(id)
+	if err != nil {
+		return err
+	}
+
+	registrations, err := s.repository.FindRegistrations(id)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to retrieve student registrations")
+	}
+
+	for _, reg := range registrations {
+		if active(reg.Status) {
+			return errors.New("student has active registrations")
+		}
+	}
+
+	err = s.repository.Delete(id)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to delete student")
+	}
+
+	return nil
+}
+
+// FindDuplicates Lista os pares de cadastros suspeitos de pertencerem ao mesmo aluno
+func (s *StudentActions) FindDuplicates() ([]student.Duplicate, error) {
+	candidates, err := s.repository.FindDuplicateCandidates()
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve students")
+	}
+
+	return student.FindDuplicates(candidates), nil
+}
+
+// Merge Incorpora o cadastro duplicado ao aluno. Os dois cadastros nao podem ter matriculas ativas
+// na mesma turma
+func (s *StudentActions) Merge(id string, dto student.MergeRequestDto) error {
+	if id == dto.DuplicateId {
+		return errors.New("student cannot be merged with itself")
+	}
+
+	survivor, err := s.find(id)
+	if err != nil {
+		return err
+	}
+
+	duplicate, err := s.find(dto.DuplicateId)
+	if err != nil {
+		return err
+	}
+
+	survivorRegistrations, err := s.repository.FindRegistrations(id)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to retrieve student registrations")
+	}
+
+	duplicateRegistrations, err := s.repository.FindRegistrations(dto.DuplicateId)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to retrieve student registrations")
+	}
+
+	for _, reg := range survivorRegistrations {
+		for _, other := range duplicateRegistrations {
+			if active(reg.Status) && active(other.Status) && reg.ClassRoomId == other.ClassRoomId {
+				return errors.New("students have active registrations in the same class room")
+			}
+		}
+	}
+
+	err = survivor.Merge(*duplicate)
+	if err != nil {
+		return err
+	}
+
+	err = s.repository.Merge(*survivor, duplicate.Id())
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to merge students")
+	}
+
+	return nil
+}
+
+// find Busca o aluno, retornando student.ErrStudentNotFound quando ele nao existe
+func (s *StudentActions) find(id string) (*student.Student, error) {
+	sdt, err := s.repository.FindById(id)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve student")
+	}
+
+	if sdt == nil {
+		return nil, student.ErrStudentNotFound
+	}
+
+	return sdt, nil
+}
+
+func active(status string) bool {
+	return status == registration.StatusWaitEnrollmentFee ||
+		status == registration.StatusApproved ||
+		status == registration.StatusLocked
+}
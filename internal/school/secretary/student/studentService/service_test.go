@@ -0,0 +1,237 @@
+package studentService
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/mocks"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/parent"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/address"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/phone"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestShouldUpdateStudentPersonalData(t *testing.T) {
+	sdt := getStudent()
+
+	var updated student.Student
+
+	repository := new(mocks.StudentRepository)
+	repository.On("FindById", sdt.Id().String()).Return(sdt, nil)
+	repository.On("DocumentAlreadyInUse", mock.Anything, sdt.Id()).Return(false, nil)
+	repository.On("Update", mock.Anything).Run(func(args mock.Arguments) {
+		updated = args.Get(0).(student.Student)
+	}).Return(nil)
+
+	dto := getUpdateRequest()
+	err := New(repository).Update(sdt.Id().String(), dto)
+	assert.NoError(t, err)
+	assert.Equal(t, sdt.Id(), updated.Id())
+	assert.Equal(t, "Maria", updated.FirstName())
+	assert.Equal(t, "maria@gmail.com", updated.Email())
+	assert.Equal(t, "2010-05-10", updated.BirthDay().Format("2006-01-02"))
+	assert.Len(t, updated.Addresses(), 1)
+	assert.Len(t, updated.Phones(), 1)
+}
+
+func TestShouldNotUpdateStudentWithCpfOfAnotherStudent(t *testing.T) {
+	sdt := getStudent()
+
+	repository := new(mocks.StudentRepository)
+	repository.On("FindById", sdt.Id().String()).Return(sdt, nil)
+	repository.On("DocumentAlreadyInUse", mock.Anything, sdt.Id()).Return(true, nil)
+
+	err := New(repository).Update(sdt.Id().String(), getUpdateRequest())
+	assert.EqualError(t, err, "cpf already registered to another student")
+	repository.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestShouldNotUpdateStudentWithoutResponsible(t *testing.T) {
+	sdt := getStudent()
+
+	repository := new(mocks.StudentRepository)
+	repository.On("FindById", sdt.Id().String()).Return(sdt, nil)
+
+	dto := getUpdateRequest()
+	dto.HimSelfResponsible = false
+
+	err := New(repository).Update(sdt.Id().String(), dto)
+	assert.EqualError(t, err, "information about student parents not found")
+	repository.AssertNotCalled(t, "Update", mock.Anything)
+}
+
+func TestShouldNotDeleteStudentWithActiveRegistration(t *testing.T) {
+	sdt := getStudent()
+	studentId := sdt.Id().String()
+
+	repository := new(mocks.StudentRepository)
+	repository.On("FindById", studentId).Return(sdt, nil)
+	repository.On("FindRegistrations", studentId).Return([]student.RegistrationSummary{
+		{Id: uuid.New(), Code: "2023000001", Status: registration.StatusConcluded},
+		{Id: uuid.New(), Code: "2024000001", Status: registration.StatusApproved},
+	}, nil)
+
+	err := New(repository).Delete(studentId)
+	assert.EqualError(t, err, "student has active registrations")
+	repository.AssertNotCalled(t, "Delete", studentId)
+}
+
+func TestShouldDeleteStudentWithoutActiveRegistration(t *testing.T) {
+	sdt := getStudent()
+	studentId := sdt.Id().String()
+
+	repository := new(mocks.StudentRepository)
+	repository.On("FindById", studentId).Return(sdt, nil)
+	repository.On("FindRegistrations", studentId).Return([]student.RegistrationSummary{
+		{Id: uuid.New(), Code: "2023000001", Status: registration.StatusCancelled},
+	}, nil)
+	repository.On("Delete", studentId).Return(nil)
+
+	err := New(repository).Delete(studentId)
+	assert.NoError(t, err)
+	repository.AssertCalled(t, "Delete", studentId)
+}
+
+func TestShouldReturnNotFoundForMissingStudent(t *testing.T) {
+	studentId := uuid.New().String()
+
+	repository := new(mocks.StudentRepository)
+	repository.On("FindById", studentId).Return((*student.Student)(nil), nil)
+
+	t.Run("should not delete missing student", func(t *testing.T) {
+		err := New(repository).Delete(studentId)
+		assert.ErrorIs(t, err, student.ErrStudentNotFound)
+		repository.AssertNotCalled(t, "Delete", studentId)
+	})
+
+	t.Run("should not find missing student", func(t *testing.T) {
+		_, err := New(repository).Find(studentId)
+		assert.ErrorIs(t, err, student.ErrStudentNotFound)
+		repository.AssertNotCalled(t, "FindRegistrations", studentId)
+	})
+}
+
+func TestShouldReturnStudentDetailWithRegistrations(t *testing.T) {
+	sdt := getStudent()
+	registrations := []student.RegistrationSummary{
+		{Id: uuid.New(), Code: "2023000001", Status: registration.StatusApproved, ClassRoom: "5A", SchoolYear: "2023"},
+	}
+
+	repository := new(mocks.StudentRepository)
+	repository.On("FindById", sdt.Id().String()).Return(sdt, nil)
+	repository.On("FindRegistrations", sdt.Id().String()).Return(registrations, nil)
+
+	detail, err := New(repository).Find(sdt.Id().String())
+	assert.NoError(t, err)
+	assert.Equal(t, sdt.Id(), detail.Student.Id())
+	assert.Equal(t, registrations, detail.Registrations)
+}
+
+func TestShouldChangeAcademicResponsible(t *testing.T) {
+	sdt := getStudent()
+	_ = sdt.AddParents([]parent.RequestDto{
+		{FirstName: "Ana", LastName: "Santos", BirthDay: "1980-01-10", CpfDocument: "624.499.720-48", Email: "ana@gmail.com", Relationship: parent.RelationshipMother},
+	})
+	parentId := sdt.Parents()[0].Id().String()
+
+	var updated student.Student
+
+	repository := new(mocks.StudentRepository)
+	repository.On("FindById", sdt.Id().String()).Return(sdt, nil)
+	repository.On("UpdateAcademicResponsible", mock.Anything).Run(func(args mock.Arguments) {
+		updated = args.Get(0).(student.Student)
+	}).Return(nil)
+
+	t.Run("should define parent of the student as academic responsible", func(t *testing.T) {
+		err := New(repository).ChangeAcademicResponsible(sdt.Id().String(), student.AcademicResponsibleRequestDto{ParentId: parentId})
+		assert.NoError(t, err)
+		assert.Equal(t, parentId, updated.AcademicResponsible().String())
+	})
+
+	t.Run("should refuse parent not linked to the student", func(t *testing.T) {
+		err := New(repository).ChangeAcademicResponsible(sdt.Id().String(), student.AcademicResponsibleRequestDto{ParentId: uuid.New().String()})
+		assert.EqualError(t, err, "academic responsible must be one of the student parents")
+	})
+}
+
+func TestShouldMergeDuplicateStudent(t *testing.T) {
+	classRoomId := uuid.New()
+
+	survivor := getStudent()
+	survivor.ChangeRg("")
+
+	duplicate, _ := student.New("Joana", "Santos", "2010-05-10", "1234567", "111.444.777-35", "joana@gmail.com", false)
+	_ = duplicate.AddParents([]parent.RequestDto{
+		{FirstName: "Ana", LastName: "Santos", BirthDay: "1980-01-10", CpfDocument: "624.499.720-48", Email: "ana@gmail.com", Relationship: parent.RelationshipMother},
+	})
+
+	t.Run("should move parents and missing data to the surviving student", func(t *testing.T) {
+		var merged student.Student
+
+		repository := new(mocks.StudentRepository)
+		repository.On("FindById", survivor.Id().String()).Return(survivor, nil)
+		repository.On("FindById", duplicate.Id().String()).Return(duplicate, nil)
+		repository.On("FindRegistrations", survivor.Id().String()).Return([]student.RegistrationSummary{
+			{Id: uuid.New(), Status: registration.StatusConcluded, ClassRoomId: classRoomId},
+		}, nil)
+		repository.On("FindRegistrations", duplicate.Id().String()).Return([]student.RegistrationSummary{
+			{Id: uuid.New(), Status: registration.StatusApproved, ClassRoomId: classRoomId},
+		}, nil)
+		repository.On("Merge", mock.Anything, duplicate.Id()).Run(func(args mock.Arguments) {
+			merged = args.Get(0).(student.Student)
+		}).Return(nil)
+
+		err := New(repository).Merge(survivor.Id().String(), student.MergeRequestDto{DuplicateId: duplicate.Id().String()})
+		assert.NoError(t, err)
+		assert.Equal(t, survivor.Id(), merged.Id())
+		assert.Equal(t, "1234567", merged.Rg())
+		assert.Len(t, merged.Parents(), 1)
+		assert.Equal(t, duplicate.Parents()[0].Id(), merged.Parents()[0].Id())
+	})
+
+	t.Run("should refuse students with active registrations in the same class room", func(t *testing.T) {
+		repository := new(mocks.StudentRepository)
+		repository.On("FindById", survivor.Id().String()).Return(survivor, nil)
+		repository.On("FindById", duplicate.Id().String()).Return(duplicate, nil)
+		repository.On("FindRegistrations", mock.Anything).Return([]student.RegistrationSummary{
+			{Id: uuid.New(), Status: registration.StatusApproved, ClassRoomId: classRoomId},
+		}, nil)
+
+		err := New(repository).Merge(survivor.Id().String(), student.MergeRequestDto{DuplicateId: duplicate.Id().String()})
+		assert.EqualError(t, err, "students have active registrations in the same class room")
+		repository.AssertNotCalled(t, "Merge", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should refuse merging the student with itself", func(t *testing.T) {
+		repository := new(mocks.StudentRepository)
+
+		err := New(repository).Merge(survivor.Id().String(), student.MergeRequestDto{DuplicateId: survivor.Id().String()})
+		assert.EqualError(t, err, "student cannot be merged with itself")
+	})
+}
+
+func getStudent() *student.Student {
+	sdt, _ := student.New("Joana", "Santos", "2010-05-10", "", "823.781.140-28", "joana@gmail.com", true)
+	sdt.AddAddress([]address.RequestDto{
+		{Street: "Rua A", City: "Salvador", District: "Centro", State: "BA", ZipCode: "40000000"},
+	})
+	sdt.AddPhones([]phone.RequestDto{
+		{Description: "Celular", Phone: "71999999999"},
+	})
+
+	return sdt
+}
+
+func getUpdateRequest() student.UpdateRequestDto {
+	return student.UpdateRequestDto{
+		FirstName:          "Maria",
+		LastName:           "Santos",
+		Birthday:           "2010-05-10",
+		CpfDocument:        "823.781.140-28",
+		Email:              "maria@gmail.com",
+		HimSelfResponsible: true,
+	}
+}
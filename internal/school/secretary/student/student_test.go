@@ -0,0 +1,44 @@
+package student
+
+import (
+	"testing"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStudentDocument(t *testing.T) {
+	t.Run("should identify student with cpf by the cpf", func(t *testing.T) {
+		sdt, err := New("Joana", "Santos", "2010-05-10", "", "823.781.140-28", "joana@gmail.com", true)
+		assert.NoError(t, err)
+		assert.Equal(t, value_objects.Document{Type: value_objects.DocumentCpf, Number: "82378114028"}, sdt.Document())
+		assert.NoError(t, sdt.ValidateDocument())
+	})
+
+	t.Run("should identify foreign student by rnm", func(t *testing.T) {
+		sdt, err := New("Pierre", "Dupont", "2012-02-15", "", "", "pierre@gmail.com", true)
+		assert.NoError(t, err)
+		assert.EqualError(t, sdt.ValidateDocument(), "student identification document not found")
+
+		err = sdt.ChangeDocument(value_objects.DocumentRnm, "V123456-K")
+		assert.NoError(t, err)
+		assert.Empty(t, sdt.Cpf())
+		assert.Equal(t, value_objects.Document{Type: value_objects.DocumentRnm, Number: "V123456K"}, sdt.Document())
+		assert.NoError(t, sdt.ValidateDocument())
+	})
+
+	t.Run("should refuse invalid alternative document", func(t *testing.T) {
+		sdt, _ := New("Pierre", "Dupont", "2012-02-15", "", "", "pierre@gmail.com", true)
+
+		err := sdt.ChangeDocument(value_objects.DocumentPassport, "12")
+		assert.EqualError(t, err, "passport must have 6 to 9 letters or digits")
+	})
+
+	t.Run("should change cpf when document type is cpf", func(t *testing.T) {
+		sdt, _ := New("Pierre", "Dupont", "2012-02-15", "", "", "pierre@gmail.com", true)
+
+		err := sdt.ChangeDocument(value_objects.DocumentCpf, "823.781.140-28")
+		assert.NoError(t, err)
+		assert.Equal(t, value_objects.CPF("82378114028"), sdt.Cpf())
+	})
+}
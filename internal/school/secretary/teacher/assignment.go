@@ -0,0 +1,129 @@
+package teacher
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/curriculum"
+)
+
+// Assignment Atribuicao do professor a uma disciplina da matriz curricular da turma no ano letivo.
+// Cada disciplina da turma possui um unico professor
+type Assignment struct {
+	id           uuid.UUID
+	teacherId    uuid.UUID
+	teacherName  string
+	classRoomId  uuid.UUID
+	classRoom    string
+	schoolYearId uuid.UUID
+	subjectId    uuid.UUID
+	subject      string
+}
+
+func NewAssignment(teacher Teacher, classRoom classroom.ClassRoom, schoolYearId string, subject curriculum.MatrixItem) (*Assignment, error) {
+	if !teacher.Active() {
+		return nil, errors.New("teacher is not active")
+	}
+
+	yearId, err := uuid.Parse(schoolYearId)
+	if err != nil {
+		return nil, errors.New("invalid school year id provided")
+	}
+
+	if classRoom.SchoolYearId() != yearId {
+		return nil, errors.New("class room does not belong to the school year provided")
+	}
+
+	if subject.SubjectId == uuid.Nil {
+		return nil, errors.New("subject cannot be empty")
+	}
+
+	return &Assignment{
+		id:           uuid.New(),
+		teacherId:    teacher.Id(),
+		teacherName:  teacher.Name(),
+		classRoomId:  classRoom.Id(),
+		classRoom:    classRoom.Identification(),
+		schoolYearId: yearId,
+		subjectId:    subject.SubjectId,
+		subject:      subject.Subject,
+	}, nil
+}
+
+func LoadAssignment(
+	id uuid.UUID,
+	teacherId uuid.UUID,
+	teacherName string,
+	classRoomId uuid.UUID,
+	classRoom string,
+	schoolYearId uuid.UUID,
+	subjectId uuid.UUID,
+	subject string,
+) *Assignment {
+	return &Assignment{
+		id:           id,
+		teacherId:    teacherId,
+		teacherName:  teacherName,
+		classRoomId:  classRoomId,
+		classRoom:    classRoom,
+		schoolYearId: schoolYearId,
+		subjectId:    subjectId,
+		subject:      subject,
+	}
+}
+
+func (a *Assignment) Id() uuid.UUID {
+	return a.id
+}
+
+func (a *Assignment) TeacherId() uuid.UUID {
+	return a.teacherId
+}
+
+func (a *Assignment) TeacherName() string {
+	return a.teacherName
+}
+
+func (a *Assignment) ClassRoomId() uuid.UUID {
+	return a.classRoomId
+}
+
+func (a *Assignment) ClassRoom() string {
+	return a.classRoom
+}
+
+func (a *Assignment) SchoolYearId() uuid.UUID {
+	return a.schoolYearId
+}
+
+func (a *Assignment) SubjectId() uuid.UUID {
+	return a.subjectId
+}
+
+func (a *Assignment) Subject() string {
+	return a.subject
+}
+
+func (a *Assignment) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Id           string `json:"id"`
+		TeacherId    string `json:"teacher_id"`
+		TeacherName  string `json:"teacher_name"`
+		ClassRoomId  string `json:"class_room_id"`
+		ClassRoom    string `json:"class_room"`
+		SchoolYearId string `json:"school_year_id"`
+		SubjectId    string `json:"subject_id"`
+		Subject      string `json:"subject"`
+	}{
+		Id:           a.Id().String(),
+		TeacherId:    a.TeacherId().String(),
+		TeacherName:  a.TeacherName(),
+		ClassRoomId:  a.ClassRoomId().String(),
+		ClassRoom:    a.ClassRoom(),
+		SchoolYearId: a.SchoolYearId().String(),
+		SubjectId:    a.SubjectId().String(),
+		Subject:      a.Subject(),
+	})
+}
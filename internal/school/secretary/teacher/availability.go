@@ -0,0 +1,56 @@
+package teacher
+
+import (
+	"errors"
+	"time"
+)
+
+// Availability Periodo da semana em que o professor pode lecionar. O dia segue time.Weekday
+// (0 domingo a 6 sabado) e os horarios o formato 15:04:05
+type Availability struct {
+	Weekday int    `json:"weekday"`
+	StartAt string `json:"start_at"`
+	EndAt   string `json:"end_at"`
+}
+
+func NewAvailability(weekday int, startAt string, endAt string) (*Availability, error) {
+	if weekday < int(time.Sunday) || weekday > int(time.Saturday) {
+		return nil, errors.New("invalid weekday provided")
+	}
+
+	start, err := time.Parse("15:04:05", startAt)
+	if err != nil {
+		return nil, errors.New("invalid availability start time provided")
+	}
+
+	end, err := time.Parse("15:04:05", endAt)
+	if err != nil {
+		return nil, errors.New("invalid availability end time provided")
+	}
+
+	if !start.Before(end) {
+		return nil, errors.New("availability start time must be before end time")
+	}
+
+	return &Availability{
+		Weekday: weekday,
+		StartAt: start.Format("15:04:05"),
+		EndAt:   end.Format("15:04:05"),
+	}, nil
+}
+
+// Available Informa se o horario cabe em algum periodo de disponibilidade. Professores sem
+// disponibilidade cadastrada podem lecionar em qualquer horario
+func Available(availability []Availability, weekday int, startAt string, endAt string) bool {
+	if len(availability) == 0 {
+		return true
+	}
+
+	for _, period := range availability {
+		if period.Weekday == weekday && period.StartAt <= startAt && period.EndAt >= endAt {
+			return true
+		}
+	}
+
+	return false
+}
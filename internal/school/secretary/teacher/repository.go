@@ -0,0 +1,24 @@
+package teacher
+
+import (
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
+)
+
+type Repository interface {
+	Create(teacher Teacher) error
+	Update(teacher Teacher) error
+	Delete(id string) error
+	FindById(id string) (*Teacher, error)
+	FindByCpf(cpf value_objects.CPF) (*Teacher, error)
+	FindAll(pagination paginator.Pagination) (*paginator.PaginationResult, error)
+	Assign(assignment Assignment) error
+	Unassign(id string) error
+	UnassignAll(teacherId uuid.UUID) error
+	FindAssignment(classRoomId uuid.UUID, subjectId uuid.UUID) (*Assignment, error)
+	FindAssignments(teacherId string, schoolYearId string) ([]Assignment, error)
+	FindClassRoomAssignments(classRoomId string) ([]Assignment, error)
+	ChangeAvailability(teacherId uuid.UUID, availability []Availability) error
+	FindAvailability(teacherId uuid.UUID) ([]Availability, error)
+}
@@ -0,0 +1,71 @@
+package teacher
+
+import (
+	"github.com/go-playground/validator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/address"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/phone"
+)
+
+type RequestDto struct {
+	FirstName      string                    `json:"first_name" validate:"required"`
+	LastName       string                    `json:"last_name" validate:"required"`
+	Birthday       string                    `json:"birthday" validate:"required"`
+	RgDocument     string                    `json:"rg_document"`
+	CpfDocument    string                    `json:"cpf_document" validate:"required"`
+	Email          string                    `json:"email" validate:"required,email"`
+	HiredAt        string                    `json:"hired_at" validate:"required"`
+	Addresses      []address.RequestDto      `json:"addresses"`
+	Phones         []phone.RequestDto        `json:"phones"`
+	Qualifications []QualificationRequestDto `json:"qualifications" validate:"dive"`
+}
+
+func (r *RequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(r)
+}
+
+type QualificationRequestDto struct {
+	Degree         string `json:"degree" validate:"required,oneof=TEACHING GRADUATION SPECIALIZATION MASTER DOCTORATE"`
+	Course         string `json:"course" validate:"required"`
+	Institution    string `json:"institution" validate:"required"`
+	ConclusionYear int    `json:"conclusion_year" validate:"required"`
+}
+
+// StatusRequestDto Nova situacao contratual do professor
+type StatusRequestDto struct {
+	Status string `json:"status" validate:"required,oneof=ACTIVE ON_LEAVE DISMISSED"`
+}
+
+func (s *StatusRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(s)
+}
+
+// AssignmentRequestDto Turma e disciplina atribuidas ao professor no ano letivo
+type AssignmentRequestDto struct {
+	ClassRoomId  string `json:"class_room_id" validate:"required,uuid"`
+	SchoolYearId string `json:"school_year_id" validate:"required,uuid"`
+	SubjectId    string `json:"subject_id" validate:"required,uuid"`
+}
+
+func (a *AssignmentRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(a)
+}
+
+// AvailabilityRequestDto Periodos em que o professor pode lecionar. Uma lista vazia libera todos
+// os horarios
+type AvailabilityRequestDto struct {
+	Periods []AvailabilityPeriodRequestDto `json:"periods" validate:"dive"`
+}
+
+func (a *AvailabilityRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(a)
+}
+
+type AvailabilityPeriodRequestDto struct {
+	Weekday int    `json:"weekday" validate:"min=0,max=6"`
+	StartAt string `json:"start_at" validate:"required"`
+	EndAt   string `json:"end_at" validate:"required"`
+}
@@ -0,0 +1,419 @@
+package teacher
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/address"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/phone"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
+)
+
+const (
+	StatusActive    = "ACTIVE"
+	StatusOnLeave   = "ON_LEAVE"
+	StatusDismissed = "DISMISSED"
+)
+
+const (
+	DegreeTeaching       = "TEACHING"
+	DegreeGraduation     = "GRADUATION"
+	DegreeSpecialization = "SPECIALIZATION"
+	DegreeMaster         = "MASTER"
+	DegreeDoctorate      = "DOCTORATE"
+)
+
+// Qualification Formacao academica do professor
+type Qualification struct {
+	Id             uuid.UUID `json:"id"`
+	Degree         string    `json:"degree"`
+	Course         string    `json:"course"`
+	Institution    string    `json:"institution"`
+	ConclusionYear int       `json:"conclusion_year"`
+}
+
+// Teacher Professor do quadro da escola. Apenas professores ativos podem ser atribuidos a turmas
+type Teacher struct {
+	id             uuid.UUID
+	firstName      string
+	lastName       string
+	birthDay       *time.Time
+	rgDocument     string
+	cpfDocument    value_objects.CPF
+	email          string
+	addresses      []value_objects.Address
+	phones         []value_objects.Phone
+	qualifications []Qualification
+	status         string
+	hiredAt        time.Time
+	dismissedAt    *time.Time
+}
+
+func New(firstName string, lastName string, birthDay string, rg string, cpf string, email string, hiredAt string) (*Teacher, error) {
+	t := &Teacher{
+		id:     uuid.New(),
+		status: StatusActive,
+	}
+
+	err := t.ChangeName(firstName, lastName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.ChangeBirthDay(birthDay)
+	if err != nil {
+		return nil, err
+	}
+
+	t.ChangeRg(rg)
+
+	err = t.ChangeCPF(cpf)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.ChangeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.ChangeHiredAt(hiredAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+func Load(
+	id string,
+	firstName string,
+	lastName string,
+	birthDay string,
+	rg string,
+	cpf string,
+	email string,
+	hiredAt string,
+	status string,
+	dismissedAt *time.Time,
+) (*Teacher, error) {
+	t, err := New(firstName, lastName, birthDay, rg, cpf, email, hiredAt)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.ChangeId(id)
+	if err != nil {
+		return nil, err
+	}
+
+	t.status = status
+	t.dismissedAt = dismissedAt
+
+	return t, nil
+}
+
+func (t *Teacher) Id() uuid.UUID {
+	return t.id
+}
+
+func (t *Teacher) FirstName() string {
+	return t.firstName
+}
+
+func (t *Teacher) LastName() string {
+	return t.lastName
+}
+
+func (t *Teacher) Name() string {
+	return t.firstName + " " + t.lastName
+}
+
+func (t *Teacher) BirthDay() *time.Time {
+	return t.birthDay
+}
+
+func (t *Teacher) Rg() string {
+	return t.rgDocument
+}
+
+func (t *Teacher) Cpf() value_objects.CPF {
+	return t.cpfDocument
+}
+
+func (t *Teacher) Email() string {
+	return t.email
+}
+
+func (t *Teacher) Status() string {
+	return t.status
+}
+
+func (t *Teacher) HiredAt() time.Time {
+	return t.hiredAt
+}
+
+func (t *Teacher) DismissedAt() *time.Time {
+	return t.dismissedAt
+}
+
+func (t *Teacher) Addresses() []value_objects.Address {
+	return t.addresses
+}
+
+func (t *Teacher) Phones() []value_objects.Phone {
+	return t.phones
+}
+
+func (t *Teacher) Qualifications() []Qualification {
+	return t.qualifications
+}
+
+// Active Informa se o professor pode receber turmas
+func (t *Teacher) Active() bool {
+	return t.status == StatusActive
+}
+
+func (t *Teacher) ChangeId(id string) error {
+	if id == "" {
+		return errors.New("teacher id cannot be empty")
+	}
+
+	teacherId, err := uuid.Parse(id)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to change teacher id")
+	}
+
+	t.id = teacherId
+
+	return nil
+}
+
+func (t *Teacher) ChangeName(firstName string, lastName string) error {
+	if firstName == "" || lastName == "" {
+		return errors.New("teacher first name and last name cannot be null")
+	}
+
+	t.firstName = firstName
+	t.lastName = lastName
+
+	return nil
+}
+
+func (t *Teacher) ChangeBirthDay(birthDay string) error {
+	if birthDay == "" {
+		return errors.New("teacher birthday cannot be empty")
+	}
+
+	b, err := time.Parse("2006-01-02", birthDay)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to change teacher birthday")
+	}
+
+	t.birthDay = &b
+
+	return nil
+}
+
+func (t *Teacher) ChangeRg(rg string) {
+	t.rgDocument = rg
+}
+
+func (t *Teacher) ChangeCPF(cpf string) error {
+	docCpf := value_objects.CPF(cpf)
+
+	err := docCpf.Validate()
+	if err != nil {
+		return err
+	}
+
+	t.cpfDocument = docCpf
+
+	return nil
+}
+
+func (t *Teacher) ChangeEmail(email string) error {
+	if email == "" {
+		return errors.New("teacher email cannot be null")
+	}
+
+	t.email = email
+
+	return nil
+}
+
+func (t *Teacher) ChangeHiredAt(hiredAt string) error {
+	if hiredAt == "" {
+		return errors.New("teacher hiring date cannot be empty")
+	}
+
+	h, err := time.Parse("2006-01-02", hiredAt)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to change teacher hiring date")
+	}
+
+	t.hiredAt = h
+
+	return nil
+}
+
+// ChangeStatus Altera a situacao contratual do professor. O desligamento registra a data e a
+// recontratacao de um professor desligado limpa a data de desligamento
+func (t *Teacher) ChangeStatus(status string) error {
+	switch status {
+	case StatusActive, StatusOnLeave:
+		if status == StatusOnLeave && t.status == StatusDismissed {
+			return errors.New("dismissed teacher cannot be put on leave")
+		}
+
+		t.dismissedAt = nil
+	case StatusDismissed:
+		if t.status == StatusDismissed {
+			return errors.New("teacher is already dismissed")
+		}
+
+		now := time.Now()
+		t.dismissedAt = &now
+	default:
+		return errors.New("invalid teacher status provided")
+	}
+
+	t.status = status
+
+	return nil
+}
+
+func (t *Teacher) AddAddress(addressDto []address.RequestDto) {
+	var addresses []value_objects.Address
+
+	for _, address := range addressDto {
+		addresses = append(addresses, value_objects.Address{
+			Id:       uuid.New(),
+			Street:   address.Street,
+			City:     address.City,
+			District: address.District,
+			State:    address.State,
+			ZipCode:  address.ZipCode,
+			OwnerId:  t.Id(),
+		})
+	}
+
+	t.addresses = addresses
+}
+
+// ChangeAddresses Substitui os enderecos do professor pelos enderecos ja cadastrados
+func (t *Teacher) ChangeAddresses(addresses []value_objects.Address) {
+	t.addresses = addresses
+}
+
+func (t *Teacher) AddPhones(phonesDto []phone.RequestDto) {
+	var phones []value_objects.Phone
+
+	for _, phone := range phonesDto {
+		phones = append(phones, value_objects.Phone{
+			Id:          uuid.New(),
+			Description: phone.Description,
+			Phone:       phone.Phone,
+			OwnerId:     t.Id(),
+		})
+	}
+
+	t.phones = phones
+}
+
+// ChangePhones Substitui os telefones do professor pelos telefones ja cadastrados
+func (t *Teacher) ChangePhones(phones []value_objects.Phone) {
+	t.phones = phones
+}
+
+func (t *Teacher) AddQualifications(qualificationsDto []QualificationRequestDto) error {
+	var qualifications []Qualification
+
+	for _, dto := range qualificationsDto {
+		qualification := Qualification{
+			Id:             uuid.New(),
+			Degree:         dto.Degree,
+			Course:         strings.TrimSpace(dto.Course),
+			Institution:    strings.TrimSpace(dto.Institution),
+			ConclusionYear: dto.ConclusionYear,
+		}
+
+		err := validateQualification(qualification)
+		if err != nil {
+			return err
+		}
+
+		qualifications = append(qualifications, qualification)
+	}
+
+	t.qualifications = qualifications
+
+	return nil
+}
+
+// ChangeQualifications Substitui a formacao do professor pela formacao ja cadastrada
+func (t *Teacher) ChangeQualifications(qualifications []Qualification) {
+	t.qualifications = qualifications
+}
+
+func validateQualification(qualification Qualification) error {
+	switch qualification.Degree {
+	case DegreeTeaching, DegreeGraduation, DegreeSpecialization, DegreeMaster, DegreeDoctorate:
+	default:
+		return errors.New("invalid qualification degree provided")
+	}
+
+	if qualification.Course == "" || qualification.Institution == "" {
+		return errors.New("qualification course and institution cannot be empty")
+	}
+
+	if qualification.ConclusionYear < 1900 || qualification.ConclusionYear > time.Now().Year()+10 {
+		return errors.New("invalid qualification conclusion year provided")
+	}
+
+	return nil
+}
+
+func (t *Teacher) MarshalJSON() ([]byte, error) {
+	dismissedAt := ""
+	if t.DismissedAt() != nil {
+		dismissedAt = t.DismissedAt().Format("2006-01-02")
+	}
+
+	return json.Marshal(struct {
+		Id             string                  `json:"id"`
+		FirstName      string                  `json:"first_name"`
+		LastName       string                  `json:"last_name"`
+		BirthDay       string                  `json:"birth_day"`
+		RgDocument     string                  `json:"rg_document"`
+		CpfDocument    value_objects.CPF       `json:"cpf_document"`
+		Email          string                  `json:"email"`
+		Addresses      []value_objects.Address `json:"addresses"`
+		Phones         []value_objects.Phone   `json:"phones"`
+		Qualifications []Qualification         `json:"qualifications"`
+		Status         string                  `json:"status"`
+		HiredAt        string                  `json:"hired_at"`
+		DismissedAt    string                  `json:"dismissed_at,omitempty"`
+	}{
+		Id:             t.Id().String(),
+		FirstName:      t.FirstName(),
+		LastName:       t.LastName(),
+		BirthDay:       t.BirthDay().Format("2006-01-02"),
+		RgDocument:     t.Rg(),
+		CpfDocument:    t.Cpf(),
+		Email:          t.Email(),
+		Addresses:      t.Addresses(),
+		Phones:         t.Phones(),
+		Qualifications: t.Qualifications(),
+		Status:         t.Status(),
+		HiredAt:        t.HiredAt().Format("2006-01-02"),
+		DismissedAt:    dismissedAt,
+	})
+}
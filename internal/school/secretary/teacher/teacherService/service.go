@@ -0,0 +1,347 @@
+package teacherService
+
+import (
+	"errors"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/curriculum"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/teacher"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+)
+
+type TeacherActionsInterface interface {
+	Create(dto teacher.RequestDto) (*teacher.Teacher, error)
+	Update(id string, dto teacher.RequestDto) error
+	ChangeStatus(id string, dto teacher.StatusRequestDto) error
+	Delete(id string) error
+	Find(id string) (*teacher.Teacher, error)
+	FindAll(dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error)
+	Assign(teacherId string, dto teacher.AssignmentRequestDto) (*teacher.Assignment, error)
+	Unassign(id string) error
+	FindAssignments(teacherId string, schoolYearId string) ([]teacher.Assignment, error)
+	FindClassRoomAssignments(classRoomId string) ([]teacher.Assignment, error)
+	ChangeAvailability(id string, dto teacher.AvailabilityRequestDto) error
+	FindAvailability(id string) ([]teacher.Availability, error)
+}
+
+type TeacherActions struct {
+	repository           teacher.Repository
+	classRoomRepository  classroom.Repository
+	curriculumRepository curriculum.Repository
+}
+
+func New(
+	repository teacher.Repository,
+	classRoomRepository classroom.Repository,
+	curriculumRepository curriculum.Repository,
+) *TeacherActions {
+	return &TeacherActions{
+		repository:           repository,
+		classRoomRepository:  classRoomRepository,
+		curriculumRepository: curriculumRepository,
+	}
+}
+
+func (t *TeacherActions) Create(dto teacher.RequestDto) (*teacher.Teacher, error) {
+	tch, err := teacher.New(dto.FirstName, dto.LastName, dto.Birthday, dto.RgDocument, dto.CpfDocument, dto.Email, dto.HiredAt)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tch.AddQualifications(dto.Qualifications)
+	if err != nil {
+		return nil, err
+	}
+
+	tch.AddAddress(dto.Addresses)
+	tch.AddPhones(dto.Phones)
+
+	err = t.checkCpf(*tch)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.repository.Create(*tch)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to create teacher")
+	}
+
+	return tch, nil
+}
+
+// Update Altera os dados pessoais, enderecos, telefones e formacao do professor. A situacao
+// contratual e alterada apenas por ChangeStatus
+func (t *TeacherActions) Update(id string, dto teacher.RequestDto) error {
+	current, err := t.find(id)
+	if err != nil {
+		return err
+	}
+
+	tch, err := teacher.Load(
+		id,
+		dto.FirstName,
+		dto.LastName,
+		dto.Birthday,
+		dto.RgDocument,
+		dto.CpfDocument,
+		dto.Email,
+		dto.HiredAt,
+		current.Status(),
+		current.DismissedAt(),
+	)
+	if err != nil {
+		return err
+	}
+
+	err = tch.AddQualifications(dto.Qualifications)
+	if err != nil {
+		return err
+	}
+
+	tch.AddAddress(dto.Addresses)
+	tch.AddPhones(dto.Phones)
+
+	err = t.checkCpf(*tch)
+	if err != nil {
+		return err
+	}
+
+	err = t.repository.Update(*tch)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to update teacher")
+	}
+
+	return nil
+}
+
+// ChangeStatus Altera a situacao contratual do professor. No desligamento as atribuicoes do
+// professor sao encerradas para que as turmas possam receber outro professor
+func (t *TeacherActions) ChangeStatus(id string, dto teacher.StatusRequestDto) error {
+	tch, err := t.find(id)
+	if err != nil {
+		return err
+	}
+
+	err = tch.ChangeStatus(dto.Status)
+	if err != nil {
+		return err
+	}
+
+	if tch.Status() == teacher.StatusDismissed {
+		err = t.repository.UnassignAll(tch.Id())
+		if err != nil {
+			log.Println(err)
+			return errors.New("failed to release teacher assignments")
+		}
+	}
+
+	err = t.repository.Update(*tch)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to update teacher status")
+	}
+
+	return nil
+}
+
+func (t *TeacherActions) Delete(id string) error {
+	err := t.repository.Delete(id)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to delete teacher")
+	}
+
+	return nil
+}
+
+func (t *TeacherActions) Find(id string) (*teacher.Teacher, error) {
+	return t.find(id)
+}
+
+// FindAll Busca paginada de professores por nome, CPF ou email
+func (t *TeacherActions) FindAll(dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error) {
+	pg := paginator.Pagination{}
+	pg.FillFromDto(dtoRequest)
+
+	teachers, err := t.repository.FindAll(pg)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve teachers")
+	}
+
+	return teachers, nil
+}
+
+// Assign Atribui ao professor uma disciplina da matriz curricular da turma. A disciplina nao pode
+// estar atribuida a outro professor na mesma turma
+func (t *TeacherActions) Assign(teacherId string, dto teacher.AssignmentRequestDto) (*teacher.Assignment, error) {
+	tch, err := t.find(teacherId)
+	if err != nil {
+		return nil, err
+	}
+
+	classRoom, err := t.classRoomRepository.FindById(dto.ClassRoomId)
+	if err != nil || classRoom == nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve class room")
+	}
+
+	matrix, err := t.curriculumRepository.FindMatrix(classRoom.Level(), classRoom.SchoolYearId())
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve class room curriculum matrix")
+	}
+
+	if matrix == nil {
+		return nil, errors.New("class room level has no curriculum matrix in the school year")
+	}
+
+	subjectId, err := uuid.Parse(dto.SubjectId)
+	if err != nil {
+		return nil, errors.New("invalid subject id provided")
+	}
+
+	subject, found := matrix.Item(subjectId)
+	if !found {
+		return nil, errors.New("subject is not part of the class room curriculum matrix")
+	}
+
+	assignment, err := teacher.NewAssignment(*tch, *classRoom, dto.SchoolYearId, subject)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := t.repository.FindAssignment(assignment.ClassRoomId(), assignment.SubjectId())
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to verify class room assignments")
+	}
+
+	if current != nil && current.TeacherId() == tch.Id() {
+		return nil, errors.New("teacher already assigned to this subject in the class room")
+	}
+
+	if current != nil {
+		return nil, errors.New("subject already assigned to another teacher in the class room")
+	}
+
+	err = t.repository.Assign(*assignment)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to assign teacher")
+	}
+
+	return assignment, nil
+}
+
+func (t *TeacherActions) Unassign(id string) error {
+	_, err := uuid.Parse(id)
+	if err != nil {
+		return errors.New("invalid assignment id provided")
+	}
+
+	err = t.repository.Unassign(id)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to remove teacher assignment")
+	}
+
+	return nil
+}
+
+// FindAssignments Turmas e disciplinas do professor. Sem o ano letivo todas as atribuicoes ativas
+// sao retornadas
+func (t *TeacherActions) FindAssignments(teacherId string, schoolYearId string) ([]teacher.Assignment, error) {
+	assignments, err := t.repository.FindAssignments(teacherId, schoolYearId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve teacher assignments")
+	}
+
+	return assignments, nil
+}
+
+// FindClassRoomAssignments Professores de cada disciplina da turma
+func (t *TeacherActions) FindClassRoomAssignments(classRoomId string) ([]teacher.Assignment, error) {
+	assignments, err := t.repository.FindClassRoomAssignments(classRoomId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve class room assignments")
+	}
+
+	return assignments, nil
+}
+
+// ChangeAvailability Substitui os periodos em que o professor pode lecionar
+func (t *TeacherActions) ChangeAvailability(id string, dto teacher.AvailabilityRequestDto) error {
+	tch, err := t.find(id)
+	if err != nil {
+		return err
+	}
+
+	var availability []teacher.Availability
+
+	for _, period := range dto.Periods {
+		item, err := teacher.NewAvailability(period.Weekday, period.StartAt, period.EndAt)
+		if err != nil {
+			return err
+		}
+
+		availability = append(availability, *item)
+	}
+
+	err = t.repository.ChangeAvailability(tch.Id(), availability)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to change teacher availability")
+	}
+
+	return nil
+}
+
+func (t *TeacherActions) FindAvailability(id string) ([]teacher.Availability, error) {
+	tch, err := t.find(id)
+	if err != nil {
+		return nil, err
+	}
+
+	availability, err := t.repository.FindAvailability(tch.Id())
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve teacher availability")
+	}
+
+	return availability, nil
+}
+
+func (t *TeacherActions) find(id string) (*teacher.Teacher, error) {
+	tch, err := t.repository.FindById(id)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve teacher")
+	}
+
+	if tch == nil {
+		return nil, errors.New("teacher not found")
+	}
+
+	return tch, nil
+}
+
+func (t *TeacherActions) checkCpf(tch teacher.Teacher) error {
+	current, err := t.repository.FindByCpf(tch.Cpf())
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to verify teacher cpf")
+	}
+
+	if current != nil && current.Id() != tch.Id() {
+		return errors.New("cpf already registered to another teacher")
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package teacherService
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/mocks"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/curriculum"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/teacher"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestShouldCreateTeacher(t *testing.T) {
+	repository := new(mocks.TeacherRepository)
+	repository.On("FindByCpf", value_objects.CPF("82378114028")).Return((*teacher.Teacher)(nil), nil)
+	repository.On("Create", mock.Anything).Return(nil)
+
+	tch, err := New(repository, new(mocks.ClassRoomRepository), new(mocks.CurriculumRepository)).Create(getRequest())
+	assert.NoError(t, err)
+	assert.Len(t, tch.Qualifications(), 1)
+	repository.AssertCalled(t, "Create", mock.Anything)
+}
+
+func TestShouldNotCreateTeacherWithCpfInUse(t *testing.T) {
+	current := getTeacher()
+
+	repository := new(mocks.TeacherRepository)
+	repository.On("FindByCpf", value_objects.CPF("82378114028")).Return(current, nil)
+
+	_, err := New(repository, new(mocks.ClassRoomRepository), new(mocks.CurriculumRepository)).Create(getRequest())
+	assert.EqualError(t, err, "cpf already registered to another teacher")
+	repository.AssertNotCalled(t, "Create", mock.Anything)
+}
+
+func TestShouldUpdateTeacherKeepingStatus(t *testing.T) {
+	current := getTeacher()
+	_ = current.ChangeStatus(teacher.StatusOnLeave)
+
+	repository := new(mocks.TeacherRepository)
+	repository.On("FindById", current.Id().String()).Return(current, nil)
+	repository.On("FindByCpf", value_objects.CPF("82378114028")).Return(current, nil)
+	repository.On("Update", mock.MatchedBy(func(tch teacher.Teacher) bool {
+		return tch.Status() == teacher.StatusOnLeave && tch.Email() == "marcos.lima@gmail.com"
+	})).Return(nil)
+
+	dto := getRequest()
+	dto.Email = "marcos.lima@gmail.com"
+
+	err := New(repository, new(mocks.ClassRoomRepository), new(mocks.CurriculumRepository)).Update(current.Id().String(), dto)
+	assert.NoError(t, err)
+	repository.AssertExpectations(t)
+}
+
+func TestShouldReleaseAssignmentsWhenTeacherIsDismissed(t *testing.T) {
+	current := getTeacher()
+
+	repository := new(mocks.TeacherRepository)
+	repository.On("FindById", current.Id().String()).Return(current, nil)
+	repository.On("UnassignAll", current.Id()).Return(nil)
+	repository.On("Update", mock.Anything).Return(nil)
+
+	err := New(repository, new(mocks.ClassRoomRepository), new(mocks.CurriculumRepository)).ChangeStatus(current.Id().String(), teacher.StatusRequestDto{Status: teacher.StatusDismissed})
+	assert.NoError(t, err)
+	repository.AssertCalled(t, "UnassignAll", current.Id())
+}
+
+func TestShouldAssignTeacherToClassRoomSubject(t *testing.T) {
+	current := getTeacher()
+	schoolYearId := uuid.New().String()
+	clr := getClassRoom(schoolYearId)
+	matrix := getMatrix(schoolYearId)
+	subject := matrix.Items()[0]
+
+	repository := new(mocks.TeacherRepository)
+	repository.On("FindById", current.Id().String()).Return(current, nil)
+	repository.On("FindAssignment", clr.Id(), subject.SubjectId).Return((*teacher.Assignment)(nil), nil)
+	repository.On("Assign", mock.Anything).Return(nil)
+
+	classRoomRepository := new(mocks.ClassRoomRepository)
+	classRoomRepository.On("FindById", clr.Id().String()).Return(clr, nil)
+
+	curriculumRepository := new(mocks.CurriculumRepository)
+	curriculumRepository.On("FindMatrix", "Fundamental", clr.SchoolYearId()).Return(matrix, nil)
+
+	assignment, err := New(repository, classRoomRepository, curriculumRepository).Assign(current.Id().String(), teacher.AssignmentRequestDto{
+		ClassRoomId:  clr.Id().String(),
+		SchoolYearId: schoolYearId,
+		SubjectId:    subject.SubjectId.String(),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, current.Id(), assignment.TeacherId())
+	assert.Equal(t, "Matematica", assignment.Subject())
+	repository.AssertCalled(t, "Assign", mock.Anything)
+}
+
+func TestShouldNotAssignSubjectOutsideClassRoomMatrix(t *testing.T) {
+	current := getTeacher()
+	schoolYearId := uuid.New().String()
+	clr := getClassRoom(schoolYearId)
+
+	repository := new(mocks.TeacherRepository)
+	repository.On("FindById", current.Id().String()).Return(current, nil)
+
+	classRoomRepository := new(mocks.ClassRoomRepository)
+	classRoomRepository.On("FindById", clr.Id().String()).Return(clr, nil)
+
+	curriculumRepository := new(mocks.CurriculumRepository)
+	curriculumRepository.On("FindMatrix", "Fundamental", clr.SchoolYearId()).Return(getMatrix(schoolYearId), nil)
+
+	_, err := New(repository, classRoomRepository, curriculumRepository).Assign(current.Id().String(), teacher.AssignmentRequestDto{
+		ClassRoomId:  clr.Id().String(),
+		SchoolYearId: schoolYearId,
+		SubjectId:    uuid.New().String(),
+	})
+	assert.EqualError(t, err, "subject is not part of the class room curriculum matrix")
+	repository.AssertNotCalled(t, "Assign", mock.Anything)
+}
+
+func TestShouldNotAssignSubjectAlreadyTaughtByAnotherTeacher(t *testing.T) {
+	current := getTeacher()
+	schoolYearId := uuid.New().String()
+	clr := getClassRoom(schoolYearId)
+	matrix := getMatrix(schoolYearId)
+	subject := matrix.Items()[0]
+	other := teacher.LoadAssignment(uuid.New(), uuid.New(), "Paula Reis", clr.Id(), "TUR-001", clr.SchoolYearId(), subject.SubjectId, "Matematica")
+
+	repository := new(mocks.TeacherRepository)
+	repository.On("FindById", current.Id().String()).Return(current, nil)
+	repository.On("FindAssignment", clr.Id(), subject.SubjectId).Return(other, nil)
+
+	classRoomRepository := new(mocks.ClassRoomRepository)
+	classRoomRepository.On("FindById", clr.Id().String()).Return(clr, nil)
+
+	curriculumRepository := new(mocks.CurriculumRepository)
+	curriculumRepository.On("FindMatrix", "Fundamental", clr.SchoolYearId()).Return(matrix, nil)
+
+	_, err := New(repository, classRoomRepository, curriculumRepository).Assign(current.Id().String(), teacher.AssignmentRequestDto{
+		ClassRoomId:  clr.Id().String(),
+		SchoolYearId: schoolYearId,
+		SubjectId:    subject.SubjectId.String(),
+	})
+	assert.EqualError(t, err, "subject already assigned to another teacher in the class room")
+	repository.AssertNotCalled(t, "Assign", mock.Anything)
+}
+
+func getTeacher() *teacher.Teacher {
+	tch, _ := teacher.New("Marcos", "Lima", "1985-04-12", "", "823.781.140-28", "marcos@gmail.com", "2023-02-01")
+	return tch
+}
+
+func getClassRoom(schoolYearId string) *classroom.ClassRoom {
+	clr, _ := classroom.New(10, "morning", "Fundamental", "TUR-001", schoolYearId, uuid.New().String(), uuid.New().String(), "ANY", "remote")
+	return clr
+}
+
+func getMatrix(schoolYearId string) *curriculum.Matrix {
+	subject, _ := curriculum.NewSubject("MAT", "Matematica", 200)
+	matrix, _ := curriculum.NewMatrix("Fundamental", schoolYearId)
+	_ = matrix.AddItem(*subject, 5)
+	return matrix
+}
+
+func getRequest() teacher.RequestDto {
+	return teacher.RequestDto{
+		FirstName:   "Marcos",
+		LastName:    "Lima",
+		Birthday:    "1985-04-12",
+		CpfDocument: "823.781.140-28",
+		Email:       "marcos@gmail.com",
+		HiredAt:     "2023-02-01",
+		Qualifications: []teacher.QualificationRequestDto{
+			{Degree: teacher.DegreeGraduation, Course: "Licenciatura em Matematica", Institution: "UFBA", ConclusionYear: 2008},
+		},
+	}
+}
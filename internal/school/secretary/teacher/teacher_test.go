@@ -0,0 +1,149 @@
+package teacher
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/curriculum"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/phone"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeacher(t *testing.T) {
+	t.Run("should create active teacher", func(t *testing.T) {
+		tch, err := New("Marcos", "Lima", "1985-04-12", "", "823.781.140-28", "marcos@gmail.com", "2023-02-01")
+		assert.NoError(t, err)
+		assert.Equal(t, "82378114028", string(tch.Cpf()))
+		assert.Equal(t, StatusActive, tch.Status())
+		assert.True(t, tch.Active())
+		assert.Equal(t, "Marcos Lima", tch.Name())
+	})
+
+	t.Run("should refuse invalid cpf", func(t *testing.T) {
+		_, err := New("Marcos", "Lima", "1985-04-12", "", "111.111.111-11", "marcos@gmail.com", "2023-02-01")
+		assert.Error(t, err)
+	})
+
+	t.Run("should require hiring date", func(t *testing.T) {
+		_, err := New("Marcos", "Lima", "1985-04-12", "", "823.781.140-28", "marcos@gmail.com", "")
+		assert.EqualError(t, err, "teacher hiring date cannot be empty")
+	})
+
+	t.Run("should keep phone number", func(t *testing.T) {
+		tch := getTeacher()
+		tch.AddPhones([]phone.RequestDto{{Description: "Celular", Phone: "71999990000"}})
+		assert.Equal(t, "71999990000", tch.Phones()[0].Phone)
+		assert.Equal(t, tch.Id(), tch.Phones()[0].OwnerId)
+	})
+
+	t.Run("should validate qualifications", func(t *testing.T) {
+		tch := getTeacher()
+
+		err := tch.AddQualifications([]QualificationRequestDto{
+			{Degree: DegreeGraduation, Course: "Licenciatura em Matematica", Institution: "UFBA", ConclusionYear: 2008},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, tch.Qualifications(), 1)
+
+		err = tch.AddQualifications([]QualificationRequestDto{
+			{Degree: "BACHELOR", Course: "Matematica", Institution: "UFBA", ConclusionYear: 2008},
+		})
+		assert.EqualError(t, err, "invalid qualification degree provided")
+
+		err = tch.AddQualifications([]QualificationRequestDto{
+			{Degree: DegreeMaster, Course: "Matematica", Institution: "UFBA", ConclusionYear: 1800},
+		})
+		assert.EqualError(t, err, "invalid qualification conclusion year provided")
+	})
+
+	t.Run("should register dismissal and rehiring", func(t *testing.T) {
+		tch := getTeacher()
+
+		err := tch.ChangeStatus(StatusDismissed)
+		assert.NoError(t, err)
+		assert.False(t, tch.Active())
+		assert.NotNil(t, tch.DismissedAt())
+
+		err = tch.ChangeStatus(StatusOnLeave)
+		assert.EqualError(t, err, "dismissed teacher cannot be put on leave")
+
+		err = tch.ChangeStatus(StatusActive)
+		assert.NoError(t, err)
+		assert.Nil(t, tch.DismissedAt())
+	})
+
+	t.Run("should refuse invalid status", func(t *testing.T) {
+		err := getTeacher().ChangeStatus("RETIRED")
+		assert.EqualError(t, err, "invalid teacher status provided")
+	})
+}
+
+func TestAssignment(t *testing.T) {
+	schoolYearId := uuid.New().String()
+	clr, _ := classroom.New(10, "morning", "Fundamental", "TUR-001", schoolYearId, uuid.New().String(), uuid.New().String(), "ANY", "remote")
+
+	subject := curriculum.MatrixItem{SubjectId: uuid.New(), Subject: "Matematica", WeeklyClasses: 5}
+
+	t.Run("should assign active teacher to class room subject", func(t *testing.T) {
+		tch := getTeacher()
+
+		assignment, err := NewAssignment(*tch, *clr, schoolYearId, subject)
+		assert.NoError(t, err)
+		assert.Equal(t, subject.SubjectId, assignment.SubjectId())
+		assert.Equal(t, "Matematica", assignment.Subject())
+		assert.Equal(t, clr.Id(), assignment.ClassRoomId())
+		assert.Equal(t, "TUR-001", assignment.ClassRoom())
+	})
+
+	t.Run("should not assign teacher on leave", func(t *testing.T) {
+		tch := getTeacher()
+		_ = tch.ChangeStatus(StatusOnLeave)
+
+		_, err := NewAssignment(*tch, *clr, schoolYearId, subject)
+		assert.EqualError(t, err, "teacher is not active")
+	})
+
+	t.Run("should not assign class room from another school year", func(t *testing.T) {
+		_, err := NewAssignment(*getTeacher(), *clr, uuid.New().String(), subject)
+		assert.EqualError(t, err, "class room does not belong to the school year provided")
+	})
+
+	t.Run("should require subject", func(t *testing.T) {
+		_, err := NewAssignment(*getTeacher(), *clr, schoolYearId, curriculum.MatrixItem{})
+		assert.EqualError(t, err, "subject cannot be empty")
+	})
+}
+
+func TestAvailability(t *testing.T) {
+	t.Run("should create availability period", func(t *testing.T) {
+		period, err := NewAvailability(1, "07:00:00", "12:00:00")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, period.Weekday)
+	})
+
+	t.Run("should not create period with start after end", func(t *testing.T) {
+		_, err := NewAvailability(1, "12:00:00", "07:00:00")
+		assert.EqualError(t, err, "availability start time must be before end time")
+	})
+
+	t.Run("should not create period with invalid weekday", func(t *testing.T) {
+		_, err := NewAvailability(7, "07:00:00", "12:00:00")
+		assert.EqualError(t, err, "invalid weekday provided")
+	})
+
+	t.Run("should check lesson inside availability", func(t *testing.T) {
+		period, _ := NewAvailability(1, "07:00:00", "12:00:00")
+		availability := []Availability{*period}
+
+		assert.True(t, Available(availability, 1, "07:50:00", "08:40:00"))
+		assert.False(t, Available(availability, 1, "11:30:00", "12:20:00"))
+		assert.False(t, Available(availability, 2, "07:50:00", "08:40:00"))
+		assert.True(t, Available(nil, 2, "07:50:00", "08:40:00"))
+	})
+}
+
+func getTeacher() *Teacher {
+	tch, _ := New("Marcos", "Lima", "1985-04-12", "", "823.781.140-28", "marcos@gmail.com", "2023-02-01")
+	return tch
+}
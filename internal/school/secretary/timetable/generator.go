@@ -0,0 +1,202 @@
+package timetable
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// searchLimit Tentativas de encaixe da busca com retrocesso. Ao esgotar, a grade e montada pela
+// primeira opcao livre de cada aula e as aulas sem horario sao informadas
+const searchLimit = 200000
+
+// Issue Restricao que nao pode ser atendida na geracao da grade
+type Issue struct {
+	ClassRoomId uuid.UUID `json:"class_room_id"`
+	ClassRoom   string    `json:"class_room"`
+	Subject     string    `json:"subject"`
+	Message     string    `json:"message"`
+}
+
+// Result Grade gerada e restricoes que nao puderam ser atendidas
+type Result struct {
+	Lessons []Lesson `json:"lessons"`
+	Issues  []Issue  `json:"issues"`
+}
+
+type unit struct {
+	classRoom ClassRoom
+	subject   Subject
+	rooms     []Room
+	options   int
+}
+
+type search struct {
+	grid         *Grid
+	schoolYearId uuid.UUID
+	units        []unit
+	steps        int
+}
+
+// Generate Distribui as aulas semanais de cada disciplina das turmas nos horarios de aula. As
+// aulas mais restritas sao encaixadas primeiro e as aulas de uma disciplina sao espalhadas pelos
+// dias da semana
+func Generate(schoolYearId uuid.UUID, grid *Grid) *Result {
+	result := &Result{}
+	var units []unit
+
+	for _, classRoom := range grid.ClassRooms() {
+		if len(classRoom.Slots) == 0 {
+			result.Issues = append(result.Issues, newIssue(classRoom, "", "class room has no lesson slots"))
+			continue
+		}
+
+		rooms := grid.RoomsFor(classRoom)
+		if len(rooms) == 0 {
+			result.Issues = append(result.Issues, newIssue(classRoom, "", "no room with capacity for the class room vacancies"))
+			continue
+		}
+
+		weeklyClasses := 0
+		for _, subject := range classRoom.Subjects {
+			weeklyClasses += subject.WeeklyClasses
+
+			options := 0
+			for _, slot := range classRoom.Slots {
+				if grid.available(subject.TeacherId, slot) {
+					options++
+				}
+			}
+
+			if options < subject.WeeklyClasses {
+				result.Issues = append(result.Issues, newIssue(classRoom, subject.Name, "teacher is not available for all weekly classes"))
+			}
+
+			for i := 0; i < subject.WeeklyClasses; i++ {
+				units = append(units, unit{classRoom: classRoom, subject: subject, rooms: rooms, options: options})
+			}
+		}
+
+		if weeklyClasses > len(classRoom.Slots) {
+			result.Issues = append(result.Issues, newIssue(classRoom, "", "weekly classes exceed the class room lesson slots"))
+		}
+	}
+
+	sort.SliceStable(units, func(i, j int) bool {
+		return units[i].options < units[j].options
+	})
+
+	s := &search{grid: grid, schoolYearId: schoolYearId, units: units}
+
+	if !s.place(0) {
+		for _, lesson := range grid.Lessons() {
+			grid.Remove(lesson.Id())
+		}
+
+		for _, u := range units {
+			if !s.placeFirst(u) {
+				result.Issues = append(result.Issues, newIssue(u.classRoom, u.subject.Name, "no free slot for the lesson without conflicts"))
+			}
+		}
+	}
+
+	result.Lessons = grid.Lessons()
+
+	return result
+}
+
+// place Encaixa as aulas a partir da posicao informada, desfazendo encaixes que impedem as
+// aulas seguintes
+func (s *search) place(position int) bool {
+	if position == len(s.units) {
+		return true
+	}
+
+	u := s.units[position]
+
+	for _, slot := range s.slots(u) {
+		for _, room := range u.rooms {
+			s.steps++
+			if s.steps > searchLimit {
+				return false
+			}
+
+			lesson := NewLesson(s.schoolYearId, u.classRoom, u.subject, room, slot)
+			if len(s.grid.Check(*lesson)) > 0 {
+				continue
+			}
+
+			s.grid.Add(*lesson)
+			if s.place(position + 1) {
+				return true
+			}
+
+			s.grid.Remove(lesson.Id())
+			if s.steps > searchLimit {
+				return false
+			}
+		}
+	}
+
+	return false
+}
+
+func (s *search) placeFirst(u unit) bool {
+	for _, slot := range s.slots(u) {
+		for _, room := range u.rooms {
+			lesson := NewLesson(s.schoolYearId, u.classRoom, u.subject, room, slot)
+			if len(s.grid.Check(*lesson)) == 0 {
+				s.grid.Add(*lesson)
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// slots Horarios da turma em ordem de preferencia: dias com menos aulas da disciplina e depois
+// dias com menos aulas da turma
+func (s *search) slots(u unit) []Slot {
+	subjectPerDay := make(map[int]int)
+	classPerDay := make(map[int]int)
+
+	for _, id := range s.grid.busy[u.classRoom.Id] {
+		lesson := s.grid.lessons[id]
+		classPerDay[lesson.Slot().Weekday]++
+		if lesson.SubjectId() == u.subject.Id {
+			subjectPerDay[lesson.Slot().Weekday]++
+		}
+	}
+
+	slots := make([]Slot, len(u.classRoom.Slots))
+	copy(slots, u.classRoom.Slots)
+
+	sort.SliceStable(slots, func(i, j int) bool {
+		a, b := slots[i], slots[j]
+		if subjectPerDay[a.Weekday] != subjectPerDay[b.Weekday] {
+			return subjectPerDay[a.Weekday] < subjectPerDay[b.Weekday]
+		}
+
+		if classPerDay[a.Weekday] != classPerDay[b.Weekday] {
+			return classPerDay[a.Weekday] < classPerDay[b.Weekday]
+		}
+
+		if a.Weekday != b.Weekday {
+			return a.Weekday < b.Weekday
+		}
+
+		return a.StartAt < b.StartAt
+	})
+
+	return slots
+}
+
+func newIssue(classRoom ClassRoom, subject string, message string) Issue {
+	return Issue{
+		ClassRoomId: classRoom.Id,
+		ClassRoom:   classRoom.Identification,
+		Subject:     subject,
+		Message:     message,
+	}
+}
@@ -0,0 +1,292 @@
+package timetable
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/teacher"
+)
+
+// Room Sala que pode receber aulas
+type Room struct {
+	Id       uuid.UUID `json:"id"`
+	Code     string    `json:"code"`
+	Capacity int       `json:"capacity"`
+}
+
+// Subject Disciplina da matriz curricular da turma com o professor atribuido
+type Subject struct {
+	Id            uuid.UUID `json:"id"`
+	Name          string    `json:"name"`
+	TeacherId     uuid.UUID `json:"teacher_id"`
+	Teacher       string    `json:"teacher"`
+	WeeklyClasses int       `json:"weekly_classes"`
+}
+
+// ClassRoom Turma da grade com seus horarios de aula e as disciplinas a distribuir. A sala da
+// turma, quando informada, e a preferida para as aulas
+type ClassRoom struct {
+	Id             uuid.UUID     `json:"id"`
+	Identification string        `json:"identification"`
+	Vacancies      int           `json:"vacancies"`
+	RoomId         uuid.NullUUID `json:"room_id"`
+	Slots          []Slot        `json:"slots"`
+	Subjects       []Subject     `json:"subjects"`
+}
+
+func (c ClassRoom) Slot(scheduleId uuid.UUID, weekday int) (Slot, bool) {
+	for _, slot := range c.Slots {
+		if slot.ScheduleId == scheduleId && slot.Weekday == weekday {
+			return slot, true
+		}
+	}
+
+	return Slot{}, false
+}
+
+func (c ClassRoom) Subject(subjectId uuid.UUID) (Subject, bool) {
+	for _, subject := range c.Subjects {
+		if subject.Id == subjectId {
+			return subject, true
+		}
+	}
+
+	return Subject{}, false
+}
+
+// Conflict Aula da grade que fere alguma regra
+type Conflict struct {
+	Lesson   Lesson   `json:"lesson"`
+	Messages []string `json:"messages"`
+}
+
+// Grid Grade horaria do ano letivo. Confere cada aula contra as aulas ja distribuidas: turma,
+// professor e sala nao podem ter duas aulas no mesmo horario, a sala precisa comportar as vagas
+// da turma e o professor precisa estar disponivel
+type Grid struct {
+	classRooms   map[uuid.UUID]ClassRoom
+	rooms        map[uuid.UUID]Room
+	availability map[uuid.UUID][]teacher.Availability
+	lessons      map[uuid.UUID]Lesson
+	busy         map[uuid.UUID][]uuid.UUID
+}
+
+func NewGrid(classRooms []ClassRoom, rooms []Room, availability map[uuid.UUID][]teacher.Availability) *Grid {
+	g := &Grid{
+		classRooms:   make(map[uuid.UUID]ClassRoom),
+		rooms:        make(map[uuid.UUID]Room),
+		availability: availability,
+		lessons:      make(map[uuid.UUID]Lesson),
+		busy:         make(map[uuid.UUID][]uuid.UUID),
+	}
+
+	for _, classRoom := range classRooms {
+		g.classRooms[classRoom.Id] = classRoom
+	}
+
+	for _, room := range rooms {
+		g.rooms[room.Id] = room
+	}
+
+	return g
+}
+
+func (g *Grid) ClassRoom(id uuid.UUID) (ClassRoom, bool) {
+	classRoom, found := g.classRooms[id]
+	return classRoom, found
+}
+
+func (g *Grid) Room(id uuid.UUID) (Room, bool) {
+	room, found := g.rooms[id]
+	return room, found
+}
+
+// ClassRooms Turmas ordenadas pela identificacao
+func (g *Grid) ClassRooms() []ClassRoom {
+	var classRooms []ClassRoom
+
+	for _, classRoom := range g.classRooms {
+		classRooms = append(classRooms, classRoom)
+	}
+
+	sort.Slice(classRooms, func(i, j int) bool {
+		return classRooms[i].Identification < classRooms[j].Identification
+	})
+
+	return classRooms
+}
+
+// RoomsFor Salas que comportam as vagas da turma. A sala da turma vem primeiro e as demais da
+// menor para a maior capacidade
+func (g *Grid) RoomsFor(classRoom ClassRoom) []Room {
+	var rooms []Room
+
+	for _, room := range g.rooms {
+		if room.Capacity < classRoom.Vacancies {
+			continue
+		}
+		rooms = append(rooms, room)
+	}
+
+	sort.Slice(rooms, func(i, j int) bool {
+		if classRoom.RoomId.Valid && (rooms[i].Id == classRoom.RoomId.UUID) != (rooms[j].Id == classRoom.RoomId.UUID) {
+			return rooms[i].Id == classRoom.RoomId.UUID
+		}
+
+		if rooms[i].Capacity != rooms[j].Capacity {
+			return rooms[i].Capacity < rooms[j].Capacity
+		}
+
+		return rooms[i].Code < rooms[j].Code
+	})
+
+	return rooms
+}
+
+// Check Regras feridas pela aula. A propria aula, quando ja esta na grade, e ignorada
+func (g *Grid) Check(lesson Lesson) []string {
+	var messages []string
+
+	classRoom, found := g.classRooms[lesson.ClassRoomId()]
+	if !found {
+		return []string{"class room is not part of the timetable"}
+	}
+
+	slot := lesson.Slot()
+	if _, found = classRoom.Slot(slot.ScheduleId, slot.Weekday); !found {
+		messages = append(messages, "schedule is not a lesson slot of the class room")
+	}
+
+	subject, found := classRoom.Subject(lesson.SubjectId())
+	switch {
+	case !found:
+		messages = append(messages, "subject is not part of the class room curriculum matrix")
+	case subject.TeacherId != lesson.TeacherId():
+		messages = append(messages, "teacher is not assigned to the subject in the class room")
+	case g.weeklyClasses(lesson) >= subject.WeeklyClasses:
+		messages = append(messages, "subject already has all its weekly classes")
+	}
+
+	room, found := g.rooms[lesson.RoomId()]
+	if !found {
+		messages = append(messages, "room not found")
+	} else if room.Capacity < classRoom.Vacancies {
+		messages = append(messages, "room capacity is less than the class room vacancies")
+	}
+
+	if !g.available(lesson.TeacherId(), slot) {
+		messages = append(messages, "teacher is not available at this time")
+	}
+
+	if g.occupied(lesson.ClassRoomId(), lesson) {
+		messages = append(messages, "class room already has a lesson at this time")
+	}
+
+	if g.occupied(lesson.TeacherId(), lesson) {
+		messages = append(messages, "teacher already has a lesson at this time")
+	}
+
+	if g.occupied(lesson.RoomId(), lesson) {
+		messages = append(messages, "room already has a lesson at this time")
+	}
+
+	return messages
+}
+
+// Add Inclui a aula na grade sem conferir as regras
+func (g *Grid) Add(lesson Lesson) {
+	g.lessons[lesson.Id()] = lesson
+
+	for _, owner := range []uuid.UUID{lesson.ClassRoomId(), lesson.TeacherId(), lesson.RoomId()} {
+		g.busy[owner] = append(g.busy[owner], lesson.Id())
+	}
+}
+
+func (g *Grid) Remove(id uuid.UUID) {
+	lesson, found := g.lessons[id]
+	if !found {
+		return
+	}
+
+	delete(g.lessons, id)
+
+	for _, owner := range []uuid.UUID{lesson.ClassRoomId(), lesson.TeacherId(), lesson.RoomId()} {
+		var ids []uuid.UUID
+		for _, lessonId := range g.busy[owner] {
+			if lessonId != id {
+				ids = append(ids, lessonId)
+			}
+		}
+		g.busy[owner] = ids
+	}
+}
+
+// Lessons Aulas ordenadas por turma, dia e horario
+func (g *Grid) Lessons() []Lesson {
+	var lessons []Lesson
+
+	for _, lesson := range g.lessons {
+		lessons = append(lessons, lesson)
+	}
+
+	sort.Slice(lessons, func(i, j int) bool {
+		a, b := lessons[i], lessons[j]
+		if a.ClassRoom() != b.ClassRoom() {
+			return a.ClassRoom() < b.ClassRoom()
+		}
+
+		if a.Slot().Weekday != b.Slot().Weekday {
+			return a.Slot().Weekday < b.Slot().Weekday
+		}
+
+		return a.Slot().StartAt < b.Slot().StartAt
+	})
+
+	return lessons
+}
+
+// Validate Confere todas as aulas da grade
+func (g *Grid) Validate() []Conflict {
+	var conflicts []Conflict
+
+	for _, lesson := range g.Lessons() {
+		messages := g.Check(lesson)
+		if len(messages) > 0 {
+			conflicts = append(conflicts, Conflict{
+				Lesson:   lesson,
+				Messages: messages,
+			})
+		}
+	}
+
+	return conflicts
+}
+
+func (g *Grid) available(teacherId uuid.UUID, slot Slot) bool {
+	return teacher.Available(g.availability[teacherId], slot.Weekday, slot.StartAt, slot.EndAt)
+}
+
+// weeklyClasses Aulas da disciplina ja distribuidas na semana da turma
+func (g *Grid) weeklyClasses(lesson Lesson) int {
+	total := 0
+
+	for _, id := range g.busy[lesson.ClassRoomId()] {
+		other := g.lessons[id]
+		if other.Id() != lesson.Id() && other.SubjectId() == lesson.SubjectId() {
+			total++
+		}
+	}
+
+	return total
+}
+
+func (g *Grid) occupied(owner uuid.UUID, lesson Lesson) bool {
+	for _, id := range g.busy[owner] {
+		other := g.lessons[id]
+		if other.Id() != lesson.Id() && other.Slot().Overlaps(lesson.Slot()) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,175 @@
+package timetable
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+// Slot Horario de aula em um dia da semana. O dia segue time.Weekday e os horarios o formato
+// 15:04:05 dos horarios cadastrados no ano letivo
+type Slot struct {
+	ScheduleId uuid.UUID `json:"schedule_id"`
+	Weekday    int       `json:"weekday"`
+	StartAt    string    `json:"start_at"`
+	EndAt      string    `json:"end_at"`
+}
+
+// Overlaps Informa se os horarios caem no mesmo dia e se sobrepoem
+func (s Slot) Overlaps(other Slot) bool {
+	return s.Weekday == other.Weekday && s.StartAt < other.EndAt && other.StartAt < s.EndAt
+}
+
+// Lesson Aula semanal da grade horaria: disciplina da turma com o professor atribuido, a sala e
+// o horario em que acontece
+type Lesson struct {
+	id           uuid.UUID
+	schoolYearId uuid.UUID
+	classRoomId  uuid.UUID
+	classRoom    string
+	subjectId    uuid.UUID
+	subject      string
+	teacherId    uuid.UUID
+	teacher      string
+	roomId       uuid.UUID
+	room         string
+	slot         Slot
+}
+
+func NewLesson(schoolYearId uuid.UUID, classRoom ClassRoom, subject Subject, room Room, slot Slot) *Lesson {
+	return &Lesson{
+		id:           uuid.New(),
+		schoolYearId: schoolYearId,
+		classRoomId:  classRoom.Id,
+		classRoom:    classRoom.Identification,
+		subjectId:    subject.Id,
+		subject:      subject.Name,
+		teacherId:    subject.TeacherId,
+		teacher:      subject.Teacher,
+		roomId:       room.Id,
+		room:         room.Code,
+		slot:         slot,
+	}
+}
+
+func LoadLesson(
+	id string,
+	schoolYearId uuid.UUID,
+	classRoomId uuid.UUID,
+	classRoom string,
+	subjectId uuid.UUID,
+	subject string,
+	teacherId uuid.UUID,
+	teacher string,
+	roomId uuid.UUID,
+	room string,
+	slot Slot,
+) (*Lesson, error) {
+
+	lessonId, err := uuid.Parse(id)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to change lesson id")
+	}
+
+	return &Lesson{
+		id:           lessonId,
+		schoolYearId: schoolYearId,
+		classRoomId:  classRoomId,
+		classRoom:    classRoom,
+		subjectId:    subjectId,
+		subject:      subject,
+		teacherId:    teacherId,
+		teacher:      teacher,
+		roomId:       roomId,
+		room:         room,
+		slot:         slot,
+	}, nil
+}
+
+// Move Troca o horario e a sala da aula
+func (l *Lesson) Move(room Room, slot Slot) {
+	l.roomId = room.Id
+	l.room = room.Code
+	l.slot = slot
+}
+
+func (l *Lesson) Id() uuid.UUID {
+	return l.id
+}
+
+func (l *Lesson) SchoolYearId() uuid.UUID {
+	return l.schoolYearId
+}
+
+func (l *Lesson) ClassRoomId() uuid.UUID {
+	return l.classRoomId
+}
+
+func (l *Lesson) ClassRoom() string {
+	return l.classRoom
+}
+
+func (l *Lesson) SubjectId() uuid.UUID {
+	return l.subjectId
+}
+
+func (l *Lesson) Subject() string {
+	return l.subject
+}
+
+func (l *Lesson) TeacherId() uuid.UUID {
+	return l.teacherId
+}
+
+func (l *Lesson) Teacher() string {
+	return l.teacher
+}
+
+func (l *Lesson) RoomId() uuid.UUID {
+	return l.roomId
+}
+
+func (l *Lesson) Room() string {
+	return l.room
+}
+
+func (l *Lesson) Slot() Slot {
+	return l.slot
+}
+
+func (l *Lesson) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Id           string `json:"id"`
+		SchoolYearId string `json:"school_year_id"`
+		ClassRoomId  string `json:"class_room_id"`
+		ClassRoom    string `json:"class_room"`
+		SubjectId    string `json:"subject_id"`
+		Subject      string `json:"subject"`
+		TeacherId    string `json:"teacher_id"`
+		Teacher      string `json:"teacher"`
+		RoomId       string `json:"room_id"`
+		Room         string `json:"room"`
+		ScheduleId   string `json:"schedule_id"`
+		Weekday      int    `json:"weekday"`
+		StartAt      string `json:"start_at"`
+		EndAt        string `json:"end_at"`
+	}{
+		Id:           l.Id().String(),
+		SchoolYearId: l.SchoolYearId().String(),
+		ClassRoomId:  l.ClassRoomId().String(),
+		ClassRoom:    l.ClassRoom(),
+		SubjectId:    l.SubjectId().String(),
+		Subject:      l.Subject(),
+		TeacherId:    l.TeacherId().String(),
+		Teacher:      l.Teacher(),
+		RoomId:       l.RoomId().String(),
+		Room:         l.Room(),
+		ScheduleId:   l.Slot().ScheduleId.String(),
+		Weekday:      l.Slot().Weekday,
+		StartAt:      l.Slot().StartAt,
+		EndAt:        l.Slot().EndAt,
+	})
+}
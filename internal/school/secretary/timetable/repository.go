@@ -0,0 +1,13 @@
+package timetable
+
+import "github.com/google/uuid"
+
+type Repository interface {
+	ReplaceLessons(schoolYearId uuid.UUID, lessons []Lesson) error
+	CreateLesson(lesson Lesson) error
+	UpdateLesson(lesson Lesson) error
+	DeleteLesson(id string) error
+	FindLessonById(id string) (*Lesson, error)
+	FindLessons(schoolYearId uuid.UUID) ([]Lesson, error)
+	FindRooms() ([]Room, error)
+}
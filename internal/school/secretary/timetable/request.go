@@ -0,0 +1,42 @@
+package timetable
+
+import "github.com/go-playground/validator"
+
+// GenerateRequestDto Ano letivo da grade e dias da semana com aula. Sem dias informados a grade
+// segue os dias da semana de cada horario
+type GenerateRequestDto struct {
+	SchoolYearId string `json:"school_year_id" validate:"required,uuid"`
+	Weekdays     []int  `json:"weekdays" validate:"omitempty,dive,min=0,max=6"`
+}
+
+func (g *GenerateRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(g)
+}
+
+// LessonRequestDto Aula incluida manualmente na grade. O professor e o atribuido a disciplina
+// da turma
+type LessonRequestDto struct {
+	ClassRoomId string `json:"class_room_id" validate:"required,uuid"`
+	SubjectId   string `json:"subject_id" validate:"required,uuid"`
+	RoomId      string `json:"room_id" validate:"required,uuid"`
+	ScheduleId  string `json:"schedule_id" validate:"required,uuid"`
+	Weekday     int    `json:"weekday" validate:"min=0,max=6"`
+}
+
+func (l *LessonRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(l)
+}
+
+// MoveLessonRequestDto Novo horario e sala de uma aula da grade
+type MoveLessonRequestDto struct {
+	RoomId     string `json:"room_id" validate:"required,uuid"`
+	ScheduleId string `json:"schedule_id" validate:"required,uuid"`
+	Weekday    int    `json:"weekday" validate:"min=0,max=6"`
+}
+
+func (m *MoveLessonRequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(m)
+}
@@ -0,0 +1,458 @@
+package timetableService
+
+import (
+	"errors"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/curriculum"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schedule"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/teacher"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/timetable"
+)
+
+// allWeekdays Sem dias informados, as aulas seguem apenas os dias da semana dos horarios
+var allWeekdays = []int{0, 1, 2, 3, 4, 5, 6}
+
+type TimetableActionsInterface interface {
+	Generate(dto timetable.GenerateRequestDto) (*timetable.Result, error)
+	Find(schoolYearId string, classRoomId string) ([]timetable.Lesson, error)
+	Validate(schoolYearId string) ([]timetable.Conflict, error)
+	CreateLesson(dto timetable.LessonRequestDto) (*timetable.Lesson, error)
+	UpdateLesson(id string, dto timetable.MoveLessonRequestDto) (*timetable.Lesson, error)
+	DeleteLesson(id string) error
+}
+
+type TimetableActions struct {
+	repository           timetable.Repository
+	schoolYearRepository schoolyear.Repository
+	classRoomRepository  classroom.Repository
+	scheduleRepository   schedule.Repository
+	curriculumRepository curriculum.Repository
+	teacherRepository    teacher.Repository
+}
+
+func New(
+	repository timetable.Repository,
+	schoolYearRepository schoolyear.Repository,
+	classRoomRepository classroom.Repository,
+	scheduleRepository schedule.Repository,
+	curriculumRepository curriculum.Repository,
+	teacherRepository teacher.Repository,
+) *TimetableActions {
+	return &TimetableActions{
+		repository:           repository,
+		schoolYearRepository: schoolYearRepository,
+		classRoomRepository:  classRoomRepository,
+		scheduleRepository:   scheduleRepository,
+		curriculumRepository: curriculumRepository,
+		teacherRepository:    teacherRepository,
+	}
+}
+
+// Generate Gera a grade das turmas do ano letivo e substitui a grade gravada. As restricoes que
+// nao puderam ser atendidas acompanham o resultado
+func (t *TimetableActions) Generate(dto timetable.GenerateRequestDto) (*timetable.Result, error) {
+	schoolYearId, err := t.checkSchoolYear(dto.SchoolYearId)
+	if err != nil {
+		return nil, err
+	}
+
+	weekdays := dto.Weekdays
+	if len(weekdays) == 0 {
+		weekdays = allWeekdays
+	}
+
+	grid, issues, err := t.grid(schoolYearId, weekdays)
+	if err != nil {
+		return nil, err
+	}
+
+	result := timetable.Generate(schoolYearId, grid)
+	result.Issues = append(issues, result.Issues...)
+
+	err = t.repository.ReplaceLessons(schoolYearId, result.Lessons)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to save timetable")
+	}
+
+	return result, nil
+}
+
+// Find Aulas da grade do ano letivo. A turma pode ser informada para trazer apenas suas aulas
+func (t *TimetableActions) Find(schoolYearId string, classRoomId string) ([]timetable.Lesson, error) {
+	yearId, err := uuid.Parse(schoolYearId)
+	if err != nil {
+		return nil, errors.New("invalid school year id provided")
+	}
+
+	lessons, err := t.repository.FindLessons(yearId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve timetable")
+	}
+
+	if classRoomId == "" {
+		return lessons, nil
+	}
+
+	var classRoomLessons []timetable.Lesson
+
+	for _, lesson := range lessons {
+		if lesson.ClassRoomId().String() == classRoomId {
+			classRoomLessons = append(classRoomLessons, lesson)
+		}
+	}
+
+	return classRoomLessons, nil
+}
+
+// Validate Confere a grade gravada contra os dados atuais de turmas, matrizes, atribuicoes,
+// salas e disponibilidade dos professores
+func (t *TimetableActions) Validate(schoolYearId string) ([]timetable.Conflict, error) {
+	yearId, err := t.checkSchoolYear(schoolYearId)
+	if err != nil {
+		return nil, err
+	}
+
+	grid, err := t.currentGrid(yearId)
+	if err != nil {
+		return nil, err
+	}
+
+	return grid.Validate(), nil
+}
+
+func (t *TimetableActions) CreateLesson(dto timetable.LessonRequestDto) (*timetable.Lesson, error) {
+	clr, err := t.classRoomRepository.FindById(dto.ClassRoomId)
+	if err != nil || clr == nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve class room")
+	}
+
+	grid, err := t.currentGrid(clr.SchoolYearId())
+	if err != nil {
+		return nil, err
+	}
+
+	classRoom, found := grid.ClassRoom(clr.Id())
+	if !found {
+		return nil, errors.New("class room is not part of the timetable")
+	}
+
+	subjectId, err := uuid.Parse(dto.SubjectId)
+	if err != nil {
+		return nil, errors.New("invalid subject id provided")
+	}
+
+	subject, found := classRoom.Subject(subjectId)
+	if !found {
+		return nil, errors.New("subject is not part of the class room curriculum matrix or has no teacher assigned")
+	}
+
+	room, slot, err := t.position(grid, classRoom, dto.RoomId, dto.ScheduleId, dto.Weekday)
+	if err != nil {
+		return nil, err
+	}
+
+	lesson := timetable.NewLesson(clr.SchoolYearId(), classRoom, subject, room, slot)
+
+	err = checkLesson(grid, *lesson)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.repository.CreateLesson(*lesson)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to create lesson")
+	}
+
+	return lesson, nil
+}
+
+// UpdateLesson Move a aula para outro horario ou sala. A grade e conferida novamente antes da
+// alteracao ser gravada
+func (t *TimetableActions) UpdateLesson(id string, dto timetable.MoveLessonRequestDto) (*timetable.Lesson, error) {
+	lesson, err := t.repository.FindLessonById(id)
+	if err != nil || lesson == nil {
+		log.Println(err)
+		return nil, errors.New("lesson not found")
+	}
+
+	grid, err := t.currentGrid(lesson.SchoolYearId())
+	if err != nil {
+		return nil, err
+	}
+
+	classRoom, found := grid.ClassRoom(lesson.ClassRoomId())
+	if !found {
+		return nil, errors.New("class room is not part of the timetable")
+	}
+
+	room, slot, err := t.position(grid, classRoom, dto.RoomId, dto.ScheduleId, dto.Weekday)
+	if err != nil {
+		return nil, err
+	}
+
+	lesson.Move(room, slot)
+
+	err = checkLesson(grid, *lesson)
+	if err != nil {
+		return nil, err
+	}
+
+	err = t.repository.UpdateLesson(*lesson)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to update lesson")
+	}
+
+	return lesson, nil
+}
+
+func (t *TimetableActions) DeleteLesson(id string) error {
+	err := t.repository.DeleteLesson(id)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to delete lesson")
+	}
+
+	return nil
+}
+
+// grid Monta a grade vazia do ano letivo. Os horarios de aula de cada turma sao os horarios do
+// ano letivo contidos no periodo da turma, repetidos nos dias informados
+func (t *TimetableActions) grid(schoolYearId uuid.UUID, weekdays []int) (*timetable.Grid, []timetable.Issue, error) {
+	classRooms, err := t.classRoomRepository.FindBySchoolYear(schoolYearId)
+	if err != nil {
+		log.Println(err)
+		return nil, nil, errors.New("failed to retrieve class rooms")
+	}
+
+	schedules, err := t.scheduleRepository.FindBySchoolYear(schoolYearId)
+	if err != nil {
+		log.Println(err)
+		return nil, nil, errors.New("failed to retrieve schedules")
+	}
+
+	rooms, err := t.repository.FindRooms()
+	if err != nil {
+		log.Println(err)
+		return nil, nil, errors.New("failed to retrieve rooms")
+	}
+
+	var issues []timetable.Issue
+	var gridClassRooms []timetable.ClassRoom
+	availability := make(map[uuid.UUID][]teacher.Availability)
+
+	for _, clr := range classRooms {
+		classRoom := timetable.ClassRoom{
+			Id:             clr.Id(),
+			Identification: clr.Identification(),
+			Vacancies:      clr.VacancyQuantity(),
+			RoomId:         clr.RoomId(),
+			Slots:          lessonSlots(clr, schedules, weekdays),
+		}
+
+		subjects, subjectIssues, err := t.subjects(clr)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, subject := range subjects {
+			if _, found := availability[subject.TeacherId]; found {
+				continue
+			}
+
+			availability[subject.TeacherId], err = t.teacherRepository.FindAvailability(subject.TeacherId)
+			if err != nil {
+				log.Println(err)
+				return nil, nil, errors.New("failed to retrieve teacher availability")
+			}
+		}
+
+		classRoom.Subjects = subjects
+		issues = append(issues, subjectIssues...)
+		gridClassRooms = append(gridClassRooms, classRoom)
+	}
+
+	return timetable.NewGrid(gridClassRooms, rooms, availability), issues, nil
+}
+
+// currentGrid Grade do ano letivo com as aulas gravadas
+func (t *TimetableActions) currentGrid(schoolYearId uuid.UUID) (*timetable.Grid, error) {
+	grid, _, err := t.grid(schoolYearId, allWeekdays)
+	if err != nil {
+		return nil, err
+	}
+
+	lessons, err := t.repository.FindLessons(schoolYearId)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve timetable")
+	}
+
+	for _, lesson := range lessons {
+		grid.Add(lesson)
+	}
+
+	return grid, nil
+}
+
+// subjects Disciplinas da matriz curricular da turma com o professor atribuido. Disciplinas sem
+// professor ficam fora da grade e sao informadas
+func (t *TimetableActions) subjects(clr classroom.ClassRoom) ([]timetable.Subject, []timetable.Issue, error) {
+	issue := func(subject string, message string) timetable.Issue {
+		return timetable.Issue{
+			ClassRoomId: clr.Id(),
+			ClassRoom:   clr.Identification(),
+			Subject:     subject,
+			Message:     message,
+		}
+	}
+
+	matrix, err := t.curriculumRepository.FindMatrix(clr.Level(), clr.SchoolYearId())
+	if err != nil {
+		log.Println(err)
+		return nil, nil, errors.New("failed to retrieve curriculum matrix")
+	}
+
+	if matrix == nil {
+		return nil, []timetable.Issue{issue("", "class room level has no curriculum matrix in the school year")}, nil
+	}
+
+	assignments, err := t.teacherRepository.FindClassRoomAssignments(clr.Id().String())
+	if err != nil {
+		log.Println(err)
+		return nil, nil, errors.New("failed to retrieve class room assignments")
+	}
+
+	var subjects []timetable.Subject
+	var issues []timetable.Issue
+
+	for _, item := range matrix.Items() {
+		var assignment *teacher.Assignment
+		for i := range assignments {
+			if assignments[i].SubjectId() == item.SubjectId {
+				assignment = &assignments[i]
+				break
+			}
+		}
+
+		if assignment == nil {
+			issues = append(issues, issue(item.Subject, "subject has no teacher assigned"))
+			continue
+		}
+
+		subjects = append(subjects, timetable.Subject{
+			Id:            item.SubjectId,
+			Name:          item.Subject,
+			TeacherId:     assignment.TeacherId(),
+			Teacher:       assignment.TeacherName(),
+			WeeklyClasses: item.WeeklyClasses,
+		})
+	}
+
+	return subjects, issues, nil
+}
+
+// position Sala e horario de aula da turma escolhidos na edicao manual
+func (t *TimetableActions) position(grid *timetable.Grid, classRoom timetable.ClassRoom, roomId string, scheduleId string, weekday int) (timetable.Room, timetable.Slot, error) {
+	parsedRoomId, err := uuid.Parse(roomId)
+	if err != nil {
+		return timetable.Room{}, timetable.Slot{}, errors.New("invalid room id provided")
+	}
+
+	parsedScheduleId, err := uuid.Parse(scheduleId)
+	if err != nil {
+		return timetable.Room{}, timetable.Slot{}, errors.New("invalid schedule id provided")
+	}
+
+	room, found := grid.Room(parsedRoomId)
+	if !found {
+		return timetable.Room{}, timetable.Slot{}, errors.New("room not found")
+	}
+
+	slot, found := classRoom.Slot(parsedScheduleId, weekday)
+	if !found {
+		return timetable.Room{}, timetable.Slot{}, errors.New("schedule is not a lesson slot of the class room")
+	}
+
+	return room, slot, nil
+}
+
+func (t *TimetableActions) checkSchoolYear(id string) (uuid.UUID, error) {
+	schoolYear, err := t.schoolYearRepository.FindById(id)
+	if err != nil || schoolYear == nil {
+		log.Println(err)
+		return uuid.Nil, errors.New("school year not found")
+	}
+
+	return schoolYear.Id(), nil
+}
+
+func checkLesson(grid *timetable.Grid, lesson timetable.Lesson) error {
+	messages := grid.Check(lesson)
+	if len(messages) > 0 {
+		return errors.New("lesson conflicts with the timetable: " + strings.Join(messages, ", "))
+	}
+
+	return nil
+}
+
+// lessonSlots Horarios do ano letivo contidos no periodo da turma, sem o proprio periodo, nos dias
+// da semana em que a turma e o horario acontecem
+func lessonSlots(clr classroom.ClassRoom, schedules []schedule.ScheduleClass, weekdays []int) []timetable.Slot {
+	var period *schedule.ScheduleClass
+	for i := range schedules {
+		if schedules[i].Id() == clr.ScheduleId() {
+			period = &schedules[i]
+			break
+		}
+	}
+
+	if period == nil {
+		return nil
+	}
+
+	var slots []timetable.Slot
+
+	for _, weekday := range weekdays {
+		if !period.HasWeekday(weekday) {
+			continue
+		}
+
+		for _, sch := range schedules {
+			if sch.Id() == period.Id() || sch.StartAt() < period.StartAt() || sch.EndAt() > period.EndAt() {
+				continue
+			}
+
+			if !sch.HasWeekday(weekday) {
+				continue
+			}
+
+			slots = append(slots, timetable.Slot{
+				ScheduleId: sch.Id(),
+				Weekday:    weekday,
+				StartAt:    sch.StartAt(),
+				EndAt:      sch.EndAt(),
+			})
+		}
+	}
+
+	sort.Slice(slots, func(i, j int) bool {
+		if slots[i].Weekday != slots[j].Weekday {
+			return slots[i].Weekday < slots[j].Weekday
+		}
+
+		return slots[i].StartAt < slots[j].StartAt
+	})
+
+	return slots
+}
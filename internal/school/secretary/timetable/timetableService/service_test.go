@@ -0,0 +1,130 @@
+package timetableService
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/mocks"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/curriculum"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schedule"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/teacher"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/timetable"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type scenario struct {
+	schoolYear           *schoolyear.SchoolYear
+	classRoom            *classroom.ClassRoom
+	room                 timetable.Room
+	schedules            []schedule.ScheduleClass
+	matrix               *curriculum.Matrix
+	repository           *mocks.TimetableRepository
+	schoolYearRepository *mocks.SchoolYearRepository
+	classRoomRepository  *mocks.ClassRoomRepository
+	scheduleRepository   *mocks.ScheduleRepository
+	curriculumRepository *mocks.CurriculumRepository
+	teacherRepository    *mocks.TeacherRepository
+}
+
+func TestShouldGenerateTimetableInsideClassRoomPeriod(t *testing.T) {
+	s := getScenario(true)
+	s.repository.On("ReplaceLessons", s.schoolYear.Id(), mock.Anything).Return(nil)
+
+	result, err := s.actions().Generate(timetable.GenerateRequestDto{SchoolYearId: s.schoolYear.Id().String()})
+	assert.NoError(t, err)
+	assert.Empty(t, result.Issues)
+	assert.Len(t, result.Lessons, 4)
+
+	for _, lesson := range result.Lessons {
+		assert.Contains(t, []string{"07:00:00", "07:50:00"}, lesson.Slot().StartAt)
+		assert.Equal(t, s.room.Id, lesson.RoomId())
+	}
+
+	s.repository.AssertCalled(t, "ReplaceLessons", s.schoolYear.Id(), mock.Anything)
+}
+
+func TestShouldReportSubjectWithoutTeacher(t *testing.T) {
+	s := getScenario(false)
+	s.repository.On("ReplaceLessons", s.schoolYear.Id(), mock.Anything).Return(nil)
+
+	result, err := s.actions().Generate(timetable.GenerateRequestDto{SchoolYearId: s.schoolYear.Id().String()})
+	assert.NoError(t, err)
+	assert.Empty(t, result.Lessons)
+	assert.Len(t, result.Issues, 1)
+	assert.Equal(t, "subject has no teacher assigned", result.Issues[0].Message)
+}
+
+func TestShouldNotMoveLessonOverAnotherLesson(t *testing.T) {
+	s := getScenario(true)
+	s.repository.On("ReplaceLessons", s.schoolYear.Id(), mock.Anything).Return(nil)
+
+	result, err := s.actions().Generate(timetable.GenerateRequestDto{SchoolYearId: s.schoolYear.Id().String()})
+	assert.NoError(t, err)
+
+	lesson := result.Lessons[0]
+	other := result.Lessons[1]
+
+	s.repository.On("FindLessons", s.schoolYear.Id()).Return(result.Lessons, nil)
+	s.repository.On("FindLessonById", lesson.Id().String()).Return(&lesson, nil)
+
+	_, err = s.actions().UpdateLesson(lesson.Id().String(), timetable.MoveLessonRequestDto{
+		RoomId:     s.room.Id.String(),
+		ScheduleId: other.Slot().ScheduleId.String(),
+		Weekday:    other.Slot().Weekday,
+	})
+	assert.EqualError(t, err, "lesson conflicts with the timetable: class room already has a lesson at this time, teacher already has a lesson at this time, room already has a lesson at this time")
+	s.repository.AssertNotCalled(t, "UpdateLesson", mock.Anything)
+}
+
+func (s *scenario) actions() *TimetableActions {
+	return New(s.repository, s.schoolYearRepository, s.classRoomRepository, s.scheduleRepository, s.curriculumRepository, s.teacherRepository)
+}
+
+func getScenario(withTeacher bool) *scenario {
+	schoolYear, _ := schoolyear.New("2024", "2024-02-01", "2024-12-15")
+	yearId := schoolYear.Id().String()
+
+	period, _ := schedule.New("Matutino", "07:00:00", "12:00:00", yearId)
+	first, _ := schedule.New("1a aula", "07:00:00", "07:50:00", yearId)
+	second, _ := schedule.New("2a aula", "07:50:00", "08:40:00", yearId)
+	afternoon, _ := schedule.New("1a aula vespertino", "13:00:00", "13:50:00", yearId)
+
+	room := timetable.Room{Id: uuid.New(), Code: "S01", Capacity: 35}
+	clr, _ := classroom.New(30, "morning", "Fundamental", "TUR-001", yearId, room.Id.String(), period.Id().String(), "ANY", "remote")
+
+	subject, _ := curriculum.NewSubject("MAT", "Matematica", 200)
+	matrix, _ := curriculum.NewMatrix("Fundamental", yearId)
+	_ = matrix.AddItem(*subject, 4)
+
+	var assignments []teacher.Assignment
+	if withTeacher {
+		assignments = append(assignments, *teacher.LoadAssignment(uuid.New(), uuid.New(), "Marcos Lima", clr.Id(), "TUR-001", schoolYear.Id(), subject.Id(), "Matematica"))
+	}
+
+	s := &scenario{
+		schoolYear:           schoolYear,
+		classRoom:            clr,
+		room:                 room,
+		schedules:            []schedule.ScheduleClass{*period, *first, *second, *afternoon},
+		matrix:               matrix,
+		repository:           new(mocks.TimetableRepository),
+		schoolYearRepository: new(mocks.SchoolYearRepository),
+		classRoomRepository:  new(mocks.ClassRoomRepository),
+		scheduleRepository:   new(mocks.ScheduleRepository),
+		curriculumRepository: new(mocks.CurriculumRepository),
+		teacherRepository:    new(mocks.TeacherRepository),
+	}
+
+	s.schoolYearRepository.On("FindById", yearId).Return(schoolYear, nil)
+	s.classRoomRepository.On("FindBySchoolYear", schoolYear.Id()).Return([]classroom.ClassRoom{*clr}, nil)
+	s.scheduleRepository.On("FindBySchoolYear", schoolYear.Id()).Return(s.schedules, nil)
+	s.repository.On("FindRooms").Return([]timetable.Room{room}, nil)
+	s.curriculumRepository.On("FindMatrix", "Fundamental", schoolYear.Id()).Return(matrix, nil)
+	s.teacherRepository.On("FindClassRoomAssignments", clr.Id().String()).Return(assignments, nil)
+	s.teacherRepository.On("FindAvailability", mock.Anything).Return([]teacher.Availability(nil), nil)
+
+	return s
+}
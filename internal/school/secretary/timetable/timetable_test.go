@@ -0,0 +1,170 @@
+package timetable
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/teacher"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Run("should generate timetable without conflicts", func(t *testing.T) {
+		teacherId := uuid.New()
+		first := getClassRoom("TUR-001", 30, getSlots(3, 1, 2, 3, 4, 5), getSubject("Matematica", teacherId, 5), getSubject("Historia", uuid.New(), 3))
+		second := getClassRoom("TUR-002", 30, first.Slots, getSubject("Matematica", teacherId, 5), getSubject("Geografia", uuid.New(), 2))
+		rooms := []Room{getRoom("S01", 35), getRoom("S02", 40)}
+
+		grid := NewGrid([]ClassRoom{first, second}, rooms, nil)
+		result := Generate(uuid.New(), grid)
+
+		assert.Empty(t, result.Issues)
+		assert.Len(t, result.Lessons, 15)
+		assert.Empty(t, grid.Validate())
+	})
+
+	t.Run("should spread subject lessons over the week", func(t *testing.T) {
+		classRoom := getClassRoom("TUR-001", 30, getSlots(3, 1, 2, 3, 4, 5), getSubject("Matematica", uuid.New(), 5))
+
+		result := Generate(uuid.New(), NewGrid([]ClassRoom{classRoom}, []Room{getRoom("S01", 35)}, nil))
+
+		weekdays := make(map[int]bool)
+		for _, lesson := range result.Lessons {
+			weekdays[lesson.Slot().Weekday] = true
+		}
+		assert.Len(t, weekdays, 5)
+	})
+
+	t.Run("should respect teacher availability", func(t *testing.T) {
+		teacherId := uuid.New()
+		classRoom := getClassRoom("TUR-001", 30, getSlots(3, 1, 2), getSubject("Matematica", teacherId, 2))
+		availability := map[uuid.UUID][]teacher.Availability{
+			teacherId: {{Weekday: 2, StartAt: "07:00:00", EndAt: "12:00:00"}},
+		}
+
+		result := Generate(uuid.New(), NewGrid([]ClassRoom{classRoom}, []Room{getRoom("S01", 35)}, availability))
+
+		assert.Empty(t, result.Issues)
+		for _, lesson := range result.Lessons {
+			assert.Equal(t, 2, lesson.Slot().Weekday)
+		}
+	})
+
+	t.Run("should report class room without room for its vacancies", func(t *testing.T) {
+		classRoom := getClassRoom("TUR-001", 50, getSlots(3, 1), getSubject("Matematica", uuid.New(), 2))
+
+		result := Generate(uuid.New(), NewGrid([]ClassRoom{classRoom}, []Room{getRoom("S01", 35)}, nil))
+
+		assert.Empty(t, result.Lessons)
+		assert.Equal(t, "no room with capacity for the class room vacancies", result.Issues[0].Message)
+	})
+
+	t.Run("should report lessons that cannot be placed", func(t *testing.T) {
+		teacherId := uuid.New()
+		slots := getSlots(1, 1)
+		first := getClassRoom("TUR-001", 30, slots, getSubject("Matematica", teacherId, 1))
+		second := getClassRoom("TUR-002", 30, slots, getSubject("Matematica", teacherId, 1))
+		rooms := []Room{getRoom("S01", 35), getRoom("S02", 35)}
+
+		result := Generate(uuid.New(), NewGrid([]ClassRoom{first, second}, rooms, nil))
+
+		assert.Len(t, result.Lessons, 1)
+		assert.Len(t, result.Issues, 1)
+		assert.Equal(t, "no free slot for the lesson without conflicts", result.Issues[0].Message)
+	})
+}
+
+func TestGridCheck(t *testing.T) {
+	teacherId := uuid.New()
+	slots := getSlots(2, 1)
+	first := getClassRoom("TUR-001", 30, slots, getSubject("Matematica", teacherId, 2))
+	second := getClassRoom("TUR-002", 30, slots, getSubject("Matematica", teacherId, 2))
+	room := getRoom("S01", 35)
+	small := getRoom("S02", 20)
+
+	t.Run("should refuse teacher and room double booking", func(t *testing.T) {
+		grid := NewGrid([]ClassRoom{first, second}, []Room{room}, nil)
+		grid.Add(*NewLesson(uuid.New(), first, first.Subjects[0], room, slots[0]))
+
+		messages := grid.Check(*NewLesson(uuid.New(), second, second.Subjects[0], room, slots[0]))
+		assert.Contains(t, messages, "teacher already has a lesson at this time")
+		assert.Contains(t, messages, "room already has a lesson at this time")
+	})
+
+	t.Run("should refuse room smaller than class room vacancies", func(t *testing.T) {
+		grid := NewGrid([]ClassRoom{first}, []Room{room, small}, nil)
+
+		messages := grid.Check(*NewLesson(uuid.New(), first, first.Subjects[0], small, slots[0]))
+		assert.Equal(t, []string{"room capacity is less than the class room vacancies"}, messages)
+	})
+
+	t.Run("should refuse teacher outside availability", func(t *testing.T) {
+		availability := map[uuid.UUID][]teacher.Availability{
+			teacherId: {{Weekday: 3, StartAt: "07:00:00", EndAt: "12:00:00"}},
+		}
+		grid := NewGrid([]ClassRoom{first}, []Room{room}, availability)
+
+		messages := grid.Check(*NewLesson(uuid.New(), first, first.Subjects[0], room, slots[0]))
+		assert.Equal(t, []string{"teacher is not available at this time"}, messages)
+	})
+
+	t.Run("should accept moving lesson to a free slot", func(t *testing.T) {
+		grid := NewGrid([]ClassRoom{first}, []Room{room}, nil)
+		lesson := NewLesson(uuid.New(), first, first.Subjects[0], room, slots[0])
+		grid.Add(*lesson)
+		grid.Add(*NewLesson(uuid.New(), first, first.Subjects[0], room, slots[1]))
+
+		lesson.Move(room, slots[1])
+		assert.Contains(t, grid.Check(*lesson), "class room already has a lesson at this time")
+
+		lesson.Move(room, slots[0])
+		assert.Empty(t, grid.Check(*lesson))
+	})
+}
+
+func getClassRoom(identification string, vacancies int, slots []Slot, subjects ...Subject) ClassRoom {
+	return ClassRoom{
+		Id:             uuid.New(),
+		Identification: identification,
+		Vacancies:      vacancies,
+		Slots:          slots,
+		Subjects:       subjects,
+	}
+}
+
+func getSubject(name string, teacherId uuid.UUID, weeklyClasses int) Subject {
+	return Subject{
+		Id:            uuid.New(),
+		Name:          name,
+		TeacherId:     teacherId,
+		Teacher:       "Marcos Lima",
+		WeeklyClasses: weeklyClasses,
+	}
+}
+
+func getRoom(code string, capacity int) Room {
+	return Room{
+		Id:       uuid.New(),
+		Code:     code,
+		Capacity: capacity,
+	}
+}
+
+// getSlots Horarios de 50 minutos a partir das 07:00 nos dias informados
+func getSlots(perDay int, weekdays ...int) []Slot {
+	starts := []string{"07:00:00", "07:50:00", "08:40:00", "09:50:00", "10:40:00"}
+	ends := []string{"07:50:00", "08:40:00", "09:30:00", "10:40:00", "11:30:00"}
+	var ids []uuid.UUID
+	for i := 0; i < perDay; i++ {
+		ids = append(ids, uuid.New())
+	}
+
+	var slots []Slot
+	for _, weekday := range weekdays {
+		for i := 0; i < perDay; i++ {
+			slots = append(slots, Slot{ScheduleId: ids[i], Weekday: weekday, StartAt: starts[i], EndAt: ends[i]})
+		}
+	}
+
+	return slots
+}
@@ -0,0 +1,10 @@
+package waitinglist
+
+import "github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+
+type Repository interface {
+	FindById(id string) (*Candidate, error)
+	FindByClassRoom(classRoomId string, pagination paginator.Pagination) (*paginator.PaginationResult, error)
+	CountAhead(candidate Candidate) (int, error)
+	UpdateStatus(candidate Candidate) error
+}
@@ -0,0 +1,30 @@
+package waitinglist
+
+import (
+	"github.com/go-playground/validator"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+)
+
+type RequestDto struct {
+	ClassRoomId          string             `json:"class_room_id" validate:"required,uuid"`
+	Shift                string             `json:"shift" validate:"required"`
+	Student              student.RequestDto `json:"student"`
+	ServiceId            string             `json:"service_id" validate:"required,uuid"`
+	MonthlyFee           float64            `json:"monthly_fee" validate:"required"`
+	InstallmentsQuantity int                `json:"installments_quantity" validate:"required"`
+	EnrollmentFee        float64            `json:"enrollment_fee"`
+	MonthDuration        int                `json:"month_duration" validate:"required"`
+	PaymentDay           string             `json:"payment_day" validate:"required"`
+	Priority             int                `json:"priority" validate:"min=0"`
+}
+
+func (r *RequestDto) Validate() error {
+	v := validator.New()
+	return v.Struct(r)
+}
+
+type PositionResponse struct {
+	Id          string `json:"id"`
+	ClassRoomId string `json:"class_room_id"`
+	Position    int    `json:"position"`
+}
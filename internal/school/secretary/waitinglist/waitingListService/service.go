@@ -0,0 +1,357 @@
+package waitingListService
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/invoice"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/service"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/waitinglist"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/shared/paginator"
+)
+
+type WaitingListActionsInterface interface {
+	Join(dto waitinglist.RequestDto) (*waitinglist.Candidate, error)
+	FindByClassRoom(classRoomId string, dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error)
+	Position(id string) (*waitinglist.PositionResponse, error)
+	Withdraw(id string) error
+	OfferVacancies(classRoomId string) error
+}
+
+type WaitingListActions struct {
+	serviceRepo    service.Repository
+	classRoomRepo  classroom.Repository
+	schoolYearRepo schoolyear.Repository
+	repository     waitinglist.Repository
+	newUow         registration.RegisterUowFactory
+}
+
+func New(
+	serviceRepo service.Repository,
+	classRoomRepo classroom.Repository,
+	schoolYearRepo schoolyear.Repository,
+	repository waitinglist.Repository,
+	registerUowFactory registration.RegisterUowFactory,
+) *WaitingListActions {
+	return &WaitingListActions{
+		serviceRepo:    serviceRepo,
+		classRoomRepo:  classRoomRepo,
+		schoolYearRepo: schoolYearRepo,
+		repository:     repository,
+		newUow:         registerUowFactory,
+	}
+}
+
+func (w *WaitingListActions) Join(dto waitinglist.RequestDto) (*waitinglist.Candidate, error) {
+	srvce, err := w.serviceRepo.FindById(dto.ServiceId)
+	if err != nil || srvce == nil {
+		log.Println(err)
+		return nil, errors.New("failed to get service information")
+	}
+
+	classRoom, err := w.classRoomRepo.FindById(dto.ClassRoomId)
+	if err != nil || classRoom == nil {
+		log.Println(err)
+		return nil, errors.New("failed to get class room information")
+	}
+
+	if classRoom.VacancyQuantity() > classRoom.OccupiedVacancies() {
+		return nil, errors.New("class room still has free vacancies")
+	}
+
+	stdent, err := student.New(
+		dto.Student.FirstName,
+		dto.Student.LastName,
+		dto.Student.Birthday,
+		dto.Student.RgDocument,
+		dto.Student.CpfDocument,
+		dto.Student.Email,
+		dto.Student.HimSelfResponsible,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = stdent.ChangeDocument(dto.Student.DocumentType, dto.Student.DocumentNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	stdent.AddAddress(dto.Student.Addresses)
+	stdent.AddPhones(dto.Student.Phones)
+
+	err = stdent.AddParents(dto.Student.Parents)
+	if err != nil {
+		return nil, err
+	}
+
+	// As condicoes sao validadas agora para que a oferta da vaga nao falhe no futuro
+	reg, err := registration.New(
+		*classRoom,
+		dto.Shift,
+		*stdent,
+		*srvce,
+		dto.MonthlyFee,
+		dto.InstallmentsQuantity,
+		dto.EnrollmentFee,
+		time.Now().AddDate(0, 0, waitinglist.OfferDueDays).Format("2006-01-02"),
+		dto.MonthDuration,
+		dto.PaymentDay,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = reg.Check()
+	if err != nil {
+		return nil, err
+	}
+
+	uow := w.newUow()
+
+	err = uow.BeginTransaction()
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to join waiting list")
+	}
+
+	studentId, err := uow.StudentAlreadyExists(stdent.Document())
+	if err != nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return nil, errors.New("failed to verify if student already exists")
+	}
+
+	if studentId == nil {
+		err = uow.CreateStudent(*stdent)
+		if err != nil {
+			_ = uow.Rollback()
+			log.Println(err)
+			return nil, errors.New("failed to save student")
+		}
+	} else {
+		err = stdent.ChangeId(studentId.String())
+		if err != nil {
+			_ = uow.Rollback()
+			return nil, err
+		}
+	}
+
+	candidate, err := waitinglist.New(
+		dto.ClassRoomId,
+		*stdent,
+		dto.ServiceId,
+		dto.Shift,
+		dto.MonthlyFee,
+		dto.InstallmentsQuantity,
+		dto.EnrollmentFee,
+		dto.MonthDuration,
+		dto.PaymentDay,
+		dto.Priority,
+	)
+
+	if err != nil {
+		_ = uow.Rollback()
+		return nil, err
+	}
+
+	err = uow.CreateWaitingCandidate(*candidate)
+	if err != nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return nil, errors.New("failed to join waiting list")
+	}
+
+	err = uow.Commit()
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to join waiting list")
+	}
+
+	return candidate, nil
+}
+
+func (w *WaitingListActions) FindByClassRoom(classRoomId string, dtoRequest paginator.PaginatorRequest) (*paginator.PaginationResult, error) {
+	pg := paginator.Pagination{}
+	pg.FillFromDto(dtoRequest)
+
+	candidates, err := w.repository.FindByClassRoom(classRoomId, pg)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to retrieve waiting list")
+	}
+
+	return candidates, nil
+}
+
+func (w *WaitingListActions) Position(id string) (*waitinglist.PositionResponse, error) {
+	candidate, err := w.repository.FindById(id)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to get waiting list candidate")
+	}
+
+	if candidate.Status() != waitinglist.StatusWaiting {
+		return nil, errors.New("candidate is not waiting for a vacancy")
+	}
+
+	ahead, err := w.repository.CountAhead(*candidate)
+	if err != nil {
+		log.Println(err)
+		return nil, errors.New("failed to get waiting list position")
+	}
+
+	return &waitinglist.PositionResponse{
+		Id:          candidate.Id().String(),
+		ClassRoomId: candidate.ClassRoomId().String(),
+		Position:    ahead + 1,
+	}, nil
+}
+
+func (w *WaitingListActions) Withdraw(id string) error {
+	candidate, err := w.repository.FindById(id)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to get waiting list candidate")
+	}
+
+	err = candidate.Withdraw()
+	if err != nil {
+		return err
+	}
+
+	err = w.repository.UpdateStatus(*candidate)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to withdraw waiting list candidate")
+	}
+
+	return nil
+}
+
+// OfferVacancies Oferece as vagas livres da turma aos proximos candidatos da fila,
+// criando uma matricula pendente para cada um deles
+func (w *WaitingListActions) OfferVacancies(classRoomId string) error {
+	uow := w.newUow()
+
+	err := uow.BeginTransaction()
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to offer vacancies")
+	}
+
+	classRoom, err := uow.FindClassRoomLock(classRoomId)
+	if err != nil || classRoom == nil {
+		_ = uow.Rollback()
+		log.Println(err)
+		return errors.New("failed to get class room information")
+	}
+
+	for classRoom.VacancyQuantity() > classRoom.OccupiedVacancies() {
+		candidate, err := uow.FindNextWaitingCandidateLock(classRoom.Id())
+		if err != nil {
+			_ = uow.Rollback()
+			log.Println(err)
+			return errors.New("failed to get next waiting list candidate")
+		}
+
+		if candidate == nil {
+			break
+		}
+
+		err = w.offer(uow, classRoom, candidate)
+		if err != nil {
+			_ = uow.Rollback()
+			return err
+		}
+	}
+
+	err = uow.Commit()
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to offer vacancies")
+	}
+
+	return nil
+}
+
+func (w *WaitingListActions) offer(uow registration.RegisterUow, classRoom *classroom.ClassRoom, candidate *waitinglist.Candidate) error {
+	srvce, err := w.serviceRepo.FindById(candidate.ServiceId().String())
+	if err != nil || srvce == nil {
+		log.Println(err)
+		return errors.New("failed to get service information")
+	}
+
+	reg, err := registration.New(
+		*classRoom,
+		string(candidate.Shift()),
+		*candidate.Student(),
+		*srvce,
+		candidate.MonthlyFee(),
+		candidate.InstallmentsQuantity(),
+		candidate.EnrollmentFee(),
+		candidate.OfferDueDate(time.Now()),
+		candidate.MonthDuration(),
+		candidate.PaymentDay(),
+	)
+
+	if err != nil {
+		return err
+	}
+
+	reg.OfferFromWaitingList()
+
+	err = classRoom.SetOccupiedVacancies(1)
+	if err != nil {
+		return err
+	}
+
+	err = uow.OccupyVacancies(*classRoom)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to update occupied vacancies")
+	}
+
+	err = uow.CreateRegister(*reg)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to create registration")
+	}
+
+	schoolYear, err := w.schoolYearRepo.FindById(classRoom.SchoolYearId().String())
+	if err != nil || schoolYear == nil {
+		log.Println(err)
+		return errors.New("failed to get school year information")
+	}
+
+	invoices, err := invoice.Generate(reg.Billing(), *schoolYear)
+	if err != nil {
+		return err
+	}
+
+	err = uow.CreateInvoices(invoices)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to create invoices")
+	}
+
+	err = candidate.Offer(reg.Id())
+	if err != nil {
+		return err
+	}
+
+	err = uow.UpdateWaitingCandidate(*candidate)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to update waiting list candidate")
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package waitingListService
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/mocks"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/financial/service"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/classroom"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/registration"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/schoolyear"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/waitinglist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestShouldOfferFreeVacancyToNextCandidate(t *testing.T) {
+	classRoom := getClassRoom(2, 1)
+	srvce, _ := service.New("Ensino Fundamental", 4800.00)
+	candidate := getCandidate(classRoom.Id().String(), srvce.Id().String())
+
+	serviceRepo := new(mocks.ServiceRepository)
+	serviceRepo.On("FindById", srvce.Id().String()).Return(srvce, nil)
+
+	schoolYear, _ := schoolyear.New("2023", time.Now().Format("2006-01-02"), time.Now().AddDate(1, 0, 0).Format("2006-01-02"))
+	schoolYearRepo := new(mocks.SchoolYearRepository)
+	schoolYearRepo.On("FindById", classRoom.SchoolYearId().String()).Return(schoolYear, nil)
+
+	var createdRegistration registration.Registration
+
+	uow := new(mocks.RegistrationUowMock)
+	uow.On("BeginTransaction").Return(nil)
+	uow.On("FindClassRoomLock", classRoom.Id().String()).Return(classRoom, nil)
+	uow.On("FindNextWaitingCandidateLock", classRoom.Id()).Return(candidate, nil).Once()
+	uow.On("OccupyVacancies", mock.Anything).Return(nil)
+	uow.On("CreateRegister", mock.Anything).Run(func(args mock.Arguments) {
+		createdRegistration = args.Get(0).(registration.Registration)
+	}).Return(nil)
+	uow.On("CreateInvoices", mock.Anything).Return(nil)
+	uow.On("UpdateWaitingCandidate", mock.Anything).Return(nil)
+	uow.On("Commit").Return(nil)
+
+	actions := New(serviceRepo, nil, schoolYearRepo, nil, func() registration.RegisterUow {
+		return uow
+	})
+
+	err := actions.OfferVacancies(classRoom.Id().String())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, classRoom.OccupiedVacancies())
+	assert.Equal(t, waitinglist.StatusOffered, candidate.Status())
+	assert.Equal(t, createdRegistration.Id(), candidate.RegistrationId().UUID)
+	assert.Equal(t, registration.StatusWaitEnrollmentFee, createdRegistration.Status())
+	assert.Equal(t, candidate.Student().Id(), createdRegistration.Student().Id())
+	uow.AssertNumberOfCalls(t, "FindNextWaitingCandidateLock", 1)
+	uow.AssertCalled(t, "CreateInvoices", mock.Anything)
+}
+
+func TestShouldStopOfferWhenWaitingListIsEmpty(t *testing.T) {
+	classRoom := getClassRoom(3, 1)
+
+	uow := new(mocks.RegistrationUowMock)
+	uow.On("BeginTransaction").Return(nil)
+	uow.On("FindClassRoomLock", classRoom.Id().String()).Return(classRoom, nil)
+	uow.On("FindNextWaitingCandidateLock", classRoom.Id()).Return((*waitinglist.Candidate)(nil), nil)
+	uow.On("Commit").Return(nil)
+
+	actions := New(new(mocks.ServiceRepository), nil, new(mocks.SchoolYearRepository), nil, func() registration.RegisterUow {
+		return uow
+	})
+
+	err := actions.OfferVacancies(classRoom.Id().String())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, classRoom.OccupiedVacancies())
+	uow.AssertNotCalled(t, "CreateRegister", mock.Anything)
+	uow.AssertCalled(t, "Commit")
+}
+
+func getClassRoom(vacancies int, occupied int) *classroom.ClassRoom {
+	clr, _ := classroom.Load(
+		uuid.New().String(),
+		true,
+		"OPEN",
+		occupied,
+		vacancies,
+		"2023-01-01",
+		"morning",
+		"Jardim",
+		"TUR-001",
+		uuid.New().String(),
+		uuid.New().String(),
+		uuid.New().String(),
+		"ANY",
+		"remote",
+	)
+
+	return clr
+}
+
+func getCandidate(classRoomId string, serviceId string) *waitinglist.Candidate {
+	std, _ := student.Load(
+		uuid.New().String(),
+		"Henrique",
+		"Rocha",
+		"1987-09-21",
+		"1452658877",
+		"823.781.140-28",
+		"test@test.com",
+		true,
+	)
+
+	candidate, _ := waitinglist.New(
+		classRoomId,
+		*std,
+		serviceId,
+		"morning",
+		400.00,
+		12,
+		60.00,
+		12,
+		"16",
+		0,
+	)
+
+	return candidate
+}
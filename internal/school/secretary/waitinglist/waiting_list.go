@@ -0,0 +1,324 @@
+package waitinglist
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/value_objects"
+)
+
+const (
+	StatusWaiting   = "WAITING"
+	StatusOffered   = "OFFERED"
+	StatusWithdrawn = "WITHDRAWN"
+)
+
+// OfferDueDays Prazo em dias para pagamento da taxa de matricula quando a vaga e oferecida
+const OfferDueDays = 5
+
+type Candidate struct {
+	id                   uuid.UUID
+	classRoomId          uuid.UUID
+	student              student.Student
+	serviceId            uuid.UUID
+	shift                value_objects.Shift
+	monthlyFee           float64
+	installmentsQuantity int
+	enrollmentFee        float64
+	monthDuration        int
+	paymentDay           string
+	priority             int
+	requestDate          time.Time
+	status               string
+	registrationId       uuid.NullUUID
+}
+
+func New(
+	classRoomId string,
+	student student.Student,
+	serviceId string,
+	shift string,
+	monthlyFee float64,
+	installmentsQuantity int,
+	enrollmentFee float64,
+	monthDuration int,
+	paymentDay string,
+	priority int,
+) (*Candidate, error) {
+
+	c := &Candidate{
+		id:                   uuid.New(),
+		student:              student,
+		monthlyFee:           monthlyFee,
+		installmentsQuantity: installmentsQuantity,
+		enrollmentFee:        enrollmentFee,
+		monthDuration:        monthDuration,
+		paymentDay:           paymentDay,
+		requestDate:          time.Now(),
+		status:               StatusWaiting,
+	}
+
+	err := c.ChangeClassRoomId(classRoomId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.ChangeServiceId(serviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.ChangeShift(shift)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.ChangePriority(priority)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func Load(
+	id string,
+	classRoomId string,
+	student student.Student,
+	serviceId string,
+	shift string,
+	monthlyFee float64,
+	installmentsQuantity int,
+	enrollmentFee float64,
+	monthDuration int,
+	paymentDay string,
+	priority int,
+	requestDate time.Time,
+	status string,
+	registrationId uuid.NullUUID,
+) (*Candidate, error) {
+
+	c, err := New(
+		classRoomId,
+		student,
+		serviceId,
+		shift,
+		monthlyFee,
+		installmentsQuantity,
+		enrollmentFee,
+		monthDuration,
+		paymentDay,
+		priority,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.ChangeId(id)
+	if err != nil {
+		return nil, err
+	}
+
+	c.requestDate = requestDate
+	c.status = status
+	c.registrationId = registrationId
+
+	return c, nil
+}
+
+func (c *Candidate) ChangeId(id string) error {
+	candidateId, err := uuid.Parse(id)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to change waiting list id")
+	}
+
+	c.id = candidateId
+
+	return nil
+}
+
+func (c *Candidate) ChangeClassRoomId(classRoomId string) error {
+	if classRoomId == "" {
+		return errors.New("class room id cannot be empty")
+	}
+
+	id, err := uuid.Parse(classRoomId)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to change class room id")
+	}
+
+	c.classRoomId = id
+
+	return nil
+}
+
+func (c *Candidate) ChangeServiceId(serviceId string) error {
+	if serviceId == "" {
+		return errors.New("service id cannot be empty")
+	}
+
+	id, err := uuid.Parse(serviceId)
+	if err != nil {
+		log.Println(err)
+		return errors.New("failed to change service id")
+	}
+
+	c.serviceId = id
+
+	return nil
+}
+
+func (c *Candidate) ChangeShift(shift string) error {
+	s := value_objects.Shift(shift)
+	err := s.Validate()
+	if err != nil {
+		return err
+	}
+
+	c.shift = s
+
+	return nil
+}
+
+func (c *Candidate) ChangePriority(priority int) error {
+	if priority < 0 {
+		return errors.New("priority cannot be negative")
+	}
+
+	c.priority = priority
+
+	return nil
+}
+
+// Offer Marca o candidato como atendido pela matricula gerada para a vaga liberada
+func (c *Candidate) Offer(registrationId uuid.UUID) error {
+	if c.status != StatusWaiting {
+		return errors.New("candidate is not waiting for a vacancy")
+	}
+
+	c.status = StatusOffered
+	c.registrationId = uuid.NullUUID{
+		UUID:  registrationId,
+		Valid: true,
+	}
+
+	return nil
+}
+
+func (c *Candidate) Withdraw() error {
+	if c.status != StatusWaiting {
+		return errors.New("candidate is not waiting for a vacancy")
+	}
+
+	c.status = StatusWithdrawn
+
+	return nil
+}
+
+// OfferDueDate Data limite para pagamento da taxa de matricula a partir da data informada
+func (c *Candidate) OfferDueDate(from time.Time) string {
+	return from.AddDate(0, 0, OfferDueDays).Format("2006-01-02")
+}
+
+func (c *Candidate) Id() uuid.UUID {
+	return c.id
+}
+
+func (c *Candidate) ClassRoomId() uuid.UUID {
+	return c.classRoomId
+}
+
+func (c *Candidate) Student() *student.Student {
+	return &c.student
+}
+
+func (c *Candidate) ServiceId() uuid.UUID {
+	return c.serviceId
+}
+
+func (c *Candidate) Shift() value_objects.Shift {
+	return c.shift
+}
+
+func (c *Candidate) MonthlyFee() float64 {
+	return c.monthlyFee
+}
+
+func (c *Candidate) InstallmentsQuantity() int {
+	return c.installmentsQuantity
+}
+
+func (c *Candidate) EnrollmentFee() float64 {
+	return c.enrollmentFee
+}
+
+func (c *Candidate) MonthDuration() int {
+	return c.monthDuration
+}
+
+func (c *Candidate) PaymentDay() string {
+	return c.paymentDay
+}
+
+func (c *Candidate) Priority() int {
+	return c.priority
+}
+
+func (c *Candidate) RequestDate() time.Time {
+	return c.requestDate
+}
+
+func (c *Candidate) Status() string {
+	return c.status
+}
+
+func (c *Candidate) RegistrationId() uuid.NullUUID {
+	return c.registrationId
+}
+
+func (c *Candidate) MarshalJSON() ([]byte, error) {
+	var registrationId *string
+	if c.registrationId.Valid {
+		id := c.registrationId.UUID.String()
+		registrationId = &id
+	}
+
+	return json.Marshal(struct {
+		Id                   string          `json:"id"`
+		ClassRoomId          string          `json:"class_room_id"`
+		Student              student.Student `json:"student"`
+		ServiceId            string          `json:"service_id"`
+		Shift                string          `json:"shift"`
+		MonthlyFee           float64         `json:"monthly_fee"`
+		InstallmentsQuantity int             `json:"installments_quantity"`
+		EnrollmentFee        float64         `json:"enrollment_fee"`
+		MonthDuration        int             `json:"month_duration"`
+		PaymentDay           string          `json:"payment_day"`
+		Priority             int             `json:"priority"`
+		RequestDate          string          `json:"request_date"`
+		Status               string          `json:"status"`
+		RegistrationId       *string         `json:"registration_id"`
+	}{
+		Id:                   c.Id().String(),
+		ClassRoomId:          c.ClassRoomId().String(),
+		Student:              *c.Student(),
+		ServiceId:            c.ServiceId().String(),
+		Shift:                string(c.Shift()),
+		MonthlyFee:           c.MonthlyFee(),
+		InstallmentsQuantity: c.InstallmentsQuantity(),
+		EnrollmentFee:        c.EnrollmentFee(),
+		MonthDuration:        c.MonthDuration(),
+		PaymentDay:           c.PaymentDay(),
+		Priority:             c.Priority(),
+		RequestDate:          c.RequestDate().Format("2006-01-02 15:04:05"),
+		Status:               c.Status(),
+		RegistrationId:       registrationId,
+	})
+}
@@ -0,0 +1,75 @@
+package waitinglist
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/henriquerocha2004/sistema-escolar/internal/school/secretary/student"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShouldCreateCandidateWaiting(t *testing.T) {
+	candidate, err := newCandidate(2)
+	assert.NoError(t, err)
+	assert.Equal(t, StatusWaiting, candidate.Status())
+	assert.Equal(t, 2, candidate.Priority())
+	assert.False(t, candidate.RegistrationId().Valid)
+}
+
+func TestShouldReturnErrorWhenPriorityIsNegative(t *testing.T) {
+	_, err := newCandidate(-1)
+	assert.Error(t, err)
+	assert.Equal(t, "priority cannot be negative", err.Error())
+}
+
+func TestShouldOfferVacancyOnlyOnce(t *testing.T) {
+	candidate, _ := newCandidate(0)
+	registrationId := uuid.New()
+
+	err := candidate.Offer(registrationId)
+	assert.NoError(t, err)
+	assert.Equal(t, StatusOffered, candidate.Status())
+	assert.Equal(t, registrationId, candidate.RegistrationId().UUID)
+
+	err = candidate.Offer(uuid.New())
+	assert.Error(t, err)
+
+	err = candidate.Withdraw()
+	assert.Error(t, err)
+}
+
+func TestShouldWithdrawCandidate(t *testing.T) {
+	candidate, _ := newCandidate(0)
+
+	err := candidate.Withdraw()
+	assert.NoError(t, err)
+	assert.Equal(t, StatusWithdrawn, candidate.Status())
+
+	err = candidate.Offer(uuid.New())
+	assert.Error(t, err)
+}
+
+func newCandidate(priority int) (*Candidate, error) {
+	std, _ := student.New(
+		"Henrique",
+		"Rocha",
+		"1987-09-21",
+		"1452658877",
+		"823.781.140-28",
+		"test@test.com",
+		true,
+	)
+
+	return New(
+		uuid.New().String(),
+		*std,
+		uuid.New().String(),
+		"morning",
+		400.00,
+		12,
+		60.00,
+		12,
+		"16",
+		priority,
+	)
+}
@@ -0,0 +1,68 @@
+package value_objects
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+const (
+	DocumentCpf              = "CPF"
+	DocumentRnm              = "RNM"
+	DocumentPassport         = "PASSPORT"
+	DocumentBirthCertificate = "BIRTH_CERTIFICATE"
+)
+
+var (
+	rnmFormat              = regexp.MustCompile(`^[A-Z][0-9]{6}[0-9A-Z]$`)
+	passportFormat         = regexp.MustCompile(`^[A-Z0-9]{6,9}$`)
+	birthCertificateFormat = regexp.MustCompile(`^[0-9]{32}$`)
+)
+
+// Document Documento de identificacao. Alem do CPF aceita RNE/RNM de estrangeiros, passaporte e a
+// matricula da certidao de nascimento
+type Document struct {
+	Type   string
+	Number string
+}
+
+// Validate Valida o numero conforme o tipo do documento. O numero e normalizado sem pontuacao e
+// em maiusculas
+func (d *Document) Validate() error {
+	if d.Number == "" {
+		return errors.New("empty document number provided")
+	}
+
+	d.clean()
+
+	switch d.Type {
+	case DocumentCpf:
+		cpf := CPF(d.Number)
+		err := cpf.Validate()
+		if err != nil {
+			return err
+		}
+		d.Number = string(cpf)
+	case DocumentRnm:
+		if !rnmFormat.MatchString(d.Number) {
+			return errors.New("rnm must be one letter, six digits and the check character")
+		}
+	case DocumentPassport:
+		if !passportFormat.MatchString(d.Number) {
+			return errors.New("passport must have 6 to 9 letters or digits")
+		}
+	case DocumentBirthCertificate:
+		if !birthCertificateFormat.MatchString(d.Number) {
+			return errors.New("birth certificate registration must be 32 digits")
+		}
+	default:
+		return errors.New("invalid document type provided")
+	}
+
+	return nil
+}
+
+func (d *Document) clean() {
+	regex := regexp.MustCompile(`[.\-/\s]`)
+	d.Number = strings.ToUpper(regex.ReplaceAllString(d.Number, ""))
+}
@@ -0,0 +1,53 @@
+package value_objects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDocument(t *testing.T) {
+	t.Run("should validate cpf through the cpf rules", func(t *testing.T) {
+		doc := Document{Type: DocumentCpf, Number: "823.781.140-28"}
+		assert.NoError(t, doc.Validate())
+		assert.Equal(t, "82378114028", doc.Number)
+
+		doc = Document{Type: DocumentCpf, Number: "823.781.140-29"}
+		assert.EqualError(t, doc.Validate(), "invalid cpf")
+	})
+
+	t.Run("should normalize and validate rnm", func(t *testing.T) {
+		doc := Document{Type: DocumentRnm, Number: "v123456-k"}
+		assert.NoError(t, doc.Validate())
+		assert.Equal(t, "V123456K", doc.Number)
+
+		doc = Document{Type: DocumentRnm, Number: "1234567"}
+		assert.EqualError(t, doc.Validate(), "rnm must be one letter, six digits and the check character")
+	})
+
+	t.Run("should validate passport", func(t *testing.T) {
+		doc := Document{Type: DocumentPassport, Number: "ab123456"}
+		assert.NoError(t, doc.Validate())
+		assert.Equal(t, "AB123456", doc.Number)
+
+		doc = Document{Type: DocumentPassport, Number: "AB12"}
+		assert.Error(t, doc.Validate())
+	})
+
+	t.Run("should validate birth certificate registration", func(t *testing.T) {
+		doc := Document{Type: DocumentBirthCertificate, Number: "104539 01 55 2015 1 00012 021 0012345-99"}
+		assert.NoError(t, doc.Validate())
+		assert.Equal(t, "10453901552015100012021001234599", doc.Number)
+
+		doc = Document{Type: DocumentBirthCertificate, Number: "12345"}
+		assert.EqualError(t, doc.Validate(), "birth certificate registration must be 32 digits")
+	})
+
+	t.Run("should refuse unknown type and empty number", func(t *testing.T) {
+		doc := Document{Type: "RG", Number: "123456"}
+		assert.EqualError(t, doc.Validate(), "invalid document type provided")
+
+		doc = Document{Type: DocumentPassport}
+		assert.EqualError(t, doc.Validate(), "empty document number provided")
+	})
+}